@@ -0,0 +1,47 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+)
+
+// PermissionAdmin grants access to support and back-office operations
+const PermissionAdmin = "admin"
+
+// HasPermission reports whether the authenticated caller's token carries permission.
+func HasPermission(ctx context.Context, permission string) bool {
+	permissions, _ := ctx.Value(PermissionsKey).([]string)
+	return slices.Contains(permissions, permission)
+}
+
+// RequirePermission returns a connect error unless the caller is authenticated and holds permission:
+// CodeUnauthenticated without claims, CodePermissionDenied without the permission.
+func RequirePermission(ctx context.Context, permission string) error {
+	if _, ok := GetUserClaims(ctx); !ok {
+		return connect.NewError(connect.CodeUnauthenticated, errors.New("authentication required"))
+	}
+	if !HasPermission(ctx, permission) {
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("missing %q permission", permission))
+	}
+	return nil
+}
+
+// GetServiceName reports which service the caller authenticated as, if it used a service token.
+func GetServiceName(ctx context.Context) (string, bool) {
+	claims, ok := GetUserClaims(ctx)
+	if !ok {
+		return "", false
+	}
+	return strings.CutPrefix(claims.Sub, ServiceSubjectPrefix)
+}
+
+// IsServiceCaller reports whether the caller authenticated with a service token.
+func IsServiceCaller(ctx context.Context) bool {
+	_, ok := GetServiceName(ctx)
+	return ok
+}
@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	authv1 "github.com/floroz/gavel/pkg/proto/auth/v1"
+)
+
+func TestRequirePermission(t *testing.T) {
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		wantCode connect.Code // 0 means allowed
+	}{
+		{name: "Anonymous", ctx: context.Background(), wantCode: connect.CodeUnauthenticated},
+		{name: "No permissions", ctx: withClaims(context.Background(), &Claims{TokenClaims: &authv1.TokenClaims{Sub: "user"}}, ""), wantCode: connect.CodePermissionDenied},
+		{
+			name:     "Other permission",
+			ctx:      withClaims(context.Background(), &Claims{TokenClaims: &authv1.TokenClaims{Sub: "user", Permissions: []string{"read:bids"}}}, ""),
+			wantCode: connect.CodePermissionDenied,
+		},
+		{name: "Admin", ctx: withClaims(context.Background(), &Claims{TokenClaims: &authv1.TokenClaims{Sub: "user", Permissions: []string{PermissionAdmin}}}, "")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := RequirePermission(tt.ctx, PermissionAdmin)
+			if tt.wantCode == 0 {
+				if err != nil {
+					t.Fatalf("Expected access, got %v", err)
+				}
+				return
+			}
+			if got := connect.CodeOf(err); got != tt.wantCode {
+				t.Errorf("Expected code %v, got %v (err: %v)", tt.wantCode, got, err)
+			}
+		})
+	}
+}
@@ -3,10 +3,12 @@ package auth
 import (
 	"crypto/rand"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"strings"
 
 	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
 )
 
 // Default params for Argon2id
@@ -19,13 +21,19 @@ const (
 )
 
 // HashPassword hashes a password using Argon2id.
+// It fails with ErrPasswordHashBusy if the hash can't start in time; see SetPasswordHashLimit.
 func HashPassword(password string) (string, error) {
 	salt := make([]byte, saltLen)
 	if _, err := rand.Read(salt); err != nil {
 		return "", err
 	}
 
-	hash := argon2.IDKey([]byte(password), salt, argonTime, argonMemory, argonThreads, keyLen)
+	hash, err := limitedHash(func() []byte {
+		return argon2.IDKey([]byte(password), salt, argonTime, argonMemory, argonThreads, keyLen)
+	})
+	if err != nil {
+		return "", err
+	}
 
 	// Format: $argon2id$v=19$m=65536,t=1,p=4$salt$hash
 	b64Salt := base64.RawStdEncoding.EncodeToString(salt)
@@ -35,8 +43,32 @@ func HashPassword(password string) (string, error) {
 		argon2.Version, argonMemory, argonTime, argonThreads, b64Salt, b64Hash), nil
 }
 
+// isBcryptHash reports whether encodedHash is a bcrypt hash, as imported from older systems
+func isBcryptHash(encodedHash string) bool {
+	return strings.HasPrefix(encodedHash, "$2a$") || strings.HasPrefix(encodedHash, "$2b$")
+}
+
+// NeedsRehash reports whether encodedHash is in a legacy format (bcrypt) that should be
+// replaced with a HashPassword hash the next time the plaintext password is known.
+func NeedsRehash(encodedHash string) bool {
+	return isBcryptHash(encodedHash)
+}
+
 // VerifyPassword compares a password with an Argon2id hash.
+// bcrypt hashes of migrated users are verified too; see NeedsRehash.
+// Argon2 verification shares HashPassword's concurrency limit.
 func VerifyPassword(encodedHash, password string) (bool, error) {
+	if isBcryptHash(encodedHash) {
+		err := bcrypt.CompareHashAndPassword([]byte(encodedHash), []byte(password))
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return false, nil
+		}
+		if err != nil {
+			return false, fmt.Errorf("invalid bcrypt hash: %w", err)
+		}
+		return true, nil
+	}
+
 	parts := strings.Split(encodedHash, "$")
 	if len(parts) != 6 {
 		return false, fmt.Errorf("invalid hash format")
@@ -70,7 +102,12 @@ func VerifyPassword(encodedHash, password string) (bool, error) {
 	}
 
 	keyLength := uint32(len(decodedHash))
-	comparisonHash := argon2.IDKey([]byte(password), salt, time, memory, threads, keyLength)
+	comparisonHash, err := limitedHash(func() []byte {
+		return argon2.IDKey([]byte(password), salt, time, memory, threads, keyLength)
+	})
+	if err != nil {
+		return false, err
+	}
 
 	if len(decodedHash) != len(comparisonHash) {
 		return false, nil
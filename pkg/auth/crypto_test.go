@@ -3,6 +3,8 @@ package auth
 import (
 	"strings"
 	"testing"
+
+	"golang.org/x/crypto/bcrypt"
 )
 
 func TestHashPassword(t *testing.T) {
@@ -161,3 +163,46 @@ func TestVerifyPassword_EdgeCases(t *testing.T) {
 		})
 	}
 }
+
+func TestVerifyPassword_Bcrypt(t *testing.T) {
+	password := "migrated-secret"
+
+	for _, prefix := range []string{"$2a$", "$2b$"} {
+		t.Run(prefix, func(t *testing.T) {
+			generated, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+			if err != nil {
+				t.Fatalf("bcrypt failed: %v", err)
+			}
+			// The library emits $2a$; $2b$ hashes from other systems differ only in the prefix
+			hash := prefix + strings.TrimPrefix(string(generated), "$2a$")
+
+			ok, err := VerifyPassword(hash, password)
+			if err != nil || !ok {
+				t.Errorf("VerifyPassword(correct) = %v, %v; want true, nil", ok, err)
+			}
+			ok, err = VerifyPassword(hash, "wrong-password")
+			if err != nil || ok {
+				t.Errorf("VerifyPassword(wrong) = %v, %v; want false, nil", ok, err)
+			}
+			if !NeedsRehash(hash) {
+				t.Error("NeedsRehash should report bcrypt hashes")
+			}
+		})
+	}
+
+	t.Run("argon2id hashes do not need a rehash", func(t *testing.T) {
+		hash, err := HashPassword(password)
+		if err != nil {
+			t.Fatalf("HashPassword failed: %v", err)
+		}
+		if NeedsRehash(hash) {
+			t.Error("NeedsRehash should not report argon2id hashes")
+		}
+	})
+
+	t.Run("corrupt bcrypt hash is an error", func(t *testing.T) {
+		if _, err := VerifyPassword("$2b$10$short", password); err == nil {
+			t.Error("VerifyPassword should fail on a truncated bcrypt hash")
+		}
+	})
+}
@@ -0,0 +1,104 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"sync/atomic"
+	"time"
+)
+
+// Environment variables read by PasswordHashLimitFromEnv
+const (
+	EnvPasswordHashConcurrency = "PASSWORD_HASH_CONCURRENCY"
+	EnvPasswordHashWait        = "PASSWORD_HASH_WAIT"
+)
+
+// DefaultPasswordHashConcurrency caps Argon2 memory at 1 GB (16 hashes of argonMemory each)
+const DefaultPasswordHashConcurrency = 16
+
+// DefaultPasswordHashWait is how long a hash waits for a free slot before failing
+const DefaultPasswordHashWait = 5 * time.Second
+
+// ErrPasswordHashBusy is returned by HashPassword and VerifyPassword when no hashing slot
+// freed up in time. The request can be retried.
+var ErrPasswordHashBusy = errors.New("too many concurrent password hashes")
+
+// hashLimiter is a semaphore bounding concurrent Argon2 hashes, since each allocates
+// argonMemory and a burst of logins would otherwise multiply that without limit
+type hashLimiter struct {
+	slots chan struct{}
+	wait  time.Duration
+}
+
+func newHashLimiter(maxConcurrent int, wait time.Duration) *hashLimiter {
+	return &hashLimiter{slots: make(chan struct{}, maxConcurrent), wait: wait}
+}
+
+// acquire takes a slot, waiting up to l.wait for one to free up
+func (l *hashLimiter) acquire() error {
+	select {
+	case l.slots <- struct{}{}:
+		return nil
+	default:
+	}
+
+	timer := time.NewTimer(l.wait)
+	defer timer.Stop()
+	select {
+	case l.slots <- struct{}{}:
+		return nil
+	case <-timer.C:
+		return ErrPasswordHashBusy
+	}
+}
+
+func (l *hashLimiter) release() {
+	<-l.slots
+}
+
+var passwordHashLimiter atomic.Pointer[hashLimiter]
+
+func init() {
+	passwordHashLimiter.Store(newHashLimiter(DefaultPasswordHashConcurrency, DefaultPasswordHashWait))
+}
+
+// SetPasswordHashLimit allows at most maxConcurrent Argon2 hashes at once across
+// HashPassword and VerifyPassword. Calls beyond that wait up to wait for a slot, then fail
+// with ErrPasswordHashBusy. Hashes already running finish under the previous limit.
+func SetPasswordHashLimit(maxConcurrent int, wait time.Duration) {
+	passwordHashLimiter.Store(newHashLimiter(maxConcurrent, wait))
+}
+
+// PasswordHashLimitFromEnv reads the SetPasswordHashLimit arguments from the environment,
+// falling back to the defaults for unset variables
+func PasswordHashLimitFromEnv() (int, time.Duration, error) {
+	maxConcurrent, wait := DefaultPasswordHashConcurrency, DefaultPasswordHashWait
+
+	if v := os.Getenv(EnvPasswordHashConcurrency); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			return 0, 0, fmt.Errorf("invalid %s %q: must be a positive integer", EnvPasswordHashConcurrency, v)
+		}
+		maxConcurrent = n
+	}
+	if v := os.Getenv(EnvPasswordHashWait); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < 0 {
+			return 0, 0, fmt.Errorf("invalid %s %q: must be a non-negative duration", EnvPasswordHashWait, v)
+		}
+		wait = d
+	}
+	return maxConcurrent, wait, nil
+}
+
+// limitedHash runs hash once a slot under the current limit is free
+func limitedHash(hash func() []byte) ([]byte, error) {
+	limiter := passwordHashLimiter.Load()
+	if err := limiter.acquire(); err != nil {
+		return nil, err
+	}
+	defer limiter.release()
+	return hash(), nil
+}
@@ -0,0 +1,136 @@
+package auth
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestHashLimiter_CapsConcurrency(t *testing.T) {
+	limiter := newHashLimiter(2, time.Second)
+
+	var running, peak atomic.Int32
+	release := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := limiter.acquire(); err != nil {
+				t.Errorf("acquire failed: %v", err)
+				return
+			}
+			defer limiter.release()
+
+			n := running.Add(1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			<-release
+			running.Add(-1)
+		}()
+	}
+
+	// Let the goroutines pile up on the semaphore, then drain them
+	time.Sleep(50 * time.Millisecond)
+	if got := running.Load(); got != 2 {
+		t.Errorf("expected 2 hashes running while the rest wait, got %d", got)
+	}
+	close(release)
+	wg.Wait()
+
+	if got := peak.Load(); got != 2 {
+		t.Errorf("expected at most 2 concurrent hashes, peak was %d", got)
+	}
+}
+
+func TestHashLimiter_WaiterProceedsWhenSlotFrees(t *testing.T) {
+	limiter := newHashLimiter(1, time.Second)
+	if err := limiter.acquire(); err != nil {
+		t.Fatalf("acquire failed: %v", err)
+	}
+
+	acquired := make(chan error, 1)
+	go func() { acquired <- limiter.acquire() }()
+
+	select {
+	case <-acquired:
+		t.Fatal("waiter acquired a slot while none was free")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	limiter.release()
+	select {
+	case err := <-acquired:
+		if err != nil {
+			t.Errorf("waiter failed after a slot freed: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("waiter did not proceed after a slot freed")
+	}
+}
+
+func TestHashLimiter_WaitTimesOut(t *testing.T) {
+	limiter := newHashLimiter(1, 20*time.Millisecond)
+	if err := limiter.acquire(); err != nil {
+		t.Fatalf("acquire failed: %v", err)
+	}
+
+	if err := limiter.acquire(); !errors.Is(err, ErrPasswordHashBusy) {
+		t.Errorf("expected ErrPasswordHashBusy, got %v", err)
+	}
+}
+
+func TestHashPassword_BusyWhenLimitReached(t *testing.T) {
+	SetPasswordHashLimit(1, 20*time.Millisecond)
+	t.Cleanup(func() { SetPasswordHashLimit(DefaultPasswordHashConcurrency, DefaultPasswordHashWait) })
+
+	hash, err := HashPassword("supersecret123")
+	if err != nil {
+		t.Fatalf("HashPassword failed: %v", err)
+	}
+
+	// Hold the only slot
+	limiter := passwordHashLimiter.Load()
+	if err := limiter.acquire(); err != nil {
+		t.Fatalf("acquire failed: %v", err)
+	}
+
+	if _, err := HashPassword("supersecret123"); !errors.Is(err, ErrPasswordHashBusy) {
+		t.Errorf("HashPassword: expected ErrPasswordHashBusy, got %v", err)
+	}
+	if _, err := VerifyPassword(hash, "supersecret123"); !errors.Is(err, ErrPasswordHashBusy) {
+		t.Errorf("VerifyPassword: expected ErrPasswordHashBusy, got %v", err)
+	}
+
+	limiter.release()
+	if ok, err := VerifyPassword(hash, "supersecret123"); err != nil || !ok {
+		t.Errorf("VerifyPassword after release = %v, %v; want true, nil", ok, err)
+	}
+}
+
+func TestPasswordHashLimitFromEnv(t *testing.T) {
+	t.Setenv(EnvPasswordHashConcurrency, "")
+	t.Setenv(EnvPasswordHashWait, "")
+	n, wait, err := PasswordHashLimitFromEnv()
+	if err != nil || n != DefaultPasswordHashConcurrency || wait != DefaultPasswordHashWait {
+		t.Errorf("defaults = %d, %v, %v", n, wait, err)
+	}
+
+	t.Setenv(EnvPasswordHashConcurrency, "4")
+	t.Setenv(EnvPasswordHashWait, "2s")
+	n, wait, err = PasswordHashLimitFromEnv()
+	if err != nil || n != 4 || wait != 2*time.Second {
+		t.Errorf("configured = %d, %v, %v", n, wait, err)
+	}
+
+	t.Setenv(EnvPasswordHashConcurrency, "0")
+	if _, _, err := PasswordHashLimitFromEnv(); err == nil {
+		t.Error("expected an error for zero concurrency")
+	}
+}
@@ -0,0 +1,55 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+)
+
+// MinRSAKeyBits is the smallest RSA modulus GenerateKeyPair accepts.
+const MinRSAKeyBits = 2048
+
+// GenerateKeyPair creates a new RSA keypair PEM-encoded in the formats NewSigner reads:
+// PKCS8 for the private key and PKIX for the public key.
+func GenerateKeyPair(bits int) (privateKeyPEM, publicKeyPEM []byte, err error) {
+	if bits < MinRSAKeyBits {
+		return nil, nil, fmt.Errorf("RSA key size must be at least %d bits, got %d", MinRSAKeyBits, bits)
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, bits)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate RSA key: %w", err)
+	}
+
+	privBytes, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal private key: %w", err)
+	}
+	pubBytes, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal public key: %w", err)
+	}
+
+	privateKeyPEM = pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privBytes})
+	publicKeyPEM = pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pubBytes})
+	return privateKeyPEM, publicKeyPEM, nil
+}
+
+// KeyID returns a stable identifier for a PEM-encoded public key:
+// the unpadded base64url SHA-256 of its DER encoding.
+func KeyID(publicKeyPEM []byte) (string, error) {
+	block, _ := pem.Decode(publicKeyPEM)
+	if block == nil {
+		return "", errors.New("failed to parse public key PEM")
+	}
+	if _, err := x509.ParsePKIXPublicKey(block.Bytes); err != nil {
+		return "", fmt.Errorf("failed to parse public key: %w", err)
+	}
+	sum := sha256.Sum256(block.Bytes)
+	return base64.RawURLEncoding.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestGenerateKeyPair(t *testing.T) {
+	privPEM, pubPEM, err := GenerateKeyPair(MinRSAKeyBits)
+	if err != nil {
+		t.Fatalf("GenerateKeyPair failed: %v", err)
+	}
+
+	signer, err := NewSigner(privPEM, pubPEM, "test-issuer", nil)
+	if err != nil {
+		t.Fatalf("NewSigner rejected generated keys: %v", err)
+	}
+
+	userID := uuid.New()
+	pair, err := signer.GenerateTokens(userID, "keygen@example.com", "Key Gen", nil)
+	if err != nil {
+		t.Fatalf("GenerateTokens failed: %v", err)
+	}
+
+	// A validate-only signer built from the public key alone must accept the token
+	verifier, err := NewSignerFromPublicKey(pubPEM, "test-issuer", nil)
+	if err != nil {
+		t.Fatalf("NewSignerFromPublicKey rejected generated key: %v", err)
+	}
+	claims, err := verifier.ValidateToken(pair.AccessToken)
+	if err != nil {
+		t.Fatalf("ValidateToken failed: %v", err)
+	}
+	if claims.Sub != userID.String() {
+		t.Errorf("Expected sub %s, got %s", userID, claims.Sub)
+	}
+}
+
+func TestGenerateKeyPair_RejectsWeakKeys(t *testing.T) {
+	if _, _, err := GenerateKeyPair(1024); err == nil {
+		t.Fatal("Expected error for 1024-bit key, got nil")
+	}
+}
+
+func TestKeyID(t *testing.T) {
+	_, pubA, err := GenerateKeyPair(MinRSAKeyBits)
+	if err != nil {
+		t.Fatalf("GenerateKeyPair failed: %v", err)
+	}
+	_, pubB, err := GenerateKeyPair(MinRSAKeyBits)
+	if err != nil {
+		t.Fatalf("GenerateKeyPair failed: %v", err)
+	}
+
+	kidA, err := KeyID(pubA)
+	if err != nil {
+		t.Fatalf("KeyID failed: %v", err)
+	}
+	again, _ := KeyID(pubA)
+	kidB, _ := KeyID(pubB)
+
+	if kidA != again {
+		t.Errorf("KeyID is not stable: %s != %s", kidA, again)
+	}
+	if kidA == kidB {
+		t.Errorf("Different keys produced the same KeyID %s", kidA)
+	}
+
+	if _, err := KeyID([]byte("not a pem")); err == nil {
+		t.Error("Expected error for invalid PEM, got nil")
+	}
+}
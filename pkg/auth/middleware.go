@@ -16,6 +16,7 @@ const (
 	UserClaimsKey  contextKey = "user_claims"
 	UserIDKey      contextKey = "user_id"
 	PermissionsKey contextKey = "permissions"
+	AccessTokenKey contextKey = "access_token"
 )
 
 // NewAuthInterceptor creates a ConnectRPC interceptor for authentication.
@@ -37,13 +38,7 @@ func NewAuthInterceptor(signer *Signer) connect.UnaryInterceptorFunc {
 				return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("invalid or expired token"))
 			}
 
-			// Inject info into context
-			ctx = context.WithValue(ctx, UserClaimsKey, claims)
-			ctx = context.WithValue(ctx, UserIDKey, claims.Sub)
-			// We will use this for the permissions check later in Phase 2
-			ctx = context.WithValue(ctx, PermissionsKey, claims.Permissions)
-
-			return next(ctx, req)
+			return next(withClaims(ctx, claims, token), req)
 		}
 	}
 }
@@ -54,7 +49,14 @@ func NewAuthInterceptorWithPublicRoutes(signer *Signer, publicRoutes map[string]
 	return func(next connect.UnaryFunc) connect.UnaryFunc {
 		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
 			// Check if this is a public route
+			// A valid token is still honored so handlers can identify the caller if present
 			if publicRoutes[req.Spec().Procedure] {
+				authHeader := req.Header().Get(tokenHeader)
+				if token, ok := strings.CutPrefix(authHeader, tokenPrefix); ok {
+					if claims, err := signer.ValidateToken(token); err == nil {
+						ctx = withClaims(ctx, claims, token)
+					}
+				}
 				return next(ctx, req)
 			}
 
@@ -74,16 +76,19 @@ func NewAuthInterceptorWithPublicRoutes(signer *Signer, publicRoutes map[string]
 				return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("invalid or expired token"))
 			}
 
-			// Inject info into context
-			ctx = context.WithValue(ctx, UserClaimsKey, claims)
-			ctx = context.WithValue(ctx, UserIDKey, claims.Sub)
-			ctx = context.WithValue(ctx, PermissionsKey, claims.Permissions)
-
-			return next(ctx, req)
+			return next(withClaims(ctx, claims, token), req)
 		}
 	}
 }
 
+// withClaims injects the validated claims and the token they came from into the context
+func withClaims(ctx context.Context, claims *Claims, token string) context.Context {
+	ctx = context.WithValue(ctx, UserClaimsKey, claims)
+	ctx = context.WithValue(ctx, UserIDKey, claims.Sub)
+	ctx = context.WithValue(ctx, AccessTokenKey, token)
+	return context.WithValue(ctx, PermissionsKey, claims.Permissions)
+}
+
 // GetUserClaims retrieves the full claims from the context.
 func GetUserClaims(ctx context.Context) (*Claims, bool) {
 	claims, ok := ctx.Value(UserClaimsKey).(*Claims)
@@ -105,3 +110,24 @@ func MustGetUserID(ctx context.Context) string {
 	}
 	return id
 }
+
+// GetAccessToken retrieves the validated access token the caller authenticated with.
+func GetAccessToken(ctx context.Context) (string, bool) {
+	token, ok := ctx.Value(AccessTokenKey).(string)
+	return token, ok && token != ""
+}
+
+// NewForwardTokenInterceptor is a client interceptor that sends the caller's access token
+// on outgoing requests, so a service calling others on a user's behalf is authorized
+// downstream exactly as that user. Requests that already carry a token, or that are made
+// outside an authenticated handler, are left alone.
+func NewForwardTokenInterceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if token, ok := GetAccessToken(ctx); ok && req.Header().Get(tokenHeader) == "" {
+				req.Header().Set(tokenHeader, tokenPrefix+token)
+			}
+			return next(ctx, req)
+		}
+	}
+}
@@ -10,7 +10,7 @@ import (
 
 func TestAuthMiddleware(t *testing.T) {
 	privPEM, pubPEM := generateTestKeys(t) // Reusing helper from token_test.go
-	signer, _ := NewSigner(privPEM, pubPEM, "test-issuer")
+	signer, _ := NewSigner(privPEM, pubPEM, "test-issuer", nil)
 
 	// Generate a valid token
 	userID := uuid.New()
@@ -50,3 +50,99 @@ func TestAuthMiddleware(t *testing.T) {
 		t.Error("Expected error for bad header format, got nil")
 	}
 }
+
+func TestAuthMiddleware_PublicRoutes(t *testing.T) {
+	privPEM, pubPEM := generateTestKeys(t)
+	signer, _ := NewSigner(privPEM, pubPEM, "test-issuer", nil)
+
+	userID := uuid.New()
+	pair, _ := signer.GenerateTokens(userID, "user@example.com", "User", nil)
+
+	// Requests built outside a server have an empty procedure
+	interceptor := NewAuthInterceptorWithPublicRoutes(signer, map[string]bool{"": true})
+
+	var gotID string
+	var gotOK bool
+	dummyHandler := func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		gotID, gotOK = GetUserID(ctx)
+		return connect.NewResponse(&struct{}{}), nil
+	}
+
+	// 1. Anonymous request is allowed without identity
+	_, err := interceptor(dummyHandler)(context.Background(), connect.NewRequest(&struct{}{}))
+	if err != nil {
+		t.Errorf("Unexpected error on anonymous public request: %v", err)
+	}
+	if gotOK {
+		t.Error("Expected no UserID for anonymous request")
+	}
+
+	// 2. Valid token on a public route identifies the caller
+	req := connect.NewRequest(&struct{}{})
+	req.Header().Set("Authorization", "Bearer "+pair.AccessToken)
+	_, err = interceptor(dummyHandler)(context.Background(), req)
+	if err != nil {
+		t.Errorf("Unexpected error on authenticated public request: %v", err)
+	}
+	if !gotOK || gotID != userID.String() {
+		t.Errorf("Context missing correct UserID. Got %v, want %s", gotID, userID)
+	}
+
+	// 3. Invalid token on a public route is ignored rather than rejected
+	reqBad := connect.NewRequest(&struct{}{})
+	reqBad.Header().Set("Authorization", "Bearer not-a-token")
+	_, err = interceptor(dummyHandler)(context.Background(), reqBad)
+	if err != nil {
+		t.Errorf("Unexpected error on public request with invalid token: %v", err)
+	}
+	if gotOK {
+		t.Error("Expected no UserID for invalid token")
+	}
+}
+
+func TestForwardTokenInterceptor(t *testing.T) {
+	privPEM, pubPEM := generateTestKeys(t)
+	signer, _ := NewSigner(privPEM, pubPEM, "test-issuer", nil)
+	pair, _ := signer.GenerateTokens(uuid.New(), "user@example.com", "User", nil)
+
+	// Capture the context an authenticated handler would see
+	var handlerCtx context.Context
+	capture := func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		handlerCtx = ctx
+		return connect.NewResponse(&struct{}{}), nil
+	}
+	in := connect.NewRequest(&struct{}{})
+	in.Header().Set("Authorization", "Bearer "+pair.AccessToken)
+	if _, err := NewAuthInterceptor(signer)(capture)(context.Background(), in); err != nil {
+		t.Fatalf("Unexpected error authenticating: %v", err)
+	}
+
+	var sent string
+	downstream := func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		sent = req.Header().Get("Authorization")
+		return connect.NewResponse(&struct{}{}), nil
+	}
+	forward := NewForwardTokenInterceptor()
+
+	// 1. Outgoing calls carry the caller's token
+	if _, err := forward(downstream)(handlerCtx, connect.NewRequest(&struct{}{})); err != nil {
+		t.Fatalf("Unexpected error forwarding: %v", err)
+	}
+	if sent != "Bearer "+pair.AccessToken {
+		t.Errorf("Expected the caller's token to be forwarded, got %q", sent)
+	}
+
+	// 2. An explicit token is not overwritten
+	out := connect.NewRequest(&struct{}{})
+	out.Header().Set("Authorization", "Bearer explicit")
+	_, _ = forward(downstream)(handlerCtx, out)
+	if sent != "Bearer explicit" {
+		t.Errorf("Expected the explicit token to be kept, got %q", sent)
+	}
+
+	// 3. Without an authenticated caller nothing is added
+	_, _ = forward(downstream)(context.Background(), connect.NewRequest(&struct{}{}))
+	if sent != "" {
+		t.Errorf("Expected no Authorization header, got %q", sent)
+	}
+}
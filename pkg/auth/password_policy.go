@@ -0,0 +1,119 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Reasons a password can fail PasswordPolicy.Validate. Validate wraps every failed rule,
+// so callers can check each with errors.Is.
+var (
+	ErrPasswordTooShort       = errors.New("password is too short")
+	ErrPasswordNeedsMixedCase = errors.New("password must contain upper and lower case letters")
+	ErrPasswordNeedsDigit     = errors.New("password must contain a digit")
+	ErrPasswordNeedsSymbol    = errors.New("password must contain a symbol")
+	ErrPasswordTooCommon      = errors.New("password is too common")
+)
+
+// Environment variables read by PasswordPolicyFromEnv
+const (
+	EnvPasswordMinLength        = "PASSWORD_MIN_LENGTH"
+	EnvPasswordRequireMixedCase = "PASSWORD_REQUIRE_MIXED_CASE"
+	EnvPasswordRequireDigit     = "PASSWORD_REQUIRE_DIGIT"
+	EnvPasswordRequireSymbol    = "PASSWORD_REQUIRE_SYMBOL"
+	EnvPasswordDenyCommon       = "PASSWORD_DENY_COMMON" // "true" rejects CommonPasswords
+)
+
+// DefaultPasswordMinLength is the minimum length of the default policy
+const DefaultPasswordMinLength = 8
+
+// CommonPasswords is a short denylist of the most frequently breached passwords
+var CommonPasswords = []string{
+	"12345678", "123456789", "1234567890", "password", "password1", "password123",
+	"qwertyuiop", "qwerty123", "iloveyou", "11111111", "00000000", "abc12345",
+	"abcd1234", "sunshine", "princess", "football", "baseball", "welcome1",
+	"admin123", "letmein1", "passw0rd", "trustno1", "superman", "1q2w3e4r",
+}
+
+// PasswordPolicy describes the rules a new password must satisfy.
+// Length is counted in characters, not bytes.
+type PasswordPolicy struct {
+	MinLength        int // values below 1 are treated as 1, so no policy accepts an empty password
+	RequireMixedCase bool
+	RequireDigit     bool
+	RequireSymbol    bool
+	Denylist         []string // compared case-insensitively
+}
+
+// DefaultPasswordPolicy only enforces a minimum length of 8
+func DefaultPasswordPolicy() PasswordPolicy {
+	return PasswordPolicy{MinLength: DefaultPasswordMinLength}
+}
+
+// PasswordPolicyFromEnv starts from DefaultPasswordPolicy and applies the PASSWORD_* variables
+func PasswordPolicyFromEnv() (PasswordPolicy, error) {
+	policy := DefaultPasswordPolicy()
+
+	if v := os.Getenv(EnvPasswordMinLength); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			return policy, fmt.Errorf("invalid %s %q: must be a positive integer", EnvPasswordMinLength, v)
+		}
+		policy.MinLength = n
+	}
+	policy.RequireMixedCase = os.Getenv(EnvPasswordRequireMixedCase) == "true"
+	policy.RequireDigit = os.Getenv(EnvPasswordRequireDigit) == "true"
+	policy.RequireSymbol = os.Getenv(EnvPasswordRequireSymbol) == "true"
+	if os.Getenv(EnvPasswordDenyCommon) == "true" {
+		policy.Denylist = CommonPasswords
+	}
+	return policy, nil
+}
+
+// Validate returns nil if password satisfies the policy, otherwise an error wrapping
+// one ErrPassword* reason per failed rule.
+func (p PasswordPolicy) Validate(password string) error {
+	var errs []error
+
+	minLength := max(p.MinLength, 1)
+	if n := utf8.RuneCountInString(password); n < minLength {
+		errs = append(errs, fmt.Errorf("%w: must be at least %d characters", ErrPasswordTooShort, minLength))
+	}
+
+	var hasUpper, hasLower, hasDigit, hasSymbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r):
+			hasSymbol = true
+		}
+	}
+	if p.RequireMixedCase && !(hasUpper && hasLower) {
+		errs = append(errs, ErrPasswordNeedsMixedCase)
+	}
+	if p.RequireDigit && !hasDigit {
+		errs = append(errs, ErrPasswordNeedsDigit)
+	}
+	if p.RequireSymbol && !hasSymbol {
+		errs = append(errs, ErrPasswordNeedsSymbol)
+	}
+
+	for _, denied := range p.Denylist {
+		if strings.EqualFold(password, denied) {
+			errs = append(errs, ErrPasswordTooCommon)
+			break
+		}
+	}
+
+	return errors.Join(errs...)
+}
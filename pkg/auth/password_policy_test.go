@@ -0,0 +1,132 @@
+package auth
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPasswordPolicy_Validate(t *testing.T) {
+	strict := PasswordPolicy{
+		MinLength:        10,
+		RequireMixedCase: true,
+		RequireDigit:     true,
+		RequireSymbol:    true,
+	}
+
+	tests := []struct {
+		name     string
+		policy   PasswordPolicy
+		password string
+		wantErrs []error
+	}{
+		{name: "Default accepts 8 characters", policy: DefaultPasswordPolicy(), password: "abcdefgh"},
+		{name: "Default rejects 7 characters", policy: DefaultPasswordPolicy(), password: "abcdefg", wantErrs: []error{ErrPasswordTooShort}},
+		{name: "Length counts characters not bytes", policy: DefaultPasswordPolicy(), password: "ééééééé", wantErrs: []error{ErrPasswordTooShort}},
+		{name: "Strict accepts compliant password", policy: strict, password: "Correct-Horse1"},
+		{name: "Missing upper case", policy: strict, password: "correct-horse1", wantErrs: []error{ErrPasswordNeedsMixedCase}},
+		{name: "Missing lower case", policy: strict, password: "CORRECT-HORSE1", wantErrs: []error{ErrPasswordNeedsMixedCase}},
+		{name: "Missing digit", policy: strict, password: "Correct-Horse", wantErrs: []error{ErrPasswordNeedsDigit}},
+		{name: "Missing symbol", policy: strict, password: "CorrectHorse1", wantErrs: []error{ErrPasswordNeedsSymbol}},
+		{
+			name:     "Reports every failed rule",
+			policy:   strict,
+			password: "short",
+			wantErrs: []error{ErrPasswordTooShort, ErrPasswordNeedsMixedCase, ErrPasswordNeedsDigit, ErrPasswordNeedsSymbol},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.Validate(tt.password)
+			if len(tt.wantErrs) == 0 {
+				if err != nil {
+					t.Fatalf("Expected no error, got %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("Expected errors %v, got nil", tt.wantErrs)
+			}
+			for _, want := range tt.wantErrs {
+				if !errors.Is(err, want) {
+					t.Errorf("Expected error to wrap %q, got %v", want, err)
+				}
+			}
+		})
+	}
+}
+
+func TestPasswordPolicy_ZeroValueRejectsEmpty(t *testing.T) {
+	var policy PasswordPolicy
+
+	if err := policy.Validate(""); !errors.Is(err, ErrPasswordTooShort) {
+		t.Errorf("Validate(\"\") = %v, want ErrPasswordTooShort", err)
+	}
+	if err := policy.Validate("x"); err != nil {
+		t.Errorf("Validate(\"x\") = %v, want nil", err)
+	}
+}
+
+func TestPasswordPolicy_Denylist(t *testing.T) {
+	policy := DefaultPasswordPolicy()
+	policy.Denylist = CommonPasswords
+
+	tests := []struct {
+		password string
+		denied   bool
+	}{
+		{password: "password123", denied: true},
+		{password: "PASSWORD123", denied: true},
+		{password: "Passw0rd", denied: true},
+		{password: "12345678", denied: true},
+		{password: "password1234", denied: false},
+		{password: "unusual-phrase", denied: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.password, func(t *testing.T) {
+			err := policy.Validate(tt.password)
+			if got := errors.Is(err, ErrPasswordTooCommon); got != tt.denied {
+				t.Errorf("Validate(%q) denied = %v, want %v (err: %v)", tt.password, got, tt.denied, err)
+			}
+		})
+	}
+}
+
+func TestPasswordPolicyFromEnv(t *testing.T) {
+	t.Run("Defaults", func(t *testing.T) {
+		policy, err := PasswordPolicyFromEnv()
+		if err != nil {
+			t.Fatalf("PasswordPolicyFromEnv failed: %v", err)
+		}
+		if policy.MinLength != DefaultPasswordMinLength || policy.RequireDigit || len(policy.Denylist) != 0 {
+			t.Errorf("Expected default policy, got %+v", policy)
+		}
+	})
+
+	t.Run("Applies settings", func(t *testing.T) {
+		t.Setenv(EnvPasswordMinLength, "12")
+		t.Setenv(EnvPasswordRequireMixedCase, "true")
+		t.Setenv(EnvPasswordRequireDigit, "true")
+		t.Setenv(EnvPasswordRequireSymbol, "true")
+		t.Setenv(EnvPasswordDenyCommon, "true")
+
+		policy, err := PasswordPolicyFromEnv()
+		if err != nil {
+			t.Fatalf("PasswordPolicyFromEnv failed: %v", err)
+		}
+		if policy.MinLength != 12 || !policy.RequireMixedCase || !policy.RequireDigit || !policy.RequireSymbol {
+			t.Errorf("Settings not applied: %+v", policy)
+		}
+		if len(policy.Denylist) != len(CommonPasswords) {
+			t.Errorf("Expected common password denylist, got %d entries", len(policy.Denylist))
+		}
+	})
+
+	t.Run("Rejects invalid length", func(t *testing.T) {
+		t.Setenv(EnvPasswordMinLength, "zero")
+		if _, err := PasswordPolicyFromEnv(); err == nil {
+			t.Error("Expected error for invalid min length, got nil")
+		}
+	})
+}
@@ -0,0 +1,141 @@
+package auth
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"github.com/floroz/gavel/pkg/clock/clocktest"
+)
+
+func TestServiceToken_PassesInterceptor(t *testing.T) {
+	privPEM, pubPEM := generateTestKeys(t)
+	signer, _ := NewSigner(privPEM, pubPEM, "test-issuer", nil)
+
+	token, _, err := signer.GenerateServiceToken("bid-service", []string{"users:read"}, time.Minute)
+	if err != nil {
+		t.Fatalf("GenerateServiceToken failed: %v", err)
+	}
+
+	var seen context.Context
+	handler := func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		seen = ctx
+		return connect.NewResponse(&struct{}{}), nil
+	}
+	req := connect.NewRequest(&struct{}{})
+	req.Header().Set("Authorization", "Bearer "+token)
+
+	if _, err := NewAuthInterceptor(signer)(handler)(context.Background(), req); err != nil {
+		t.Fatalf("service token was rejected: %v", err)
+	}
+
+	if name, ok := GetServiceName(seen); !ok || name != "bid-service" {
+		t.Errorf("GetServiceName = %q, %v; want bid-service, true", name, ok)
+	}
+	if !HasPermission(seen, "users:read") {
+		t.Error("service token should carry the users:read permission")
+	}
+	if HasPermission(seen, PermissionAdmin) {
+		t.Error("service token should not carry permissions it was not minted with")
+	}
+	claims, _ := GetUserClaims(seen)
+	if claims.Role != RoleService {
+		t.Errorf("got role %q, want %q", claims.Role, RoleService)
+	}
+}
+
+func TestServiceToken_UserTokensAreNotServices(t *testing.T) {
+	privPEM, pubPEM := generateTestKeys(t)
+	signer, _ := NewSigner(privPEM, pubPEM, "test-issuer", nil)
+	pair, _ := signer.GenerateTokens(uuid.New(), "user@example.com", "User", nil)
+	claims, _ := signer.ValidateToken(pair.AccessToken)
+
+	if IsServiceCaller(withClaims(context.Background(), claims, pair.AccessToken)) {
+		t.Error("a user token must not be treated as a service token")
+	}
+	if IsServiceCaller(context.Background()) {
+		t.Error("an anonymous caller must not be treated as a service")
+	}
+}
+
+func TestServiceToken_Validation(t *testing.T) {
+	privPEM, pubPEM := generateTestKeys(t)
+	signer, _ := NewSigner(privPEM, pubPEM, "test-issuer", nil)
+
+	for _, name := range []string{"", "bid service", "service:bid"} {
+		if _, _, err := signer.GenerateServiceToken(name, nil, time.Minute); err == nil {
+			t.Errorf("expected service name %q to be rejected", name)
+		}
+	}
+
+	verifier, _ := NewSignerFromPublicKey(pubPEM, "test-issuer", nil)
+	if _, _, err := verifier.GenerateServiceToken("bid-service", nil, time.Minute); err == nil {
+		t.Error("a validate-only signer must not mint service tokens")
+	}
+}
+
+func TestServiceTokenSource_RefreshesBeforeExpiry(t *testing.T) {
+	privPEM, pubPEM := generateTestKeys(t)
+	clk := clocktest.NewFake(time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC))
+	signer, _ := NewSigner(privPEM, pubPEM, "test-issuer", clk)
+	source := NewServiceTokenSource(signer, "bid-service", []string{"users:read"}, 10*time.Minute)
+	ctx := context.Background()
+
+	first, err := source.Token(ctx)
+	if err != nil {
+		t.Fatalf("Token failed: %v", err)
+	}
+
+	clk.Advance(7 * time.Minute)
+	if again, _ := source.Token(ctx); again != first {
+		t.Error("token should be reused while most of its lifetime remains")
+	}
+
+	clk.Advance(2 * time.Minute)
+	refreshed, _ := source.Token(ctx)
+	if refreshed == first {
+		t.Fatal("token should be replaced when close to expiry")
+	}
+	claims, err := signer.ValidateToken(refreshed)
+	if err != nil {
+		t.Fatalf("refreshed token does not validate: %v", err)
+	}
+	if !slices.Equal(claims.Permissions, []string{"users:read"}) {
+		t.Errorf("got permissions %v", claims.Permissions)
+	}
+}
+
+func TestFileTokenSource(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "service-token")
+	source := NewFileTokenSource(path)
+	ctx := context.Background()
+
+	if _, err := source.Token(ctx); err == nil {
+		t.Error("expected an error for a missing file")
+	}
+
+	if err := os.WriteFile(path, []byte("first-token\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := source.Token(ctx); err != nil || got != "first-token" {
+		t.Fatalf("Token = %q, %v; want first-token", got, err)
+	}
+
+	// Rotation: a rewritten file is picked up on the next call
+	if err := os.WriteFile(path, []byte("second-token\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	later := time.Now().Add(time.Minute)
+	if err := os.Chtimes(path, later, later); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := source.Token(ctx); got != "second-token" {
+		t.Errorf("Token = %q after rotation, want second-token", got)
+	}
+}
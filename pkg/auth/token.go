@@ -13,6 +13,7 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 
+	"github.com/floroz/gavel/pkg/clock"
 	authv1 "github.com/floroz/gavel/pkg/proto/auth/v1"
 )
 
@@ -60,10 +61,12 @@ type Signer struct {
 	privateKey *rsa.PrivateKey
 	publicKey  *rsa.PublicKey
 	issuer     string
+	clock      clock.Clock // stamps iat/exp and decides expiry
 }
 
 // NewSigner creates a Signer from PEM-encoded keys (for auth-service that signs tokens).
-func NewSigner(privateKeyPEM, publicKeyPEM []byte, issuer string) (*Signer, error) {
+// A nil clk uses the wall clock.
+func NewSigner(privateKeyPEM, publicKeyPEM []byte, issuer string, clk clock.Clock) (*Signer, error) {
 	block, _ := pem.Decode(privateKeyPEM)
 	if block == nil {
 		return nil, errors.New("failed to parse private key PEM")
@@ -100,12 +103,13 @@ func NewSigner(privateKeyPEM, publicKeyPEM []byte, issuer string) (*Signer, erro
 		privateKey: priv,
 		publicKey:  rsaPub,
 		issuer:     issuer,
+		clock:      clock.OrReal(clk),
 	}, nil
 }
 
 // NewSignerFromPublicKey creates a Signer with only the public key (for services that only validate tokens).
-// This signer cannot generate tokens, only validate them.
-func NewSignerFromPublicKey(publicKeyPEM []byte, issuer string) (*Signer, error) {
+// This signer cannot generate tokens, only validate them. A nil clk uses the wall clock.
+func NewSignerFromPublicKey(publicKeyPEM []byte, issuer string, clk clock.Clock) (*Signer, error) {
 	blockPub, _ := pem.Decode(publicKeyPEM)
 	if blockPub == nil {
 		return nil, errors.New("failed to parse public key PEM")
@@ -123,12 +127,43 @@ func NewSignerFromPublicKey(publicKeyPEM []byte, issuer string) (*Signer, error)
 		privateKey: nil, // No private key - cannot sign tokens
 		publicKey:  rsaPub,
 		issuer:     issuer,
+		clock:      clock.OrReal(clk),
 	}, nil
 }
 
+// SelfTest checks that the signer's keys actually work, for services to call at startup.
+// A signer with a private key signs a throwaway token and validates it against its public key,
+// which catches a key pair that parses but does not match. A validate-only signer has nothing
+// to sign with, so only its public key is checked.
+func (s *Signer) SelfTest() error {
+	if s.publicKey == nil {
+		return errors.New("signer self-test failed: no public key")
+	}
+	if s.privateKey == nil {
+		return nil
+	}
+	if err := s.privateKey.Validate(); err != nil {
+		return fmt.Errorf("signer self-test failed: invalid private key: %w", err)
+	}
+
+	subject := uuid.New()
+	pair, err := s.GenerateTokens(subject, "self-test@invalid", "Self Test", nil)
+	if err != nil {
+		return fmt.Errorf("signer self-test failed: %w", err)
+	}
+	claims, err := s.ValidateToken(pair.AccessToken)
+	if err != nil {
+		return fmt.Errorf("signer self-test failed: a freshly signed token does not validate, is the public key from the same pair as the private key? %w", err)
+	}
+	if claims.Sub != subject.String() {
+		return errors.New("signer self-test failed: validated token has the wrong subject")
+	}
+	return nil
+}
+
 // GenerateTokens creates an access token (JWT) and a refresh token (random string).
 func (s *Signer) GenerateTokens(userID uuid.UUID, email, fullName string, permissions []string) (*TokenPair, error) {
-	now := time.Now()
+	now := s.clock.Now()
 	accessExpiry := now.Add(15 * time.Minute)
 
 	claims := &Claims{
@@ -170,7 +205,7 @@ func (s *Signer) ValidateToken(tokenString string) (*Claims, error) {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
 		return s.publicKey, nil
-	})
+	}, jwt.WithTimeFunc(s.clock.Now))
 
 	if err != nil {
 		return nil, err
@@ -5,6 +5,7 @@ import (
 	"crypto/rsa"
 	"crypto/x509"
 	"encoding/pem"
+	"errors"
 	"strings"
 	"testing"
 	"time"
@@ -12,6 +13,7 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 
+	"github.com/floroz/gavel/pkg/clock/clocktest"
 	authv1 "github.com/floroz/gavel/pkg/proto/auth/v1"
 )
 
@@ -43,7 +45,7 @@ func generateTestKeys(t *testing.T) ([]byte, []byte) {
 
 func TestTokenLifecycle(t *testing.T) {
 	privPEM, pubPEM := generateTestKeys(t)
-	signer, err := NewSigner(privPEM, pubPEM, "test-issuer")
+	signer, err := NewSigner(privPEM, pubPEM, "test-issuer", nil)
 	if err != nil {
 		t.Fatalf("NewSigner failed: %v", err)
 	}
@@ -74,9 +76,37 @@ func TestTokenLifecycle(t *testing.T) {
 	}
 }
 
+func TestTokenExpiryUsesClock(t *testing.T) {
+	privPEM, pubPEM := generateTestKeys(t)
+	clk := clocktest.NewFake(time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC))
+	signer, err := NewSigner(privPEM, pubPEM, "test-issuer", clk)
+	if err != nil {
+		t.Fatalf("NewSigner failed: %v", err)
+	}
+
+	pair, err := signer.GenerateTokens(uuid.New(), "test@example.com", "Test User", nil)
+	if err != nil {
+		t.Fatalf("GenerateTokens failed: %v", err)
+	}
+	if want := clk.Now().Add(15 * time.Minute); !pair.AccessExpiry.Equal(want) {
+		t.Errorf("got access expiry %v, want %v", pair.AccessExpiry, want)
+	}
+
+	// Issued against a clock far in the past, so wall time alone would reject it
+	clk.Advance(14 * time.Minute)
+	if _, err := signer.ValidateToken(pair.AccessToken); err != nil {
+		t.Fatalf("token should still be valid by the signer's clock: %v", err)
+	}
+
+	clk.Advance(2 * time.Minute)
+	if _, err := signer.ValidateToken(pair.AccessToken); !errors.Is(err, jwt.ErrTokenExpired) {
+		t.Errorf("got %v, want jwt.ErrTokenExpired", err)
+	}
+}
+
 func TestSecurityScenarios(t *testing.T) {
 	privPEM, pubPEM := generateTestKeys(t)
-	signer, _ := NewSigner(privPEM, pubPEM, "test-issuer")
+	signer, _ := NewSigner(privPEM, pubPEM, "test-issuer", nil)
 
 	// Valid claims for reuse
 	validClaims := &Claims{
@@ -161,9 +191,50 @@ func TestNewSignerValidation(t *testing.T) {
 	_, pubPEM := generateTestKeys(t)
 
 	t.Run("Fails on invalid private key", func(t *testing.T) {
-		_, err := NewSigner([]byte("not-a-pem"), pubPEM, "test-issuer")
+		_, err := NewSigner([]byte("not-a-pem"), pubPEM, "test-issuer", nil)
 		if err == nil {
 			t.Error("Should fail on invalid private key")
 		}
 	})
 }
+
+func TestSignerSelfTest(t *testing.T) {
+	privPEM, pubPEM := generateTestKeys(t)
+
+	t.Run("Passes for a matching pair", func(t *testing.T) {
+		signer, err := NewSigner(privPEM, pubPEM, "test-issuer", nil)
+		if err != nil {
+			t.Fatalf("Failed to create signer: %v", err)
+		}
+		if err := signer.SelfTest(); err != nil {
+			t.Errorf("SelfTest() = %v, want nil", err)
+		}
+	})
+
+	t.Run("Detects a mismatched pair", func(t *testing.T) {
+		_, otherPubPEM := generateTestKeys(t)
+
+		// Both keys parse, so construction succeeds; only signing reveals the mismatch
+		signer, err := NewSigner(privPEM, otherPubPEM, "test-issuer", nil)
+		if err != nil {
+			t.Fatalf("Failed to create signer: %v", err)
+		}
+		err = signer.SelfTest()
+		if err == nil {
+			t.Fatal("SelfTest() should fail for a mismatched key pair")
+		}
+		if !strings.Contains(err.Error(), "same pair") {
+			t.Errorf("SelfTest() error = %q, want it to point at the key pair", err)
+		}
+	})
+
+	t.Run("Validate-only signer checks its public key", func(t *testing.T) {
+		signer, err := NewSignerFromPublicKey(pubPEM, "test-issuer", nil)
+		if err != nil {
+			t.Fatalf("Failed to create signer: %v", err)
+		}
+		if err := signer.SelfTest(); err != nil {
+			t.Errorf("SelfTest() = %v, want nil", err)
+		}
+	})
+}
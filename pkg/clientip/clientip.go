@@ -0,0 +1,82 @@
+// Package clientip derives the address of the client behind a request from the
+// connection and, for deployments behind proxies, the X-Forwarded-For header.
+package clientip
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+
+	"connectrpc.com/connect"
+)
+
+// EnvTrustedProxies is the number of proxies in front of the service that append to
+// X-Forwarded-For. Zero, the default, ignores the header entirely.
+const EnvTrustedProxies = "TRUSTED_PROXY_COUNT"
+
+const forwardedForHeader = "X-Forwarded-For"
+
+type contextKey struct{}
+
+// TrustedProxiesFromEnv reads EnvTrustedProxies, defaulting to zero
+func TrustedProxiesFromEnv() (int, error) {
+	v := os.Getenv(EnvTrustedProxies)
+	if v == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid %s %q: must be a non-negative integer", EnvTrustedProxies, v)
+	}
+	return n, nil
+}
+
+// Resolve returns the client IP for a request that arrived from remoteAddr with the
+// given X-Forwarded-For values. Each trusted proxy appends the address it received the
+// request from, so the client is the entry trustedProxies places from the right; anything
+// to its left was sent by the client and can't be trusted. If the header is shorter than
+// that, the request bypassed the proxies and the remote address is used.
+func Resolve(forwardedFor []string, remoteAddr string, trustedProxies int) string {
+	if trustedProxies > 0 {
+		var hops []string
+		for _, value := range forwardedFor {
+			for _, hop := range strings.Split(value, ",") {
+				hops = append(hops, strings.TrimSpace(hop))
+			}
+		}
+		if len(hops) >= trustedProxies {
+			if ip := net.ParseIP(hops[len(hops)-trustedProxies]); ip != nil {
+				return ip.String()
+			}
+		}
+	}
+
+	if host, _, err := net.SplitHostPort(remoteAddr); err == nil {
+		return host
+	}
+	return remoteAddr
+}
+
+// NewInterceptor resolves the client IP of every request and stores it in the context
+func NewInterceptor(trustedProxies int) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			ip := Resolve(req.Header().Values(forwardedForHeader), req.Peer().Addr, trustedProxies)
+			return next(WithIP(ctx, ip), req)
+		}
+	}
+}
+
+// WithIP returns a copy of ctx carrying ip as the client IP
+func WithIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, contextKey{}, ip)
+}
+
+// FromContext returns the client IP stored by NewInterceptor, or "" outside of it
+func FromContext(ctx context.Context) string {
+	ip, _ := ctx.Value(contextKey{}).(string)
+	return ip
+}
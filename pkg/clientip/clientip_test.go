@@ -0,0 +1,130 @@
+package clientip
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestResolve(t *testing.T) {
+	tests := []struct {
+		name           string
+		forwardedFor   []string
+		remoteAddr     string
+		trustedProxies int
+		want           string
+	}{
+		{
+			name:       "direct connection",
+			remoteAddr: "203.0.113.7:51234",
+			want:       "203.0.113.7",
+		},
+		{
+			name:         "direct connection ignores a forged header",
+			forwardedFor: []string{"198.51.100.1"},
+			remoteAddr:   "203.0.113.7:51234",
+			want:         "203.0.113.7",
+		},
+		{
+			name:           "single proxy",
+			forwardedFor:   []string{"203.0.113.7"},
+			remoteAddr:     "10.0.0.2:443",
+			trustedProxies: 1,
+			want:           "203.0.113.7",
+		},
+		{
+			name:           "spoofed header behind a single proxy",
+			forwardedFor:   []string{"198.51.100.1, 203.0.113.7"},
+			remoteAddr:     "10.0.0.2:443",
+			trustedProxies: 1,
+			want:           "203.0.113.7",
+		},
+		{
+			name:           "spoofed header behind two proxies",
+			forwardedFor:   []string{"198.51.100.1, 203.0.113.7", "10.0.0.1"},
+			remoteAddr:     "10.0.0.2:443",
+			trustedProxies: 2,
+			want:           "203.0.113.7",
+		},
+		{
+			name:           "header shorter than the proxy chain",
+			remoteAddr:     "203.0.113.7:51234",
+			trustedProxies: 1,
+			want:           "203.0.113.7",
+		},
+		{
+			name:           "malformed entry",
+			forwardedFor:   []string{"not-an-ip"},
+			remoteAddr:     "10.0.0.2:443",
+			trustedProxies: 1,
+			want:           "10.0.0.2",
+		},
+		{
+			name:           "ipv6 client",
+			forwardedFor:   []string{"2001:db8::1"},
+			remoteAddr:     "[::1]:443",
+			trustedProxies: 1,
+			want:           "2001:db8::1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Resolve(tt.forwardedFor, tt.remoteAddr, tt.trustedProxies))
+		})
+	}
+}
+
+func TestInterceptor(t *testing.T) {
+	const procedure = "/test.v1.TestService/Echo"
+
+	var seen string
+	mux := http.NewServeMux()
+	mux.Handle(procedure, connect.NewUnaryHandler(procedure,
+		func(ctx context.Context, _ *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error) {
+			seen = FromContext(ctx)
+			return connect.NewResponse(&emptypb.Empty{}), nil
+		},
+		connect.WithInterceptors(NewInterceptor(1)),
+	))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client := connect.NewClient[emptypb.Empty, emptypb.Empty](server.Client(), server.URL+procedure)
+
+	t.Run("uses the forwarded address", func(t *testing.T) {
+		req := connect.NewRequest(&emptypb.Empty{})
+		req.Header().Set("X-Forwarded-For", "198.51.100.1, 203.0.113.7")
+		_, err := client.CallUnary(context.Background(), req)
+		require.NoError(t, err)
+		assert.Equal(t, "203.0.113.7", seen)
+	})
+
+	t.Run("falls back to the remote address", func(t *testing.T) {
+		_, err := client.CallUnary(context.Background(), connect.NewRequest(&emptypb.Empty{}))
+		require.NoError(t, err)
+		assert.Equal(t, "127.0.0.1", seen)
+	})
+}
+
+func TestTrustedProxiesFromEnv(t *testing.T) {
+	n, err := TrustedProxiesFromEnv()
+	require.NoError(t, err)
+	assert.Zero(t, n)
+
+	t.Setenv(EnvTrustedProxies, "2")
+	n, err = TrustedProxiesFromEnv()
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	for _, v := range []string{"-1", "two"} {
+		t.Setenv(EnvTrustedProxies, v)
+		_, err = TrustedProxiesFromEnv()
+		assert.ErrorContains(t, err, EnvTrustedProxies)
+	}
+}
@@ -0,0 +1,15 @@
+// Package auth is a typed client for the auth service, for other services to call it with.
+package auth
+
+import (
+	"connectrpc.com/connect"
+
+	"github.com/floroz/gavel/pkg/clients"
+	"github.com/floroz/gavel/pkg/proto/auth/v1/authv1connect"
+)
+
+// NewClient returns an auth service client at baseURL that authenticates with tokens (nil sends
+// no token) and returns *clients.Error for failed calls. opts are applied after the defaults.
+func NewClient(httpClient connect.HTTPClient, baseURL string, tokens clients.TokenSource, opts ...connect.ClientOption) authv1connect.AuthServiceClient {
+	return authv1connect.NewAuthServiceClient(httpClient, baseURL, append([]connect.ClientOption{clients.WithServiceAuth(tokens)}, opts...)...)
+}
@@ -0,0 +1,15 @@
+// Package bid is a typed client for the bid service, for other services to call it with.
+package bid
+
+import (
+	"connectrpc.com/connect"
+
+	"github.com/floroz/gavel/pkg/clients"
+	"github.com/floroz/gavel/pkg/proto/bids/v1/bidsv1connect"
+)
+
+// NewClient returns a bid service client at baseURL that authenticates with tokens (nil sends
+// no token) and returns *clients.Error for failed calls. opts are applied after the defaults.
+func NewClient(httpClient connect.HTTPClient, baseURL string, tokens clients.TokenSource, opts ...connect.ClientOption) bidsv1connect.BidServiceClient {
+	return bidsv1connect.NewBidServiceClient(httpClient, baseURL, append([]connect.ClientOption{clients.WithServiceAuth(tokens)}, opts...)...)
+}
@@ -0,0 +1,100 @@
+// Package clients holds what the typed service clients in its subpackages share:
+// attaching a service-to-service token to every call and turning Connect errors
+// into errors callers can match with errors.Is.
+package clients
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+)
+
+// TokenSource supplies the bearer token sent with each call
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// StaticToken is a TokenSource that always returns the same token
+type StaticToken string
+
+// Token returns t
+func (t StaticToken) Token(context.Context) (string, error) { return string(t), nil }
+
+// Errors a client call can be matched against with errors.Is, one per Connect code
+// that callers are expected to handle. Other codes only match the *connect.Error.
+var (
+	ErrInvalidArgument    = errors.New("invalid argument")
+	ErrNotFound           = errors.New("not found")
+	ErrAlreadyExists      = errors.New("already exists")
+	ErrPermissionDenied   = errors.New("permission denied")
+	ErrUnauthenticated    = errors.New("unauthenticated")
+	ErrFailedPrecondition = errors.New("failed precondition")
+	ErrResourceExhausted  = errors.New("resource exhausted")
+	ErrUnavailable        = errors.New("service unavailable")
+)
+
+var codeErrors = map[connect.Code]error{
+	connect.CodeInvalidArgument:    ErrInvalidArgument,
+	connect.CodeNotFound:           ErrNotFound,
+	connect.CodeAlreadyExists:      ErrAlreadyExists,
+	connect.CodePermissionDenied:   ErrPermissionDenied,
+	connect.CodeUnauthenticated:    ErrUnauthenticated,
+	connect.CodeFailedPrecondition: ErrFailedPrecondition,
+	connect.CodeResourceExhausted:  ErrResourceExhausted,
+	connect.CodeUnavailable:        ErrUnavailable,
+	connect.CodeDeadlineExceeded:   ErrUnavailable,
+}
+
+// Error is a failed call. It matches the sentinel for its code and still unwraps to the
+// *connect.Error, so connect.CodeOf keeps working.
+type Error struct {
+	Procedure string
+	Err       *connect.Error
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.Procedure, e.Err.Error())
+}
+
+func (e *Error) Unwrap() []error {
+	if sentinel, ok := codeErrors[e.Err.Code()]; ok {
+		return []error{sentinel, e.Err}
+	}
+	return []error{e.Err}
+}
+
+// WithServiceAuth returns the client option every typed client is built with: tokens, if not nil,
+// is sent as a bearer token on each call, and failed calls return an *Error.
+func WithServiceAuth(tokens TokenSource) connect.ClientOption {
+	return connect.WithInterceptors(errorInterceptor(), tokenInterceptor(tokens))
+}
+
+func tokenInterceptor(tokens TokenSource) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if tokens != nil {
+				token, err := tokens.Token(ctx)
+				if err != nil {
+					return nil, fmt.Errorf("failed to get service token: %w", err)
+				}
+				req.Header().Set("Authorization", "Bearer "+token)
+			}
+			return next(ctx, req)
+		}
+	}
+}
+
+func errorInterceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			res, err := next(ctx, req)
+			var connectErr *connect.Error
+			if err != nil && errors.As(err, &connectErr) {
+				return nil, &Error{Procedure: req.Spec().Procedure, Err: connectErr}
+			}
+			return res, err
+		}
+	}
+}
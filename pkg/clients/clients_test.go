@@ -0,0 +1,188 @@
+package clients_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/floroz/gavel/pkg/clients"
+	authclient "github.com/floroz/gavel/pkg/clients/auth"
+	bidclient "github.com/floroz/gavel/pkg/clients/bid"
+	userstatsclient "github.com/floroz/gavel/pkg/clients/userstats"
+	authv1 "github.com/floroz/gavel/pkg/proto/auth/v1"
+	"github.com/floroz/gavel/pkg/proto/auth/v1/authv1connect"
+	bidsv1 "github.com/floroz/gavel/pkg/proto/bids/v1"
+	"github.com/floroz/gavel/pkg/proto/bids/v1/bidsv1connect"
+	userstatsv1 "github.com/floroz/gavel/pkg/proto/userstats/v1"
+	"github.com/floroz/gavel/pkg/proto/userstats/v1/userstatsv1connect"
+)
+
+// headerRecorder remembers the Authorization header of the last request it saw
+type headerRecorder struct {
+	mu   sync.Mutex
+	last string
+}
+
+func (r *headerRecorder) wrap(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		r.mu.Lock()
+		r.last = req.Header.Get("Authorization")
+		r.mu.Unlock()
+		next.ServeHTTP(w, req)
+	})
+}
+
+func (r *headerRecorder) get() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.last
+}
+
+// fakeUserStats fails with the code named by the requested user id, and succeeds otherwise
+type fakeUserStats struct {
+	userstatsv1connect.UnimplementedUserStatsServiceHandler
+}
+
+var codesByUser = map[string]connect.Code{
+	"missing": connect.CodeNotFound,
+	"denied":  connect.CodePermissionDenied,
+	"bad":     connect.CodeInvalidArgument,
+	"down":    connect.CodeUnavailable,
+	"broken":  connect.CodeInternal,
+}
+
+func (fakeUserStats) GetUserStats(_ context.Context, req *connect.Request[userstatsv1.GetUserStatsRequest]) (*connect.Response[userstatsv1.UserStatsResponse], error) {
+	if code, ok := codesByUser[req.Msg.UserId]; ok {
+		return nil, connect.NewError(code, errors.New(req.Msg.UserId))
+	}
+	return connect.NewResponse(&userstatsv1.UserStatsResponse{Stats: &userstatsv1.UserStats{UserId: req.Msg.UserId}}), nil
+}
+
+func startUserStats(t *testing.T) (*httptest.Server, *headerRecorder) {
+	t.Helper()
+	recorder := &headerRecorder{}
+	_, handler := userstatsv1connect.NewUserStatsServiceHandler(fakeUserStats{})
+	server := httptest.NewServer(recorder.wrap(handler))
+	t.Cleanup(server.Close)
+	return server, recorder
+}
+
+func TestClient_AttachesServiceToken(t *testing.T) {
+	server, recorder := startUserStats(t)
+	client := userstatsclient.NewClient(server.Client(), server.URL, clients.StaticToken("svc-token"))
+
+	res, err := client.GetUserStats(context.Background(), connect.NewRequest(&userstatsv1.GetUserStatsRequest{UserId: "u1"}))
+	require.NoError(t, err)
+	assert.Equal(t, "u1", res.Msg.Stats.UserId)
+	assert.Equal(t, "Bearer svc-token", recorder.get())
+}
+
+func TestClient_WithoutTokenSendsNoHeader(t *testing.T) {
+	server, recorder := startUserStats(t)
+	client := userstatsclient.NewClient(server.Client(), server.URL, nil)
+
+	_, err := client.GetUserStats(context.Background(), connect.NewRequest(&userstatsv1.GetUserStatsRequest{UserId: "u1"}))
+	require.NoError(t, err)
+	assert.Empty(t, recorder.get())
+}
+
+type failingTokenSource struct{}
+
+func (failingTokenSource) Token(context.Context) (string, error) {
+	return "", errors.New("token expired")
+}
+
+func TestClient_TokenSourceFailureSkipsCall(t *testing.T) {
+	server, recorder := startUserStats(t)
+	client := userstatsclient.NewClient(server.Client(), server.URL, failingTokenSource{})
+
+	_, err := client.GetUserStats(context.Background(), connect.NewRequest(&userstatsv1.GetUserStatsRequest{UserId: "u1"}))
+	require.ErrorContains(t, err, "token expired")
+	assert.Empty(t, recorder.get(), "no request should reach the server")
+}
+
+func TestClient_MapsErrors(t *testing.T) {
+	server, _ := startUserStats(t)
+	client := userstatsclient.NewClient(server.Client(), server.URL, clients.StaticToken("svc-token"))
+
+	tests := []struct {
+		userID string
+		want   error
+	}{
+		{"missing", clients.ErrNotFound},
+		{"denied", clients.ErrPermissionDenied},
+		{"bad", clients.ErrInvalidArgument},
+		{"down", clients.ErrUnavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.userID, func(t *testing.T) {
+			_, err := client.GetUserStats(context.Background(), connect.NewRequest(&userstatsv1.GetUserStatsRequest{UserId: tt.userID}))
+			require.ErrorIs(t, err, tt.want)
+			assert.Equal(t, codesByUser[tt.userID], connect.CodeOf(err), "the connect code must survive the mapping")
+
+			var clientErr *clients.Error
+			require.ErrorAs(t, err, &clientErr)
+			assert.Equal(t, userstatsv1connect.UserStatsServiceGetUserStatsProcedure, clientErr.Procedure)
+		})
+	}
+
+	t.Run("unmapped codes only match the connect error", func(t *testing.T) {
+		_, err := client.GetUserStats(context.Background(), connect.NewRequest(&userstatsv1.GetUserStatsRequest{UserId: "broken"}))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeInternal, connect.CodeOf(err))
+		assert.NotErrorIs(t, err, clients.ErrNotFound)
+		assert.NotErrorIs(t, err, clients.ErrUnavailable)
+	})
+}
+
+type fakeBids struct {
+	bidsv1connect.UnimplementedBidServiceHandler
+}
+
+func (fakeBids) GetItem(context.Context, *connect.Request[bidsv1.GetItemRequest]) (*connect.Response[bidsv1.GetItemResponse], error) {
+	return nil, connect.NewError(connect.CodeNotFound, errors.New("item not found"))
+}
+
+type fakeAuth struct {
+	authv1connect.UnimplementedAuthServiceHandler
+}
+
+func (fakeAuth) GetDisplayNames(_ context.Context, req *connect.Request[authv1.GetDisplayNamesRequest]) (*connect.Response[authv1.GetDisplayNamesResponse], error) {
+	names := make(map[string]string, len(req.Msg.UserIds))
+	for _, id := range req.Msg.UserIds {
+		names[id] = "Name of " + id
+	}
+	return connect.NewResponse(&authv1.GetDisplayNamesResponse{DisplayNames: names}), nil
+}
+
+func TestBidAndAuthClients(t *testing.T) {
+	recorder := &headerRecorder{}
+	mux := http.NewServeMux()
+	mux.Handle(bidsv1connect.NewBidServiceHandler(fakeBids{}))
+	mux.Handle(authv1connect.NewAuthServiceHandler(fakeAuth{}))
+	server := httptest.NewServer(recorder.wrap(mux))
+	t.Cleanup(server.Close)
+	tokens := clients.StaticToken("svc-token")
+
+	t.Run("bid", func(t *testing.T) {
+		client := bidclient.NewClient(server.Client(), server.URL, tokens)
+		_, err := client.GetItem(context.Background(), connect.NewRequest(&bidsv1.GetItemRequest{Id: "x"}))
+		assert.ErrorIs(t, err, clients.ErrNotFound)
+		assert.Equal(t, "Bearer svc-token", recorder.get())
+	})
+
+	t.Run("auth", func(t *testing.T) {
+		client := authclient.NewClient(server.Client(), server.URL, tokens)
+		res, err := client.GetDisplayNames(context.Background(), connect.NewRequest(&authv1.GetDisplayNamesRequest{UserIds: []string{"u1"}}))
+		require.NoError(t, err)
+		assert.Equal(t, "Name of u1", res.Msg.DisplayNames["u1"])
+		assert.Equal(t, "Bearer svc-token", recorder.get())
+	})
+}
@@ -0,0 +1,15 @@
+// Package userstats is a typed client for the user-stats service, for other services to call it with.
+package userstats
+
+import (
+	"connectrpc.com/connect"
+
+	"github.com/floroz/gavel/pkg/clients"
+	"github.com/floroz/gavel/pkg/proto/userstats/v1/userstatsv1connect"
+)
+
+// NewClient returns a user-stats service client at baseURL that authenticates with tokens (nil
+// sends no token) and returns *clients.Error for failed calls. opts are applied after the defaults.
+func NewClient(httpClient connect.HTTPClient, baseURL string, tokens clients.TokenSource, opts ...connect.ClientOption) userstatsv1connect.UserStatsServiceClient {
+	return userstatsv1connect.NewUserStatsServiceClient(httpClient, baseURL, append([]connect.ClientOption{clients.WithServiceAuth(tokens)}, opts...)...)
+}
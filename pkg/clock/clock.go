@@ -0,0 +1,24 @@
+// Package clock abstracts the current time so time-dependent logic (auction end
+// checks, token expiry) can be tested without sleeping.
+package clock
+
+import "time"
+
+// Clock reports the current time
+type Clock interface {
+	Now() time.Time
+}
+
+// Real is the wall clock
+type Real struct{}
+
+// Now returns time.Now()
+func (Real) Now() time.Time { return time.Now() }
+
+// OrReal returns c, or the wall clock if c is nil, for constructors that take an optional Clock
+func OrReal(c Clock) Clock {
+	if c == nil {
+		return Real{}
+	}
+	return c
+}
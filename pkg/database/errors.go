@@ -0,0 +1,50 @@
+package database
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// Postgres error codes for transient failures where retrying the whole
+// transaction is expected to succeed.
+const (
+	pgSerializationFailure = "40001"
+	pgDeadlockDetected     = "40P01"
+	pgLockNotAvailable     = "55P03" // raised when lock_timeout expires
+)
+
+const (
+	pgForeignKeyViolation = "23503"
+	pgUniqueViolation     = "23505"
+)
+
+// IsRetryable reports whether err is a transient Postgres error
+// (serialization failure, deadlock or lock timeout).
+func IsRetryable(err error) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+
+	switch pgErr.Code {
+	case pgSerializationFailure, pgDeadlockDetected, pgLockNotAvailable:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsForeignKeyViolation reports whether err is a Postgres foreign key violation,
+// typically meaning the referenced row does not exist.
+func IsForeignKeyViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == pgForeignKeyViolation
+}
+
+// IsUniqueViolation reports whether err is a Postgres unique constraint violation,
+// meaning a row with the same key already exists.
+func IsUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation
+}
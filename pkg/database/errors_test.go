@@ -0,0 +1,40 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "Serialization failure", err: &pgconn.PgError{Code: "40001"}, want: true},
+		{name: "Deadlock detected", err: &pgconn.PgError{Code: "40P01"}, want: true},
+		{name: "Lock timeout", err: &pgconn.PgError{Code: "55P03"}, want: true},
+		{name: "Wrapped lock timeout", err: fmt.Errorf("item not found: %w", &pgconn.PgError{Code: "55P03"}), want: true},
+		{name: "Unique violation", err: &pgconn.PgError{Code: "23505"}, want: false},
+		{name: "Non-postgres error", err: errors.New("bid amount must be higher"), want: false},
+		{name: "Nil error", err: nil, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsRetryable(tt.err))
+		})
+	}
+}
+
+func TestIsUniqueViolation(t *testing.T) {
+	assert.True(t, IsUniqueViolation(&pgconn.PgError{Code: "23505"}))
+	assert.True(t, IsUniqueViolation(fmt.Errorf("failed to create user: %w", &pgconn.PgError{Code: "23505"})))
+	assert.False(t, IsUniqueViolation(&pgconn.PgError{Code: "23503"}))
+	assert.False(t, IsUniqueViolation(errors.New("duplicate")))
+	assert.False(t, IsUniqueViolation(nil))
+}
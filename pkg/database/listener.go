@@ -0,0 +1,127 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Notification is a single message received from a Postgres NOTIFY
+type Notification struct {
+	Channel string
+	Payload string
+}
+
+// subscriberBuffer is how many notifications a slow subscriber can fall behind
+// before new ones are dropped for it
+const subscriberBuffer = 64
+
+// Listener holds a dedicated connection that LISTENs on a Postgres channel and fans
+// notifications out to in-process subscribers.
+//
+// NOTIFY is best effort: notifications sent while the listener is reconnecting are lost,
+// so subscribers must treat them as hints and read the database for the current state.
+type Listener struct {
+	pool           *pgxpool.Pool
+	channel        string
+	reconnectDelay time.Duration
+	logger         *slog.Logger
+
+	mu   sync.Mutex
+	subs map[chan Notification]struct{}
+}
+
+// NewListener creates a listener for channel. It takes one connection from pool
+// for as long as Run is active.
+// reconnectDelay: wait between reconnection attempts after the connection drops
+func NewListener(pool *pgxpool.Pool, channel string, reconnectDelay time.Duration, logger *slog.Logger) *Listener {
+	return &Listener{
+		pool:           pool,
+		channel:        channel,
+		reconnectDelay: reconnectDelay,
+		logger:         logger,
+		subs:           make(map[chan Notification]struct{}),
+	}
+}
+
+// Subscribe registers a new subscriber. The returned function unsubscribes and closes the channel.
+// Notifications are dropped for a subscriber whose buffer is full rather than blocking the others.
+func (l *Listener) Subscribe() (<-chan Notification, func()) {
+	ch := make(chan Notification, subscriberBuffer)
+
+	l.mu.Lock()
+	l.subs[ch] = struct{}{}
+	l.mu.Unlock()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			l.mu.Lock()
+			delete(l.subs, ch)
+			l.mu.Unlock()
+			close(ch)
+		})
+	}
+	return ch, unsubscribe
+}
+
+// Run listens until ctx is canceled, reconnecting whenever the connection is lost
+func (l *Listener) Run(ctx context.Context) error {
+	for {
+		err := l.listen(ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
+		l.logger.Warn("Listener connection lost, reconnecting", "channel", l.channel, "error", err)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(l.reconnectDelay):
+		}
+	}
+}
+
+// listen acquires a connection, issues LISTEN and dispatches notifications until an error occurs
+func (l *Listener) listen(ctx context.Context) error {
+	conn, err := l.pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	// The session still has LISTEN active, so never hand it back to the pool
+	defer func() {
+		_ = conn.Conn().Close(context.Background())
+		conn.Release()
+	}()
+
+	if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{l.channel}.Sanitize()); err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", l.channel, err)
+	}
+	l.logger.Info("Listening for notifications", "channel", l.channel)
+
+	for {
+		n, err := conn.Conn().WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+		l.dispatch(Notification{Channel: n.Channel, Payload: n.Payload})
+	}
+}
+
+func (l *Listener) dispatch(n Notification) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for ch := range l.subs {
+		select {
+		case ch <- n:
+		default:
+			l.logger.Warn("Dropping notification for slow subscriber", "channel", n.Channel)
+		}
+	}
+}
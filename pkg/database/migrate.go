@@ -0,0 +1,67 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"os"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/pressly/goose/v3"
+	"github.com/pressly/goose/v3/lock"
+)
+
+// Environment variables read by MigrateFromEnv
+const (
+	EnvRunMigrations = "RUN_MIGRATIONS" // "true" to migrate on startup
+	EnvMigrationsDir = "MIGRATIONS_DIR"
+)
+
+// DefaultMigrationsDir is where the service images ship their goose migrations,
+// relative to the working directory
+const DefaultMigrationsDir = "migrations"
+
+// Migrate applies every pending goose migration in dir to the database at url.
+// A Postgres advisory lock is held for the duration, so replicas starting together
+// apply each migration once.
+func Migrate(ctx context.Context, url, dir string, logger *slog.Logger) error {
+	db, err := sql.Open("pgx", url)
+	if err != nil {
+		return fmt.Errorf("failed to open sql db for migrations: %w", err)
+	}
+	defer db.Close()
+
+	locker, err := lock.NewPostgresSessionLocker()
+	if err != nil {
+		return fmt.Errorf("failed to create migration lock: %w", err)
+	}
+
+	provider, err := goose.NewProvider(goose.DialectPostgres, db, os.DirFS(dir), goose.WithSessionLocker(locker))
+	if err != nil {
+		return fmt.Errorf("failed to load migrations from %s: %w", dir, err)
+	}
+
+	results, err := provider.Up(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to run migrations: %w", err)
+	}
+	for _, r := range results {
+		logger.Info("Applied migration", "source", r.Source.Path, "duration", r.Duration)
+	}
+	return nil
+}
+
+// MigrateFromEnv runs Migrate against MIGRATIONS_DIR when RUN_MIGRATIONS=true.
+// It is opt-in: deployments that run migrations as a separate job leave it unset.
+func MigrateFromEnv(ctx context.Context, url string, logger *slog.Logger) error {
+	if os.Getenv(EnvRunMigrations) != "true" {
+		return nil
+	}
+	dir := os.Getenv(EnvMigrationsDir)
+	if dir == "" {
+		dir = DefaultMigrationsDir
+	}
+	logger.Info("Running database migrations", "dir", dir)
+	return Migrate(ctx, url, dir, logger)
+}
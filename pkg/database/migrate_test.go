@@ -0,0 +1,75 @@
+//go:build integration
+
+package database
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+func TestMigrate_Integration(t *testing.T) {
+	ctx := context.Background()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	container, err := postgres.Run(ctx,
+		"postgres:16-alpine",
+		postgres.WithDatabase("testdb"),
+		postgres.WithUsername("user"),
+		postgres.WithPassword("password"),
+		testcontainers.WithWaitStrategy(
+			wait.ForLog("database system is ready to accept connections").
+				WithOccurrence(2).
+				WithStartupTimeout(5*time.Second)),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = container.Terminate(ctx) })
+
+	connStr, err := container.ConnectionString(ctx, "sslmode=disable")
+	require.NoError(t, err)
+
+	pool, err := pgxpool.New(ctx, connStr)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	dir := "../../services/bid-service/migrations"
+
+	t.Run("brings an empty database up to date", func(t *testing.T) {
+		require.NoError(t, Migrate(ctx, connStr, dir, logger))
+
+		files, err := filepath.Glob(filepath.Join(dir, "*.sql"))
+		require.NoError(t, err)
+
+		var version int64
+		err = pool.QueryRow(ctx, "SELECT MAX(version_id) FROM goose_db_version").Scan(&version)
+		require.NoError(t, err)
+		assert.Equal(t, int64(len(files)), version)
+
+		var exists bool
+		err = pool.QueryRow(ctx, "SELECT to_regclass('public.items') IS NOT NULL").Scan(&exists)
+		require.NoError(t, err)
+		assert.True(t, exists)
+	})
+
+	t.Run("is a no-op when already up to date", func(t *testing.T) {
+		require.NoError(t, Migrate(ctx, connStr, dir, logger))
+	})
+
+	t.Run("MigrateFromEnv does nothing unless enabled", func(t *testing.T) {
+		t.Setenv(EnvMigrationsDir, "does-not-exist")
+		require.NoError(t, MigrateFromEnv(ctx, connStr, logger))
+
+		t.Setenv(EnvRunMigrations, "true")
+		assert.Error(t, MigrateFromEnv(ctx, connStr, logger))
+	})
+}
@@ -0,0 +1,91 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Environment variables read by NewPool to size the connection pool
+const (
+	EnvMaxConns        = "DB_MAX_CONNS"
+	EnvMinConns        = "DB_MIN_CONNS"
+	EnvMaxConnLifetime = "DB_MAX_CONN_LIFETIME"  // Go duration, e.g. "30m"
+	EnvMaxConnIdleTime = "DB_MAX_CONN_IDLE_TIME" // Go duration, e.g. "5m"
+)
+
+// NewPool creates a connection pool for url, sized from the DB_* environment variables
+// and instrumented with QueryTracer. It does not ping the database.
+func NewPool(ctx context.Context, url string) (*pgxpool.Pool, error) {
+	config, err := PoolConfigFromEnv(url)
+	if err != nil {
+		return nil, err
+	}
+	pool, err := pgxpool.NewWithConfig(ctx, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create connection pool: %w", err)
+	}
+	return pool, nil
+}
+
+// PoolConfigFromEnv parses url and applies pool sizing from the environment.
+// Settings given in the URL (e.g. pool_max_conns) are overridden by the environment;
+// a setting neither gives keeps pgxpool's default.
+func PoolConfigFromEnv(url string) (*pgxpool.Config, error) {
+	config, err := pgxpool.ParseConfig(url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse database config: %w", err)
+	}
+
+	if err := envInt(EnvMaxConns, 1, &config.MaxConns); err != nil {
+		return nil, err
+	}
+	if err := envInt(EnvMinConns, 0, &config.MinConns); err != nil {
+		return nil, err
+	}
+	if config.MinConns > config.MaxConns {
+		return nil, fmt.Errorf("minimum pool size %d (%s) must not exceed maximum %d (%s)",
+			config.MinConns, EnvMinConns, config.MaxConns, EnvMaxConns)
+	}
+	if err := envDuration(EnvMaxConnLifetime, &config.MaxConnLifetime); err != nil {
+		return nil, err
+	}
+	if err := envDuration(EnvMaxConnIdleTime, &config.MaxConnIdleTime); err != nil {
+		return nil, err
+	}
+	config.ConnConfig.Tracer = QueryTracer{}
+
+	return config, nil
+}
+
+// envInt sets *dst from key when it is set, rejecting values below lowest
+func envInt(key string, lowest int32, dst *int32) error {
+	v := os.Getenv(key)
+	if v == "" {
+		return nil
+	}
+	n, err := strconv.ParseInt(v, 10, 32)
+	if err != nil || n < int64(lowest) {
+		return fmt.Errorf("invalid %s %q: must be an integer of at least %d", key, v, lowest)
+	}
+	*dst = int32(n)
+	return nil
+}
+
+// envDuration sets *dst from key when it is set
+func envDuration(key string, dst *time.Duration) error {
+	v := os.Getenv(key)
+	if v == "" {
+		return nil
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d <= 0 {
+		return fmt.Errorf("invalid %s %q: must be a positive duration", key, v)
+	}
+	*dst = d
+	return nil
+}
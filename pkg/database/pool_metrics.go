@@ -0,0 +1,77 @@
+package database
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// PoolCollector exports the statistics of a connection pool to Prometheus, reading them
+// at scrape time. Connection counts are gauges; the acquire counts and durations pgx
+// accumulates since the pool was created are counters.
+type PoolCollector struct {
+	pool *pgxpool.Pool
+
+	maxConns          *prometheus.Desc
+	totalConns        *prometheus.Desc
+	idleConns         *prometheus.Desc
+	acquiredConns     *prometheus.Desc
+	constructingConns *prometheus.Desc
+	acquires          *prometheus.Desc
+	acquireDuration   *prometheus.Desc
+	emptyAcquires     *prometheus.Desc
+	emptyAcquireWait  *prometheus.Desc
+}
+
+var _ prometheus.Collector = (*PoolCollector)(nil)
+
+// NewPoolCollector creates a collector for pool; register it with the service's registry
+func NewPoolCollector(pool *pgxpool.Pool) *PoolCollector {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName("db", "pool", name), help, nil, nil)
+	}
+	return &PoolCollector{
+		pool:              pool,
+		maxConns:          desc("max_conns", "Maximum size of the pool."),
+		totalConns:        desc("total_conns", "Connections currently in the pool, idle, acquired or being constructed."),
+		idleConns:         desc("idle_conns", "Idle connections in the pool."),
+		acquiredConns:     desc("acquired_conns", "Connections currently checked out of the pool."),
+		constructingConns: desc("constructing_conns", "Connections being established."),
+		acquires:          desc("acquires_total", "Successful acquires from the pool."),
+		acquireDuration:   desc("acquire_duration_seconds_total", "Time spent in successful acquires."),
+		emptyAcquires:     desc("empty_acquires_total", "Acquires that waited because the pool had no idle connection."),
+		emptyAcquireWait:  desc("empty_acquire_wait_seconds_total", "Time acquires spent waiting for a connection."),
+	}
+}
+
+// Describe implements prometheus.Collector
+func (c *PoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.maxConns
+	ch <- c.totalConns
+	ch <- c.idleConns
+	ch <- c.acquiredConns
+	ch <- c.constructingConns
+	ch <- c.acquires
+	ch <- c.acquireDuration
+	ch <- c.emptyAcquires
+	ch <- c.emptyAcquireWait
+}
+
+// Collect implements prometheus.Collector
+func (c *PoolCollector) Collect(ch chan<- prometheus.Metric) {
+	stat := c.pool.Stat()
+	gauge := func(desc *prometheus.Desc, v float64) {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, v)
+	}
+	counter := func(desc *prometheus.Desc, v float64) {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.CounterValue, v)
+	}
+	gauge(c.maxConns, float64(stat.MaxConns()))
+	gauge(c.totalConns, float64(stat.TotalConns()))
+	gauge(c.idleConns, float64(stat.IdleConns()))
+	gauge(c.acquiredConns, float64(stat.AcquiredConns()))
+	gauge(c.constructingConns, float64(stat.ConstructingConns()))
+	counter(c.acquires, float64(stat.AcquireCount()))
+	counter(c.acquireDuration, stat.AcquireDuration().Seconds())
+	counter(c.emptyAcquires, float64(stat.EmptyAcquireCount()))
+	counter(c.emptyAcquireWait, stat.EmptyAcquireWaitTime().Seconds())
+}
@@ -0,0 +1,63 @@
+package database
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPoolCollector_ReportsPoolStat(t *testing.T) {
+	// The pool never dials: with no minimum it only connects on acquire
+	config, err := pgxpool.ParseConfig(testURL)
+	require.NoError(t, err)
+	config.MaxConns = 3
+	config.MinConns = 0
+	pool, err := pgxpool.NewWithConfig(context.Background(), config)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(NewPoolCollector(pool))
+
+	expected := `
+# HELP db_pool_max_conns Maximum size of the pool.
+# TYPE db_pool_max_conns gauge
+db_pool_max_conns 3
+# HELP db_pool_acquired_conns Connections currently checked out of the pool.
+# TYPE db_pool_acquired_conns gauge
+db_pool_acquired_conns 0
+# HELP db_pool_acquires_total Successful acquires from the pool.
+# TYPE db_pool_acquires_total counter
+db_pool_acquires_total 0
+`
+	assert.NoError(t, testutil.GatherAndCompare(reg, strings.NewReader(expected),
+		"db_pool_max_conns", "db_pool_acquired_conns", "db_pool_acquires_total"))
+
+	families, err := reg.Gather()
+	require.NoError(t, err)
+	types := make(map[string]string, len(families))
+	for _, f := range families {
+		types[f.GetName()] = f.GetType().String()
+	}
+	assert.Equal(t, map[string]string{
+		"db_pool_max_conns":                        "GAUGE",
+		"db_pool_total_conns":                      "GAUGE",
+		"db_pool_idle_conns":                       "GAUGE",
+		"db_pool_acquired_conns":                   "GAUGE",
+		"db_pool_constructing_conns":               "GAUGE",
+		"db_pool_acquires_total":                   "COUNTER",
+		"db_pool_acquire_duration_seconds_total":   "COUNTER",
+		"db_pool_empty_acquires_total":             "COUNTER",
+		"db_pool_empty_acquire_wait_seconds_total": "COUNTER",
+	}, types)
+
+	problems, err := testutil.CollectAndLint(NewPoolCollector(pool))
+	require.NoError(t, err)
+	assert.Empty(t, problems)
+}
@@ -0,0 +1,19 @@
+package database
+
+import (
+	"context"
+	"time"
+)
+
+// DefaultQueryTimeout is the per-operation deadline used by repositories
+// when no explicit timeout is configured.
+const DefaultQueryTimeout = 5 * time.Second
+
+// WithQueryTimeout bounds a single repository operation so a slow query fails fast
+// instead of hanging the caller. A non-positive timeout leaves the context unchanged.
+func WithQueryTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, timeout)
+}
@@ -0,0 +1,122 @@
+package database
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/floroz/gavel/pkg/database"
+
+// QueryTracer is a pgx.QueryTracer that records a client span for every statement,
+// so each repository call shows up under the RPC (and transaction) that issued it.
+// Install it with poolConfig.ConnConfig.Tracer = database.QueryTracer{}.
+// Spans are no-ops until a tracer provider is configured.
+type QueryTracer struct{}
+
+var _ pgx.QueryTracer = QueryTracer{}
+
+// TraceQueryStart starts a span named after the SQL operation
+func (QueryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	operation := sqlOperation(data.SQL)
+	ctx, _ = otel.Tracer(tracerName).Start(ctx, "db."+strings.ToLower(operation),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemPostgreSQL,
+			semconv.DBOperationName(operation),
+			semconv.DBQueryText(data.SQL),
+		),
+	)
+	return ctx
+}
+
+// TraceQueryEnd ends the span started by TraceQueryStart
+func (QueryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	span := trace.SpanFromContext(ctx)
+	if data.Err != nil {
+		span.RecordError(data.Err)
+		span.SetStatus(codes.Error, data.Err.Error())
+	}
+	span.End()
+}
+
+// sqlOperation returns the leading SQL keyword, e.g. "SELECT"
+func sqlOperation(sql string) string {
+	fields := strings.Fields(sql)
+	if len(fields) == 0 {
+		return "QUERY"
+	}
+	return strings.ToUpper(fields[0])
+}
+
+// startTxSpan starts the span that parents every statement run inside a transaction
+func startTxSpan(ctx context.Context) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, "db.transaction",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.DBSystemPostgreSQL),
+	)
+}
+
+// tracedTx parents the statements it runs under the transaction span and ends
+// that span when the transaction commits or rolls back.
+// Repositories receive the caller's context, so without this their queries would
+// be siblings of the transaction span rather than its children.
+type tracedTx struct {
+	pgx.Tx
+	span        trace.Span
+	afterCommit []func()
+}
+
+func (t *tracedTx) withSpan(ctx context.Context) context.Context {
+	return trace.ContextWithSpan(ctx, t.span)
+}
+
+func (t *tracedTx) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	return t.Tx.Exec(t.withSpan(ctx), sql, args...)
+}
+
+func (t *tracedTx) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	return t.Tx.Query(t.withSpan(ctx), sql, args...)
+}
+
+func (t *tracedTx) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	return t.Tx.QueryRow(t.withSpan(ctx), sql, args...)
+}
+
+func (t *tracedTx) CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error) {
+	return t.Tx.CopyFrom(t.withSpan(ctx), tableName, columnNames, rowSrc)
+}
+
+func (t *tracedTx) SendBatch(ctx context.Context, b *pgx.Batch) pgx.BatchResults {
+	return t.Tx.SendBatch(t.withSpan(ctx), b)
+}
+
+func (t *tracedTx) Commit(ctx context.Context) error {
+	err := t.Tx.Commit(t.withSpan(ctx))
+	if err != nil {
+		t.span.RecordError(err)
+		t.span.SetStatus(codes.Error, err.Error())
+	}
+	t.span.End()
+	if err == nil {
+		for _, fn := range t.afterCommit {
+			fn()
+		}
+	}
+	t.afterCommit = nil
+	return err
+}
+
+func (t *tracedTx) Rollback(ctx context.Context) error {
+	t.afterCommit = nil
+	err := t.Tx.Rollback(t.withSpan(ctx))
+	// Rollback after Commit is the usual deferred cleanup; ending twice is a no-op
+	t.span.End()
+	return err
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/codes"
 )
 
 // PostgresTransactionManager implements database.TransactionManager using pgx
@@ -25,11 +26,17 @@ func NewPostgresTransactionManager(pool *pgxpool.Pool, lockTimeout time.Duration
 }
 
 // BeginTx starts a new transaction with configured lock timeout
+// The transaction is traced as a single span covering every statement until commit or rollback.
 func (m *PostgresTransactionManager) BeginTx(ctx context.Context) (pgx.Tx, error) {
-	tx, err := m.pool.Begin(ctx)
+	spanCtx, span := startTxSpan(ctx)
+	pgxTx, err := m.pool.Begin(spanCtx)
 	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		span.End()
 		return nil, err
 	}
+	tx := &tracedTx{Tx: pgxTx, span: span}
 
 	// Set lock timeout for this transaction
 	if m.lockTimeout > 0 {
@@ -43,3 +50,13 @@ func (m *PostgresTransactionManager) BeginTx(ctx context.Context) (pgx.Tx, error
 
 	return tx, nil
 }
+
+// AfterCommit runs fn once tx commits, and never if it rolls back. A transaction that was
+// not begun by PostgresTransactionManager (or no transaction at all) runs fn right away.
+func AfterCommit(tx pgx.Tx, fn func()) {
+	if t, ok := tx.(*tracedTx); ok {
+		t.afterCommit = append(t.afterCommit, fn)
+		return
+	}
+	fn()
+}
@@ -0,0 +1,58 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// stubTx is a pgx.Tx whose commit succeeds or fails with err
+type stubTx struct {
+	pgx.Tx
+	err error
+}
+
+func (s stubTx) Commit(context.Context) error   { return s.err }
+func (s stubTx) Rollback(context.Context) error { return nil }
+
+func TestAfterCommit(t *testing.T) {
+	ctx := context.Background()
+	newTx := func(err error) *tracedTx {
+		return &tracedTx{Tx: stubTx{err: err}, span: trace.SpanFromContext(ctx)}
+	}
+
+	t.Run("runs once the transaction commits", func(t *testing.T) {
+		tx := newTx(nil)
+		var ran int
+		AfterCommit(tx, func() { ran++ })
+		assert.Zero(t, ran, "not before the commit")
+
+		assert.NoError(t, tx.Commit(ctx))
+		assert.Equal(t, 1, ran)
+		_ = tx.Rollback(ctx)
+		assert.Equal(t, 1, ran, "the deferred rollback does not run it again")
+	})
+
+	t.Run("never runs after a rollback or a failed commit", func(t *testing.T) {
+		var ran bool
+		rolledBack := newTx(nil)
+		AfterCommit(rolledBack, func() { ran = true })
+		_ = rolledBack.Rollback(ctx)
+
+		failed := newTx(errors.New("serialization failure"))
+		AfterCommit(failed, func() { ran = true })
+		assert.Error(t, failed.Commit(ctx))
+
+		assert.False(t, ran)
+	})
+
+	t.Run("runs right away outside a managed transaction", func(t *testing.T) {
+		var ran bool
+		AfterCommit(nil, func() { ran = true })
+		assert.True(t, ran)
+	})
+}
@@ -0,0 +1,75 @@
+package dial
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// Environment variables read by RabbitMQFromEnv, besides the RABBITMQ_TLS* ones
+const (
+	EnvRabbitMQURL      = "RABBITMQ_URL"
+	EnvRabbitMQUsername = "RABBITMQ_USERNAME" // with RABBITMQ_PASSWORD, replaces credentials in the URL
+	EnvRabbitMQPassword = "RABBITMQ_PASSWORD"
+)
+
+// amqpLocale is what amqp.Dial sends; DialConfig leaves it to the caller
+const amqpLocale = "en_US"
+
+// RabbitMQConfig is how the services connect to RabbitMQ
+type RabbitMQConfig struct {
+	URL      string
+	Username string // with Password, overrides the URL's credentials when set
+	Password string
+	TLS      *tls.Config // nil dials plaintext
+}
+
+// RabbitMQFromEnv reads RABBITMQ_URL, the optional separate credentials and RABBITMQ_TLS*.
+// TLS needs an amqps:// URL, since the client only negotiates TLS for that scheme.
+func RabbitMQFromEnv() (RabbitMQConfig, error) {
+	cfg := RabbitMQConfig{
+		URL:      os.Getenv(EnvRabbitMQURL),
+		Username: os.Getenv(EnvRabbitMQUsername),
+		Password: os.Getenv(EnvRabbitMQPassword),
+	}
+	if cfg.URL == "" {
+		return cfg, errors.New(EnvRabbitMQURL + " is not set")
+	}
+	if (cfg.Username == "") != (cfg.Password == "") {
+		return cfg, errors.New(EnvRabbitMQUsername + " and " + EnvRabbitMQPassword + " must be set together")
+	}
+
+	tlsConfig, err := TLSConfigFromEnv("RABBITMQ")
+	if err != nil {
+		return cfg, err
+	}
+	if tlsConfig != nil {
+		u, err := url.Parse(cfg.URL)
+		if err != nil || u.Scheme != "amqps" {
+			return cfg, fmt.Errorf("RABBITMQ_TLS needs an amqps:// %s", EnvRabbitMQURL)
+		}
+	}
+	cfg.TLS = tlsConfig
+	return cfg, nil
+}
+
+// AMQPConfig is the client config Dial connects with
+func (c RabbitMQConfig) AMQPConfig() amqp.Config {
+	cfg := amqp.Config{
+		TLSClientConfig: c.TLS,
+		Locale:          amqpLocale,
+	}
+	if c.Username != "" {
+		cfg.SASL = []amqp.Authentication{&amqp.PlainAuth{Username: c.Username, Password: c.Password}}
+	}
+	return cfg
+}
+
+// Dial opens a connection to RabbitMQ
+func (c RabbitMQConfig) Dial() (*amqp.Connection, error) {
+	return amqp.DialConfig(c.URL, c.AMQPConfig())
+}
@@ -0,0 +1,33 @@
+package dial
+
+import (
+	"os"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Environment variables read by RedisOptionsFromEnv, besides the REDIS_TLS* ones
+const (
+	EnvRedisURL      = "REDIS_URL" // host:port
+	EnvRedisUsername = "REDIS_USERNAME"
+	EnvRedisPassword = "REDIS_PASSWORD"
+)
+
+// RedisOptionsFromEnv reads REDIS_URL, the optional credentials and REDIS_TLS*.
+// It returns nil options when REDIS_URL is unset, since Redis is optional everywhere.
+func RedisOptionsFromEnv() (*redis.Options, error) {
+	addr := os.Getenv(EnvRedisURL)
+	if addr == "" {
+		return nil, nil
+	}
+	tlsConfig, err := TLSConfigFromEnv("REDIS")
+	if err != nil {
+		return nil, err
+	}
+	return &redis.Options{
+		Addr:      addr,
+		Username:  os.Getenv(EnvRedisUsername),
+		Password:  os.Getenv(EnvRedisPassword),
+		TLSConfig: tlsConfig,
+	}, nil
+}
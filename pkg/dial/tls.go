@@ -0,0 +1,69 @@
+// Package dial builds the RabbitMQ and Redis connection settings shared by the mains
+// from the environment. With TLS off it dials plaintext, as local development does.
+package dial
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+)
+
+// TLS settings read by TLSConfigFromEnv, each under a service prefix such as RABBITMQ
+const (
+	envTLS           = "_TLS"             // "true" to dial with TLS
+	envTLSCAFile     = "_TLS_CA_FILE"     // PEM bundle to verify the server with, the system roots if unset
+	envTLSCertFile   = "_TLS_CERT_FILE"   // client certificate, for brokers that require mutual TLS
+	envTLSKeyFile    = "_TLS_KEY_FILE"    // client key, set together with the certificate
+	envTLSServerName = "_TLS_SERVER_NAME" // name to verify when it differs from the dialed host
+)
+
+// TLSConfigFromEnv returns the TLS config described by the <prefix>_TLS* variables,
+// or nil when <prefix>_TLS is unset or false
+func TLSConfigFromEnv(prefix string) (*tls.Config, error) {
+	key := prefix + envTLS
+	enabled := false
+	if v := os.Getenv(key); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s %q: must be a boolean", key, v)
+		}
+		enabled = b
+	}
+	if !enabled {
+		return nil, nil
+	}
+
+	cfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: os.Getenv(prefix + envTLSServerName),
+	}
+
+	if path := os.Getenv(prefix + envTLSCAFile); path != "" {
+		pem, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", prefix+envTLSCAFile, err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("%s %q holds no PEM certificates", prefix+envTLSCAFile, path)
+		}
+		cfg.RootCAs = pool
+	}
+
+	certFile, keyFile := os.Getenv(prefix+envTLSCertFile), os.Getenv(prefix+envTLSKeyFile)
+	if (certFile == "") != (keyFile == "") {
+		return nil, errors.New(prefix + envTLSCertFile + " and " + prefix + envTLSKeyFile + " must be set together")
+	}
+	if certFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load %s client certificate: %w", prefix, err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,123 @@
+// Package eventstest provides in-memory stand-ins for the broker side of pkg/events,
+// so relays and producers can be tested without RabbitMQ.
+package eventstest
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/floroz/gavel/pkg/events"
+)
+
+// PublishedEvent is one message handed to a RecordingPublisher
+type PublishedEvent struct {
+	Exchange   string
+	RoutingKey string
+	Body       []byte
+	Expiration time.Duration // 0 when published without expiration
+}
+
+// RecordingPublisher is an events.EventPublisher that keeps every published message in memory.
+// It is safe for concurrent use.
+type RecordingPublisher struct {
+	// OnPublish, if set, is called for every Publish before it is recorded.
+	// Returning an error fails that Publish and the message is not recorded.
+	OnPublish func(PublishedEvent) error
+
+	mu     sync.Mutex
+	events []PublishedEvent
+}
+
+var _ events.EventPublisher = (*RecordingPublisher)(nil)
+
+// NewRecordingPublisher creates an empty RecordingPublisher
+func NewRecordingPublisher() *RecordingPublisher {
+	return &RecordingPublisher{}
+}
+
+// Publish records the message, or returns the error from OnPublish
+func (p *RecordingPublisher) Publish(_ context.Context, exchange, routingKey string, body []byte) error {
+	return p.record(PublishedEvent{
+		Exchange:   exchange,
+		RoutingKey: routingKey,
+		Body:       append([]byte(nil), body...),
+	})
+}
+
+// PublishWithExpiration records the message along with its ttl
+func (p *RecordingPublisher) PublishWithExpiration(_ context.Context, exchange, routingKey string, body []byte, ttl time.Duration) error {
+	return p.record(PublishedEvent{
+		Exchange:   exchange,
+		RoutingKey: routingKey,
+		Body:       append([]byte(nil), body...),
+		Expiration: ttl,
+	})
+}
+
+func (p *RecordingPublisher) record(event PublishedEvent) error {
+	if p.OnPublish != nil {
+		if err := p.OnPublish(event); err != nil {
+			return err
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.events = append(p.events, event)
+	return nil
+}
+
+// Events returns a copy of the recorded messages in publish order
+func (p *RecordingPublisher) Events() []PublishedEvent {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]PublishedEvent(nil), p.events...)
+}
+
+// Reset forgets all recorded messages
+func (p *RecordingPublisher) Reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.events = nil
+}
+
+// RequireCount fails the test unless exactly n messages were published
+func (p *RecordingPublisher) RequireCount(t testing.TB, n int) {
+	t.Helper()
+	if got := len(p.Events()); got != n {
+		t.Fatalf("expected %d published events, got %d", n, got)
+	}
+}
+
+// RequirePublished fails the test unless a message was published to exchange with routingKey,
+// and returns the first such message.
+func (p *RecordingPublisher) RequirePublished(t testing.TB, exchange, routingKey string) PublishedEvent {
+	t.Helper()
+	for _, e := range p.Events() {
+		if e.Exchange == exchange && e.RoutingKey == routingKey {
+			return e
+		}
+	}
+	t.Fatalf("expected an event on %s with routing key %q, got %v", exchange, routingKey, p.routes())
+	return PublishedEvent{}
+}
+
+// RequireNotPublished fails the test if any message was published with routingKey
+func (p *RecordingPublisher) RequireNotPublished(t testing.TB, routingKey string) {
+	t.Helper()
+	for _, e := range p.Events() {
+		if e.RoutingKey == routingKey {
+			t.Fatalf("expected no event with routing key %q, got one on %s", routingKey, e.Exchange)
+		}
+	}
+}
+
+func (p *RecordingPublisher) routes() []string {
+	var routes []string
+	for _, e := range p.Events() {
+		routes = append(routes, e.Exchange+"/"+e.RoutingKey)
+	}
+	return routes
+}
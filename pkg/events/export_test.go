@@ -0,0 +1,8 @@
+package events
+
+import "context"
+
+// ProcessBatch exposes one relay iteration to the external test package
+func (r *OutboxRelay) ProcessBatch(ctx context.Context) error {
+	return r.processBatch(ctx)
+}
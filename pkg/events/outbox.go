@@ -43,6 +43,10 @@ type OutboxRepository interface {
 // EventPublisher defines the interface for publishing events to a broker
 type EventPublisher interface {
 	Publish(ctx context.Context, exchange, routingKey string, body []byte) error
+
+	// PublishWithExpiration publishes a message the broker may drop once it has
+	// waited in a queue for longer than ttl
+	PublishWithExpiration(ctx context.Context, exchange, routingKey string, body []byte, ttl time.Duration) error
 }
 
 // OutboxRelay is a generic relay that polls the database for pending events and publishes them
@@ -53,10 +57,13 @@ type OutboxRelay struct {
 	batchSize  int
 	interval   time.Duration
 	exchange   string
+	ttls       map[string]time.Duration
 	logger     *slog.Logger
 }
 
 // NewOutboxRelay creates a new generic outbox relay
+// messageTTLs maps event types to a message expiration for events that are only useful briefly;
+// event types not in the map, and a nil map, publish without expiration.
 func NewOutboxRelay(
 	outboxRepo OutboxRepository,
 	publisher EventPublisher,
@@ -64,6 +71,7 @@ func NewOutboxRelay(
 	batchSize int,
 	interval time.Duration,
 	exchange string,
+	messageTTLs map[string]time.Duration,
 	logger *slog.Logger,
 ) *OutboxRelay {
 	return &OutboxRelay{
@@ -73,6 +81,7 @@ func NewOutboxRelay(
 		batchSize:  batchSize,
 		interval:   interval,
 		exchange:   exchange,
+		ttls:       messageTTLs,
 		logger:     logger,
 	}
 }
@@ -82,8 +91,12 @@ func (r *OutboxRelay) Run(ctx context.Context) error {
 	ticker := time.NewTicker(r.interval)
 	defer ticker.Stop()
 
+	// A batch runs to its commit even if ctx is cancelled partway: rolling it back after
+	// its events were published would only get them published again on the next start
+	batchCtx := context.WithoutCancel(ctx)
+
 	// Initial run
-	if err := r.processBatch(ctx); err != nil {
+	if err := r.processBatch(batchCtx); err != nil {
 		r.logger.Error("Error processing batch", "error", err)
 	}
 
@@ -92,7 +105,7 @@ func (r *OutboxRelay) Run(ctx context.Context) error {
 		case <-ctx.Done():
 			return nil
 		case <-ticker.C:
-			if err := r.processBatch(ctx); err != nil {
+			if err := r.processBatch(batchCtx); err != nil {
 				r.logger.Error("Error processing batch", "error", err)
 			}
 		}
@@ -123,7 +136,7 @@ func (r *OutboxRelay) processBatch(ctx context.Context) error {
 	for _, event := range events {
 		// Publish to RabbitMQ
 		// Exchange is configurable, Routing Key is the event type
-		err := r.publisher.Publish(ctx, r.exchange, event.EventType, event.Payload)
+		err := r.publish(ctx, event)
 		if err != nil {
 			// If publishing fails, we return error and the transaction rolls back.
 			// The event remains 'pending' and will be retried.
@@ -139,3 +152,11 @@ func (r *OutboxRelay) processBatch(ctx context.Context) error {
 
 	return tx.Commit(ctx)
 }
+
+// publish sends one event, with an expiration if its type has a configured TTL
+func (r *OutboxRelay) publish(ctx context.Context, event *OutboxEvent) error {
+	if ttl := r.ttls[event.EventType]; ttl > 0 {
+		return r.publisher.PublishWithExpiration(ctx, r.exchange, event.EventType, event.Payload, ttl)
+	}
+	return r.publisher.Publish(ctx, r.exchange, event.EventType, event.Payload)
+}
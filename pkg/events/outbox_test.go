@@ -0,0 +1,250 @@
+package events_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/floroz/gavel/pkg/events"
+	"github.com/floroz/gavel/pkg/events/eventstest"
+)
+
+// callLog records relay side effects across the fakes in the order they happen
+type callLog struct {
+	calls []string
+}
+
+func (l *callLog) add(call string) { l.calls = append(l.calls, call) }
+
+type fakeTx struct {
+	pgx.Tx
+	log *callLog
+}
+
+func (tx *fakeTx) Commit(context.Context) error {
+	tx.log.add("commit")
+	return nil
+}
+
+func (tx *fakeTx) Rollback(context.Context) error {
+	tx.log.add("rollback")
+	return nil
+}
+
+type fakeTxManager struct {
+	log *callLog
+}
+
+func (m *fakeTxManager) BeginTx(context.Context) (pgx.Tx, error) {
+	return &fakeTx{log: m.log}, nil
+}
+
+type fakeOutboxRepo struct {
+	log     *callLog
+	pending []*events.OutboxEvent
+}
+
+func (r *fakeOutboxRepo) GetPendingEvents(_ context.Context, _ pgx.Tx, limit int) ([]*events.OutboxEvent, error) {
+	if len(r.pending) > limit {
+		return r.pending[:limit], nil
+	}
+	return r.pending, nil
+}
+
+// LockPendingEvent claims an event from pending unless another publisher holds it
+func (r *fakeOutboxRepo) LockPendingEvent(_ context.Context, _ pgx.Tx, id uuid.UUID) (*events.OutboxEvent, error) {
+	for _, event := range r.pending {
+		if event.ID == id && event.Status == events.OutboxStatusPending {
+			r.log.add("lock " + id.String())
+			return event, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeOutboxRepo) UpdateEventStatus(_ context.Context, _ pgx.Tx, id uuid.UUID, status events.OutboxStatus) error {
+	r.log.add("status " + id.String() + " " + string(status))
+	return nil
+}
+
+func newTestRelay(t *testing.T, pending []*events.OutboxEvent, batchSize int) (*events.OutboxRelay, *eventstest.RecordingPublisher, *callLog) {
+	return newTestRelayWithTTLs(t, pending, batchSize, nil)
+}
+
+func newTestRelayWithTTLs(t *testing.T, pending []*events.OutboxEvent, batchSize int, ttls map[string]time.Duration) (*events.OutboxRelay, *eventstest.RecordingPublisher, *callLog) {
+	t.Helper()
+	log := &callLog{}
+	publisher := eventstest.NewRecordingPublisher()
+	publisher.OnPublish = func(e eventstest.PublishedEvent) error {
+		log.add("publish " + e.RoutingKey)
+		return nil
+	}
+	relay := events.NewOutboxRelay(
+		&fakeOutboxRepo{log: log, pending: pending},
+		publisher,
+		&fakeTxManager{log: log},
+		batchSize,
+		time.Second,
+		events.AuctionEventsExchange,
+		ttls,
+		slog.New(slog.NewTextHandler(io.Discard, nil)),
+	)
+	return relay, publisher, log
+}
+
+func pendingEvent(eventType string) *events.OutboxEvent {
+	return &events.OutboxEvent{
+		ID:        uuid.New(),
+		EventType: eventType,
+		Payload:   []byte(eventType + "-payload"),
+		Status:    events.OutboxStatusPending,
+	}
+}
+
+func TestOutboxRelay_ProcessBatch(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("publishes each event before marking it published", func(t *testing.T) {
+		placed, created := pendingEvent("bid.placed"), pendingEvent("user.created")
+		relay, publisher, log := newTestRelay(t, []*events.OutboxEvent{placed, created}, 10)
+
+		require.NoError(t, relay.ProcessBatch(ctx))
+
+		assert.Equal(t, []string{
+			"publish bid.placed",
+			"status " + placed.ID.String() + " published",
+			"publish user.created",
+			"status " + created.ID.String() + " published",
+			"commit",
+			"rollback", // deferred, a no-op after commit
+		}, log.calls)
+
+		publisher.RequireCount(t, 2)
+		msg := publisher.RequirePublished(t, events.AuctionEventsExchange, "bid.placed")
+		assert.Equal(t, []byte("bid.placed-payload"), msg.Body)
+	})
+
+	t.Run("a failed publish leaves the event pending", func(t *testing.T) {
+		placed, created := pendingEvent("bid.placed"), pendingEvent("user.created")
+		relay, publisher, log := newTestRelay(t, []*events.OutboxEvent{placed, created}, 10)
+		publisher.OnPublish = func(e eventstest.PublishedEvent) error {
+			log.add("publish " + e.RoutingKey)
+			if e.RoutingKey == "user.created" {
+				return errors.New("broker unavailable")
+			}
+			return nil
+		}
+
+		err := relay.ProcessBatch(ctx)
+		require.Error(t, err)
+
+		// The first status update rolls back with the transaction, so both events are retried
+		assert.Equal(t, []string{
+			"publish bid.placed",
+			"status " + placed.ID.String() + " published",
+			"publish user.created",
+			"rollback",
+		}, log.calls)
+		publisher.RequireNotPublished(t, "user.created")
+	})
+
+	t.Run("respects the batch size", func(t *testing.T) {
+		pending := []*events.OutboxEvent{pendingEvent("a"), pendingEvent("b"), pendingEvent("c")}
+		relay, publisher, _ := newTestRelay(t, pending, 2)
+
+		require.NoError(t, relay.ProcessBatch(ctx))
+		publisher.RequireCount(t, 2)
+	})
+
+	t.Run("does nothing without pending events", func(t *testing.T) {
+		relay, publisher, log := newTestRelay(t, nil, 10)
+
+		require.NoError(t, relay.ProcessBatch(ctx))
+		publisher.RequireCount(t, 0)
+		assert.Equal(t, []string{"rollback"}, log.calls)
+	})
+}
+
+func TestOutboxRelay_MessageTTLs(t *testing.T) {
+	pending := []*events.OutboxEvent{pendingEvent("price.updated"), pendingEvent("bid.placed")}
+	relay, publisher, _ := newTestRelayWithTTLs(t, pending, 10, map[string]time.Duration{
+		"price.updated": 5 * time.Second,
+	})
+
+	require.NoError(t, relay.ProcessBatch(context.Background()))
+
+	transient := publisher.RequirePublished(t, events.AuctionEventsExchange, "price.updated")
+	assert.Equal(t, 5*time.Second, transient.Expiration)
+
+	persistent := publisher.RequirePublished(t, events.AuctionEventsExchange, "bid.placed")
+	assert.Zero(t, persistent.Expiration, "business events must not expire")
+}
+
+func TestOutboxRelay_PublishNow(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("publishes and marks the events published", func(t *testing.T) {
+		placed := pendingEvent("bid.placed")
+		relay, publisher, log := newTestRelay(t, []*events.OutboxEvent{placed}, 10)
+
+		require.NoError(t, relay.PublishNow(ctx, placed.ID))
+
+		assert.Equal(t, []string{
+			"lock " + placed.ID.String(),
+			"publish bid.placed",
+			"status " + placed.ID.String() + " published",
+			"commit",
+			"rollback",
+		}, log.calls)
+		publisher.RequireCount(t, 1)
+	})
+
+	t.Run("skips events the relay already claimed", func(t *testing.T) {
+		claimed := pendingEvent("bid.placed")
+		claimed.Status = events.OutboxStatusProcessing
+		relay, publisher, _ := newTestRelay(t, []*events.OutboxEvent{claimed}, 10)
+
+		require.NoError(t, relay.PublishNow(ctx, claimed.ID))
+		publisher.RequireCount(t, 0)
+	})
+
+	t.Run("a failed publish is left to the relay", func(t *testing.T) {
+		placed := pendingEvent("bid.placed")
+		relay, publisher, log := newTestRelay(t, []*events.OutboxEvent{placed}, 10)
+		publisher.OnPublish = func(e eventstest.PublishedEvent) error {
+			log.add("publish " + e.RoutingKey)
+			return errors.New("broker unavailable")
+		}
+
+		require.Error(t, relay.PublishNow(ctx, placed.ID))
+		assert.Equal(t, []string{"lock " + placed.ID.String(), "publish bid.placed", "rollback"}, log.calls)
+		publisher.RequireNotPublished(t, "bid.placed")
+
+		// The event is still pending, so the next poll publishes it
+		publisher.OnPublish = nil
+		require.NoError(t, relay.ProcessBatch(ctx))
+		publisher.RequirePublished(t, events.AuctionEventsExchange, "bid.placed")
+	})
+
+	t.Run("needs a repository that can lock single events", func(t *testing.T) {
+		relay := events.NewOutboxRelay(
+			struct{ events.OutboxRepository }{},
+			eventstest.NewRecordingPublisher(),
+			&fakeTxManager{log: &callLog{}},
+			10,
+			time.Second,
+			events.AuctionEventsExchange,
+			nil,
+			slog.New(slog.NewTextHandler(io.Discard, nil)),
+		)
+		assert.Error(t, relay.PublishNow(ctx, uuid.New()))
+	})
+}
@@ -0,0 +1,61 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// PendingEventLocker is implemented by outbox repositories that support PublishNow
+type PendingEventLocker interface {
+	// LockPendingEvent locks one event if it is still pending and not locked by another
+	// publisher (FOR UPDATE SKIP LOCKED). It returns nil when there is nothing to claim.
+	LockPendingEvent(ctx context.Context, tx pgx.Tx, id uuid.UUID) (*OutboxEvent, error)
+}
+
+// errNoEventLocker is returned by PublishNow when the relay's repository cannot claim single events
+var errNoEventLocker = errors.New("outbox repository does not implement PendingEventLocker")
+
+// PublishNow publishes committed outbox events straight away instead of waiting for
+// the next poll, and marks them published. It is best effort: an event the relay has
+// already claimed is skipped, and on any failure the transaction rolls back and the
+// events stay pending for the relay to publish. Call it only after the transaction
+// that saved the events has committed.
+func (r *OutboxRelay) PublishNow(ctx context.Context, ids ...uuid.UUID) error {
+	locker, ok := r.outboxRepo.(PendingEventLocker)
+	if !ok {
+		return errNoEventLocker
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	tx, err := r.txManager.BeginTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	for _, id := range ids {
+		event, err := locker.LockPendingEvent(ctx, tx, id)
+		if err != nil {
+			return fmt.Errorf("failed to lock event %s: %w", id, err)
+		}
+		if event == nil {
+			continue // the relay has it, or already published it
+		}
+		if err := r.publish(ctx, event); err != nil {
+			return fmt.Errorf("failed to publish event %s: %w", id, err)
+		}
+		if err := r.outboxRepo.UpdateEventStatus(ctx, tx, id, OutboxStatusPublished); err != nil {
+			return fmt.Errorf("failed to update event status %s: %w", id, err)
+		}
+	}
+
+	return tx.Commit(ctx)
+}
@@ -3,10 +3,15 @@ package events
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"time"
 
 	amqp "github.com/rabbitmq/amqp091-go"
 )
 
+// AuctionEventsExchange is the topic exchange every service publishes domain events to
+const AuctionEventsExchange = "auction.events"
+
 // RabbitMQPublisher implements auction.EventPublisher
 type RabbitMQPublisher struct {
 	conn    *amqp.Connection
@@ -22,13 +27,13 @@ func NewRabbitMQPublisher(conn *amqp.Connection) (*RabbitMQPublisher, error) {
 
 	// Ensure the exchange exists
 	err = ch.ExchangeDeclare(
-		"auction.events", // name
-		"topic",          // type
-		true,             // durable
-		false,            // auto-deleted
-		false,            // internal
-		false,            // no-wait
-		nil,              // arguments
+		AuctionEventsExchange, // name
+		"topic",               // type
+		true,                  // durable
+		false,                 // auto-deleted
+		false,                 // internal
+		false,                 // no-wait
+		nil,                   // arguments
 	)
 	if err != nil {
 		ch.Close()
@@ -48,14 +53,37 @@ func (p *RabbitMQPublisher) Close() error {
 
 // Publish publishes a message to the broker
 func (p *RabbitMQPublisher) Publish(ctx context.Context, exchange, routingKey string, body []byte) error {
+	return p.publish(ctx, exchange, routingKey, amqp.Publishing{
+		ContentType: "application/x-protobuf",
+		Body:        body,
+	})
+}
+
+// PublishWithExpiration publishes a message that RabbitMQ drops after ttl in a queue
+func (p *RabbitMQPublisher) PublishWithExpiration(ctx context.Context, exchange, routingKey string, body []byte, ttl time.Duration) error {
+	return p.publish(ctx, exchange, routingKey, amqp.Publishing{
+		ContentType: "application/x-protobuf",
+		Body:        body,
+		Expiration:  expiration(ttl),
+	})
+}
+
+func (p *RabbitMQPublisher) publish(ctx context.Context, exchange, routingKey string, msg amqp.Publishing) error {
 	return p.channel.PublishWithContext(ctx,
 		exchange,   // exchange
 		routingKey, // routing key
 		false,      // mandatory
 		false,      // immediate
-		amqp.Publishing{
-			ContentType: "application/x-protobuf",
-			Body:        body,
-		},
+		msg,
 	)
 }
+
+// expiration formats ttl as the AMQP per-message expiration: whole milliseconds as a string.
+// Anything under a millisecond is rounded up so the message is not expired on arrival.
+func expiration(ttl time.Duration) string {
+	ms := ttl.Milliseconds()
+	if ms < 1 {
+		ms = 1
+	}
+	return strconv.FormatInt(ms, 10)
+}
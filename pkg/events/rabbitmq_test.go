@@ -0,0 +1,14 @@
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpiration(t *testing.T) {
+	assert.Equal(t, "5000", expiration(5*time.Second))
+	assert.Equal(t, "250", expiration(250*time.Millisecond))
+	assert.Equal(t, "1", expiration(100*time.Microsecond), "sub-millisecond TTLs round up")
+}
@@ -0,0 +1,57 @@
+package events
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+// Environment variables read by RelayConfigFromEnv
+const (
+	EnvOutboxBatchSize    = "OUTBOX_BATCH_SIZE"
+	EnvOutboxPollInterval = "OUTBOX_POLL_INTERVAL" // Go duration, e.g. "500ms"
+	EnvOutboxPublishNow   = "OUTBOX_PUBLISH_NOW"   // "true" to publish latency-sensitive events on commit
+)
+
+// MaxOutboxBatchSize bounds how many events one relay transaction may lock and publish
+const MaxOutboxBatchSize = 1000
+
+// RelayConfig tunes how often an OutboxRelay polls and how many events it takes per poll
+type RelayConfig struct {
+	BatchSize int
+	Interval  time.Duration
+
+	// PublishNow asks services to publish latency-sensitive events as soon as they
+	// commit, through OutboxRelay.PublishNow, leaving the poll as the fallback
+	PublishNow bool
+}
+
+// RelayConfigFromEnv starts from defaults and applies the OUTBOX_* variables.
+// Each binary passes its own defaults since relays run at different cadences.
+func RelayConfigFromEnv(defaults RelayConfig) (RelayConfig, error) {
+	cfg := defaults
+
+	if v := os.Getenv(EnvOutboxBatchSize); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > MaxOutboxBatchSize {
+			return cfg, fmt.Errorf("invalid %s %q: must be an integer between 1 and %d", EnvOutboxBatchSize, v, MaxOutboxBatchSize)
+		}
+		cfg.BatchSize = n
+	}
+	if v := os.Getenv(EnvOutboxPollInterval); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return cfg, fmt.Errorf("invalid %s %q: must be a positive duration", EnvOutboxPollInterval, v)
+		}
+		cfg.Interval = d
+	}
+	if v := os.Getenv(EnvOutboxPublishNow); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid %s %q: must be a boolean", EnvOutboxPublishNow, v)
+		}
+		cfg.PublishNow = b
+	}
+	return cfg, nil
+}
@@ -0,0 +1,53 @@
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelayConfigFromEnv(t *testing.T) {
+	defaults := RelayConfig{BatchSize: 10, Interval: time.Second}
+
+	t.Run("keeps defaults", func(t *testing.T) {
+		cfg, err := RelayConfigFromEnv(defaults)
+		require.NoError(t, err)
+		assert.Equal(t, defaults, cfg)
+	})
+
+	t.Run("applies env settings", func(t *testing.T) {
+		t.Setenv(EnvOutboxBatchSize, "250")
+		t.Setenv(EnvOutboxPollInterval, "200ms")
+		t.Setenv(EnvOutboxPublishNow, "true")
+
+		cfg, err := RelayConfigFromEnv(defaults)
+		require.NoError(t, err)
+		assert.Equal(t, RelayConfig{BatchSize: 250, Interval: 200 * time.Millisecond, PublishNow: true}, cfg)
+	})
+
+	t.Run("rejects invalid values", func(t *testing.T) {
+		tests := []struct {
+			key   string
+			value string
+		}{
+			{EnvOutboxBatchSize, "lots"},
+			{EnvOutboxBatchSize, "0"},
+			{EnvOutboxBatchSize, "-5"},
+			{EnvOutboxBatchSize, "1001"},
+			{EnvOutboxPollInterval, "soon"},
+			{EnvOutboxPollInterval, "0s"},
+			{EnvOutboxPollInterval, "-1s"},
+			{EnvOutboxPollInterval, "5"},
+			{EnvOutboxPublishNow, "sometimes"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.key+"="+tt.value, func(t *testing.T) {
+				t.Setenv(tt.key, tt.value)
+				_, err := RelayConfigFromEnv(defaults)
+				assert.ErrorContains(t, err, tt.key)
+			})
+		}
+	})
+}
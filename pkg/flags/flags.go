@@ -0,0 +1,19 @@
+// Package flags reads on/off feature flags from the environment, so a new RPC can be
+// deployed dark and switched on per environment.
+package flags
+
+import (
+	"os"
+	"strconv"
+)
+
+// EnvPrefix is prepended to a flag name to get the environment variable that sets it
+const EnvPrefix = "FEATURE_"
+
+// Enabled reports whether FEATURE_<name> holds a true value ("true", "1", ...).
+// Unset and unparseable values leave the feature off. The variable is read on each
+// call, so tests can flip a flag with t.Setenv.
+func Enabled(name string) bool {
+	on, err := strconv.ParseBool(os.Getenv(EnvPrefix + name))
+	return err == nil && on
+}
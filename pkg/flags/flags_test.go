@@ -0,0 +1,32 @@
+package flags
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnabled(t *testing.T) {
+	t.Run("off when unset", func(t *testing.T) {
+		assert.False(t, Enabled("SOME_UNSET_FEATURE"))
+	})
+
+	tests := []struct {
+		value string
+		want  bool
+	}{
+		{"true", true},
+		{"1", true},
+		{"TRUE", true},
+		{"false", false},
+		{"0", false},
+		{"yes", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		t.Run("value "+tt.value, func(t *testing.T) {
+			t.Setenv(EnvPrefix+"TEST_FEATURE", tt.value)
+			assert.Equal(t, tt.want, Enabled("TEST_FEATURE"))
+		})
+	}
+}
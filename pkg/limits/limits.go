@@ -0,0 +1,46 @@
+// Package limits holds the request size caps shared by the Connect services.
+package limits
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+)
+
+// EnvMaxRequestBytes overrides DefaultMaxRequestBytes
+const EnvMaxRequestBytes = "MAX_REQUEST_BYTES"
+
+// DefaultMaxRequestBytes comfortably fits the largest legitimate message (a CreateItem
+// with a long description and a full set of image URLs) while keeping a single request
+// from pinning a meaningful amount of memory.
+const DefaultMaxRequestBytes = 1 << 20 // 1 MiB
+
+// envelopePrefixBytes is the framing the Connect and gRPC protocols put in front of each
+// message, which the body limit has to allow on top of the message itself
+const envelopePrefixBytes = 5
+
+// MaxRequestBytesFromEnv returns MAX_REQUEST_BYTES, or DefaultMaxRequestBytes if unset
+func MaxRequestBytesFromEnv() (int, error) {
+	v := os.Getenv(EnvMaxRequestBytes)
+	if v == "" {
+		return DefaultMaxRequestBytes, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("invalid %s %q: must be a positive integer", EnvMaxRequestBytes, v)
+	}
+	return n, nil
+}
+
+// MaxBytesHandler caps how much of a request body next may read. Pair it with
+// connect.WithReadMaxBytes(maxBytes): Connect enforces the limit on the decoded message
+// and answers CodeResourceExhausted, but on its own it drains the rest of an oversized
+// body so the connection can be reused. The body cap stops that read early instead.
+func MaxBytesHandler(next http.Handler, maxBytes int) http.Handler {
+	limit := int64(maxBytes) + envelopePrefixBytes
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.Body = http.MaxBytesReader(w, r.Body, limit)
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,95 @@
+package limits
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestMaxRequestBytesFromEnv(t *testing.T) {
+	t.Run("defaults when unset", func(t *testing.T) {
+		n, err := MaxRequestBytesFromEnv()
+		require.NoError(t, err)
+		assert.Equal(t, DefaultMaxRequestBytes, n)
+	})
+
+	t.Run("applies env setting", func(t *testing.T) {
+		t.Setenv(EnvMaxRequestBytes, "4096")
+		n, err := MaxRequestBytesFromEnv()
+		require.NoError(t, err)
+		assert.Equal(t, 4096, n)
+	})
+
+	t.Run("rejects invalid values", func(t *testing.T) {
+		for _, v := range []string{"lots", "0", "-1", "1MiB"} {
+			t.Run(v, func(t *testing.T) {
+				t.Setenv(EnvMaxRequestBytes, v)
+				_, err := MaxRequestBytesFromEnv()
+				assert.ErrorContains(t, err, EnvMaxRequestBytes)
+			})
+		}
+	})
+}
+
+// countingBody records how much of the request body the server actually read
+type countingBody struct {
+	io.ReadCloser
+	n *atomic.Int64
+}
+
+func (b countingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.n.Add(int64(n))
+	return n, err
+}
+
+func TestMaxBytesHandler(t *testing.T) {
+	const procedure = "/test.v1.TestService/Echo"
+	const limit = 1024
+
+	var bodyRead atomic.Int64
+	var handled atomic.Bool
+	mux := http.NewServeMux()
+	mux.Handle(procedure, connect.NewUnaryHandler(procedure,
+		func(context.Context, *connect.Request[wrapperspb.StringValue]) (*connect.Response[emptypb.Empty], error) {
+			handled.Store(true)
+			return connect.NewResponse(&emptypb.Empty{}), nil
+		},
+		connect.WithReadMaxBytes(limit),
+	))
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.Body = countingBody{ReadCloser: r.Body, n: &bodyRead}
+		MaxBytesHandler(mux, limit).ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	client := connect.NewClient[wrapperspb.StringValue, emptypb.Empty](server.Client(), server.URL+procedure)
+	ctx := context.Background()
+
+	t.Run("accepts messages within the limit", func(t *testing.T) {
+		_, err := client.CallUnary(ctx, connect.NewRequest(wrapperspb.String("short description")))
+		require.NoError(t, err)
+	})
+
+	t.Run("rejects oversized messages before reading them whole", func(t *testing.T) {
+		handled.Store(false)
+		bodyRead.Store(0)
+		oversized := strings.Repeat("x", 8<<20)
+
+		_, err := client.CallUnary(ctx, connect.NewRequest(wrapperspb.String(oversized)))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err))
+		assert.False(t, handled.Load(), "the handler must not see an oversized message")
+		assert.Less(t, bodyRead.Load(), int64(len(oversized)/2), "the server should stop reading near the limit")
+	})
+}
@@ -0,0 +1,162 @@
+// Package lock provides a Redis-backed lease for coordinating singleton work
+// (such as periodic sweeps) across multiple service replicas.
+package lock
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// ErrNotHeld is returned when renewing or releasing a lease this instance does not own
+var ErrNotHeld = errors.New("lease not held")
+
+// renewScript extends the lease only if it is still owned by the caller's token
+var renewScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('PEXPIRE', KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// releaseScript deletes the lease only if it is still owned by the caller's token
+var releaseScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+// Lease is a time-bound exclusive lock held in Redis.
+// Each Lease has a unique token so only the owner can renew or release it.
+type Lease struct {
+	rdb   *redis.Client
+	key   string
+	token string
+	ttl   time.Duration
+}
+
+// NewLease creates a lease for key that expires after ttl unless renewed
+func NewLease(rdb *redis.Client, key string, ttl time.Duration) *Lease {
+	return &Lease{
+		rdb:   rdb,
+		key:   key,
+		token: newToken(),
+		ttl:   ttl,
+	}
+}
+
+// Acquire tries to take the lease. It returns false if another owner holds it.
+func (l *Lease) Acquire(ctx context.Context) (bool, error) {
+	ok, err := l.rdb.SetNX(ctx, l.key, l.token, l.ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to acquire lease %s: %w", l.key, err)
+	}
+	return ok, nil
+}
+
+// Renew extends the lease by its TTL. It returns ErrNotHeld if the lease expired
+// or was taken over by another owner.
+func (l *Lease) Renew(ctx context.Context) error {
+	res, err := renewScript.Run(ctx, l.rdb, []string{l.key}, l.token, l.ttl.Milliseconds()).Int()
+	if err != nil {
+		return fmt.Errorf("failed to renew lease %s: %w", l.key, err)
+	}
+	if res == 0 {
+		return ErrNotHeld
+	}
+	return nil
+}
+
+// Release gives up the lease. It returns ErrNotHeld if the lease is no longer owned.
+func (l *Lease) Release(ctx context.Context) error {
+	res, err := releaseScript.Run(ctx, l.rdb, []string{l.key}, l.token).Int()
+	if err != nil {
+		return fmt.Errorf("failed to release lease %s: %w", l.key, err)
+	}
+	if res == 0 {
+		return ErrNotHeld
+	}
+	return nil
+}
+
+// RunAsLeader blocks until ctx is canceled, running fn only while this instance holds the lease.
+// It retries acquisition every retryInterval and renews the lease at a third of its TTL.
+// If a renewal fails, the context passed to fn is canceled and acquisition starts over.
+func RunAsLeader(ctx context.Context, l *Lease, retryInterval time.Duration, logger *slog.Logger, fn func(ctx context.Context) error) error {
+	ticker := time.NewTicker(retryInterval)
+	defer ticker.Stop()
+
+	for {
+		acquired, err := l.Acquire(ctx)
+		if err != nil {
+			logger.Warn("Lease acquisition failed", "key", l.key, "error", err)
+		}
+		if acquired {
+			logger.Info("Lease acquired", "key", l.key)
+			if err := l.lead(ctx, logger, fn); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// lead runs fn while keeping the lease alive, then releases it
+func (l *Lease) lead(ctx context.Context, logger *slog.Logger, fn func(ctx context.Context) error) error {
+	leaderCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- fn(leaderCtx)
+	}()
+
+	renewTicker := time.NewTicker(l.ttl / 3)
+	defer renewTicker.Stop()
+
+	for {
+		select {
+		case err := <-done:
+			l.release(logger)
+			return err
+		case <-renewTicker.C:
+			if ctx.Err() != nil {
+				// Shutting down: wait for fn to return, then release
+				continue
+			}
+			if err := l.Renew(ctx); err != nil {
+				logger.Warn("Lease lost", "key", l.key, "error", err)
+				cancel()
+				<-done
+				return nil
+			}
+		}
+	}
+}
+
+// release gives up the lease using a fresh context, since the caller's may be canceled
+func (l *Lease) release(logger *slog.Logger) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := l.Release(ctx); err != nil && !errors.Is(err, ErrNotHeld) {
+		logger.Warn("Lease release failed", "key", l.key, "error", err)
+	}
+}
+
+func newToken() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,119 @@
+//go:build integration
+
+package lock_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/floroz/gavel/pkg/lock"
+	"github.com/floroz/gavel/pkg/testhelpers"
+)
+
+func TestLease_Integration(t *testing.T) {
+	rdb := testhelpers.NewTestRedis(t)
+	ctx := context.Background()
+
+	t.Run("only one owner can acquire", func(t *testing.T) {
+		a := lock.NewLease(rdb, "lease:acquire", time.Second)
+		b := lock.NewLease(rdb, "lease:acquire", time.Second)
+
+		ok, err := a.Acquire(ctx)
+		require.NoError(t, err)
+		assert.True(t, ok)
+
+		ok, err = b.Acquire(ctx)
+		require.NoError(t, err)
+		assert.False(t, ok)
+
+		// Only the owner can release
+		assert.ErrorIs(t, b.Release(ctx), lock.ErrNotHeld)
+		require.NoError(t, a.Release(ctx))
+
+		ok, err = b.Acquire(ctx)
+		require.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("renewal keeps the lease past its ttl", func(t *testing.T) {
+		a := lock.NewLease(rdb, "lease:renew", 300*time.Millisecond)
+		b := lock.NewLease(rdb, "lease:renew", 300*time.Millisecond)
+
+		ok, err := a.Acquire(ctx)
+		require.NoError(t, err)
+		require.True(t, ok)
+
+		for i := 0; i < 4; i++ {
+			time.Sleep(150 * time.Millisecond)
+			require.NoError(t, a.Renew(ctx))
+		}
+
+		ok, err = b.Acquire(ctx)
+		require.NoError(t, err)
+		assert.False(t, ok, "renewed lease should still be held")
+	})
+
+	t.Run("expired lease is handed over", func(t *testing.T) {
+		a := lock.NewLease(rdb, "lease:expiry", 200*time.Millisecond)
+		b := lock.NewLease(rdb, "lease:expiry", 200*time.Millisecond)
+
+		ok, err := a.Acquire(ctx)
+		require.NoError(t, err)
+		require.True(t, ok)
+
+		require.Eventually(t, func() bool {
+			ok, err := b.Acquire(ctx)
+			return err == nil && ok
+		}, 2*time.Second, 50*time.Millisecond)
+
+		// The previous owner can no longer renew or release
+		assert.ErrorIs(t, a.Renew(ctx), lock.ErrNotHeld)
+		assert.ErrorIs(t, a.Release(ctx), lock.ErrNotHeld)
+	})
+
+	t.Run("RunAsLeader runs a single leader at a time", func(t *testing.T) {
+		logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+		runCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		var active, maxActive int32
+		work := func(ctx context.Context) error {
+			n := atomic.AddInt32(&active, 1)
+			for {
+				m := atomic.LoadInt32(&maxActive)
+				if n <= m || atomic.CompareAndSwapInt32(&maxActive, m, n) {
+					break
+				}
+			}
+			<-ctx.Done()
+			atomic.AddInt32(&active, -1)
+			return nil
+		}
+
+		done := make(chan struct{}, 2)
+		for i := 0; i < 2; i++ {
+			go func() {
+				lease := lock.NewLease(rdb, "lease:leader", 300*time.Millisecond)
+				_ = lock.RunAsLeader(runCtx, lease, 50*time.Millisecond, logger, work)
+				done <- struct{}{}
+			}()
+		}
+
+		require.Eventually(t, func() bool {
+			return atomic.LoadInt32(&active) == 1
+		}, 2*time.Second, 20*time.Millisecond)
+		time.Sleep(time.Second) // several renewal cycles
+
+		cancel()
+		<-done
+		<-done
+		assert.Equal(t, int32(1), atomic.LoadInt32(&maxActive))
+	})
+}
@@ -0,0 +1,43 @@
+// Package logging builds the structured logger shared by every service binary.
+package logging
+
+import (
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+// Environment variables read by NewFromEnv
+const (
+	EnvLevel  = "LOG_LEVEL"  // debug, info, warn, error (default info)
+	EnvFormat = "LOG_FORMAT" // json or text (default json)
+)
+
+// New returns a logger writing to w at the given level and format.
+// Unknown values fall back to info level and JSON output.
+func New(w io.Writer, level, format string) *slog.Logger {
+	opts := &slog.HandlerOptions{Level: ParseLevel(level)}
+
+	var handler slog.Handler
+	if strings.EqualFold(strings.TrimSpace(format), "text") {
+		handler = slog.NewTextHandler(w, opts)
+	} else {
+		handler = slog.NewJSONHandler(w, opts)
+	}
+	return slog.New(handler)
+}
+
+// NewFromEnv returns a stdout logger configured from LOG_LEVEL and LOG_FORMAT
+func NewFromEnv() *slog.Logger {
+	return New(os.Stdout, os.Getenv(EnvLevel), os.Getenv(EnvFormat))
+}
+
+// ParseLevel maps a level name to a slog.Level, defaulting to info
+func ParseLevel(level string) slog.Level {
+	var l slog.Level
+	if err := l.UnmarshalText([]byte(strings.TrimSpace(level))); err != nil {
+		return slog.LevelInfo
+	}
+	return l
+}
@@ -0,0 +1,82 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLevel(t *testing.T) {
+	tests := []struct {
+		input string
+		want  slog.Level
+	}{
+		{"debug", slog.LevelDebug},
+		{"DEBUG", slog.LevelDebug},
+		{"info", slog.LevelInfo},
+		{"warn", slog.LevelWarn},
+		{" error ", slog.LevelError},
+		{"", slog.LevelInfo},
+		{"verbose", slog.LevelInfo},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.want, ParseLevel(tt.input))
+		})
+	}
+}
+
+func TestNew_RespectsLevel(t *testing.T) {
+	t.Run("debug suppressed at info", func(t *testing.T) {
+		var buf bytes.Buffer
+		logger := New(&buf, "info", "json")
+
+		logger.Debug("hidden")
+		logger.Info("shown")
+
+		assert.NotContains(t, buf.String(), "hidden")
+		assert.Contains(t, buf.String(), "shown")
+	})
+
+	t.Run("debug emitted at debug", func(t *testing.T) {
+		var buf bytes.Buffer
+		logger := New(&buf, "debug", "json")
+
+		logger.Debug("visible")
+
+		assert.Contains(t, buf.String(), "visible")
+	})
+}
+
+func TestNewFromEnv(t *testing.T) {
+	t.Setenv(EnvLevel, "warn")
+	t.Setenv(EnvFormat, "text")
+
+	logger := NewFromEnv()
+	assert.False(t, logger.Enabled(t.Context(), slog.LevelInfo))
+	assert.True(t, logger.Enabled(t.Context(), slog.LevelWarn))
+}
+
+func TestNew_Format(t *testing.T) {
+	t.Run("json by default", func(t *testing.T) {
+		var buf bytes.Buffer
+		New(&buf, "", "").Info("hello")
+
+		var entry map[string]any
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+		assert.Equal(t, "hello", entry["msg"])
+	})
+
+	t.Run("text when requested", func(t *testing.T) {
+		var buf bytes.Buffer
+		New(&buf, "", "text").Info("hello")
+
+		assert.True(t, strings.Contains(buf.String(), "msg=hello"))
+	})
+}
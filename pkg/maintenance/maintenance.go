@@ -0,0 +1,122 @@
+// Package maintenance lets a service keep serving reads while it rejects writes,
+// for deploys and migrations that cannot take concurrent changes.
+package maintenance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/floroz/gavel/pkg/proto/auth/v1/authv1connect"
+	"github.com/floroz/gavel/pkg/proto/bids/v1/bidsv1connect"
+)
+
+// EnvMaintenanceMode starts a service in maintenance mode when set to a true value
+const EnvMaintenanceMode = "MAINTENANCE_MODE"
+
+// RetryAfter is the delay suggested to clients whose writes are rejected
+const RetryAfter = 30 * time.Second
+
+// ErrMaintenance is returned, as CodeUnavailable, for writes made during maintenance
+var ErrMaintenance = errors.New("service is in maintenance mode, writes are temporarily disabled")
+
+// WriteProcedures lists every RPC that changes state. Anything not listed is treated as a read
+// and keeps working during maintenance, so new mutating RPCs must be added here.
+// Login, Refresh and Logout write sessions, so nobody can sign in while maintenance is on.
+var WriteProcedures = map[string]bool{
+	// Auth service
+	authv1connect.AuthServiceRegisterProcedure:      true,
+	authv1connect.AuthServiceLoginProcedure:         true,
+	authv1connect.AuthServiceRefreshProcedure:       true,
+	authv1connect.AuthServiceLogoutProcedure:        true,
+	authv1connect.AuthServiceDeleteAccountProcedure: true,
+
+	// Bid service
+	bidsv1connect.BidServicePlaceBidProcedure:           true,
+	bidsv1connect.BidServiceBuyNowProcedure:             true,
+	bidsv1connect.BidServiceCreateItemProcedure:         true,
+	bidsv1connect.BidServiceUpdateItemProcedure:         true,
+	bidsv1connect.BidServiceCancelItemProcedure:         true,
+	bidsv1connect.BidServiceBulkCancelItemsProcedure:    true,
+	bidsv1connect.BidServicePauseItemProcedure:          true,
+	bidsv1connect.BidServiceResumeItemProcedure:         true,
+	bidsv1connect.BidServiceExtendAuctionProcedure:      true,
+	bidsv1connect.BidServiceRecordItemViewProcedure:     true,
+	bidsv1connect.BidServiceAdminReconcileItemProcedure: true,
+}
+
+// Mode is the maintenance switch. It is safe to flip while requests are being served.
+type Mode struct {
+	enabled atomic.Bool
+}
+
+// FromEnv returns a Mode initialised from MAINTENANCE_MODE (off if unset)
+func FromEnv() (*Mode, error) {
+	m := &Mode{}
+	if v := os.Getenv(EnvMaintenanceMode); v != "" {
+		enabled, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s %q: must be a boolean", EnvMaintenanceMode, v)
+		}
+		m.enabled.Store(enabled)
+	}
+	return m, nil
+}
+
+// Enabled reports whether writes are currently rejected
+func (m *Mode) Enabled() bool { return m.enabled.Load() }
+
+// Set turns maintenance mode on or off
+func (m *Mode) Set(enabled bool) { m.enabled.Store(enabled) }
+
+// ToggleOnSignal flips the mode each time sig arrives, until ctx is done, so operators can
+// switch a running instance without a restart (e.g. kill -USR1).
+func (m *Mode) ToggleOnSignal(ctx context.Context, logger *slog.Logger, sig os.Signal) {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, sig)
+	go func() {
+		defer signal.Stop(ch)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ch:
+				enabled := !m.enabled.Load()
+				m.enabled.Store(enabled)
+				logger.Warn("Maintenance mode toggled", "enabled", enabled)
+			}
+		}
+	}()
+}
+
+// NewInterceptor rejects WriteProcedures with CodeUnavailable while mode is enabled. The error
+// carries a RetryInfo detail and a Retry-After header with RetryAfter.
+func NewInterceptor(mode *Mode) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if mode.Enabled() && WriteProcedures[req.Spec().Procedure] {
+				return nil, unavailableError()
+			}
+			return next(ctx, req)
+		}
+	}
+}
+
+func unavailableError() *connect.Error {
+	err := connect.NewError(connect.CodeUnavailable, ErrMaintenance)
+	err.Meta().Set("Retry-After", strconv.Itoa(int(RetryAfter.Seconds())))
+	if detail, detailErr := connect.NewErrorDetail(&errdetails.RetryInfo{RetryDelay: durationpb.New(RetryAfter)}); detailErr == nil {
+		err.AddDetail(detail)
+	}
+	return err
+}
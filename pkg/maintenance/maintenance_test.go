@@ -0,0 +1,101 @@
+package maintenance
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+
+	bidsv1 "github.com/floroz/gavel/pkg/proto/bids/v1"
+	"github.com/floroz/gavel/pkg/proto/bids/v1/bidsv1connect"
+)
+
+type fakeBidService struct {
+	bidsv1connect.UnimplementedBidServiceHandler
+}
+
+func (fakeBidService) PlaceBid(context.Context, *connect.Request[bidsv1.PlaceBidRequest]) (*connect.Response[bidsv1.PlaceBidResponse], error) {
+	return connect.NewResponse(&bidsv1.PlaceBidResponse{Bid: &bidsv1.Bid{Id: "bid-1"}}), nil
+}
+
+func (fakeBidService) GetItem(context.Context, *connect.Request[bidsv1.GetItemRequest]) (*connect.Response[bidsv1.GetItemResponse], error) {
+	return connect.NewResponse(&bidsv1.GetItemResponse{Item: &bidsv1.Item{Id: "item-1"}}), nil
+}
+
+func TestInterceptor(t *testing.T) {
+	mode := &Mode{}
+	_, handler := bidsv1connect.NewBidServiceHandler(fakeBidService{}, connect.WithInterceptors(NewInterceptor(mode)))
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	client := bidsv1connect.NewBidServiceClient(server.Client(), server.URL)
+	ctx := context.Background()
+
+	placeBid := func() error {
+		_, err := client.PlaceBid(ctx, connect.NewRequest(&bidsv1.PlaceBidRequest{ItemId: "item-1", Amount: 100}))
+		return err
+	}
+
+	t.Run("writes pass when off", func(t *testing.T) {
+		require.NoError(t, placeBid())
+	})
+
+	mode.Set(true)
+
+	t.Run("writes are rejected with a retry hint", func(t *testing.T) {
+		err := placeBid()
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeUnavailable, connect.CodeOf(err))
+
+		var connectErr *connect.Error
+		require.ErrorAs(t, err, &connectErr)
+		assert.Equal(t, "30", connectErr.Meta().Get("Retry-After"))
+
+		var retry *errdetails.RetryInfo
+		for _, detail := range connectErr.Details() {
+			if msg, valueErr := detail.Value(); valueErr == nil {
+				if info, ok := msg.(*errdetails.RetryInfo); ok {
+					retry = info
+				}
+			}
+		}
+		require.NotNil(t, retry, "expected a RetryInfo detail")
+		assert.Equal(t, RetryAfter, retry.RetryDelay.AsDuration())
+	})
+
+	t.Run("reads still succeed", func(t *testing.T) {
+		res, err := client.GetItem(ctx, connect.NewRequest(&bidsv1.GetItemRequest{Id: "item-1"}))
+		require.NoError(t, err)
+		assert.Equal(t, "item-1", res.Msg.Item.Id)
+	})
+
+	mode.Set(false)
+
+	t.Run("writes resume when switched off", func(t *testing.T) {
+		require.NoError(t, placeBid())
+	})
+}
+
+func TestFromEnv(t *testing.T) {
+	t.Run("off by default", func(t *testing.T) {
+		mode, err := FromEnv()
+		require.NoError(t, err)
+		assert.False(t, mode.Enabled())
+	})
+
+	t.Run("on", func(t *testing.T) {
+		t.Setenv(EnvMaintenanceMode, "true")
+		mode, err := FromEnv()
+		require.NoError(t, err)
+		assert.True(t, mode.Enabled())
+	})
+
+	t.Run("rejects invalid values", func(t *testing.T) {
+		t.Setenv(EnvMaintenanceMode, "sometimes")
+		_, err := FromEnv()
+		assert.ErrorContains(t, err, EnvMaintenanceMode)
+	})
+}
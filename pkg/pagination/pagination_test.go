@@ -0,0 +1,103 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testCodec(t *testing.T, key string) *Codec {
+	t.Helper()
+	codec, err := NewCodec([]byte(strings.Repeat(key, MinKeyLen)))
+	require.NoError(t, err)
+	return codec
+}
+
+func TestCursor_RoundTrip(t *testing.T) {
+	codec := testCodec(t, "k")
+	cursor := Cursor{Time: time.Date(2026, 3, 1, 12, 30, 0, 123456000, time.UTC), ID: uuid.New()}
+
+	token, err := EncodeCursor(codec, cursor)
+	require.NoError(t, err)
+	assert.NotContains(t, token, "=", "tokens are unpadded base64url")
+
+	decoded, err := DecodeCursor[Cursor](codec, token)
+	require.NoError(t, err)
+	require.NotNil(t, decoded)
+	assert.True(t, cursor.Time.Equal(decoded.Time))
+	assert.Equal(t, cursor.ID, decoded.ID)
+}
+
+func TestCursor_CustomType(t *testing.T) {
+	type amountCursor struct {
+		Amount int64     `json:"a"`
+		ID     uuid.UUID `json:"i"`
+	}
+	codec := testCodec(t, "k")
+	cursor := amountCursor{Amount: 4200, ID: uuid.New()}
+
+	token, err := EncodeCursor(codec, cursor)
+	require.NoError(t, err)
+
+	decoded, err := DecodeCursor[amountCursor](codec, token)
+	require.NoError(t, err)
+	assert.Equal(t, cursor, *decoded)
+}
+
+func TestDecodeCursor_EmptyTokenIsFirstPage(t *testing.T) {
+	decoded, err := DecodeCursor[Cursor](testCodec(t, "k"), "")
+	require.NoError(t, err)
+	assert.Nil(t, decoded)
+}
+
+func TestDecodeCursor_RejectsTampering(t *testing.T) {
+	codec := testCodec(t, "k")
+	token, err := EncodeCursor(codec, Cursor{Time: time.Now(), ID: uuid.New()})
+	require.NoError(t, err)
+	payload, sig, _ := strings.Cut(token, ".")
+
+	// A client rewriting the position keeps the old signature
+	forged := base64.RawURLEncoding.EncodeToString([]byte(`{"t":"2020-01-01T00:00:00Z","i":"` + uuid.NewString() + `"}`))
+
+	tests := map[string]string{
+		"forged position":         forged + "." + sig,
+		"signature dropped":       payload,
+		"empty signature":         payload + ".",
+		"truncated signature":     payload + "." + sig[:len(sig)-2],
+		"not base64":              "not a token!.sig",
+		"signed with another key": mustEncode(t, testCodec(t, "x"), Cursor{ID: uuid.New()}),
+	}
+	for name, token := range tests {
+		t.Run(name, func(t *testing.T) {
+			decoded, err := DecodeCursor[Cursor](codec, token)
+			assert.ErrorIs(t, err, ErrInvalidToken)
+			assert.Nil(t, decoded)
+		})
+	}
+}
+
+func TestDecodeCursor_RejectsWrongShape(t *testing.T) {
+	codec := testCodec(t, "k")
+	token, err := EncodeCursor(codec, "just a string")
+	require.NoError(t, err)
+
+	_, err = DecodeCursor[Cursor](codec, token)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestNewCodec_RejectsShortKey(t *testing.T) {
+	_, err := NewCodec([]byte("short"))
+	assert.Error(t, err)
+}
+
+func mustEncode(t *testing.T, codec *Codec, cursor Cursor) string {
+	t.Helper()
+	token, err := EncodeCursor(codec, cursor)
+	require.NoError(t, err)
+	return token
+}
@@ -30,6 +30,7 @@ type RegisterRequest struct {
 	FullName      string                 `protobuf:"bytes,3,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
 	CountryCode   string                 `protobuf:"bytes,4,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"` // ISO 3166-1 alpha-2
 	PhoneNumber   string                 `protobuf:"bytes,5,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	Locale        string                 `protobuf:"bytes,6,opt,name=locale,proto3" json:"locale,omitempty"` // BCP 47, e.g. "en-US"; its region is used when country_code is empty
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -99,6 +100,13 @@ func (x *RegisterRequest) GetPhoneNumber() string {
 	return ""
 }
 
+func (x *RegisterRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
 type RegisterResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -144,11 +152,14 @@ func (x *RegisterResponse) GetUserId() string {
 }
 
 type LoginRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	IpAddress     string                 `protobuf:"bytes,3,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
-	UserAgent     string                 `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Email    string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Password string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// Ignored: the server records the client IP it resolves from the connection
+	//
+	// Deprecated: Marked as deprecated in auth/v1/auth_service.proto.
+	IpAddress     string `protobuf:"bytes,3,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	UserAgent     string `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -197,6 +208,7 @@ func (x *LoginRequest) GetPassword() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in auth/v1/auth_service.proto.
 func (x *LoginRequest) GetIpAddress() string {
 	if x != nil {
 		return x.IpAddress
@@ -272,10 +284,13 @@ func (x *LoginResponse) GetExpiresAt() *timestamppb.Timestamp {
 }
 
 type RefreshRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
-	IpAddress     string                 `protobuf:"bytes,2,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
-	UserAgent     string                 `protobuf:"bytes,3,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	RefreshToken string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	// Ignored: the server records the client IP it resolves from the connection
+	//
+	// Deprecated: Marked as deprecated in auth/v1/auth_service.proto.
+	IpAddress     string `protobuf:"bytes,2,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	UserAgent     string `protobuf:"bytes,3,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -317,6 +332,7 @@ func (x *RefreshRequest) GetRefreshToken() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in auth/v1/auth_service.proto.
 func (x *RefreshRequest) GetIpAddress() string {
 	if x != nil {
 		return x.IpAddress
@@ -599,6 +615,446 @@ func (x *GetProfileResponse) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+type GetDisplayNamesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []string               `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDisplayNamesRequest) Reset() {
+	*x = GetDisplayNamesRequest{}
+	mi := &file_auth_v1_auth_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDisplayNamesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDisplayNamesRequest) ProtoMessage() {}
+
+func (x *GetDisplayNamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDisplayNamesRequest.ProtoReflect.Descriptor instead.
+func (*GetDisplayNamesRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetDisplayNamesRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type GetDisplayNamesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DisplayNames  map[string]string      `protobuf:"bytes,1,rep,name=display_names,json=displayNames,proto3" json:"display_names,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // user_id -> display name
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDisplayNamesResponse) Reset() {
+	*x = GetDisplayNamesResponse{}
+	mi := &file_auth_v1_auth_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDisplayNamesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDisplayNamesResponse) ProtoMessage() {}
+
+func (x *GetDisplayNamesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDisplayNamesResponse.ProtoReflect.Descriptor instead.
+func (*GetDisplayNamesResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetDisplayNamesResponse) GetDisplayNames() map[string]string {
+	if x != nil {
+		return x.DisplayNames
+	}
+	return nil
+}
+
+type AdminGetUserRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Lookup:
+	//
+	//	*AdminGetUserRequest_UserId
+	//	*AdminGetUserRequest_Email
+	Lookup        isAdminGetUserRequest_Lookup `protobuf_oneof:"lookup"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminGetUserRequest) Reset() {
+	*x = AdminGetUserRequest{}
+	mi := &file_auth_v1_auth_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGetUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGetUserRequest) ProtoMessage() {}
+
+func (x *AdminGetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGetUserRequest.ProtoReflect.Descriptor instead.
+func (*AdminGetUserRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AdminGetUserRequest) GetLookup() isAdminGetUserRequest_Lookup {
+	if x != nil {
+		return x.Lookup
+	}
+	return nil
+}
+
+func (x *AdminGetUserRequest) GetUserId() string {
+	if x != nil {
+		if x, ok := x.Lookup.(*AdminGetUserRequest_UserId); ok {
+			return x.UserId
+		}
+	}
+	return ""
+}
+
+func (x *AdminGetUserRequest) GetEmail() string {
+	if x != nil {
+		if x, ok := x.Lookup.(*AdminGetUserRequest_Email); ok {
+			return x.Email
+		}
+	}
+	return ""
+}
+
+type isAdminGetUserRequest_Lookup interface {
+	isAdminGetUserRequest_Lookup()
+}
+
+type AdminGetUserRequest_UserId struct {
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3,oneof"`
+}
+
+type AdminGetUserRequest_Email struct {
+	Email string `protobuf:"bytes,2,opt,name=email,proto3,oneof"`
+}
+
+func (*AdminGetUserRequest_UserId) isAdminGetUserRequest_Lookup() {}
+
+func (*AdminGetUserRequest_Email) isAdminGetUserRequest_Lookup() {}
+
+// AdminGetUserResponse carries the non-sensitive user fields; credentials are never exposed.
+type AdminGetUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	FullName      string                 `protobuf:"bytes,3,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	AvatarUrl     string                 `protobuf:"bytes,4,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	CountryCode   string                 `protobuf:"bytes,5,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+	PhoneNumber   string                 `protobuf:"bytes,6,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminGetUserResponse) Reset() {
+	*x = AdminGetUserResponse{}
+	mi := &file_auth_v1_auth_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGetUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGetUserResponse) ProtoMessage() {}
+
+func (x *AdminGetUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGetUserResponse.ProtoReflect.Descriptor instead.
+func (*AdminGetUserResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AdminGetUserResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AdminGetUserResponse) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *AdminGetUserResponse) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *AdminGetUserResponse) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *AdminGetUserResponse) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+func (x *AdminGetUserResponse) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *AdminGetUserResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AdminGetUserResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ExportUserDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // optional, defaults to the authenticated user
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserDataRequest) Reset() {
+	*x = ExportUserDataRequest{}
+	mi := &file_auth_v1_auth_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataRequest) ProtoMessage() {}
+
+func (x *ExportUserDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportUserDataRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ExportUserDataRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ExportUserDataResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// JSON object with "exported_at", "profile", "bids" and "stats" sections
+	Document      []byte `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserDataResponse) Reset() {
+	*x = ExportUserDataResponse{}
+	mi := &file_auth_v1_auth_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataResponse) ProtoMessage() {}
+
+func (x *ExportUserDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataResponse.ProtoReflect.Descriptor instead.
+func (*ExportUserDataResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ExportUserDataResponse) GetDocument() []byte {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+type DeleteAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // optional, defaults to the authenticated user
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccountRequest) Reset() {
+	*x = DeleteAccountRequest{}
+	mi := &file_auth_v1_auth_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountRequest) ProtoMessage() {}
+
+func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DeleteAccountRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccountResponse) Reset() {
+	*x = DeleteAccountResponse{}
+	mi := &file_auth_v1_auth_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountResponse) ProtoMessage() {}
+
+func (x *DeleteAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAccountResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_service_proto_rawDescGZIP(), []int{17}
+}
+
 type TokenClaims struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Sub           string                 `protobuf:"bytes,1,opt,name=sub,proto3" json:"sub,omitempty"`
@@ -615,7 +1071,7 @@ type TokenClaims struct {
 
 func (x *TokenClaims) Reset() {
 	*x = TokenClaims{}
-	mi := &file_auth_v1_auth_service_proto_msgTypes[10]
+	mi := &file_auth_v1_auth_service_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -627,7 +1083,7 @@ func (x *TokenClaims) String() string {
 func (*TokenClaims) ProtoMessage() {}
 
 func (x *TokenClaims) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_v1_auth_service_proto_msgTypes[10]
+	mi := &file_auth_v1_auth_service_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -640,7 +1096,7 @@ func (x *TokenClaims) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TokenClaims.ProtoReflect.Descriptor instead.
 func (*TokenClaims) Descriptor() ([]byte, []int) {
-	return file_auth_v1_auth_service_proto_rawDescGZIP(), []int{10}
+	return file_auth_v1_auth_service_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *TokenClaims) GetSub() string {
@@ -703,31 +1159,32 @@ var File_auth_v1_auth_service_proto protoreflect.FileDescriptor
 
 const file_auth_v1_auth_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1aauth/v1/auth_service.proto\x12\aauth.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa6\x01\n" +
+	"\x1aauth/v1/auth_service.proto\x12\aauth.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xbe\x01\n" +
 	"\x0fRegisterRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x1b\n" +
 	"\tfull_name\x18\x03 \x01(\tR\bfullName\x12!\n" +
 	"\fcountry_code\x18\x04 \x01(\tR\vcountryCode\x12!\n" +
-	"\fphone_number\x18\x05 \x01(\tR\vphoneNumber\"+\n" +
+	"\fphone_number\x18\x05 \x01(\tR\vphoneNumber\x12\x16\n" +
+	"\x06locale\x18\x06 \x01(\tR\x06locale\"+\n" +
 	"\x10RegisterResponse\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"~\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x82\x01\n" +
 	"\fLoginRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x1d\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12!\n" +
 	"\n" +
-	"ip_address\x18\x03 \x01(\tR\tipAddress\x12\x1d\n" +
+	"ip_address\x18\x03 \x01(\tB\x02\x18\x01R\tipAddress\x12\x1d\n" +
 	"\n" +
 	"user_agent\x18\x04 \x01(\tR\tuserAgent\"\x92\x01\n" +
 	"\rLoginResponse\x12!\n" +
 	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
 	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x129\n" +
 	"\n" +
-	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"s\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"w\n" +
 	"\x0eRefreshRequest\x12#\n" +
-	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\x12\x1d\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\x12!\n" +
 	"\n" +
-	"ip_address\x18\x02 \x01(\tR\tipAddress\x12\x1d\n" +
+	"ip_address\x18\x02 \x01(\tB\x02\x18\x01R\tipAddress\x12\x1d\n" +
 	"\n" +
 	"user_agent\x18\x03 \x01(\tR\tuserAgent\"\x94\x01\n" +
 	"\x0fRefreshResponse\x12!\n" +
@@ -748,7 +1205,37 @@ const file_auth_v1_auth_service_proto_rawDesc = "" +
 	"avatar_url\x18\x04 \x01(\tR\tavatarUrl\x12!\n" +
 	"\fcountry_code\x18\x05 \x01(\tR\vcountryCode\x129\n" +
 	"\n" +
-	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xbe\x01\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"3\n" +
+	"\x16GetDisplayNamesRequest\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\tR\auserIds\"\xb3\x01\n" +
+	"\x17GetDisplayNamesResponse\x12W\n" +
+	"\rdisplay_names\x18\x01 \x03(\v22.auth.v1.GetDisplayNamesResponse.DisplayNamesEntryR\fdisplayNames\x1a?\n" +
+	"\x11DisplayNamesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"R\n" +
+	"\x13AdminGetUserRequest\x12\x19\n" +
+	"\auser_id\x18\x01 \x01(\tH\x00R\x06userId\x12\x16\n" +
+	"\x05email\x18\x02 \x01(\tH\x00R\x05emailB\b\n" +
+	"\x06lookup\"\xb4\x02\n" +
+	"\x14AdminGetUserResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1b\n" +
+	"\tfull_name\x18\x03 \x01(\tR\bfullName\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x04 \x01(\tR\tavatarUrl\x12!\n" +
+	"\fcountry_code\x18\x05 \x01(\tR\vcountryCode\x12!\n" +
+	"\fphone_number\x18\x06 \x01(\tR\vphoneNumber\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"0\n" +
+	"\x15ExportUserDataRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"4\n" +
+	"\x16ExportUserDataResponse\x12\x1a\n" +
+	"\bdocument\x18\x01 \x01(\fR\bdocument\"/\n" +
+	"\x14DeleteAccountRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x17\n" +
+	"\x15DeleteAccountResponse\"\xbe\x01\n" +
 	"\vTokenClaims\x12\x10\n" +
 	"\x03sub\x18\x01 \x01(\tR\x03sub\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1b\n" +
@@ -757,14 +1244,18 @@ const file_auth_v1_auth_service_proto_rawDesc = "" +
 	"\vpermissions\x18\x05 \x03(\tR\vpermissions\x12\x10\n" +
 	"\x03iss\x18\x06 \x01(\tR\x03iss\x12\x10\n" +
 	"\x03exp\x18\a \x01(\x01R\x03exp\x12\x10\n" +
-	"\x03iat\x18\b \x01(\x01R\x03iat2\xc6\x02\n" +
+	"\x03iat\x18\b \x01(\x01R\x03iat2\x8c\x05\n" +
 	"\vAuthService\x12?\n" +
 	"\bRegister\x12\x18.auth.v1.RegisterRequest\x1a\x19.auth.v1.RegisterResponse\x126\n" +
 	"\x05Login\x12\x15.auth.v1.LoginRequest\x1a\x16.auth.v1.LoginResponse\x12<\n" +
 	"\aRefresh\x12\x17.auth.v1.RefreshRequest\x1a\x18.auth.v1.RefreshResponse\x129\n" +
 	"\x06Logout\x12\x16.auth.v1.LogoutRequest\x1a\x17.auth.v1.LogoutResponse\x12E\n" +
 	"\n" +
-	"GetProfile\x12\x1a.auth.v1.GetProfileRequest\x1a\x1b.auth.v1.GetProfileResponseB2Z0github.com/floroz/gavel/pkg/proto/auth/v1;authv1b\x06proto3"
+	"GetProfile\x12\x1a.auth.v1.GetProfileRequest\x1a\x1b.auth.v1.GetProfileResponse\x12T\n" +
+	"\x0fGetDisplayNames\x12\x1f.auth.v1.GetDisplayNamesRequest\x1a .auth.v1.GetDisplayNamesResponse\x12K\n" +
+	"\fAdminGetUser\x12\x1c.auth.v1.AdminGetUserRequest\x1a\x1d.auth.v1.AdminGetUserResponse\x12Q\n" +
+	"\x0eExportUserData\x12\x1e.auth.v1.ExportUserDataRequest\x1a\x1f.auth.v1.ExportUserDataResponse\x12N\n" +
+	"\rDeleteAccount\x12\x1d.auth.v1.DeleteAccountRequest\x1a\x1e.auth.v1.DeleteAccountResponseB2Z0github.com/floroz/gavel/pkg/proto/auth/v1;authv1b\x06proto3"
 
 var (
 	file_auth_v1_auth_service_proto_rawDescOnce sync.Once
@@ -778,40 +1269,60 @@ func file_auth_v1_auth_service_proto_rawDescGZIP() []byte {
 	return file_auth_v1_auth_service_proto_rawDescData
 }
 
-var file_auth_v1_auth_service_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_auth_v1_auth_service_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_auth_v1_auth_service_proto_goTypes = []any{
-	(*RegisterRequest)(nil),       // 0: auth.v1.RegisterRequest
-	(*RegisterResponse)(nil),      // 1: auth.v1.RegisterResponse
-	(*LoginRequest)(nil),          // 2: auth.v1.LoginRequest
-	(*LoginResponse)(nil),         // 3: auth.v1.LoginResponse
-	(*RefreshRequest)(nil),        // 4: auth.v1.RefreshRequest
-	(*RefreshResponse)(nil),       // 5: auth.v1.RefreshResponse
-	(*LogoutRequest)(nil),         // 6: auth.v1.LogoutRequest
-	(*LogoutResponse)(nil),        // 7: auth.v1.LogoutResponse
-	(*GetProfileRequest)(nil),     // 8: auth.v1.GetProfileRequest
-	(*GetProfileResponse)(nil),    // 9: auth.v1.GetProfileResponse
-	(*TokenClaims)(nil),           // 10: auth.v1.TokenClaims
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(*RegisterRequest)(nil),         // 0: auth.v1.RegisterRequest
+	(*RegisterResponse)(nil),        // 1: auth.v1.RegisterResponse
+	(*LoginRequest)(nil),            // 2: auth.v1.LoginRequest
+	(*LoginResponse)(nil),           // 3: auth.v1.LoginResponse
+	(*RefreshRequest)(nil),          // 4: auth.v1.RefreshRequest
+	(*RefreshResponse)(nil),         // 5: auth.v1.RefreshResponse
+	(*LogoutRequest)(nil),           // 6: auth.v1.LogoutRequest
+	(*LogoutResponse)(nil),          // 7: auth.v1.LogoutResponse
+	(*GetProfileRequest)(nil),       // 8: auth.v1.GetProfileRequest
+	(*GetProfileResponse)(nil),      // 9: auth.v1.GetProfileResponse
+	(*GetDisplayNamesRequest)(nil),  // 10: auth.v1.GetDisplayNamesRequest
+	(*GetDisplayNamesResponse)(nil), // 11: auth.v1.GetDisplayNamesResponse
+	(*AdminGetUserRequest)(nil),     // 12: auth.v1.AdminGetUserRequest
+	(*AdminGetUserResponse)(nil),    // 13: auth.v1.AdminGetUserResponse
+	(*ExportUserDataRequest)(nil),   // 14: auth.v1.ExportUserDataRequest
+	(*ExportUserDataResponse)(nil),  // 15: auth.v1.ExportUserDataResponse
+	(*DeleteAccountRequest)(nil),    // 16: auth.v1.DeleteAccountRequest
+	(*DeleteAccountResponse)(nil),   // 17: auth.v1.DeleteAccountResponse
+	(*TokenClaims)(nil),             // 18: auth.v1.TokenClaims
+	nil,                             // 19: auth.v1.GetDisplayNamesResponse.DisplayNamesEntry
+	(*timestamppb.Timestamp)(nil),   // 20: google.protobuf.Timestamp
 }
 var file_auth_v1_auth_service_proto_depIdxs = []int32{
-	11, // 0: auth.v1.LoginResponse.expires_at:type_name -> google.protobuf.Timestamp
-	11, // 1: auth.v1.RefreshResponse.expires_at:type_name -> google.protobuf.Timestamp
-	11, // 2: auth.v1.GetProfileResponse.created_at:type_name -> google.protobuf.Timestamp
-	0,  // 3: auth.v1.AuthService.Register:input_type -> auth.v1.RegisterRequest
-	2,  // 4: auth.v1.AuthService.Login:input_type -> auth.v1.LoginRequest
-	4,  // 5: auth.v1.AuthService.Refresh:input_type -> auth.v1.RefreshRequest
-	6,  // 6: auth.v1.AuthService.Logout:input_type -> auth.v1.LogoutRequest
-	8,  // 7: auth.v1.AuthService.GetProfile:input_type -> auth.v1.GetProfileRequest
-	1,  // 8: auth.v1.AuthService.Register:output_type -> auth.v1.RegisterResponse
-	3,  // 9: auth.v1.AuthService.Login:output_type -> auth.v1.LoginResponse
-	5,  // 10: auth.v1.AuthService.Refresh:output_type -> auth.v1.RefreshResponse
-	7,  // 11: auth.v1.AuthService.Logout:output_type -> auth.v1.LogoutResponse
-	9,  // 12: auth.v1.AuthService.GetProfile:output_type -> auth.v1.GetProfileResponse
-	8,  // [8:13] is the sub-list for method output_type
-	3,  // [3:8] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	20, // 0: auth.v1.LoginResponse.expires_at:type_name -> google.protobuf.Timestamp
+	20, // 1: auth.v1.RefreshResponse.expires_at:type_name -> google.protobuf.Timestamp
+	20, // 2: auth.v1.GetProfileResponse.created_at:type_name -> google.protobuf.Timestamp
+	19, // 3: auth.v1.GetDisplayNamesResponse.display_names:type_name -> auth.v1.GetDisplayNamesResponse.DisplayNamesEntry
+	20, // 4: auth.v1.AdminGetUserResponse.created_at:type_name -> google.protobuf.Timestamp
+	20, // 5: auth.v1.AdminGetUserResponse.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 6: auth.v1.AuthService.Register:input_type -> auth.v1.RegisterRequest
+	2,  // 7: auth.v1.AuthService.Login:input_type -> auth.v1.LoginRequest
+	4,  // 8: auth.v1.AuthService.Refresh:input_type -> auth.v1.RefreshRequest
+	6,  // 9: auth.v1.AuthService.Logout:input_type -> auth.v1.LogoutRequest
+	8,  // 10: auth.v1.AuthService.GetProfile:input_type -> auth.v1.GetProfileRequest
+	10, // 11: auth.v1.AuthService.GetDisplayNames:input_type -> auth.v1.GetDisplayNamesRequest
+	12, // 12: auth.v1.AuthService.AdminGetUser:input_type -> auth.v1.AdminGetUserRequest
+	14, // 13: auth.v1.AuthService.ExportUserData:input_type -> auth.v1.ExportUserDataRequest
+	16, // 14: auth.v1.AuthService.DeleteAccount:input_type -> auth.v1.DeleteAccountRequest
+	1,  // 15: auth.v1.AuthService.Register:output_type -> auth.v1.RegisterResponse
+	3,  // 16: auth.v1.AuthService.Login:output_type -> auth.v1.LoginResponse
+	5,  // 17: auth.v1.AuthService.Refresh:output_type -> auth.v1.RefreshResponse
+	7,  // 18: auth.v1.AuthService.Logout:output_type -> auth.v1.LogoutResponse
+	9,  // 19: auth.v1.AuthService.GetProfile:output_type -> auth.v1.GetProfileResponse
+	11, // 20: auth.v1.AuthService.GetDisplayNames:output_type -> auth.v1.GetDisplayNamesResponse
+	13, // 21: auth.v1.AuthService.AdminGetUser:output_type -> auth.v1.AdminGetUserResponse
+	15, // 22: auth.v1.AuthService.ExportUserData:output_type -> auth.v1.ExportUserDataResponse
+	17, // 23: auth.v1.AuthService.DeleteAccount:output_type -> auth.v1.DeleteAccountResponse
+	15, // [15:24] is the sub-list for method output_type
+	6,  // [6:15] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_auth_v1_auth_service_proto_init() }
@@ -819,13 +1330,17 @@ func file_auth_v1_auth_service_proto_init() {
 	if File_auth_v1_auth_service_proto != nil {
 		return
 	}
+	file_auth_v1_auth_service_proto_msgTypes[12].OneofWrappers = []any{
+		(*AdminGetUserRequest_UserId)(nil),
+		(*AdminGetUserRequest_Email)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_auth_v1_auth_service_proto_rawDesc), len(file_auth_v1_auth_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
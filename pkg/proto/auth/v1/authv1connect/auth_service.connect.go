@@ -45,6 +45,18 @@ const (
 	AuthServiceLogoutProcedure = "/auth.v1.AuthService/Logout"
 	// AuthServiceGetProfileProcedure is the fully-qualified name of the AuthService's GetProfile RPC.
 	AuthServiceGetProfileProcedure = "/auth.v1.AuthService/GetProfile"
+	// AuthServiceGetDisplayNamesProcedure is the fully-qualified name of the AuthService's
+	// GetDisplayNames RPC.
+	AuthServiceGetDisplayNamesProcedure = "/auth.v1.AuthService/GetDisplayNames"
+	// AuthServiceAdminGetUserProcedure is the fully-qualified name of the AuthService's AdminGetUser
+	// RPC.
+	AuthServiceAdminGetUserProcedure = "/auth.v1.AuthService/AdminGetUser"
+	// AuthServiceExportUserDataProcedure is the fully-qualified name of the AuthService's
+	// ExportUserData RPC.
+	AuthServiceExportUserDataProcedure = "/auth.v1.AuthService/ExportUserData"
+	// AuthServiceDeleteAccountProcedure is the fully-qualified name of the AuthService's DeleteAccount
+	// RPC.
+	AuthServiceDeleteAccountProcedure = "/auth.v1.AuthService/DeleteAccount"
 )
 
 // AuthServiceClient is a client for the auth.v1.AuthService service.
@@ -60,6 +72,20 @@ type AuthServiceClient interface {
 	// GetProfile returns the full user details.
 	// If user_id is empty, it returns the profile of the authenticated user ("Me").
 	GetProfile(context.Context, *connect.Request[v1.GetProfileRequest]) (*connect.Response[v1.GetProfileResponse], error)
+	// GetDisplayNames returns the public display names of up to 100 users, for other services
+	// to label user ids. Unknown ids are omitted from the result. Requires a service
+	// token or the "admin" permission.
+	GetDisplayNames(context.Context, *connect.Request[v1.GetDisplayNamesRequest]) (*connect.Response[v1.GetDisplayNamesResponse], error)
+	// AdminGetUser looks up any user by id or email. Requires the "admin" permission.
+	AdminGetUser(context.Context, *connect.Request[v1.AdminGetUserRequest]) (*connect.Response[v1.AdminGetUserResponse], error)
+	// ExportUserData returns everything held about a user across the auth, bid and
+	// user-stats services as one JSON document. Users may export themselves; exporting
+	// anyone else requires the "admin" permission.
+	ExportUserData(context.Context, *connect.Request[v1.ExportUserDataRequest]) (*connect.Response[v1.ExportUserDataResponse], error)
+	// DeleteAccount deletes a user and ends all of their sessions. The bid and user-stats
+	// services erase their copies asynchronously from the user.deleted event. Users may
+	// delete themselves; deleting anyone else requires the "admin" permission.
+	DeleteAccount(context.Context, *connect.Request[v1.DeleteAccountRequest]) (*connect.Response[v1.DeleteAccountResponse], error)
 }
 
 // NewAuthServiceClient constructs a client for the auth.v1.AuthService service. By default, it uses
@@ -103,16 +129,44 @@ func NewAuthServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(authServiceMethods.ByName("GetProfile")),
 			connect.WithClientOptions(opts...),
 		),
+		getDisplayNames: connect.NewClient[v1.GetDisplayNamesRequest, v1.GetDisplayNamesResponse](
+			httpClient,
+			baseURL+AuthServiceGetDisplayNamesProcedure,
+			connect.WithSchema(authServiceMethods.ByName("GetDisplayNames")),
+			connect.WithClientOptions(opts...),
+		),
+		adminGetUser: connect.NewClient[v1.AdminGetUserRequest, v1.AdminGetUserResponse](
+			httpClient,
+			baseURL+AuthServiceAdminGetUserProcedure,
+			connect.WithSchema(authServiceMethods.ByName("AdminGetUser")),
+			connect.WithClientOptions(opts...),
+		),
+		exportUserData: connect.NewClient[v1.ExportUserDataRequest, v1.ExportUserDataResponse](
+			httpClient,
+			baseURL+AuthServiceExportUserDataProcedure,
+			connect.WithSchema(authServiceMethods.ByName("ExportUserData")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAccount: connect.NewClient[v1.DeleteAccountRequest, v1.DeleteAccountResponse](
+			httpClient,
+			baseURL+AuthServiceDeleteAccountProcedure,
+			connect.WithSchema(authServiceMethods.ByName("DeleteAccount")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // authServiceClient implements AuthServiceClient.
 type authServiceClient struct {
-	register   *connect.Client[v1.RegisterRequest, v1.RegisterResponse]
-	login      *connect.Client[v1.LoginRequest, v1.LoginResponse]
-	refresh    *connect.Client[v1.RefreshRequest, v1.RefreshResponse]
-	logout     *connect.Client[v1.LogoutRequest, v1.LogoutResponse]
-	getProfile *connect.Client[v1.GetProfileRequest, v1.GetProfileResponse]
+	register        *connect.Client[v1.RegisterRequest, v1.RegisterResponse]
+	login           *connect.Client[v1.LoginRequest, v1.LoginResponse]
+	refresh         *connect.Client[v1.RefreshRequest, v1.RefreshResponse]
+	logout          *connect.Client[v1.LogoutRequest, v1.LogoutResponse]
+	getProfile      *connect.Client[v1.GetProfileRequest, v1.GetProfileResponse]
+	getDisplayNames *connect.Client[v1.GetDisplayNamesRequest, v1.GetDisplayNamesResponse]
+	adminGetUser    *connect.Client[v1.AdminGetUserRequest, v1.AdminGetUserResponse]
+	exportUserData  *connect.Client[v1.ExportUserDataRequest, v1.ExportUserDataResponse]
+	deleteAccount   *connect.Client[v1.DeleteAccountRequest, v1.DeleteAccountResponse]
 }
 
 // Register calls auth.v1.AuthService.Register.
@@ -140,6 +194,26 @@ func (c *authServiceClient) GetProfile(ctx context.Context, req *connect.Request
 	return c.getProfile.CallUnary(ctx, req)
 }
 
+// GetDisplayNames calls auth.v1.AuthService.GetDisplayNames.
+func (c *authServiceClient) GetDisplayNames(ctx context.Context, req *connect.Request[v1.GetDisplayNamesRequest]) (*connect.Response[v1.GetDisplayNamesResponse], error) {
+	return c.getDisplayNames.CallUnary(ctx, req)
+}
+
+// AdminGetUser calls auth.v1.AuthService.AdminGetUser.
+func (c *authServiceClient) AdminGetUser(ctx context.Context, req *connect.Request[v1.AdminGetUserRequest]) (*connect.Response[v1.AdminGetUserResponse], error) {
+	return c.adminGetUser.CallUnary(ctx, req)
+}
+
+// ExportUserData calls auth.v1.AuthService.ExportUserData.
+func (c *authServiceClient) ExportUserData(ctx context.Context, req *connect.Request[v1.ExportUserDataRequest]) (*connect.Response[v1.ExportUserDataResponse], error) {
+	return c.exportUserData.CallUnary(ctx, req)
+}
+
+// DeleteAccount calls auth.v1.AuthService.DeleteAccount.
+func (c *authServiceClient) DeleteAccount(ctx context.Context, req *connect.Request[v1.DeleteAccountRequest]) (*connect.Response[v1.DeleteAccountResponse], error) {
+	return c.deleteAccount.CallUnary(ctx, req)
+}
+
 // AuthServiceHandler is an implementation of the auth.v1.AuthService service.
 type AuthServiceHandler interface {
 	// Register creates a new user account.
@@ -153,6 +227,20 @@ type AuthServiceHandler interface {
 	// GetProfile returns the full user details.
 	// If user_id is empty, it returns the profile of the authenticated user ("Me").
 	GetProfile(context.Context, *connect.Request[v1.GetProfileRequest]) (*connect.Response[v1.GetProfileResponse], error)
+	// GetDisplayNames returns the public display names of up to 100 users, for other services
+	// to label user ids. Unknown ids are omitted from the result. Requires a service
+	// token or the "admin" permission.
+	GetDisplayNames(context.Context, *connect.Request[v1.GetDisplayNamesRequest]) (*connect.Response[v1.GetDisplayNamesResponse], error)
+	// AdminGetUser looks up any user by id or email. Requires the "admin" permission.
+	AdminGetUser(context.Context, *connect.Request[v1.AdminGetUserRequest]) (*connect.Response[v1.AdminGetUserResponse], error)
+	// ExportUserData returns everything held about a user across the auth, bid and
+	// user-stats services as one JSON document. Users may export themselves; exporting
+	// anyone else requires the "admin" permission.
+	ExportUserData(context.Context, *connect.Request[v1.ExportUserDataRequest]) (*connect.Response[v1.ExportUserDataResponse], error)
+	// DeleteAccount deletes a user and ends all of their sessions. The bid and user-stats
+	// services erase their copies asynchronously from the user.deleted event. Users may
+	// delete themselves; deleting anyone else requires the "admin" permission.
+	DeleteAccount(context.Context, *connect.Request[v1.DeleteAccountRequest]) (*connect.Response[v1.DeleteAccountResponse], error)
 }
 
 // NewAuthServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -192,6 +280,30 @@ func NewAuthServiceHandler(svc AuthServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(authServiceMethods.ByName("GetProfile")),
 		connect.WithHandlerOptions(opts...),
 	)
+	authServiceGetDisplayNamesHandler := connect.NewUnaryHandler(
+		AuthServiceGetDisplayNamesProcedure,
+		svc.GetDisplayNames,
+		connect.WithSchema(authServiceMethods.ByName("GetDisplayNames")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceAdminGetUserHandler := connect.NewUnaryHandler(
+		AuthServiceAdminGetUserProcedure,
+		svc.AdminGetUser,
+		connect.WithSchema(authServiceMethods.ByName("AdminGetUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceExportUserDataHandler := connect.NewUnaryHandler(
+		AuthServiceExportUserDataProcedure,
+		svc.ExportUserData,
+		connect.WithSchema(authServiceMethods.ByName("ExportUserData")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceDeleteAccountHandler := connect.NewUnaryHandler(
+		AuthServiceDeleteAccountProcedure,
+		svc.DeleteAccount,
+		connect.WithSchema(authServiceMethods.ByName("DeleteAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/auth.v1.AuthService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case AuthServiceRegisterProcedure:
@@ -204,6 +316,14 @@ func NewAuthServiceHandler(svc AuthServiceHandler, opts ...connect.HandlerOption
 			authServiceLogoutHandler.ServeHTTP(w, r)
 		case AuthServiceGetProfileProcedure:
 			authServiceGetProfileHandler.ServeHTTP(w, r)
+		case AuthServiceGetDisplayNamesProcedure:
+			authServiceGetDisplayNamesHandler.ServeHTTP(w, r)
+		case AuthServiceAdminGetUserProcedure:
+			authServiceAdminGetUserHandler.ServeHTTP(w, r)
+		case AuthServiceExportUserDataProcedure:
+			authServiceExportUserDataHandler.ServeHTTP(w, r)
+		case AuthServiceDeleteAccountProcedure:
+			authServiceDeleteAccountHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -232,3 +352,19 @@ func (UnimplementedAuthServiceHandler) Logout(context.Context, *connect.Request[
 func (UnimplementedAuthServiceHandler) GetProfile(context.Context, *connect.Request[v1.GetProfileRequest]) (*connect.Response[v1.GetProfileResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.GetProfile is not implemented"))
 }
+
+func (UnimplementedAuthServiceHandler) GetDisplayNames(context.Context, *connect.Request[v1.GetDisplayNamesRequest]) (*connect.Response[v1.GetDisplayNamesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.GetDisplayNames is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) AdminGetUser(context.Context, *connect.Request[v1.AdminGetUserRequest]) (*connect.Response[v1.AdminGetUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.AdminGetUser is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) ExportUserData(context.Context, *connect.Request[v1.ExportUserDataRequest]) (*connect.Response[v1.ExportUserDataResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.ExportUserData is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) DeleteAccount(context.Context, *connect.Request[v1.DeleteAccountRequest]) (*connect.Response[v1.DeleteAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.DeleteAccount is not implemented"))
+}
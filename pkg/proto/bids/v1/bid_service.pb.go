@@ -13,6 +13,7 @@ import (
 
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 const (
@@ -30,6 +31,8 @@ const (
 	ItemStatus_ITEM_STATUS_ACTIVE      ItemStatus = 1
 	ItemStatus_ITEM_STATUS_ENDED       ItemStatus = 2
 	ItemStatus_ITEM_STATUS_CANCELLED   ItemStatus = 3
+	ItemStatus_ITEM_STATUS_SCHEDULED   ItemStatus = 4 // start_at is in the future, bids are rejected
+	ItemStatus_ITEM_STATUS_PAUSED      ItemStatus = 5 // bidding suspended, existing bids are kept
 )
 
 // Enum value maps for ItemStatus.
@@ -39,12 +42,16 @@ var (
 		1: "ITEM_STATUS_ACTIVE",
 		2: "ITEM_STATUS_ENDED",
 		3: "ITEM_STATUS_CANCELLED",
+		4: "ITEM_STATUS_SCHEDULED",
+		5: "ITEM_STATUS_PAUSED",
 	}
 	ItemStatus_value = map[string]int32{
 		"ITEM_STATUS_UNSPECIFIED": 0,
 		"ITEM_STATUS_ACTIVE":      1,
 		"ITEM_STATUS_ENDED":       2,
 		"ITEM_STATUS_CANCELLED":   3,
+		"ITEM_STATUS_SCHEDULED":   4,
+		"ITEM_STATUS_PAUSED":      5,
 	}
 )
 
@@ -75,6 +82,57 @@ func (ItemStatus) EnumDescriptor() ([]byte, []int) {
 	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{0}
 }
 
+// GetItemBids
+// Sort order for GetItemBids
+type BidOrderBy int32
+
+const (
+	BidOrderBy_BID_ORDER_BY_UNSPECIFIED BidOrderBy = 0 // same as TIME
+	BidOrderBy_BID_ORDER_BY_TIME        BidOrderBy = 1 // newest first
+	BidOrderBy_BID_ORDER_BY_AMOUNT      BidOrderBy = 2 // highest first, earlier bid wins ties
+)
+
+// Enum value maps for BidOrderBy.
+var (
+	BidOrderBy_name = map[int32]string{
+		0: "BID_ORDER_BY_UNSPECIFIED",
+		1: "BID_ORDER_BY_TIME",
+		2: "BID_ORDER_BY_AMOUNT",
+	}
+	BidOrderBy_value = map[string]int32{
+		"BID_ORDER_BY_UNSPECIFIED": 0,
+		"BID_ORDER_BY_TIME":        1,
+		"BID_ORDER_BY_AMOUNT":      2,
+	}
+)
+
+func (x BidOrderBy) Enum() *BidOrderBy {
+	p := new(BidOrderBy)
+	*p = x
+	return p
+}
+
+func (x BidOrderBy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BidOrderBy) Descriptor() protoreflect.EnumDescriptor {
+	return file_bids_v1_bid_service_proto_enumTypes[1].Descriptor()
+}
+
+func (BidOrderBy) Type() protoreflect.EnumType {
+	return &file_bids_v1_bid_service_proto_enumTypes[1]
+}
+
+func (x BidOrderBy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BidOrderBy.Descriptor instead.
+func (BidOrderBy) EnumDescriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{1}
+}
+
 type PlaceBidRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
@@ -128,8 +186,11 @@ func (x *PlaceBidRequest) GetAmount() int64 {
 }
 
 type PlaceBidResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Bid           *Bid                   `protobuf:"bytes,1,opt,name=bid,proto3" json:"bid,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Bid   *Bid                   `protobuf:"bytes,1,opt,name=bid,proto3" json:"bid,omitempty"`
+	// The item as the bid left it: current_highest_bid is this bid, end_at includes any
+	// anti-sniping extension, and auction_state is computed at response time
+	Item          *Item `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -171,31 +232,37 @@ func (x *PlaceBidResponse) GetBid() *Bid {
 	return nil
 }
 
-type Bid struct {
+func (x *PlaceBidResponse) GetItem() *Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+// ListUserBids (every bid a user placed, newest first)
+type ListUserBidsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ItemId        string                 `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Amount        int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // ISO 8601 string
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // optional, defaults to the caller; other users require the admin permission
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Bid) Reset() {
-	*x = Bid{}
+func (x *ListUserBidsRequest) Reset() {
+	*x = ListUserBidsRequest{}
 	mi := &file_bids_v1_bid_service_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Bid) String() string {
+func (x *ListUserBidsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Bid) ProtoMessage() {}
+func (*ListUserBidsRequest) ProtoMessage() {}
 
-func (x *Bid) ProtoReflect() protoreflect.Message {
+func (x *ListUserBidsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_bids_v1_bid_service_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -207,79 +274,54 @@ func (x *Bid) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Bid.ProtoReflect.Descriptor instead.
-func (*Bid) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListUserBidsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserBidsRequest) Descriptor() ([]byte, []int) {
 	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *Bid) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *Bid) GetItemId() string {
-	if x != nil {
-		return x.ItemId
-	}
-	return ""
-}
-
-func (x *Bid) GetUserId() string {
+func (x *ListUserBidsRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *Bid) GetAmount() int64 {
+func (x *ListUserBidsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Amount
+		return x.PageSize
 	}
 	return 0
 }
 
-func (x *Bid) GetCreatedAt() string {
+func (x *ListUserBidsRequest) GetPageToken() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.PageToken
 	}
 	return ""
 }
 
-// Item message
-type Item struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title             string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description       string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	StartPrice        int64                  `protobuf:"varint,4,opt,name=start_price,json=startPrice,proto3" json:"start_price,omitempty"`
-	CurrentHighestBid int64                  `protobuf:"varint,5,opt,name=current_highest_bid,json=currentHighestBid,proto3" json:"current_highest_bid,omitempty"`
-	EndAt             string                 `protobuf:"bytes,6,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`             // ISO 8601 string
-	CreatedAt         string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // ISO 8601 string
-	UpdatedAt         string                 `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // ISO 8601 string
-	Images            []string               `protobuf:"bytes,9,rep,name=images,proto3" json:"images,omitempty"`
-	Category          string                 `protobuf:"bytes,10,opt,name=category,proto3" json:"category,omitempty"`
-	SellerId          string                 `protobuf:"bytes,11,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
-	Status            ItemStatus             `protobuf:"varint,12,opt,name=status,proto3,enum=bids.v1.ItemStatus" json:"status,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type ListUserBidsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bids          []*Bid                 `protobuf:"bytes,1,rep,name=bids,proto3" json:"bids,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Item) Reset() {
-	*x = Item{}
+func (x *ListUserBidsResponse) Reset() {
+	*x = ListUserBidsResponse{}
 	mi := &file_bids_v1_bid_service_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Item) String() string {
+func (x *ListUserBidsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Item) ProtoMessage() {}
+func (*ListUserBidsResponse) ProtoMessage() {}
 
-func (x *Item) ProtoReflect() protoreflect.Message {
+func (x *ListUserBidsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_bids_v1_bid_service_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -291,122 +333,54 @@ func (x *Item) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Item.ProtoReflect.Descriptor instead.
-func (*Item) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListUserBidsResponse.ProtoReflect.Descriptor instead.
+func (*ListUserBidsResponse) Descriptor() ([]byte, []int) {
 	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *Item) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *Item) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
-}
-
-func (x *Item) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *Item) GetStartPrice() int64 {
-	if x != nil {
-		return x.StartPrice
-	}
-	return 0
-}
-
-func (x *Item) GetCurrentHighestBid() int64 {
-	if x != nil {
-		return x.CurrentHighestBid
-	}
-	return 0
-}
-
-func (x *Item) GetEndAt() string {
-	if x != nil {
-		return x.EndAt
-	}
-	return ""
-}
-
-func (x *Item) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-func (x *Item) GetUpdatedAt() string {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return ""
-}
-
-func (x *Item) GetImages() []string {
+func (x *ListUserBidsResponse) GetBids() []*Bid {
 	if x != nil {
-		return x.Images
+		return x.Bids
 	}
 	return nil
 }
 
-func (x *Item) GetCategory() string {
-	if x != nil {
-		return x.Category
-	}
-	return ""
-}
-
-func (x *Item) GetSellerId() string {
+func (x *ListUserBidsResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.SellerId
+		return x.NextPageToken
 	}
 	return ""
 }
 
-func (x *Item) GetStatus() ItemStatus {
-	if x != nil {
-		return x.Status
-	}
-	return ItemStatus_ITEM_STATUS_UNSPECIFIED
-}
-
-// CreateItem
-type CreateItemRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	StartPrice    int64                  `protobuf:"varint,3,opt,name=start_price,json=startPrice,proto3" json:"start_price,omitempty"`
-	EndAt         string                 `protobuf:"bytes,4,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"` // ISO 8601 string
-	Images        []string               `protobuf:"bytes,5,rep,name=images,proto3" json:"images,omitempty"`
-	Category      string                 `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"`
+type Bid struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ItemId            string                 `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	UserId            string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount            int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	CreatedAt         string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                           // ISO 8601 string
+	BidderDisplayName string                 `protobuf:"bytes,6,opt,name=bidder_display_name,json=bidderDisplayName,proto3" json:"bidder_display_name,omitempty"` // GetItemBids with include_bidder_names only; empty if unavailable
+	// GetItemBids: stable per-item pseudonym, e.g. "Bidder #3f9a2c". user_id is left empty unless
+	// the caller is the item's seller, an admin, or the bidder.
+	BidderLabel   string `protobuf:"bytes,7,opt,name=bidder_label,json=bidderLabel,proto3" json:"bidder_label,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateItemRequest) Reset() {
-	*x = CreateItemRequest{}
+func (x *Bid) Reset() {
+	*x = Bid{}
 	mi := &file_bids_v1_bid_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateItemRequest) String() string {
+func (x *Bid) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateItemRequest) ProtoMessage() {}
+func (*Bid) ProtoMessage() {}
 
-func (x *CreateItemRequest) ProtoReflect() protoreflect.Message {
+func (x *Bid) ProtoReflect() protoreflect.Message {
 	mi := &file_bids_v1_bid_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -418,74 +392,82 @@ func (x *CreateItemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateItemRequest.ProtoReflect.Descriptor instead.
-func (*CreateItemRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Bid.ProtoReflect.Descriptor instead.
+func (*Bid) Descriptor() ([]byte, []int) {
 	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *CreateItemRequest) GetTitle() string {
+func (x *Bid) GetId() string {
 	if x != nil {
-		return x.Title
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateItemRequest) GetDescription() string {
+func (x *Bid) GetItemId() string {
 	if x != nil {
-		return x.Description
+		return x.ItemId
 	}
 	return ""
 }
 
-func (x *CreateItemRequest) GetStartPrice() int64 {
+func (x *Bid) GetUserId() string {
 	if x != nil {
-		return x.StartPrice
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Bid) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
 	}
 	return 0
 }
 
-func (x *CreateItemRequest) GetEndAt() string {
+func (x *Bid) GetCreatedAt() string {
 	if x != nil {
-		return x.EndAt
+		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *CreateItemRequest) GetImages() []string {
+func (x *Bid) GetBidderDisplayName() string {
 	if x != nil {
-		return x.Images
+		return x.BidderDisplayName
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateItemRequest) GetCategory() string {
+func (x *Bid) GetBidderLabel() string {
 	if x != nil {
-		return x.Category
+		return x.BidderLabel
 	}
 	return ""
 }
 
-type CreateItemResponse struct {
+// BuyNow purchases an item at its buy_now_price, ending the auction
+type BuyNowRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Item          *Item                  `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateItemResponse) Reset() {
-	*x = CreateItemResponse{}
+func (x *BuyNowRequest) Reset() {
+	*x = BuyNowRequest{}
 	mi := &file_bids_v1_bid_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateItemResponse) String() string {
+func (x *BuyNowRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateItemResponse) ProtoMessage() {}
+func (*BuyNowRequest) ProtoMessage() {}
 
-func (x *CreateItemResponse) ProtoReflect() protoreflect.Message {
+func (x *BuyNowRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_bids_v1_bid_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -497,40 +479,40 @@ func (x *CreateItemResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateItemResponse.ProtoReflect.Descriptor instead.
-func (*CreateItemResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BuyNowRequest.ProtoReflect.Descriptor instead.
+func (*BuyNowRequest) Descriptor() ([]byte, []int) {
 	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *CreateItemResponse) GetItem() *Item {
+func (x *BuyNowRequest) GetItemId() string {
 	if x != nil {
-		return x.Item
+		return x.ItemId
 	}
-	return nil
+	return ""
 }
 
-// GetItem
-type GetItemRequest struct {
+type BuyNowResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Bid           *Bid                   `protobuf:"bytes,1,opt,name=bid,proto3" json:"bid,omitempty"`   // the purchase, recorded as the winning bid
+	Item          *Item                  `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"` // the item, now ended
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetItemRequest) Reset() {
-	*x = GetItemRequest{}
+func (x *BuyNowResponse) Reset() {
+	*x = BuyNowResponse{}
 	mi := &file_bids_v1_bid_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetItemRequest) String() string {
+func (x *BuyNowResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetItemRequest) ProtoMessage() {}
+func (*BuyNowResponse) ProtoMessage() {}
 
-func (x *GetItemRequest) ProtoReflect() protoreflect.Message {
+func (x *BuyNowResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_bids_v1_bid_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -542,40 +524,2028 @@ func (x *GetItemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetItemRequest.ProtoReflect.Descriptor instead.
-func (*GetItemRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BuyNowResponse.ProtoReflect.Descriptor instead.
+func (*BuyNowResponse) Descriptor() ([]byte, []int) {
 	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetItemRequest) GetId() string {
+func (x *BuyNowResponse) GetBid() *Bid {
 	if x != nil {
-		return x.Id
+		return x.Bid
 	}
-	return ""
+	return nil
 }
 
-type GetItemResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Item          *Item                  `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *BuyNowResponse) GetItem() *Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+// GetBid (visible to the bidder and the item's seller)
+type GetBidRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BidId         string                 `protobuf:"bytes,1,opt,name=bid_id,json=bidId,proto3" json:"bid_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBidRequest) Reset() {
+	*x = GetBidRequest{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBidRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBidRequest) ProtoMessage() {}
+
+func (x *GetBidRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBidRequest.ProtoReflect.Descriptor instead.
+func (*GetBidRequest) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetBidRequest) GetBidId() string {
+	if x != nil {
+		return x.BidId
+	}
+	return ""
+}
+
+type GetBidResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bid           *Bid                   `protobuf:"bytes,1,opt,name=bid,proto3" json:"bid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBidResponse) Reset() {
+	*x = GetBidResponse{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBidResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBidResponse) ProtoMessage() {}
+
+func (x *GetBidResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBidResponse.ProtoReflect.Descriptor instead.
+func (*GetBidResponse) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetBidResponse) GetBid() *Bid {
+	if x != nil {
+		return x.Bid
+	}
+	return nil
+}
+
+// Item message
+type Item struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title             string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description       string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	StartPrice        int64                  `protobuf:"varint,4,opt,name=start_price,json=startPrice,proto3" json:"start_price,omitempty"`
+	CurrentHighestBid int64                  `protobuf:"varint,5,opt,name=current_highest_bid,json=currentHighestBid,proto3" json:"current_highest_bid,omitempty"`
+	EndAt             string                 `protobuf:"bytes,6,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`             // ISO 8601 string
+	CreatedAt         string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // ISO 8601 string
+	UpdatedAt         string                 `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // ISO 8601 string
+	Images            []string               `protobuf:"bytes,9,rep,name=images,proto3" json:"images,omitempty"`
+	Category          string                 `protobuf:"bytes,10,opt,name=category,proto3" json:"category,omitempty"`
+	SellerId          string                 `protobuf:"bytes,11,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	Status            ItemStatus             `protobuf:"varint,12,opt,name=status,proto3,enum=bids.v1.ItemStatus" json:"status,omitempty"`
+	Views             int64                  `protobuf:"varint,13,opt,name=views,proto3" json:"views,omitempty"`
+	EndAtTimezone     string                 `protobuf:"bytes,14,opt,name=end_at_timezone,json=endAtTimezone,proto3" json:"end_at_timezone,omitempty"` // IANA zone end_at is expressed in, empty for UTC
+	StartAt           string                 `protobuf:"bytes,15,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`                     // ISO 8601 string, when bidding opens
+	BuyNowPrice       int64                  `protobuf:"varint,16,opt,name=buy_now_price,json=buyNowPrice,proto3" json:"buy_now_price,omitempty"`      // 0 when the item cannot be bought outright
+	BidCount          int64                  `protobuf:"varint,17,opt,name=bid_count,json=bidCount,proto3" json:"bid_count,omitempty"`
+	// Computed by the server when the item is read, so clients need not trust their own clock
+	SecondsRemaining int64      `protobuf:"varint,18,opt,name=seconds_remaining,json=secondsRemaining,proto3" json:"seconds_remaining,omitempty"`             // whole seconds until end_at, 0 once the auction is over
+	AuctionState     ItemStatus `protobuf:"varint,19,opt,name=auction_state,json=auctionState,proto3,enum=bids.v1.ItemStatus" json:"auction_state,omitempty"` // status as of now: an ACTIVE item past end_at is ENDED
+	BidStep          int64      `protobuf:"varint,20,opt,name=bid_step,json=bidStep,proto3" json:"bid_step,omitempty"`                                        // bids must be start_price plus a multiple of this; 0 when unrestricted
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Item) Reset() {
+	*x = Item{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Item) ProtoMessage() {}
+
+func (x *Item) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Item.ProtoReflect.Descriptor instead.
+func (*Item) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Item) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Item) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Item) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Item) GetStartPrice() int64 {
+	if x != nil {
+		return x.StartPrice
+	}
+	return 0
+}
+
+func (x *Item) GetCurrentHighestBid() int64 {
+	if x != nil {
+		return x.CurrentHighestBid
+	}
+	return 0
+}
+
+func (x *Item) GetEndAt() string {
+	if x != nil {
+		return x.EndAt
+	}
+	return ""
+}
+
+func (x *Item) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Item) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *Item) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *Item) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Item) GetSellerId() string {
+	if x != nil {
+		return x.SellerId
+	}
+	return ""
+}
+
+func (x *Item) GetStatus() ItemStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ItemStatus_ITEM_STATUS_UNSPECIFIED
+}
+
+func (x *Item) GetViews() int64 {
+	if x != nil {
+		return x.Views
+	}
+	return 0
+}
+
+func (x *Item) GetEndAtTimezone() string {
+	if x != nil {
+		return x.EndAtTimezone
+	}
+	return ""
+}
+
+func (x *Item) GetStartAt() string {
+	if x != nil {
+		return x.StartAt
+	}
+	return ""
+}
+
+func (x *Item) GetBuyNowPrice() int64 {
+	if x != nil {
+		return x.BuyNowPrice
+	}
+	return 0
+}
+
+func (x *Item) GetBidCount() int64 {
+	if x != nil {
+		return x.BidCount
+	}
+	return 0
+}
+
+func (x *Item) GetSecondsRemaining() int64 {
+	if x != nil {
+		return x.SecondsRemaining
+	}
+	return 0
+}
+
+func (x *Item) GetAuctionState() ItemStatus {
+	if x != nil {
+		return x.AuctionState
+	}
+	return ItemStatus_ITEM_STATUS_UNSPECIFIED
+}
+
+func (x *Item) GetBidStep() int64 {
+	if x != nil {
+		return x.BidStep
+	}
+	return 0
+}
+
+// CreateItem
+type CreateItemRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Title       string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	StartPrice  int64                  `protobuf:"varint,3,opt,name=start_price,json=startPrice,proto3" json:"start_price,omitempty"`
+	EndAt       string                 `protobuf:"bytes,4,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"` // ISO 8601 string
+	Images      []string               `protobuf:"bytes,5,rep,name=images,proto3" json:"images,omitempty"`
+	Category    string                 `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"`
+	// Optional IANA zone (e.g. "Europe/Rome"). When set, end_at may be a local
+	// wall-clock time without offset ("2006-01-02T15:04:05") resolved in this zone.
+	EndAtTimezone string `protobuf:"bytes,7,opt,name=end_at_timezone,json=endAtTimezone,proto3" json:"end_at_timezone,omitempty"`
+	// Optional start time, same formats as end_at. A future value schedules the
+	// auction; empty opens it immediately.
+	StartAt string `protobuf:"bytes,8,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	// Optional price at which a buyer can end the auction immediately.
+	// Must be greater than start_price; 0 disables buy-now.
+	BuyNowPrice int64 `protobuf:"varint,9,opt,name=buy_now_price,json=buyNowPrice,proto3" json:"buy_now_price,omitempty"`
+	// Run every check without creating the item. Errors are the same as for a real
+	// create; on success the response previews the item with an empty id.
+	ValidateOnly bool `protobuf:"varint,10,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+	// Optional granularity: bids must be start_price plus a whole number of steps
+	// (e.g. 100 for whole-currency bids). 0 accepts any amount.
+	BidStep       int64 `protobuf:"varint,11,opt,name=bid_step,json=bidStep,proto3" json:"bid_step,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateItemRequest) Reset() {
+	*x = CreateItemRequest{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateItemRequest) ProtoMessage() {}
+
+func (x *CreateItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateItemRequest.ProtoReflect.Descriptor instead.
+func (*CreateItemRequest) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreateItemRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateItemRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateItemRequest) GetStartPrice() int64 {
+	if x != nil {
+		return x.StartPrice
+	}
+	return 0
+}
+
+func (x *CreateItemRequest) GetEndAt() string {
+	if x != nil {
+		return x.EndAt
+	}
+	return ""
+}
+
+func (x *CreateItemRequest) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *CreateItemRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CreateItemRequest) GetEndAtTimezone() string {
+	if x != nil {
+		return x.EndAtTimezone
+	}
+	return ""
+}
+
+func (x *CreateItemRequest) GetStartAt() string {
+	if x != nil {
+		return x.StartAt
+	}
+	return ""
+}
+
+func (x *CreateItemRequest) GetBuyNowPrice() int64 {
+	if x != nil {
+		return x.BuyNowPrice
+	}
+	return 0
+}
+
+func (x *CreateItemRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+func (x *CreateItemRequest) GetBidStep() int64 {
+	if x != nil {
+		return x.BidStep
+	}
+	return 0
+}
+
+type CreateItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *Item                  `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateItemResponse) Reset() {
+	*x = CreateItemResponse{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateItemResponse) ProtoMessage() {}
+
+func (x *CreateItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateItemResponse.ProtoReflect.Descriptor instead.
+func (*CreateItemResponse) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreateItemResponse) GetItem() *Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+// GetItem
+type GetItemRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Item fields to return, e.g. ["title", "current_highest_bid"]; empty returns them all
+	ReadMask      *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetItemRequest) Reset() {
+	*x = GetItemRequest{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetItemRequest) ProtoMessage() {}
+
+func (x *GetItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetItemRequest.ProtoReflect.Descriptor instead.
+func (*GetItemRequest) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetItemRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetItemRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+type GetItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *Item                  `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetItemResponse) Reset() {
+	*x = GetItemResponse{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetItemResponse) ProtoMessage() {}
+
+func (x *GetItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetItemResponse.ProtoReflect.Descriptor instead.
+func (*GetItemResponse) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetItemResponse) GetItem() *Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+// ListItems
+type ListItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`                 // optional filter
+	ReadMask      *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"` // as in GetItemRequest, applied to every item
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListItemsRequest) Reset() {
+	*x = ListItemsRequest{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListItemsRequest) ProtoMessage() {}
+
+func (x *ListItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListItemsRequest.ProtoReflect.Descriptor instead.
+func (*ListItemsRequest) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListItemsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListItemsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListItemsRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ListItemsRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+type ListItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Item                `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListItemsResponse) Reset() {
+	*x = ListItemsResponse{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListItemsResponse) ProtoMessage() {}
+
+func (x *ListItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListItemsResponse.ProtoReflect.Descriptor instead.
+func (*ListItemsResponse) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListItemsResponse) GetItems() []*Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListItemsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// ListEndingSoon lists active items closing within a window, soonest first
+type ListEndingSoonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WithinSeconds int64                  `protobuf:"varint,1,opt,name=within_seconds,json=withinSeconds,proto3" json:"within_seconds,omitempty"` // defaults to one hour, capped at seven days
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEndingSoonRequest) Reset() {
+	*x = ListEndingSoonRequest{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEndingSoonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEndingSoonRequest) ProtoMessage() {}
+
+func (x *ListEndingSoonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEndingSoonRequest.ProtoReflect.Descriptor instead.
+func (*ListEndingSoonRequest) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListEndingSoonRequest) GetWithinSeconds() int64 {
+	if x != nil {
+		return x.WithinSeconds
+	}
+	return 0
+}
+
+func (x *ListEndingSoonRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListEndingSoonRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListEndingSoonResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Item                `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEndingSoonResponse) Reset() {
+	*x = ListEndingSoonResponse{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEndingSoonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEndingSoonResponse) ProtoMessage() {}
+
+func (x *ListEndingSoonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEndingSoonResponse.ProtoReflect.Descriptor instead.
+func (*ListEndingSoonResponse) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListEndingSoonResponse) GetItems() []*Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListEndingSoonResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// ListSellerItems
+type ListSellerItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSellerItemsRequest) Reset() {
+	*x = ListSellerItemsRequest{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSellerItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSellerItemsRequest) ProtoMessage() {}
+
+func (x *ListSellerItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSellerItemsRequest.ProtoReflect.Descriptor instead.
+func (*ListSellerItemsRequest) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListSellerItemsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSellerItemsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListSellerItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Item                `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSellerItemsResponse) Reset() {
+	*x = ListSellerItemsResponse{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSellerItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSellerItemsResponse) ProtoMessage() {}
+
+func (x *ListSellerItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSellerItemsResponse.ProtoReflect.Descriptor instead.
+func (*ListSellerItemsResponse) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListSellerItemsResponse) GetItems() []*Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListSellerItemsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// ListWonAuctions lists the finished auctions the caller won, most recently ended first
+type ListWonAuctionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWonAuctionsRequest) Reset() {
+	*x = ListWonAuctionsRequest{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWonAuctionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWonAuctionsRequest) ProtoMessage() {}
+
+func (x *ListWonAuctionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWonAuctionsRequest.ProtoReflect.Descriptor instead.
+func (*ListWonAuctionsRequest) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListWonAuctionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListWonAuctionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type WonAuction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *Item                  `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	WinningBid    *Bid                   `protobuf:"bytes,2,opt,name=winning_bid,json=winningBid,proto3" json:"winning_bid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WonAuction) Reset() {
+	*x = WonAuction{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WonAuction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WonAuction) ProtoMessage() {}
+
+func (x *WonAuction) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WonAuction.ProtoReflect.Descriptor instead.
+func (*WonAuction) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *WonAuction) GetItem() *Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *WonAuction) GetWinningBid() *Bid {
+	if x != nil {
+		return x.WinningBid
+	}
+	return nil
+}
+
+type ListWonAuctionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Auctions      []*WonAuction          `protobuf:"bytes,1,rep,name=auctions,proto3" json:"auctions,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWonAuctionsResponse) Reset() {
+	*x = ListWonAuctionsResponse{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWonAuctionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWonAuctionsResponse) ProtoMessage() {}
+
+func (x *ListWonAuctionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWonAuctionsResponse.ProtoReflect.Descriptor instead.
+func (*ListWonAuctionsResponse) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListWonAuctionsResponse) GetAuctions() []*WonAuction {
+	if x != nil {
+		return x.Auctions
+	}
+	return nil
+}
+
+func (x *ListWonAuctionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// AdminListItems
+type AdminListItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        ItemStatus             `protobuf:"varint,1,opt,name=status,proto3,enum=bids.v1.ItemStatus" json:"status,omitempty"` // required
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminListItemsRequest) Reset() {
+	*x = AdminListItemsRequest{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListItemsRequest) ProtoMessage() {}
+
+func (x *AdminListItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListItemsRequest.ProtoReflect.Descriptor instead.
+func (*AdminListItemsRequest) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AdminListItemsRequest) GetStatus() ItemStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ItemStatus_ITEM_STATUS_UNSPECIFIED
+}
+
+func (x *AdminListItemsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *AdminListItemsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type AdminListItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Item                `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminListItemsResponse) Reset() {
+	*x = AdminListItemsResponse{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListItemsResponse) ProtoMessage() {}
+
+func (x *AdminListItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListItemsResponse.ProtoReflect.Descriptor instead.
+func (*AdminListItemsResponse) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AdminListItemsResponse) GetItems() []*Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *AdminListItemsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// AdminReconcileItem (recomputes an item's bid count and highest bid from its bids)
+type AdminReconcileItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminReconcileItemRequest) Reset() {
+	*x = AdminReconcileItemRequest{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminReconcileItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminReconcileItemRequest) ProtoMessage() {}
+
+func (x *AdminReconcileItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminReconcileItemRequest.ProtoReflect.Descriptor instead.
+func (*AdminReconcileItemRequest) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AdminReconcileItemRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+type AdminReconcileItemResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	StoredBidCount   int64                  `protobuf:"varint,1,opt,name=stored_bid_count,json=storedBidCount,proto3" json:"stored_bid_count,omitempty"` // values found on the item before reconciling
+	StoredHighestBid int64                  `protobuf:"varint,2,opt,name=stored_highest_bid,json=storedHighestBid,proto3" json:"stored_highest_bid,omitempty"`
+	BidCount         int64                  `protobuf:"varint,3,opt,name=bid_count,json=bidCount,proto3" json:"bid_count,omitempty"` // values the item holds now
+	HighestBid       int64                  `protobuf:"varint,4,opt,name=highest_bid,json=highestBid,proto3" json:"highest_bid,omitempty"`
+	Corrected        bool                   `protobuf:"varint,5,opt,name=corrected,proto3" json:"corrected,omitempty"` // true if the stored values were wrong
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AdminReconcileItemResponse) Reset() {
+	*x = AdminReconcileItemResponse{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminReconcileItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminReconcileItemResponse) ProtoMessage() {}
+
+func (x *AdminReconcileItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminReconcileItemResponse.ProtoReflect.Descriptor instead.
+func (*AdminReconcileItemResponse) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AdminReconcileItemResponse) GetStoredBidCount() int64 {
+	if x != nil {
+		return x.StoredBidCount
+	}
+	return 0
+}
+
+func (x *AdminReconcileItemResponse) GetStoredHighestBid() int64 {
+	if x != nil {
+		return x.StoredHighestBid
+	}
+	return 0
+}
+
+func (x *AdminReconcileItemResponse) GetBidCount() int64 {
+	if x != nil {
+		return x.BidCount
+	}
+	return 0
+}
+
+func (x *AdminReconcileItemResponse) GetHighestBid() int64 {
+	if x != nil {
+		return x.HighestBid
+	}
+	return 0
+}
+
+func (x *AdminReconcileItemResponse) GetCorrected() bool {
+	if x != nil {
+		return x.Corrected
+	}
+	return false
+}
+
+// AdminGetUserBidTotals (a user's bid figures computed from the bids table; user-stats
+// rebuilds its aggregates from these)
+type AdminGetUserBidTotalsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminGetUserBidTotalsRequest) Reset() {
+	*x = AdminGetUserBidTotalsRequest{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGetUserBidTotalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGetUserBidTotalsRequest) ProtoMessage() {}
+
+func (x *AdminGetUserBidTotalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGetUserBidTotalsRequest.ProtoReflect.Descriptor instead.
+func (*AdminGetUserBidTotalsRequest) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AdminGetUserBidTotalsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type AdminGetUserBidTotalsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BidCount      int64                  `protobuf:"varint,1,opt,name=bid_count,json=bidCount,proto3" json:"bid_count,omitempty"`
+	TotalAmount   int64                  `protobuf:"varint,2,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"` // saturates at the int64 maximum
+	LastBidAt     string                 `protobuf:"bytes,3,opt,name=last_bid_at,json=lastBidAt,proto3" json:"last_bid_at,omitempty"`      // RFC 3339 with fractional seconds; empty if the user has no bids
+	AsOf          string                 `protobuf:"bytes,4,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`                       // the totals cover bids placed up to this time, a couple of seconds behind the server clock (RFC 3339 with fractional seconds)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminGetUserBidTotalsResponse) Reset() {
+	*x = AdminGetUserBidTotalsResponse{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGetUserBidTotalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGetUserBidTotalsResponse) ProtoMessage() {}
+
+func (x *AdminGetUserBidTotalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGetUserBidTotalsResponse.ProtoReflect.Descriptor instead.
+func (*AdminGetUserBidTotalsResponse) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *AdminGetUserBidTotalsResponse) GetBidCount() int64 {
+	if x != nil {
+		return x.BidCount
+	}
+	return 0
+}
+
+func (x *AdminGetUserBidTotalsResponse) GetTotalAmount() int64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *AdminGetUserBidTotalsResponse) GetLastBidAt() string {
+	if x != nil {
+		return x.LastBidAt
+	}
+	return ""
+}
+
+func (x *AdminGetUserBidTotalsResponse) GetAsOf() string {
+	if x != nil {
+		return x.AsOf
+	}
+	return ""
+}
+
+// GetSellerDashboard (aggregates for the authenticated seller)
+type GetSellerDashboardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSellerDashboardRequest) Reset() {
+	*x = GetSellerDashboardRequest{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSellerDashboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSellerDashboardRequest) ProtoMessage() {}
+
+func (x *GetSellerDashboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSellerDashboardRequest.ProtoReflect.Descriptor instead.
+func (*GetSellerDashboardRequest) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{29}
+}
+
+type GetSellerDashboardResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	SellerId          string                 `protobuf:"bytes,1,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	ActiveListings    int64                  `protobuf:"varint,2,opt,name=active_listings,json=activeListings,proto3" json:"active_listings,omitempty"`
+	TotalBidsReceived int64                  `protobuf:"varint,3,opt,name=total_bids_received,json=totalBidsReceived,proto3" json:"total_bids_received,omitempty"`
+	HighestValuedItem *Item                  `protobuf:"bytes,4,opt,name=highest_valued_item,json=highestValuedItem,proto3" json:"highest_valued_item,omitempty"` // unset if none of the seller's items has bids
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetSellerDashboardResponse) Reset() {
+	*x = GetSellerDashboardResponse{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSellerDashboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSellerDashboardResponse) ProtoMessage() {}
+
+func (x *GetSellerDashboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSellerDashboardResponse.ProtoReflect.Descriptor instead.
+func (*GetSellerDashboardResponse) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetSellerDashboardResponse) GetSellerId() string {
+	if x != nil {
+		return x.SellerId
+	}
+	return ""
+}
+
+func (x *GetSellerDashboardResponse) GetActiveListings() int64 {
+	if x != nil {
+		return x.ActiveListings
+	}
+	return 0
+}
+
+func (x *GetSellerDashboardResponse) GetTotalBidsReceived() int64 {
+	if x != nil {
+		return x.TotalBidsReceived
+	}
+	return 0
+}
+
+func (x *GetSellerDashboardResponse) GetHighestValuedItem() *Item {
+	if x != nil {
+		return x.HighestValuedItem
+	}
+	return nil
+}
+
+// UpdateItem
+// Only the fields below are editable; price, schedule, seller and status are fixed once
+// listed, and requests carrying any other field are rejected with INVALID_ARGUMENT
+type UpdateItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Images        []string               `protobuf:"bytes,4,rep,name=images,proto3" json:"images,omitempty"`
+	Category      *string                `protobuf:"bytes,5,opt,name=category,proto3,oneof" json:"category,omitempty"`
+	EndAtTimezone *string                `protobuf:"bytes,6,opt,name=end_at_timezone,json=endAtTimezone,proto3,oneof" json:"end_at_timezone,omitempty"` // changes only the zone end_at is displayed in
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateItemRequest) Reset() {
+	*x = UpdateItemRequest{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateItemRequest) ProtoMessage() {}
+
+func (x *UpdateItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateItemRequest.ProtoReflect.Descriptor instead.
+func (*UpdateItemRequest) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UpdateItemRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateItemRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdateItemRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateItemRequest) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *UpdateItemRequest) GetCategory() string {
+	if x != nil && x.Category != nil {
+		return *x.Category
+	}
+	return ""
+}
+
+func (x *UpdateItemRequest) GetEndAtTimezone() string {
+	if x != nil && x.EndAtTimezone != nil {
+		return *x.EndAtTimezone
+	}
+	return ""
+}
+
+type UpdateItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *Item                  `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateItemResponse) Reset() {
+	*x = UpdateItemResponse{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateItemResponse) ProtoMessage() {}
+
+func (x *UpdateItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateItemResponse.ProtoReflect.Descriptor instead.
+func (*UpdateItemResponse) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UpdateItemResponse) GetItem() *Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+// CancelItem
+type CancelItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelItemRequest) Reset() {
+	*x = CancelItemRequest{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelItemRequest) ProtoMessage() {}
+
+func (x *CancelItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelItemRequest.ProtoReflect.Descriptor instead.
+func (*CancelItemRequest) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CancelItemRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CancelItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *Item                  `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelItemResponse) Reset() {
+	*x = CancelItemResponse{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelItemResponse) ProtoMessage() {}
+
+func (x *CancelItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelItemResponse.ProtoReflect.Descriptor instead.
+func (*CancelItemResponse) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CancelItemResponse) GetItem() *Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+// BulkCancelItems cancels every scheduled or active item of the caller that has no bids.
+// Items with bids are skipped rather than failing the request.
+type BulkCancelItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkCancelItemsRequest) Reset() {
+	*x = BulkCancelItemsRequest{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkCancelItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkCancelItemsRequest) ProtoMessage() {}
+
+func (x *BulkCancelItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkCancelItemsRequest.ProtoReflect.Descriptor instead.
+func (*BulkCancelItemsRequest) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{35}
+}
+
+type BulkCancelItemsResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CancelledCount   int32                  `protobuf:"varint,1,opt,name=cancelled_count,json=cancelledCount,proto3" json:"cancelled_count,omitempty"`
+	SkippedCount     int32                  `protobuf:"varint,2,opt,name=skipped_count,json=skippedCount,proto3" json:"skipped_count,omitempty"`
+	CancelledItemIds []string               `protobuf:"bytes,3,rep,name=cancelled_item_ids,json=cancelledItemIds,proto3" json:"cancelled_item_ids,omitempty"`
+	SkippedItemIds   []string               `protobuf:"bytes,4,rep,name=skipped_item_ids,json=skippedItemIds,proto3" json:"skipped_item_ids,omitempty"` // already bid on, so they cannot be cancelled
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *BulkCancelItemsResponse) Reset() {
+	*x = BulkCancelItemsResponse{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkCancelItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkCancelItemsResponse) ProtoMessage() {}
+
+func (x *BulkCancelItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkCancelItemsResponse.ProtoReflect.Descriptor instead.
+func (*BulkCancelItemsResponse) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *BulkCancelItemsResponse) GetCancelledCount() int32 {
+	if x != nil {
+		return x.CancelledCount
+	}
+	return 0
+}
+
+func (x *BulkCancelItemsResponse) GetSkippedCount() int32 {
+	if x != nil {
+		return x.SkippedCount
+	}
+	return 0
+}
+
+func (x *BulkCancelItemsResponse) GetCancelledItemIds() []string {
+	if x != nil {
+		return x.CancelledItemIds
+	}
+	return nil
+}
+
+func (x *BulkCancelItemsResponse) GetSkippedItemIds() []string {
+	if x != nil {
+		return x.SkippedItemIds
+	}
+	return nil
+}
+
+// PauseItem (only active items can be paused)
+type PauseItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseItemRequest) Reset() {
+	*x = PauseItemRequest{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseItemRequest) ProtoMessage() {}
+
+func (x *PauseItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseItemRequest.ProtoReflect.Descriptor instead.
+func (*PauseItemRequest) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PauseItemRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+type PauseItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *Item                  `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseItemResponse) Reset() {
+	*x = PauseItemResponse{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseItemResponse) ProtoMessage() {}
+
+func (x *PauseItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseItemResponse.ProtoReflect.Descriptor instead.
+func (*PauseItemResponse) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PauseItemResponse) GetItem() *Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+// ResumeItem
+type ResumeItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	ExtendEndAt   bool                   `protobuf:"varint,2,opt,name=extend_end_at,json=extendEndAt,proto3" json:"extend_end_at,omitempty"` // push end_at back by the time spent paused
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeItemRequest) Reset() {
+	*x = ResumeItemRequest{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetItemResponse) Reset() {
-	*x = GetItemResponse{}
-	mi := &file_bids_v1_bid_service_proto_msgTypes[7]
+func (*ResumeItemRequest) ProtoMessage() {}
+
+func (x *ResumeItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeItemRequest.ProtoReflect.Descriptor instead.
+func (*ResumeItemRequest) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ResumeItemRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *ResumeItemRequest) GetExtendEndAt() bool {
+	if x != nil {
+		return x.ExtendEndAt
+	}
+	return false
+}
+
+type ResumeItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *Item                  `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeItemResponse) Reset() {
+	*x = ResumeItemResponse{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetItemResponse) String() string {
+func (x *ResumeItemResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetItemResponse) ProtoMessage() {}
+func (*ResumeItemResponse) ProtoMessage() {}
 
-func (x *GetItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bids_v1_bid_service_proto_msgTypes[7]
+func (x *ResumeItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -586,43 +2556,42 @@ func (x *GetItemResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetItemResponse.ProtoReflect.Descriptor instead.
-func (*GetItemResponse) Descriptor() ([]byte, []int) {
-	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ResumeItemResponse.ProtoReflect.Descriptor instead.
+func (*ResumeItemResponse) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *GetItemResponse) GetItem() *Item {
+func (x *ResumeItemResponse) GetItem() *Item {
 	if x != nil {
 		return x.Item
 	}
 	return nil
 }
 
-// ListItems
-type ListItemsRequest struct {
+// ExtendAuction (moves a scheduled, active or paused auction's end time later)
+type ExtendAuctionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"` // optional filter
+	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	NewEndAt      string                 `protobuf:"bytes,2,opt,name=new_end_at,json=newEndAt,proto3" json:"new_end_at,omitempty"` // RFC 3339; must be after the current end_at
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListItemsRequest) Reset() {
-	*x = ListItemsRequest{}
-	mi := &file_bids_v1_bid_service_proto_msgTypes[8]
+func (x *ExtendAuctionRequest) Reset() {
+	*x = ExtendAuctionRequest{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListItemsRequest) String() string {
+func (x *ExtendAuctionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListItemsRequest) ProtoMessage() {}
+func (*ExtendAuctionRequest) ProtoMessage() {}
 
-func (x *ListItemsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bids_v1_bid_service_proto_msgTypes[8]
+func (x *ExtendAuctionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -633,55 +2602,197 @@ func (x *ListItemsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListItemsRequest.ProtoReflect.Descriptor instead.
-func (*ListItemsRequest) Descriptor() ([]byte, []int) {
-	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ExtendAuctionRequest.ProtoReflect.Descriptor instead.
+func (*ExtendAuctionRequest) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *ListItemsRequest) GetPageSize() int32 {
+func (x *ExtendAuctionRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *ExtendAuctionRequest) GetNewEndAt() string {
+	if x != nil {
+		return x.NewEndAt
+	}
+	return ""
+}
+
+type ExtendAuctionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *Item                  `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtendAuctionResponse) Reset() {
+	*x = ExtendAuctionResponse{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtendAuctionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendAuctionResponse) ProtoMessage() {}
+
+func (x *ExtendAuctionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendAuctionResponse.ProtoReflect.Descriptor instead.
+func (*ExtendAuctionResponse) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ExtendAuctionResponse) GetItem() *Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type GetItemBidsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ItemId    string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	PageSize  int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token from a previous call with the same order_by
+	OrderBy   BidOrderBy             `protobuf:"varint,4,opt,name=order_by,json=orderBy,proto3,enum=bids.v1.BidOrderBy" json:"order_by,omitempty"`
+	// Label bids with bidder display names from the auth service. Only the item's seller and
+	// admins may ask; bids are still returned, without names, if the lookup fails.
+	IncludeBidderNames bool `protobuf:"varint,5,opt,name=include_bidder_names,json=includeBidderNames,proto3" json:"include_bidder_names,omitempty"`
+	// Delta polling: only bids created after this RFC 3339 time, oldest first. Pass the previous
+	// response's server_time. Cannot be combined with page_token or BID_ORDER_BY_AMOUNT.
+	Since string `protobuf:"bytes,6,opt,name=since,proto3" json:"since,omitempty"`
+	// With since: also return the bids created exactly at since that sort after this bid id.
+	// Pass the previous response's since_bid_id alongside its server_time.
+	SinceBidId    string `protobuf:"bytes,7,opt,name=since_bid_id,json=sinceBidId,proto3" json:"since_bid_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetItemBidsRequest) Reset() {
+	*x = GetItemBidsRequest{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetItemBidsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetItemBidsRequest) ProtoMessage() {}
+
+func (x *GetItemBidsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetItemBidsRequest.ProtoReflect.Descriptor instead.
+func (*GetItemBidsRequest) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetItemBidsRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *GetItemBidsRequest) GetPageSize() int32 {
 	if x != nil {
 		return x.PageSize
 	}
 	return 0
 }
 
-func (x *ListItemsRequest) GetPageToken() string {
+func (x *GetItemBidsRequest) GetPageToken() string {
 	if x != nil {
 		return x.PageToken
 	}
 	return ""
 }
 
-func (x *ListItemsRequest) GetCategory() string {
+func (x *GetItemBidsRequest) GetOrderBy() BidOrderBy {
 	if x != nil {
-		return x.Category
+		return x.OrderBy
+	}
+	return BidOrderBy_BID_ORDER_BY_UNSPECIFIED
+}
+
+func (x *GetItemBidsRequest) GetIncludeBidderNames() bool {
+	if x != nil {
+		return x.IncludeBidderNames
+	}
+	return false
+}
+
+func (x *GetItemBidsRequest) GetSince() string {
+	if x != nil {
+		return x.Since
 	}
 	return ""
 }
 
-type ListItemsResponse struct {
+func (x *GetItemBidsRequest) GetSinceBidId() string {
+	if x != nil {
+		return x.SinceBidId
+	}
+	return ""
+}
+
+type GetItemBidsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*Item                `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Bids          []*Bid                 `protobuf:"bytes,1,rep,name=bids,proto3" json:"bids,omitempty"`
 	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Set when since was given: the next since to poll with, taken from the database clock
+	// (RFC 3339 with nanoseconds) so client clock skew cannot skip or repeat bids. It trails
+	// the clock by a couple of seconds, so bids still committing are picked up by a later poll.
+	ServerTime string `protobuf:"bytes,3,opt,name=server_time,json=serverTime,proto3" json:"server_time,omitempty"`
+	// Set when a full page stopped partway through the bids created at server_time: the next
+	// since_bid_id to poll with
+	SinceBidId    string `protobuf:"bytes,4,opt,name=since_bid_id,json=sinceBidId,proto3" json:"since_bid_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListItemsResponse) Reset() {
-	*x = ListItemsResponse{}
-	mi := &file_bids_v1_bid_service_proto_msgTypes[9]
+func (x *GetItemBidsResponse) Reset() {
+	*x = GetItemBidsResponse{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListItemsResponse) String() string {
+func (x *GetItemBidsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListItemsResponse) ProtoMessage() {}
+func (*GetItemBidsResponse) ProtoMessage() {}
 
-func (x *ListItemsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bids_v1_bid_service_proto_msgTypes[9]
+func (x *GetItemBidsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -692,49 +2803,143 @@ func (x *ListItemsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListItemsResponse.ProtoReflect.Descriptor instead.
-func (*ListItemsResponse) Descriptor() ([]byte, []int) {
-	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use GetItemBidsResponse.ProtoReflect.Descriptor instead.
+func (*GetItemBidsResponse) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *ListItemsResponse) GetItems() []*Item {
+func (x *GetItemBidsResponse) GetBids() []*Bid {
 	if x != nil {
-		return x.Items
+		return x.Bids
 	}
 	return nil
 }
 
-func (x *ListItemsResponse) GetNextPageToken() string {
+func (x *GetItemBidsResponse) GetNextPageToken() string {
 	if x != nil {
 		return x.NextPageToken
 	}
 	return ""
 }
 
-// ListSellerItems
-type ListSellerItemsRequest struct {
+func (x *GetItemBidsResponse) GetServerTime() string {
+	if x != nil {
+		return x.ServerTime
+	}
+	return ""
+}
+
+func (x *GetItemBidsResponse) GetSinceBidId() string {
+	if x != nil {
+		return x.SinceBidId
+	}
+	return ""
+}
+
+// RecordItemView
+type RecordItemViewRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordItemViewRequest) Reset() {
+	*x = RecordItemViewRequest{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordItemViewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordItemViewRequest) ProtoMessage() {}
+
+func (x *RecordItemViewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordItemViewRequest.ProtoReflect.Descriptor instead.
+func (*RecordItemViewRequest) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RecordItemViewRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+type RecordItemViewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordItemViewResponse) Reset() {
+	*x = RecordItemViewResponse{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordItemViewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordItemViewResponse) ProtoMessage() {}
+
+func (x *RecordItemViewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordItemViewResponse.ProtoReflect.Descriptor instead.
+func (*RecordItemViewResponse) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{46}
+}
+
+// GetCurrentPrice (fast read, may briefly lag behind GetItem)
+type GetCurrentPriceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSellerItemsRequest) Reset() {
-	*x = ListSellerItemsRequest{}
-	mi := &file_bids_v1_bid_service_proto_msgTypes[10]
+func (x *GetCurrentPriceRequest) Reset() {
+	*x = GetCurrentPriceRequest{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSellerItemsRequest) String() string {
+func (x *GetCurrentPriceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSellerItemsRequest) ProtoMessage() {}
+func (*GetCurrentPriceRequest) ProtoMessage() {}
 
-func (x *ListSellerItemsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bids_v1_bid_service_proto_msgTypes[10]
+func (x *GetCurrentPriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -745,48 +2950,41 @@ func (x *ListSellerItemsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSellerItemsRequest.ProtoReflect.Descriptor instead.
-func (*ListSellerItemsRequest) Descriptor() ([]byte, []int) {
-	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *ListSellerItemsRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
+// Deprecated: Use GetCurrentPriceRequest.ProtoReflect.Descriptor instead.
+func (*GetCurrentPriceRequest) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *ListSellerItemsRequest) GetPageToken() string {
+func (x *GetCurrentPriceRequest) GetItemId() string {
 	if x != nil {
-		return x.PageToken
+		return x.ItemId
 	}
 	return ""
 }
 
-type ListSellerItemsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*Item                `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetCurrentPriceResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ItemId            string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	CurrentHighestBid int64                  `protobuf:"varint,2,opt,name=current_highest_bid,json=currentHighestBid,proto3" json:"current_highest_bid,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *ListSellerItemsResponse) Reset() {
-	*x = ListSellerItemsResponse{}
-	mi := &file_bids_v1_bid_service_proto_msgTypes[11]
+func (x *GetCurrentPriceResponse) Reset() {
+	*x = GetCurrentPriceResponse{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSellerItemsResponse) String() string {
+func (x *GetCurrentPriceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSellerItemsResponse) ProtoMessage() {}
+func (*GetCurrentPriceResponse) ProtoMessage() {}
 
-func (x *ListSellerItemsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bids_v1_bid_service_proto_msgTypes[11]
+func (x *GetCurrentPriceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -797,52 +2995,48 @@ func (x *ListSellerItemsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSellerItemsResponse.ProtoReflect.Descriptor instead.
-func (*ListSellerItemsResponse) Descriptor() ([]byte, []int) {
-	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use GetCurrentPriceResponse.ProtoReflect.Descriptor instead.
+func (*GetCurrentPriceResponse) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *ListSellerItemsResponse) GetItems() []*Item {
+func (x *GetCurrentPriceResponse) GetItemId() string {
 	if x != nil {
-		return x.Items
+		return x.ItemId
 	}
-	return nil
+	return ""
 }
 
-func (x *ListSellerItemsResponse) GetNextPageToken() string {
+func (x *GetCurrentPriceResponse) GetCurrentHighestBid() int64 {
 	if x != nil {
-		return x.NextPageToken
+		return x.CurrentHighestBid
 	}
-	return ""
+	return 0
 }
 
-// UpdateItem
-type UpdateItemRequest struct {
+// GetWinningBid (the item's current highest bid; public, with GetItemBids' disclosure rules)
+type GetWinningBidRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
-	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	Images        []string               `protobuf:"bytes,4,rep,name=images,proto3" json:"images,omitempty"`
-	Category      *string                `protobuf:"bytes,5,opt,name=category,proto3,oneof" json:"category,omitempty"`
+	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateItemRequest) Reset() {
-	*x = UpdateItemRequest{}
-	mi := &file_bids_v1_bid_service_proto_msgTypes[12]
+func (x *GetWinningBidRequest) Reset() {
+	*x = GetWinningBidRequest{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateItemRequest) String() string {
+func (x *GetWinningBidRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateItemRequest) ProtoMessage() {}
+func (*GetWinningBidRequest) ProtoMessage() {}
 
-func (x *UpdateItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bids_v1_bid_service_proto_msgTypes[12]
+func (x *GetWinningBidRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -853,68 +3047,41 @@ func (x *UpdateItemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateItemRequest.ProtoReflect.Descriptor instead.
-func (*UpdateItemRequest) Descriptor() ([]byte, []int) {
-	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *UpdateItemRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *UpdateItemRequest) GetTitle() string {
-	if x != nil && x.Title != nil {
-		return *x.Title
-	}
-	return ""
-}
-
-func (x *UpdateItemRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
-	}
-	return ""
+// Deprecated: Use GetWinningBidRequest.ProtoReflect.Descriptor instead.
+func (*GetWinningBidRequest) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *UpdateItemRequest) GetImages() []string {
+func (x *GetWinningBidRequest) GetItemId() string {
 	if x != nil {
-		return x.Images
-	}
-	return nil
-}
-
-func (x *UpdateItemRequest) GetCategory() string {
-	if x != nil && x.Category != nil {
-		return *x.Category
+		return x.ItemId
 	}
 	return ""
 }
 
-type UpdateItemResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Item          *Item                  `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetWinningBidResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Bid             *Bid                   `protobuf:"bytes,1,opt,name=bid,proto3" json:"bid,omitempty"`                                                   // unset while the item has no bids
+	IsCallerWinning bool                   `protobuf:"varint,2,opt,name=is_caller_winning,json=isCallerWinning,proto3" json:"is_caller_winning,omitempty"` // the authenticated caller placed the winning bid
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *UpdateItemResponse) Reset() {
-	*x = UpdateItemResponse{}
-	mi := &file_bids_v1_bid_service_proto_msgTypes[13]
+func (x *GetWinningBidResponse) Reset() {
+	*x = GetWinningBidResponse{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateItemResponse) String() string {
+func (x *GetWinningBidResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateItemResponse) ProtoMessage() {}
+func (*GetWinningBidResponse) ProtoMessage() {}
 
-func (x *UpdateItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bids_v1_bid_service_proto_msgTypes[13]
+func (x *GetWinningBidResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -925,41 +3092,48 @@ func (x *UpdateItemResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateItemResponse.ProtoReflect.Descriptor instead.
-func (*UpdateItemResponse) Descriptor() ([]byte, []int) {
-	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use GetWinningBidResponse.ProtoReflect.Descriptor instead.
+func (*GetWinningBidResponse) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *UpdateItemResponse) GetItem() *Item {
+func (x *GetWinningBidResponse) GetBid() *Bid {
 	if x != nil {
-		return x.Item
+		return x.Bid
 	}
 	return nil
 }
 
-// CancelItem
-type CancelItemRequest struct {
+func (x *GetWinningBidResponse) GetIsCallerWinning() bool {
+	if x != nil {
+		return x.IsCallerWinning
+	}
+	return false
+}
+
+// GetBidPosition (where the caller's best bid ranks among each bidder's best bid)
+type GetBidPositionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelItemRequest) Reset() {
-	*x = CancelItemRequest{}
-	mi := &file_bids_v1_bid_service_proto_msgTypes[14]
+func (x *GetBidPositionRequest) Reset() {
+	*x = GetBidPositionRequest{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelItemRequest) String() string {
+func (x *GetBidPositionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelItemRequest) ProtoMessage() {}
+func (*GetBidPositionRequest) ProtoMessage() {}
 
-func (x *CancelItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bids_v1_bid_service_proto_msgTypes[14]
+func (x *GetBidPositionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -970,40 +3144,44 @@ func (x *CancelItemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelItemRequest.ProtoReflect.Descriptor instead.
-func (*CancelItemRequest) Descriptor() ([]byte, []int) {
-	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use GetBidPositionRequest.ProtoReflect.Descriptor instead.
+func (*GetBidPositionRequest) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *CancelItemRequest) GetId() string {
+func (x *GetBidPositionRequest) GetItemId() string {
 	if x != nil {
-		return x.Id
+		return x.ItemId
 	}
 	return ""
 }
 
-type CancelItemResponse struct {
+type GetBidPositionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Item          *Item                  `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	IsBidding     bool                   `protobuf:"varint,1,opt,name=is_bidding,json=isBidding,proto3" json:"is_bidding,omitempty"`            // false when the caller has not bid on the item; best_bid and rank are then unset
+	BestBid       *Bid                   `protobuf:"bytes,2,opt,name=best_bid,json=bestBid,proto3" json:"best_bid,omitempty"`                   // the caller's highest bid on the item
+	Rank          int32                  `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`                                       // 1 is leading; equal amounts rank by who bid first
+	BidderCount   int32                  `protobuf:"varint,4,opt,name=bidder_count,json=bidderCount,proto3" json:"bidder_count,omitempty"`      // distinct bidders on the item
+	AmountToLead  int64                  `protobuf:"varint,5,opt,name=amount_to_lead,json=amountToLead,proto3" json:"amount_to_lead,omitempty"` // smallest bid that would take the lead, 0 while leading
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelItemResponse) Reset() {
-	*x = CancelItemResponse{}
-	mi := &file_bids_v1_bid_service_proto_msgTypes[15]
+func (x *GetBidPositionResponse) Reset() {
+	*x = GetBidPositionResponse{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelItemResponse) String() string {
+func (x *GetBidPositionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelItemResponse) ProtoMessage() {}
+func (*GetBidPositionResponse) ProtoMessage() {}
 
-func (x *CancelItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bids_v1_bid_service_proto_msgTypes[15]
+func (x *GetBidPositionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1014,43 +3192,70 @@ func (x *CancelItemResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelItemResponse.ProtoReflect.Descriptor instead.
-func (*CancelItemResponse) Descriptor() ([]byte, []int) {
-	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use GetBidPositionResponse.ProtoReflect.Descriptor instead.
+func (*GetBidPositionResponse) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *CancelItemResponse) GetItem() *Item {
+func (x *GetBidPositionResponse) GetIsBidding() bool {
 	if x != nil {
-		return x.Item
+		return x.IsBidding
+	}
+	return false
+}
+
+func (x *GetBidPositionResponse) GetBestBid() *Bid {
+	if x != nil {
+		return x.BestBid
 	}
 	return nil
 }
 
-// GetItemBids
-type GetItemBidsRequest struct {
+func (x *GetBidPositionResponse) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *GetBidPositionResponse) GetBidderCount() int32 {
+	if x != nil {
+		return x.BidderCount
+	}
+	return 0
+}
+
+func (x *GetBidPositionResponse) GetAmountToLead() int64 {
+	if x != nil {
+		return x.AmountToLead
+	}
+	return 0
+}
+
+// ListCategories (the managed set accepted as Item.category, in display order)
+type Category struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"` // the value stored on items
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetItemBidsRequest) Reset() {
-	*x = GetItemBidsRequest{}
-	mi := &file_bids_v1_bid_service_proto_msgTypes[16]
+func (x *Category) Reset() {
+	*x = Category{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetItemBidsRequest) String() string {
+func (x *Category) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetItemBidsRequest) ProtoMessage() {}
+func (*Category) ProtoMessage() {}
 
-func (x *GetItemBidsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bids_v1_bid_service_proto_msgTypes[16]
+func (x *Category) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1061,55 +3266,46 @@ func (x *GetItemBidsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetItemBidsRequest.ProtoReflect.Descriptor instead.
-func (*GetItemBidsRequest) Descriptor() ([]byte, []int) {
-	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use Category.ProtoReflect.Descriptor instead.
+func (*Category) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *GetItemBidsRequest) GetItemId() string {
+func (x *Category) GetSlug() string {
 	if x != nil {
-		return x.ItemId
+		return x.Slug
 	}
 	return ""
 }
 
-func (x *GetItemBidsRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-func (x *GetItemBidsRequest) GetPageToken() string {
+func (x *Category) GetName() string {
 	if x != nil {
-		return x.PageToken
+		return x.Name
 	}
 	return ""
 }
 
-type GetItemBidsResponse struct {
+type ListCategoriesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Bids          []*Bid                 `protobuf:"bytes,1,rep,name=bids,proto3" json:"bids,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetItemBidsResponse) Reset() {
-	*x = GetItemBidsResponse{}
-	mi := &file_bids_v1_bid_service_proto_msgTypes[17]
+func (x *ListCategoriesRequest) Reset() {
+	*x = ListCategoriesRequest{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetItemBidsResponse) String() string {
+func (x *ListCategoriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetItemBidsResponse) ProtoMessage() {}
+func (*ListCategoriesRequest) ProtoMessage() {}
 
-func (x *GetItemBidsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bids_v1_bid_service_proto_msgTypes[17]
+func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1120,42 +3316,92 @@ func (x *GetItemBidsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetItemBidsResponse.ProtoReflect.Descriptor instead.
-func (*GetItemBidsResponse) Descriptor() ([]byte, []int) {
-	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *GetItemBidsResponse) GetBids() []*Bid {
+type ListCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*Category            `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesResponse) Reset() {
+	*x = ListCategoriesResponse{}
+	mi := &file_bids_v1_bid_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesResponse) ProtoMessage() {}
+
+func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bids_v1_bid_service_proto_msgTypes[55]
 	if x != nil {
-		return x.Bids
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GetItemBidsResponse) GetNextPageToken() string {
+// Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_bids_v1_bid_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListCategoriesResponse) GetCategories() []*Category {
 	if x != nil {
-		return x.NextPageToken
+		return x.Categories
 	}
-	return ""
+	return nil
 }
 
 var File_bids_v1_bid_service_proto protoreflect.FileDescriptor
 
 const file_bids_v1_bid_service_proto_rawDesc = "" +
 	"\n" +
-	"\x19bids/v1/bid_service.proto\x12\abids.v1\"B\n" +
+	"\x19bids/v1/bid_service.proto\x12\abids.v1\x1a google/protobuf/field_mask.proto\"B\n" +
 	"\x0fPlaceBidRequest\x12\x17\n" +
 	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12\x16\n" +
-	"\x06amount\x18\x02 \x01(\x03R\x06amount\"2\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\"U\n" +
 	"\x10PlaceBidResponse\x12\x1e\n" +
-	"\x03bid\x18\x01 \x01(\v2\f.bids.v1.BidR\x03bid\"~\n" +
+	"\x03bid\x18\x01 \x01(\v2\f.bids.v1.BidR\x03bid\x12!\n" +
+	"\x04item\x18\x02 \x01(\v2\r.bids.v1.ItemR\x04item\"j\n" +
+	"\x13ListUserBidsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"`\n" +
+	"\x14ListUserBidsResponse\x12 \n" +
+	"\x04bids\x18\x01 \x03(\v2\f.bids.v1.BidR\x04bids\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xd1\x01\n" +
 	"\x03Bid\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\aitem_id\x18\x02 \x01(\tR\x06itemId\x12\x17\n" +
 	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x16\n" +
 	"\x06amount\x18\x04 \x01(\x03R\x06amount\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\x05 \x01(\tR\tcreatedAt\"\xf2\x02\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12.\n" +
+	"\x13bidder_display_name\x18\x06 \x01(\tR\x11bidderDisplayName\x12!\n" +
+	"\fbidder_label\x18\a \x01(\tR\vbidderLabel\"(\n" +
+	"\rBuyNowRequest\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\"S\n" +
+	"\x0eBuyNowResponse\x12\x1e\n" +
+	"\x03bid\x18\x01 \x01(\v2\f.bids.v1.BidR\x03bid\x12!\n" +
+	"\x04item\x18\x02 \x01(\v2\r.bids.v1.ItemR\x04item\"&\n" +
+	"\rGetBidRequest\x12\x15\n" +
+	"\x06bid_id\x18\x01 \x01(\tR\x05bidId\"0\n" +
+	"\x0eGetBidResponse\x12\x1e\n" +
+	"\x03bid\x18\x01 \x01(\v2\f.bids.v1.BidR\x03bid\"\x8e\x05\n" +
 	"\x04Item\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
@@ -1172,7 +3418,15 @@ const file_bids_v1_bid_service_proto_rawDesc = "" +
 	"\bcategory\x18\n" +
 	" \x01(\tR\bcategory\x12\x1b\n" +
 	"\tseller_id\x18\v \x01(\tR\bsellerId\x12+\n" +
-	"\x06status\x18\f \x01(\x0e2\x13.bids.v1.ItemStatusR\x06status\"\xb7\x01\n" +
+	"\x06status\x18\f \x01(\x0e2\x13.bids.v1.ItemStatusR\x06status\x12\x14\n" +
+	"\x05views\x18\r \x01(\x03R\x05views\x12&\n" +
+	"\x0fend_at_timezone\x18\x0e \x01(\tR\rendAtTimezone\x12\x19\n" +
+	"\bstart_at\x18\x0f \x01(\tR\astartAt\x12\"\n" +
+	"\rbuy_now_price\x18\x10 \x01(\x03R\vbuyNowPrice\x12\x1b\n" +
+	"\tbid_count\x18\x11 \x01(\x03R\bbidCount\x12+\n" +
+	"\x11seconds_remaining\x18\x12 \x01(\x03R\x10secondsRemaining\x128\n" +
+	"\rauction_state\x18\x13 \x01(\x0e2\x13.bids.v1.ItemStatusR\fauctionState\x12\x19\n" +
+	"\bbid_step\x18\x14 \x01(\x03R\abidStep\"\xde\x02\n" +
 	"\x11CreateItemRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1f\n" +
@@ -1180,20 +3434,36 @@ const file_bids_v1_bid_service_proto_rawDesc = "" +
 	"startPrice\x12\x15\n" +
 	"\x06end_at\x18\x04 \x01(\tR\x05endAt\x12\x16\n" +
 	"\x06images\x18\x05 \x03(\tR\x06images\x12\x1a\n" +
-	"\bcategory\x18\x06 \x01(\tR\bcategory\"7\n" +
+	"\bcategory\x18\x06 \x01(\tR\bcategory\x12&\n" +
+	"\x0fend_at_timezone\x18\a \x01(\tR\rendAtTimezone\x12\x19\n" +
+	"\bstart_at\x18\b \x01(\tR\astartAt\x12\"\n" +
+	"\rbuy_now_price\x18\t \x01(\x03R\vbuyNowPrice\x12#\n" +
+	"\rvalidate_only\x18\n" +
+	" \x01(\bR\fvalidateOnly\x12\x19\n" +
+	"\bbid_step\x18\v \x01(\x03R\abidStep\"7\n" +
 	"\x12CreateItemResponse\x12!\n" +
-	"\x04item\x18\x01 \x01(\v2\r.bids.v1.ItemR\x04item\" \n" +
+	"\x04item\x18\x01 \x01(\v2\r.bids.v1.ItemR\x04item\"Y\n" +
 	"\x0eGetItemRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"4\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x127\n" +
+	"\tread_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\"4\n" +
 	"\x0fGetItemResponse\x12!\n" +
-	"\x04item\x18\x01 \x01(\v2\r.bids.v1.ItemR\x04item\"j\n" +
+	"\x04item\x18\x01 \x01(\v2\r.bids.v1.ItemR\x04item\"\xa3\x01\n" +
 	"\x10ListItemsRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
 	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x1a\n" +
-	"\bcategory\x18\x03 \x01(\tR\bcategory\"`\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x127\n" +
+	"\tread_mask\x18\x04 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\"`\n" +
 	"\x11ListItemsResponse\x12#\n" +
 	"\x05items\x18\x01 \x03(\v2\r.bids.v1.ItemR\x05items\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"z\n" +
+	"\x15ListEndingSoonRequest\x12%\n" +
+	"\x0ewithin_seconds\x18\x01 \x01(\x03R\rwithinSeconds\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"e\n" +
+	"\x16ListEndingSoonResponse\x12#\n" +
+	"\x05items\x18\x01 \x03(\v2\r.bids.v1.ItemR\x05items\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"T\n" +
 	"\x16ListSellerItemsRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
@@ -1201,49 +3471,179 @@ const file_bids_v1_bid_service_proto_rawDesc = "" +
 	"page_token\x18\x02 \x01(\tR\tpageToken\"f\n" +
 	"\x17ListSellerItemsResponse\x12#\n" +
 	"\x05items\x18\x01 \x03(\v2\r.bids.v1.ItemR\x05items\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xc5\x01\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"T\n" +
+	"\x16ListWonAuctionsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"^\n" +
+	"\n" +
+	"WonAuction\x12!\n" +
+	"\x04item\x18\x01 \x01(\v2\r.bids.v1.ItemR\x04item\x12-\n" +
+	"\vwinning_bid\x18\x02 \x01(\v2\f.bids.v1.BidR\n" +
+	"winningBid\"r\n" +
+	"\x17ListWonAuctionsResponse\x12/\n" +
+	"\bauctions\x18\x01 \x03(\v2\x13.bids.v1.WonAuctionR\bauctions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x80\x01\n" +
+	"\x15AdminListItemsRequest\x12+\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x13.bids.v1.ItemStatusR\x06status\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"e\n" +
+	"\x16AdminListItemsResponse\x12#\n" +
+	"\x05items\x18\x01 \x03(\v2\r.bids.v1.ItemR\x05items\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"4\n" +
+	"\x19AdminReconcileItemRequest\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\"\xd0\x01\n" +
+	"\x1aAdminReconcileItemResponse\x12(\n" +
+	"\x10stored_bid_count\x18\x01 \x01(\x03R\x0estoredBidCount\x12,\n" +
+	"\x12stored_highest_bid\x18\x02 \x01(\x03R\x10storedHighestBid\x12\x1b\n" +
+	"\tbid_count\x18\x03 \x01(\x03R\bbidCount\x12\x1f\n" +
+	"\vhighest_bid\x18\x04 \x01(\x03R\n" +
+	"highestBid\x12\x1c\n" +
+	"\tcorrected\x18\x05 \x01(\bR\tcorrected\"7\n" +
+	"\x1cAdminGetUserBidTotalsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x94\x01\n" +
+	"\x1dAdminGetUserBidTotalsResponse\x12\x1b\n" +
+	"\tbid_count\x18\x01 \x01(\x03R\bbidCount\x12!\n" +
+	"\ftotal_amount\x18\x02 \x01(\x03R\vtotalAmount\x12\x1e\n" +
+	"\vlast_bid_at\x18\x03 \x01(\tR\tlastBidAt\x12\x13\n" +
+	"\x05as_of\x18\x04 \x01(\tR\x04asOf\"\x1b\n" +
+	"\x19GetSellerDashboardRequest\"\xd1\x01\n" +
+	"\x1aGetSellerDashboardResponse\x12\x1b\n" +
+	"\tseller_id\x18\x01 \x01(\tR\bsellerId\x12'\n" +
+	"\x0factive_listings\x18\x02 \x01(\x03R\x0eactiveListings\x12.\n" +
+	"\x13total_bids_received\x18\x03 \x01(\x03R\x11totalBidsReceived\x12=\n" +
+	"\x13highest_valued_item\x18\x04 \x01(\v2\r.bids.v1.ItemR\x11highestValuedItem\"\x86\x02\n" +
 	"\x11UpdateItemRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12%\n" +
 	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12\x16\n" +
 	"\x06images\x18\x04 \x03(\tR\x06images\x12\x1f\n" +
-	"\bcategory\x18\x05 \x01(\tH\x02R\bcategory\x88\x01\x01B\b\n" +
+	"\bcategory\x18\x05 \x01(\tH\x02R\bcategory\x88\x01\x01\x12+\n" +
+	"\x0fend_at_timezone\x18\x06 \x01(\tH\x03R\rendAtTimezone\x88\x01\x01B\b\n" +
 	"\x06_titleB\x0e\n" +
 	"\f_descriptionB\v\n" +
-	"\t_category\"7\n" +
+	"\t_categoryB\x12\n" +
+	"\x10_end_at_timezone\"7\n" +
 	"\x12UpdateItemResponse\x12!\n" +
 	"\x04item\x18\x01 \x01(\v2\r.bids.v1.ItemR\x04item\"#\n" +
 	"\x11CancelItemRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"7\n" +
 	"\x12CancelItemResponse\x12!\n" +
-	"\x04item\x18\x01 \x01(\v2\r.bids.v1.ItemR\x04item\"i\n" +
+	"\x04item\x18\x01 \x01(\v2\r.bids.v1.ItemR\x04item\"\x18\n" +
+	"\x16BulkCancelItemsRequest\"\xbf\x01\n" +
+	"\x17BulkCancelItemsResponse\x12'\n" +
+	"\x0fcancelled_count\x18\x01 \x01(\x05R\x0ecancelledCount\x12#\n" +
+	"\rskipped_count\x18\x02 \x01(\x05R\fskippedCount\x12,\n" +
+	"\x12cancelled_item_ids\x18\x03 \x03(\tR\x10cancelledItemIds\x12(\n" +
+	"\x10skipped_item_ids\x18\x04 \x03(\tR\x0eskippedItemIds\"+\n" +
+	"\x10PauseItemRequest\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\"6\n" +
+	"\x11PauseItemResponse\x12!\n" +
+	"\x04item\x18\x01 \x01(\v2\r.bids.v1.ItemR\x04item\"P\n" +
+	"\x11ResumeItemRequest\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12\"\n" +
+	"\rextend_end_at\x18\x02 \x01(\bR\vextendEndAt\"7\n" +
+	"\x12ResumeItemResponse\x12!\n" +
+	"\x04item\x18\x01 \x01(\v2\r.bids.v1.ItemR\x04item\"M\n" +
+	"\x14ExtendAuctionRequest\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12\x1c\n" +
+	"\n" +
+	"new_end_at\x18\x02 \x01(\tR\bnewEndAt\":\n" +
+	"\x15ExtendAuctionResponse\x12!\n" +
+	"\x04item\x18\x01 \x01(\v2\r.bids.v1.ItemR\x04item\"\x83\x02\n" +
 	"\x12GetItemBidsRequest\x12\x17\n" +
 	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x03 \x01(\tR\tpageToken\"_\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12.\n" +
+	"\border_by\x18\x04 \x01(\x0e2\x13.bids.v1.BidOrderByR\aorderBy\x120\n" +
+	"\x14include_bidder_names\x18\x05 \x01(\bR\x12includeBidderNames\x12\x14\n" +
+	"\x05since\x18\x06 \x01(\tR\x05since\x12 \n" +
+	"\fsince_bid_id\x18\a \x01(\tR\n" +
+	"sinceBidId\"\xa2\x01\n" +
 	"\x13GetItemBidsResponse\x12 \n" +
 	"\x04bids\x18\x01 \x03(\v2\f.bids.v1.BidR\x04bids\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken*s\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vserver_time\x18\x03 \x01(\tR\n" +
+	"serverTime\x12 \n" +
+	"\fsince_bid_id\x18\x04 \x01(\tR\n" +
+	"sinceBidId\"0\n" +
+	"\x15RecordItemViewRequest\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\"\x18\n" +
+	"\x16RecordItemViewResponse\"1\n" +
+	"\x16GetCurrentPriceRequest\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\"b\n" +
+	"\x17GetCurrentPriceResponse\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12.\n" +
+	"\x13current_highest_bid\x18\x02 \x01(\x03R\x11currentHighestBid\"/\n" +
+	"\x14GetWinningBidRequest\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\"c\n" +
+	"\x15GetWinningBidResponse\x12\x1e\n" +
+	"\x03bid\x18\x01 \x01(\v2\f.bids.v1.BidR\x03bid\x12*\n" +
+	"\x11is_caller_winning\x18\x02 \x01(\bR\x0fisCallerWinning\"0\n" +
+	"\x15GetBidPositionRequest\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\"\xbd\x01\n" +
+	"\x16GetBidPositionResponse\x12\x1d\n" +
+	"\n" +
+	"is_bidding\x18\x01 \x01(\bR\tisBidding\x12'\n" +
+	"\bbest_bid\x18\x02 \x01(\v2\f.bids.v1.BidR\abestBid\x12\x12\n" +
+	"\x04rank\x18\x03 \x01(\x05R\x04rank\x12!\n" +
+	"\fbidder_count\x18\x04 \x01(\x05R\vbidderCount\x12$\n" +
+	"\x0eamount_to_lead\x18\x05 \x01(\x03R\famountToLead\"2\n" +
+	"\bCategory\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\x17\n" +
+	"\x15ListCategoriesRequest\"K\n" +
+	"\x16ListCategoriesResponse\x121\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x11.bids.v1.CategoryR\n" +
+	"categories*\xa6\x01\n" +
 	"\n" +
 	"ItemStatus\x12\x1b\n" +
 	"\x17ITEM_STATUS_UNSPECIFIED\x10\x00\x12\x16\n" +
 	"\x12ITEM_STATUS_ACTIVE\x10\x01\x12\x15\n" +
 	"\x11ITEM_STATUS_ENDED\x10\x02\x12\x19\n" +
-	"\x15ITEM_STATUS_CANCELLED\x10\x032\xc4\x04\n" +
+	"\x15ITEM_STATUS_CANCELLED\x10\x03\x12\x19\n" +
+	"\x15ITEM_STATUS_SCHEDULED\x10\x04\x12\x16\n" +
+	"\x12ITEM_STATUS_PAUSED\x10\x05*Z\n" +
+	"\n" +
+	"BidOrderBy\x12\x1c\n" +
+	"\x18BID_ORDER_BY_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11BID_ORDER_BY_TIME\x10\x01\x12\x17\n" +
+	"\x13BID_ORDER_BY_AMOUNT\x10\x022\xf9\x0f\n" +
 	"\n" +
 	"BidService\x12?\n" +
-	"\bPlaceBid\x12\x18.bids.v1.PlaceBidRequest\x1a\x19.bids.v1.PlaceBidResponse\x12E\n" +
+	"\bPlaceBid\x12\x18.bids.v1.PlaceBidRequest\x1a\x19.bids.v1.PlaceBidResponse\x129\n" +
+	"\x06GetBid\x12\x16.bids.v1.GetBidRequest\x1a\x17.bids.v1.GetBidResponse\x129\n" +
+	"\x06BuyNow\x12\x16.bids.v1.BuyNowRequest\x1a\x17.bids.v1.BuyNowResponse\x12K\n" +
+	"\fListUserBids\x12\x1c.bids.v1.ListUserBidsRequest\x1a\x1d.bids.v1.ListUserBidsResponse\x12E\n" +
 	"\n" +
 	"CreateItem\x12\x1a.bids.v1.CreateItemRequest\x1a\x1b.bids.v1.CreateItemResponse\x12<\n" +
 	"\aGetItem\x12\x17.bids.v1.GetItemRequest\x1a\x18.bids.v1.GetItemResponse\x12B\n" +
-	"\tListItems\x12\x19.bids.v1.ListItemsRequest\x1a\x1a.bids.v1.ListItemsResponse\x12T\n" +
-	"\x0fListSellerItems\x12\x1f.bids.v1.ListSellerItemsRequest\x1a .bids.v1.ListSellerItemsResponse\x12E\n" +
+	"\tListItems\x12\x19.bids.v1.ListItemsRequest\x1a\x1a.bids.v1.ListItemsResponse\x12Q\n" +
+	"\x0eListEndingSoon\x12\x1e.bids.v1.ListEndingSoonRequest\x1a\x1f.bids.v1.ListEndingSoonResponse\x12T\n" +
+	"\x0fListSellerItems\x12\x1f.bids.v1.ListSellerItemsRequest\x1a .bids.v1.ListSellerItemsResponse\x12T\n" +
+	"\x0fListWonAuctions\x12\x1f.bids.v1.ListWonAuctionsRequest\x1a .bids.v1.ListWonAuctionsResponse\x12]\n" +
+	"\x12GetSellerDashboard\x12\".bids.v1.GetSellerDashboardRequest\x1a#.bids.v1.GetSellerDashboardResponse\x12E\n" +
 	"\n" +
 	"UpdateItem\x12\x1a.bids.v1.UpdateItemRequest\x1a\x1b.bids.v1.UpdateItemResponse\x12E\n" +
 	"\n" +
-	"CancelItem\x12\x1a.bids.v1.CancelItemRequest\x1a\x1b.bids.v1.CancelItemResponse\x12H\n" +
-	"\vGetItemBids\x12\x1b.bids.v1.GetItemBidsRequest\x1a\x1c.bids.v1.GetItemBidsResponseB2Z0github.com/floroz/gavel/pkg/proto/bids/v1;bidsv1b\x06proto3"
+	"CancelItem\x12\x1a.bids.v1.CancelItemRequest\x1a\x1b.bids.v1.CancelItemResponse\x12T\n" +
+	"\x0fBulkCancelItems\x12\x1f.bids.v1.BulkCancelItemsRequest\x1a .bids.v1.BulkCancelItemsResponse\x12B\n" +
+	"\tPauseItem\x12\x19.bids.v1.PauseItemRequest\x1a\x1a.bids.v1.PauseItemResponse\x12E\n" +
+	"\n" +
+	"ResumeItem\x12\x1a.bids.v1.ResumeItemRequest\x1a\x1b.bids.v1.ResumeItemResponse\x12N\n" +
+	"\rExtendAuction\x12\x1d.bids.v1.ExtendAuctionRequest\x1a\x1e.bids.v1.ExtendAuctionResponse\x12H\n" +
+	"\vGetItemBids\x12\x1b.bids.v1.GetItemBidsRequest\x1a\x1c.bids.v1.GetItemBidsResponse\x12Q\n" +
+	"\x0eRecordItemView\x12\x1e.bids.v1.RecordItemViewRequest\x1a\x1f.bids.v1.RecordItemViewResponse\x12T\n" +
+	"\x0fGetCurrentPrice\x12\x1f.bids.v1.GetCurrentPriceRequest\x1a .bids.v1.GetCurrentPriceResponse\x12N\n" +
+	"\rGetWinningBid\x12\x1d.bids.v1.GetWinningBidRequest\x1a\x1e.bids.v1.GetWinningBidResponse\x12Q\n" +
+	"\x0eGetBidPosition\x12\x1e.bids.v1.GetBidPositionRequest\x1a\x1f.bids.v1.GetBidPositionResponse\x12Q\n" +
+	"\x0eListCategories\x12\x1e.bids.v1.ListCategoriesRequest\x1a\x1f.bids.v1.ListCategoriesResponse\x12Q\n" +
+	"\x0eAdminListItems\x12\x1e.bids.v1.AdminListItemsRequest\x1a\x1f.bids.v1.AdminListItemsResponse\x12]\n" +
+	"\x12AdminReconcileItem\x12\".bids.v1.AdminReconcileItemRequest\x1a#.bids.v1.AdminReconcileItemResponse\x12f\n" +
+	"\x15AdminGetUserBidTotals\x12%.bids.v1.AdminGetUserBidTotalsRequest\x1a&.bids.v1.AdminGetUserBidTotalsResponseB2Z0github.com/floroz/gavel/pkg/proto/bids/v1;bidsv1b\x06proto3"
 
 var (
 	file_bids_v1_bid_service_proto_rawDescOnce sync.Once
@@ -1257,60 +3657,158 @@ func file_bids_v1_bid_service_proto_rawDescGZIP() []byte {
 	return file_bids_v1_bid_service_proto_rawDescData
 }
 
-var file_bids_v1_bid_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_bids_v1_bid_service_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_bids_v1_bid_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_bids_v1_bid_service_proto_msgTypes = make([]protoimpl.MessageInfo, 56)
 var file_bids_v1_bid_service_proto_goTypes = []any{
-	(ItemStatus)(0),                 // 0: bids.v1.ItemStatus
-	(*PlaceBidRequest)(nil),         // 1: bids.v1.PlaceBidRequest
-	(*PlaceBidResponse)(nil),        // 2: bids.v1.PlaceBidResponse
-	(*Bid)(nil),                     // 3: bids.v1.Bid
-	(*Item)(nil),                    // 4: bids.v1.Item
-	(*CreateItemRequest)(nil),       // 5: bids.v1.CreateItemRequest
-	(*CreateItemResponse)(nil),      // 6: bids.v1.CreateItemResponse
-	(*GetItemRequest)(nil),          // 7: bids.v1.GetItemRequest
-	(*GetItemResponse)(nil),         // 8: bids.v1.GetItemResponse
-	(*ListItemsRequest)(nil),        // 9: bids.v1.ListItemsRequest
-	(*ListItemsResponse)(nil),       // 10: bids.v1.ListItemsResponse
-	(*ListSellerItemsRequest)(nil),  // 11: bids.v1.ListSellerItemsRequest
-	(*ListSellerItemsResponse)(nil), // 12: bids.v1.ListSellerItemsResponse
-	(*UpdateItemRequest)(nil),       // 13: bids.v1.UpdateItemRequest
-	(*UpdateItemResponse)(nil),      // 14: bids.v1.UpdateItemResponse
-	(*CancelItemRequest)(nil),       // 15: bids.v1.CancelItemRequest
-	(*CancelItemResponse)(nil),      // 16: bids.v1.CancelItemResponse
-	(*GetItemBidsRequest)(nil),      // 17: bids.v1.GetItemBidsRequest
-	(*GetItemBidsResponse)(nil),     // 18: bids.v1.GetItemBidsResponse
+	(ItemStatus)(0),                       // 0: bids.v1.ItemStatus
+	(BidOrderBy)(0),                       // 1: bids.v1.BidOrderBy
+	(*PlaceBidRequest)(nil),               // 2: bids.v1.PlaceBidRequest
+	(*PlaceBidResponse)(nil),              // 3: bids.v1.PlaceBidResponse
+	(*ListUserBidsRequest)(nil),           // 4: bids.v1.ListUserBidsRequest
+	(*ListUserBidsResponse)(nil),          // 5: bids.v1.ListUserBidsResponse
+	(*Bid)(nil),                           // 6: bids.v1.Bid
+	(*BuyNowRequest)(nil),                 // 7: bids.v1.BuyNowRequest
+	(*BuyNowResponse)(nil),                // 8: bids.v1.BuyNowResponse
+	(*GetBidRequest)(nil),                 // 9: bids.v1.GetBidRequest
+	(*GetBidResponse)(nil),                // 10: bids.v1.GetBidResponse
+	(*Item)(nil),                          // 11: bids.v1.Item
+	(*CreateItemRequest)(nil),             // 12: bids.v1.CreateItemRequest
+	(*CreateItemResponse)(nil),            // 13: bids.v1.CreateItemResponse
+	(*GetItemRequest)(nil),                // 14: bids.v1.GetItemRequest
+	(*GetItemResponse)(nil),               // 15: bids.v1.GetItemResponse
+	(*ListItemsRequest)(nil),              // 16: bids.v1.ListItemsRequest
+	(*ListItemsResponse)(nil),             // 17: bids.v1.ListItemsResponse
+	(*ListEndingSoonRequest)(nil),         // 18: bids.v1.ListEndingSoonRequest
+	(*ListEndingSoonResponse)(nil),        // 19: bids.v1.ListEndingSoonResponse
+	(*ListSellerItemsRequest)(nil),        // 20: bids.v1.ListSellerItemsRequest
+	(*ListSellerItemsResponse)(nil),       // 21: bids.v1.ListSellerItemsResponse
+	(*ListWonAuctionsRequest)(nil),        // 22: bids.v1.ListWonAuctionsRequest
+	(*WonAuction)(nil),                    // 23: bids.v1.WonAuction
+	(*ListWonAuctionsResponse)(nil),       // 24: bids.v1.ListWonAuctionsResponse
+	(*AdminListItemsRequest)(nil),         // 25: bids.v1.AdminListItemsRequest
+	(*AdminListItemsResponse)(nil),        // 26: bids.v1.AdminListItemsResponse
+	(*AdminReconcileItemRequest)(nil),     // 27: bids.v1.AdminReconcileItemRequest
+	(*AdminReconcileItemResponse)(nil),    // 28: bids.v1.AdminReconcileItemResponse
+	(*AdminGetUserBidTotalsRequest)(nil),  // 29: bids.v1.AdminGetUserBidTotalsRequest
+	(*AdminGetUserBidTotalsResponse)(nil), // 30: bids.v1.AdminGetUserBidTotalsResponse
+	(*GetSellerDashboardRequest)(nil),     // 31: bids.v1.GetSellerDashboardRequest
+	(*GetSellerDashboardResponse)(nil),    // 32: bids.v1.GetSellerDashboardResponse
+	(*UpdateItemRequest)(nil),             // 33: bids.v1.UpdateItemRequest
+	(*UpdateItemResponse)(nil),            // 34: bids.v1.UpdateItemResponse
+	(*CancelItemRequest)(nil),             // 35: bids.v1.CancelItemRequest
+	(*CancelItemResponse)(nil),            // 36: bids.v1.CancelItemResponse
+	(*BulkCancelItemsRequest)(nil),        // 37: bids.v1.BulkCancelItemsRequest
+	(*BulkCancelItemsResponse)(nil),       // 38: bids.v1.BulkCancelItemsResponse
+	(*PauseItemRequest)(nil),              // 39: bids.v1.PauseItemRequest
+	(*PauseItemResponse)(nil),             // 40: bids.v1.PauseItemResponse
+	(*ResumeItemRequest)(nil),             // 41: bids.v1.ResumeItemRequest
+	(*ResumeItemResponse)(nil),            // 42: bids.v1.ResumeItemResponse
+	(*ExtendAuctionRequest)(nil),          // 43: bids.v1.ExtendAuctionRequest
+	(*ExtendAuctionResponse)(nil),         // 44: bids.v1.ExtendAuctionResponse
+	(*GetItemBidsRequest)(nil),            // 45: bids.v1.GetItemBidsRequest
+	(*GetItemBidsResponse)(nil),           // 46: bids.v1.GetItemBidsResponse
+	(*RecordItemViewRequest)(nil),         // 47: bids.v1.RecordItemViewRequest
+	(*RecordItemViewResponse)(nil),        // 48: bids.v1.RecordItemViewResponse
+	(*GetCurrentPriceRequest)(nil),        // 49: bids.v1.GetCurrentPriceRequest
+	(*GetCurrentPriceResponse)(nil),       // 50: bids.v1.GetCurrentPriceResponse
+	(*GetWinningBidRequest)(nil),          // 51: bids.v1.GetWinningBidRequest
+	(*GetWinningBidResponse)(nil),         // 52: bids.v1.GetWinningBidResponse
+	(*GetBidPositionRequest)(nil),         // 53: bids.v1.GetBidPositionRequest
+	(*GetBidPositionResponse)(nil),        // 54: bids.v1.GetBidPositionResponse
+	(*Category)(nil),                      // 55: bids.v1.Category
+	(*ListCategoriesRequest)(nil),         // 56: bids.v1.ListCategoriesRequest
+	(*ListCategoriesResponse)(nil),        // 57: bids.v1.ListCategoriesResponse
+	(*fieldmaskpb.FieldMask)(nil),         // 58: google.protobuf.FieldMask
 }
 var file_bids_v1_bid_service_proto_depIdxs = []int32{
-	3,  // 0: bids.v1.PlaceBidResponse.bid:type_name -> bids.v1.Bid
-	0,  // 1: bids.v1.Item.status:type_name -> bids.v1.ItemStatus
-	4,  // 2: bids.v1.CreateItemResponse.item:type_name -> bids.v1.Item
-	4,  // 3: bids.v1.GetItemResponse.item:type_name -> bids.v1.Item
-	4,  // 4: bids.v1.ListItemsResponse.items:type_name -> bids.v1.Item
-	4,  // 5: bids.v1.ListSellerItemsResponse.items:type_name -> bids.v1.Item
-	4,  // 6: bids.v1.UpdateItemResponse.item:type_name -> bids.v1.Item
-	4,  // 7: bids.v1.CancelItemResponse.item:type_name -> bids.v1.Item
-	3,  // 8: bids.v1.GetItemBidsResponse.bids:type_name -> bids.v1.Bid
-	1,  // 9: bids.v1.BidService.PlaceBid:input_type -> bids.v1.PlaceBidRequest
-	5,  // 10: bids.v1.BidService.CreateItem:input_type -> bids.v1.CreateItemRequest
-	7,  // 11: bids.v1.BidService.GetItem:input_type -> bids.v1.GetItemRequest
-	9,  // 12: bids.v1.BidService.ListItems:input_type -> bids.v1.ListItemsRequest
-	11, // 13: bids.v1.BidService.ListSellerItems:input_type -> bids.v1.ListSellerItemsRequest
-	13, // 14: bids.v1.BidService.UpdateItem:input_type -> bids.v1.UpdateItemRequest
-	15, // 15: bids.v1.BidService.CancelItem:input_type -> bids.v1.CancelItemRequest
-	17, // 16: bids.v1.BidService.GetItemBids:input_type -> bids.v1.GetItemBidsRequest
-	2,  // 17: bids.v1.BidService.PlaceBid:output_type -> bids.v1.PlaceBidResponse
-	6,  // 18: bids.v1.BidService.CreateItem:output_type -> bids.v1.CreateItemResponse
-	8,  // 19: bids.v1.BidService.GetItem:output_type -> bids.v1.GetItemResponse
-	10, // 20: bids.v1.BidService.ListItems:output_type -> bids.v1.ListItemsResponse
-	12, // 21: bids.v1.BidService.ListSellerItems:output_type -> bids.v1.ListSellerItemsResponse
-	14, // 22: bids.v1.BidService.UpdateItem:output_type -> bids.v1.UpdateItemResponse
-	16, // 23: bids.v1.BidService.CancelItem:output_type -> bids.v1.CancelItemResponse
-	18, // 24: bids.v1.BidService.GetItemBids:output_type -> bids.v1.GetItemBidsResponse
-	17, // [17:25] is the sub-list for method output_type
-	9,  // [9:17] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	6,  // 0: bids.v1.PlaceBidResponse.bid:type_name -> bids.v1.Bid
+	11, // 1: bids.v1.PlaceBidResponse.item:type_name -> bids.v1.Item
+	6,  // 2: bids.v1.ListUserBidsResponse.bids:type_name -> bids.v1.Bid
+	6,  // 3: bids.v1.BuyNowResponse.bid:type_name -> bids.v1.Bid
+	11, // 4: bids.v1.BuyNowResponse.item:type_name -> bids.v1.Item
+	6,  // 5: bids.v1.GetBidResponse.bid:type_name -> bids.v1.Bid
+	0,  // 6: bids.v1.Item.status:type_name -> bids.v1.ItemStatus
+	0,  // 7: bids.v1.Item.auction_state:type_name -> bids.v1.ItemStatus
+	11, // 8: bids.v1.CreateItemResponse.item:type_name -> bids.v1.Item
+	58, // 9: bids.v1.GetItemRequest.read_mask:type_name -> google.protobuf.FieldMask
+	11, // 10: bids.v1.GetItemResponse.item:type_name -> bids.v1.Item
+	58, // 11: bids.v1.ListItemsRequest.read_mask:type_name -> google.protobuf.FieldMask
+	11, // 12: bids.v1.ListItemsResponse.items:type_name -> bids.v1.Item
+	11, // 13: bids.v1.ListEndingSoonResponse.items:type_name -> bids.v1.Item
+	11, // 14: bids.v1.ListSellerItemsResponse.items:type_name -> bids.v1.Item
+	11, // 15: bids.v1.WonAuction.item:type_name -> bids.v1.Item
+	6,  // 16: bids.v1.WonAuction.winning_bid:type_name -> bids.v1.Bid
+	23, // 17: bids.v1.ListWonAuctionsResponse.auctions:type_name -> bids.v1.WonAuction
+	0,  // 18: bids.v1.AdminListItemsRequest.status:type_name -> bids.v1.ItemStatus
+	11, // 19: bids.v1.AdminListItemsResponse.items:type_name -> bids.v1.Item
+	11, // 20: bids.v1.GetSellerDashboardResponse.highest_valued_item:type_name -> bids.v1.Item
+	11, // 21: bids.v1.UpdateItemResponse.item:type_name -> bids.v1.Item
+	11, // 22: bids.v1.CancelItemResponse.item:type_name -> bids.v1.Item
+	11, // 23: bids.v1.PauseItemResponse.item:type_name -> bids.v1.Item
+	11, // 24: bids.v1.ResumeItemResponse.item:type_name -> bids.v1.Item
+	11, // 25: bids.v1.ExtendAuctionResponse.item:type_name -> bids.v1.Item
+	1,  // 26: bids.v1.GetItemBidsRequest.order_by:type_name -> bids.v1.BidOrderBy
+	6,  // 27: bids.v1.GetItemBidsResponse.bids:type_name -> bids.v1.Bid
+	6,  // 28: bids.v1.GetWinningBidResponse.bid:type_name -> bids.v1.Bid
+	6,  // 29: bids.v1.GetBidPositionResponse.best_bid:type_name -> bids.v1.Bid
+	55, // 30: bids.v1.ListCategoriesResponse.categories:type_name -> bids.v1.Category
+	2,  // 31: bids.v1.BidService.PlaceBid:input_type -> bids.v1.PlaceBidRequest
+	9,  // 32: bids.v1.BidService.GetBid:input_type -> bids.v1.GetBidRequest
+	7,  // 33: bids.v1.BidService.BuyNow:input_type -> bids.v1.BuyNowRequest
+	4,  // 34: bids.v1.BidService.ListUserBids:input_type -> bids.v1.ListUserBidsRequest
+	12, // 35: bids.v1.BidService.CreateItem:input_type -> bids.v1.CreateItemRequest
+	14, // 36: bids.v1.BidService.GetItem:input_type -> bids.v1.GetItemRequest
+	16, // 37: bids.v1.BidService.ListItems:input_type -> bids.v1.ListItemsRequest
+	18, // 38: bids.v1.BidService.ListEndingSoon:input_type -> bids.v1.ListEndingSoonRequest
+	20, // 39: bids.v1.BidService.ListSellerItems:input_type -> bids.v1.ListSellerItemsRequest
+	22, // 40: bids.v1.BidService.ListWonAuctions:input_type -> bids.v1.ListWonAuctionsRequest
+	31, // 41: bids.v1.BidService.GetSellerDashboard:input_type -> bids.v1.GetSellerDashboardRequest
+	33, // 42: bids.v1.BidService.UpdateItem:input_type -> bids.v1.UpdateItemRequest
+	35, // 43: bids.v1.BidService.CancelItem:input_type -> bids.v1.CancelItemRequest
+	37, // 44: bids.v1.BidService.BulkCancelItems:input_type -> bids.v1.BulkCancelItemsRequest
+	39, // 45: bids.v1.BidService.PauseItem:input_type -> bids.v1.PauseItemRequest
+	41, // 46: bids.v1.BidService.ResumeItem:input_type -> bids.v1.ResumeItemRequest
+	43, // 47: bids.v1.BidService.ExtendAuction:input_type -> bids.v1.ExtendAuctionRequest
+	45, // 48: bids.v1.BidService.GetItemBids:input_type -> bids.v1.GetItemBidsRequest
+	47, // 49: bids.v1.BidService.RecordItemView:input_type -> bids.v1.RecordItemViewRequest
+	49, // 50: bids.v1.BidService.GetCurrentPrice:input_type -> bids.v1.GetCurrentPriceRequest
+	51, // 51: bids.v1.BidService.GetWinningBid:input_type -> bids.v1.GetWinningBidRequest
+	53, // 52: bids.v1.BidService.GetBidPosition:input_type -> bids.v1.GetBidPositionRequest
+	56, // 53: bids.v1.BidService.ListCategories:input_type -> bids.v1.ListCategoriesRequest
+	25, // 54: bids.v1.BidService.AdminListItems:input_type -> bids.v1.AdminListItemsRequest
+	27, // 55: bids.v1.BidService.AdminReconcileItem:input_type -> bids.v1.AdminReconcileItemRequest
+	29, // 56: bids.v1.BidService.AdminGetUserBidTotals:input_type -> bids.v1.AdminGetUserBidTotalsRequest
+	3,  // 57: bids.v1.BidService.PlaceBid:output_type -> bids.v1.PlaceBidResponse
+	10, // 58: bids.v1.BidService.GetBid:output_type -> bids.v1.GetBidResponse
+	8,  // 59: bids.v1.BidService.BuyNow:output_type -> bids.v1.BuyNowResponse
+	5,  // 60: bids.v1.BidService.ListUserBids:output_type -> bids.v1.ListUserBidsResponse
+	13, // 61: bids.v1.BidService.CreateItem:output_type -> bids.v1.CreateItemResponse
+	15, // 62: bids.v1.BidService.GetItem:output_type -> bids.v1.GetItemResponse
+	17, // 63: bids.v1.BidService.ListItems:output_type -> bids.v1.ListItemsResponse
+	19, // 64: bids.v1.BidService.ListEndingSoon:output_type -> bids.v1.ListEndingSoonResponse
+	21, // 65: bids.v1.BidService.ListSellerItems:output_type -> bids.v1.ListSellerItemsResponse
+	24, // 66: bids.v1.BidService.ListWonAuctions:output_type -> bids.v1.ListWonAuctionsResponse
+	32, // 67: bids.v1.BidService.GetSellerDashboard:output_type -> bids.v1.GetSellerDashboardResponse
+	34, // 68: bids.v1.BidService.UpdateItem:output_type -> bids.v1.UpdateItemResponse
+	36, // 69: bids.v1.BidService.CancelItem:output_type -> bids.v1.CancelItemResponse
+	38, // 70: bids.v1.BidService.BulkCancelItems:output_type -> bids.v1.BulkCancelItemsResponse
+	40, // 71: bids.v1.BidService.PauseItem:output_type -> bids.v1.PauseItemResponse
+	42, // 72: bids.v1.BidService.ResumeItem:output_type -> bids.v1.ResumeItemResponse
+	44, // 73: bids.v1.BidService.ExtendAuction:output_type -> bids.v1.ExtendAuctionResponse
+	46, // 74: bids.v1.BidService.GetItemBids:output_type -> bids.v1.GetItemBidsResponse
+	48, // 75: bids.v1.BidService.RecordItemView:output_type -> bids.v1.RecordItemViewResponse
+	50, // 76: bids.v1.BidService.GetCurrentPrice:output_type -> bids.v1.GetCurrentPriceResponse
+	52, // 77: bids.v1.BidService.GetWinningBid:output_type -> bids.v1.GetWinningBidResponse
+	54, // 78: bids.v1.BidService.GetBidPosition:output_type -> bids.v1.GetBidPositionResponse
+	57, // 79: bids.v1.BidService.ListCategories:output_type -> bids.v1.ListCategoriesResponse
+	26, // 80: bids.v1.BidService.AdminListItems:output_type -> bids.v1.AdminListItemsResponse
+	28, // 81: bids.v1.BidService.AdminReconcileItem:output_type -> bids.v1.AdminReconcileItemResponse
+	30, // 82: bids.v1.BidService.AdminGetUserBidTotals:output_type -> bids.v1.AdminGetUserBidTotalsResponse
+	57, // [57:83] is the sub-list for method output_type
+	31, // [31:57] is the sub-list for method input_type
+	31, // [31:31] is the sub-list for extension type_name
+	31, // [31:31] is the sub-list for extension extendee
+	0,  // [0:31] is the sub-list for field type_name
 }
 
 func init() { file_bids_v1_bid_service_proto_init() }
@@ -1318,14 +3816,14 @@ func file_bids_v1_bid_service_proto_init() {
 	if File_bids_v1_bid_service_proto != nil {
 		return
 	}
-	file_bids_v1_bid_service_proto_msgTypes[12].OneofWrappers = []any{}
+	file_bids_v1_bid_service_proto_msgTypes[31].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_bids_v1_bid_service_proto_rawDesc), len(file_bids_v1_bid_service_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   18,
+			NumEnums:      2,
+			NumMessages:   56,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -37,34 +37,102 @@ const (
 const (
 	// BidServicePlaceBidProcedure is the fully-qualified name of the BidService's PlaceBid RPC.
 	BidServicePlaceBidProcedure = "/bids.v1.BidService/PlaceBid"
+	// BidServiceGetBidProcedure is the fully-qualified name of the BidService's GetBid RPC.
+	BidServiceGetBidProcedure = "/bids.v1.BidService/GetBid"
+	// BidServiceBuyNowProcedure is the fully-qualified name of the BidService's BuyNow RPC.
+	BidServiceBuyNowProcedure = "/bids.v1.BidService/BuyNow"
+	// BidServiceListUserBidsProcedure is the fully-qualified name of the BidService's ListUserBids RPC.
+	BidServiceListUserBidsProcedure = "/bids.v1.BidService/ListUserBids"
 	// BidServiceCreateItemProcedure is the fully-qualified name of the BidService's CreateItem RPC.
 	BidServiceCreateItemProcedure = "/bids.v1.BidService/CreateItem"
 	// BidServiceGetItemProcedure is the fully-qualified name of the BidService's GetItem RPC.
 	BidServiceGetItemProcedure = "/bids.v1.BidService/GetItem"
 	// BidServiceListItemsProcedure is the fully-qualified name of the BidService's ListItems RPC.
 	BidServiceListItemsProcedure = "/bids.v1.BidService/ListItems"
+	// BidServiceListEndingSoonProcedure is the fully-qualified name of the BidService's ListEndingSoon
+	// RPC.
+	BidServiceListEndingSoonProcedure = "/bids.v1.BidService/ListEndingSoon"
 	// BidServiceListSellerItemsProcedure is the fully-qualified name of the BidService's
 	// ListSellerItems RPC.
 	BidServiceListSellerItemsProcedure = "/bids.v1.BidService/ListSellerItems"
+	// BidServiceListWonAuctionsProcedure is the fully-qualified name of the BidService's
+	// ListWonAuctions RPC.
+	BidServiceListWonAuctionsProcedure = "/bids.v1.BidService/ListWonAuctions"
+	// BidServiceGetSellerDashboardProcedure is the fully-qualified name of the BidService's
+	// GetSellerDashboard RPC.
+	BidServiceGetSellerDashboardProcedure = "/bids.v1.BidService/GetSellerDashboard"
 	// BidServiceUpdateItemProcedure is the fully-qualified name of the BidService's UpdateItem RPC.
 	BidServiceUpdateItemProcedure = "/bids.v1.BidService/UpdateItem"
 	// BidServiceCancelItemProcedure is the fully-qualified name of the BidService's CancelItem RPC.
 	BidServiceCancelItemProcedure = "/bids.v1.BidService/CancelItem"
+	// BidServiceBulkCancelItemsProcedure is the fully-qualified name of the BidService's
+	// BulkCancelItems RPC.
+	BidServiceBulkCancelItemsProcedure = "/bids.v1.BidService/BulkCancelItems"
+	// BidServicePauseItemProcedure is the fully-qualified name of the BidService's PauseItem RPC.
+	BidServicePauseItemProcedure = "/bids.v1.BidService/PauseItem"
+	// BidServiceResumeItemProcedure is the fully-qualified name of the BidService's ResumeItem RPC.
+	BidServiceResumeItemProcedure = "/bids.v1.BidService/ResumeItem"
+	// BidServiceExtendAuctionProcedure is the fully-qualified name of the BidService's ExtendAuction
+	// RPC.
+	BidServiceExtendAuctionProcedure = "/bids.v1.BidService/ExtendAuction"
 	// BidServiceGetItemBidsProcedure is the fully-qualified name of the BidService's GetItemBids RPC.
 	BidServiceGetItemBidsProcedure = "/bids.v1.BidService/GetItemBids"
+	// BidServiceRecordItemViewProcedure is the fully-qualified name of the BidService's RecordItemView
+	// RPC.
+	BidServiceRecordItemViewProcedure = "/bids.v1.BidService/RecordItemView"
+	// BidServiceGetCurrentPriceProcedure is the fully-qualified name of the BidService's
+	// GetCurrentPrice RPC.
+	BidServiceGetCurrentPriceProcedure = "/bids.v1.BidService/GetCurrentPrice"
+	// BidServiceGetWinningBidProcedure is the fully-qualified name of the BidService's GetWinningBid
+	// RPC.
+	BidServiceGetWinningBidProcedure = "/bids.v1.BidService/GetWinningBid"
+	// BidServiceGetBidPositionProcedure is the fully-qualified name of the BidService's GetBidPosition
+	// RPC.
+	BidServiceGetBidPositionProcedure = "/bids.v1.BidService/GetBidPosition"
+	// BidServiceListCategoriesProcedure is the fully-qualified name of the BidService's ListCategories
+	// RPC.
+	BidServiceListCategoriesProcedure = "/bids.v1.BidService/ListCategories"
+	// BidServiceAdminListItemsProcedure is the fully-qualified name of the BidService's AdminListItems
+	// RPC.
+	BidServiceAdminListItemsProcedure = "/bids.v1.BidService/AdminListItems"
+	// BidServiceAdminReconcileItemProcedure is the fully-qualified name of the BidService's
+	// AdminReconcileItem RPC.
+	BidServiceAdminReconcileItemProcedure = "/bids.v1.BidService/AdminReconcileItem"
+	// BidServiceAdminGetUserBidTotalsProcedure is the fully-qualified name of the BidService's
+	// AdminGetUserBidTotals RPC.
+	BidServiceAdminGetUserBidTotalsProcedure = "/bids.v1.BidService/AdminGetUserBidTotals"
 )
 
 // BidServiceClient is a client for the bids.v1.BidService service.
 type BidServiceClient interface {
 	PlaceBid(context.Context, *connect.Request[v1.PlaceBidRequest]) (*connect.Response[v1.PlaceBidResponse], error)
+	GetBid(context.Context, *connect.Request[v1.GetBidRequest]) (*connect.Response[v1.GetBidResponse], error)
+	BuyNow(context.Context, *connect.Request[v1.BuyNowRequest]) (*connect.Response[v1.BuyNowResponse], error)
+	ListUserBids(context.Context, *connect.Request[v1.ListUserBidsRequest]) (*connect.Response[v1.ListUserBidsResponse], error)
 	// Item management
 	CreateItem(context.Context, *connect.Request[v1.CreateItemRequest]) (*connect.Response[v1.CreateItemResponse], error)
 	GetItem(context.Context, *connect.Request[v1.GetItemRequest]) (*connect.Response[v1.GetItemResponse], error)
 	ListItems(context.Context, *connect.Request[v1.ListItemsRequest]) (*connect.Response[v1.ListItemsResponse], error)
+	ListEndingSoon(context.Context, *connect.Request[v1.ListEndingSoonRequest]) (*connect.Response[v1.ListEndingSoonResponse], error)
 	ListSellerItems(context.Context, *connect.Request[v1.ListSellerItemsRequest]) (*connect.Response[v1.ListSellerItemsResponse], error)
+	ListWonAuctions(context.Context, *connect.Request[v1.ListWonAuctionsRequest]) (*connect.Response[v1.ListWonAuctionsResponse], error)
+	GetSellerDashboard(context.Context, *connect.Request[v1.GetSellerDashboardRequest]) (*connect.Response[v1.GetSellerDashboardResponse], error)
 	UpdateItem(context.Context, *connect.Request[v1.UpdateItemRequest]) (*connect.Response[v1.UpdateItemResponse], error)
 	CancelItem(context.Context, *connect.Request[v1.CancelItemRequest]) (*connect.Response[v1.CancelItemResponse], error)
+	BulkCancelItems(context.Context, *connect.Request[v1.BulkCancelItemsRequest]) (*connect.Response[v1.BulkCancelItemsResponse], error)
+	PauseItem(context.Context, *connect.Request[v1.PauseItemRequest]) (*connect.Response[v1.PauseItemResponse], error)
+	ResumeItem(context.Context, *connect.Request[v1.ResumeItemRequest]) (*connect.Response[v1.ResumeItemResponse], error)
+	ExtendAuction(context.Context, *connect.Request[v1.ExtendAuctionRequest]) (*connect.Response[v1.ExtendAuctionResponse], error)
 	GetItemBids(context.Context, *connect.Request[v1.GetItemBidsRequest]) (*connect.Response[v1.GetItemBidsResponse], error)
+	RecordItemView(context.Context, *connect.Request[v1.RecordItemViewRequest]) (*connect.Response[v1.RecordItemViewResponse], error)
+	GetCurrentPrice(context.Context, *connect.Request[v1.GetCurrentPriceRequest]) (*connect.Response[v1.GetCurrentPriceResponse], error)
+	GetWinningBid(context.Context, *connect.Request[v1.GetWinningBidRequest]) (*connect.Response[v1.GetWinningBidResponse], error)
+	GetBidPosition(context.Context, *connect.Request[v1.GetBidPositionRequest]) (*connect.Response[v1.GetBidPositionResponse], error)
+	ListCategories(context.Context, *connect.Request[v1.ListCategoriesRequest]) (*connect.Response[v1.ListCategoriesResponse], error)
+	// Moderation (requires the "admin" permission)
+	AdminListItems(context.Context, *connect.Request[v1.AdminListItemsRequest]) (*connect.Response[v1.AdminListItemsResponse], error)
+	AdminReconcileItem(context.Context, *connect.Request[v1.AdminReconcileItemRequest]) (*connect.Response[v1.AdminReconcileItemResponse], error)
+	AdminGetUserBidTotals(context.Context, *connect.Request[v1.AdminGetUserBidTotalsRequest]) (*connect.Response[v1.AdminGetUserBidTotalsResponse], error)
 }
 
 // NewBidServiceClient constructs a client for the bids.v1.BidService service. By default, it uses
@@ -84,6 +152,24 @@ func NewBidServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(bidServiceMethods.ByName("PlaceBid")),
 			connect.WithClientOptions(opts...),
 		),
+		getBid: connect.NewClient[v1.GetBidRequest, v1.GetBidResponse](
+			httpClient,
+			baseURL+BidServiceGetBidProcedure,
+			connect.WithSchema(bidServiceMethods.ByName("GetBid")),
+			connect.WithClientOptions(opts...),
+		),
+		buyNow: connect.NewClient[v1.BuyNowRequest, v1.BuyNowResponse](
+			httpClient,
+			baseURL+BidServiceBuyNowProcedure,
+			connect.WithSchema(bidServiceMethods.ByName("BuyNow")),
+			connect.WithClientOptions(opts...),
+		),
+		listUserBids: connect.NewClient[v1.ListUserBidsRequest, v1.ListUserBidsResponse](
+			httpClient,
+			baseURL+BidServiceListUserBidsProcedure,
+			connect.WithSchema(bidServiceMethods.ByName("ListUserBids")),
+			connect.WithClientOptions(opts...),
+		),
 		createItem: connect.NewClient[v1.CreateItemRequest, v1.CreateItemResponse](
 			httpClient,
 			baseURL+BidServiceCreateItemProcedure,
@@ -102,12 +188,30 @@ func NewBidServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(bidServiceMethods.ByName("ListItems")),
 			connect.WithClientOptions(opts...),
 		),
+		listEndingSoon: connect.NewClient[v1.ListEndingSoonRequest, v1.ListEndingSoonResponse](
+			httpClient,
+			baseURL+BidServiceListEndingSoonProcedure,
+			connect.WithSchema(bidServiceMethods.ByName("ListEndingSoon")),
+			connect.WithClientOptions(opts...),
+		),
 		listSellerItems: connect.NewClient[v1.ListSellerItemsRequest, v1.ListSellerItemsResponse](
 			httpClient,
 			baseURL+BidServiceListSellerItemsProcedure,
 			connect.WithSchema(bidServiceMethods.ByName("ListSellerItems")),
 			connect.WithClientOptions(opts...),
 		),
+		listWonAuctions: connect.NewClient[v1.ListWonAuctionsRequest, v1.ListWonAuctionsResponse](
+			httpClient,
+			baseURL+BidServiceListWonAuctionsProcedure,
+			connect.WithSchema(bidServiceMethods.ByName("ListWonAuctions")),
+			connect.WithClientOptions(opts...),
+		),
+		getSellerDashboard: connect.NewClient[v1.GetSellerDashboardRequest, v1.GetSellerDashboardResponse](
+			httpClient,
+			baseURL+BidServiceGetSellerDashboardProcedure,
+			connect.WithSchema(bidServiceMethods.ByName("GetSellerDashboard")),
+			connect.WithClientOptions(opts...),
+		),
 		updateItem: connect.NewClient[v1.UpdateItemRequest, v1.UpdateItemResponse](
 			httpClient,
 			baseURL+BidServiceUpdateItemProcedure,
@@ -120,25 +224,115 @@ func NewBidServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(bidServiceMethods.ByName("CancelItem")),
 			connect.WithClientOptions(opts...),
 		),
+		bulkCancelItems: connect.NewClient[v1.BulkCancelItemsRequest, v1.BulkCancelItemsResponse](
+			httpClient,
+			baseURL+BidServiceBulkCancelItemsProcedure,
+			connect.WithSchema(bidServiceMethods.ByName("BulkCancelItems")),
+			connect.WithClientOptions(opts...),
+		),
+		pauseItem: connect.NewClient[v1.PauseItemRequest, v1.PauseItemResponse](
+			httpClient,
+			baseURL+BidServicePauseItemProcedure,
+			connect.WithSchema(bidServiceMethods.ByName("PauseItem")),
+			connect.WithClientOptions(opts...),
+		),
+		resumeItem: connect.NewClient[v1.ResumeItemRequest, v1.ResumeItemResponse](
+			httpClient,
+			baseURL+BidServiceResumeItemProcedure,
+			connect.WithSchema(bidServiceMethods.ByName("ResumeItem")),
+			connect.WithClientOptions(opts...),
+		),
+		extendAuction: connect.NewClient[v1.ExtendAuctionRequest, v1.ExtendAuctionResponse](
+			httpClient,
+			baseURL+BidServiceExtendAuctionProcedure,
+			connect.WithSchema(bidServiceMethods.ByName("ExtendAuction")),
+			connect.WithClientOptions(opts...),
+		),
 		getItemBids: connect.NewClient[v1.GetItemBidsRequest, v1.GetItemBidsResponse](
 			httpClient,
 			baseURL+BidServiceGetItemBidsProcedure,
 			connect.WithSchema(bidServiceMethods.ByName("GetItemBids")),
 			connect.WithClientOptions(opts...),
 		),
+		recordItemView: connect.NewClient[v1.RecordItemViewRequest, v1.RecordItemViewResponse](
+			httpClient,
+			baseURL+BidServiceRecordItemViewProcedure,
+			connect.WithSchema(bidServiceMethods.ByName("RecordItemView")),
+			connect.WithClientOptions(opts...),
+		),
+		getCurrentPrice: connect.NewClient[v1.GetCurrentPriceRequest, v1.GetCurrentPriceResponse](
+			httpClient,
+			baseURL+BidServiceGetCurrentPriceProcedure,
+			connect.WithSchema(bidServiceMethods.ByName("GetCurrentPrice")),
+			connect.WithClientOptions(opts...),
+		),
+		getWinningBid: connect.NewClient[v1.GetWinningBidRequest, v1.GetWinningBidResponse](
+			httpClient,
+			baseURL+BidServiceGetWinningBidProcedure,
+			connect.WithSchema(bidServiceMethods.ByName("GetWinningBid")),
+			connect.WithClientOptions(opts...),
+		),
+		getBidPosition: connect.NewClient[v1.GetBidPositionRequest, v1.GetBidPositionResponse](
+			httpClient,
+			baseURL+BidServiceGetBidPositionProcedure,
+			connect.WithSchema(bidServiceMethods.ByName("GetBidPosition")),
+			connect.WithClientOptions(opts...),
+		),
+		listCategories: connect.NewClient[v1.ListCategoriesRequest, v1.ListCategoriesResponse](
+			httpClient,
+			baseURL+BidServiceListCategoriesProcedure,
+			connect.WithSchema(bidServiceMethods.ByName("ListCategories")),
+			connect.WithClientOptions(opts...),
+		),
+		adminListItems: connect.NewClient[v1.AdminListItemsRequest, v1.AdminListItemsResponse](
+			httpClient,
+			baseURL+BidServiceAdminListItemsProcedure,
+			connect.WithSchema(bidServiceMethods.ByName("AdminListItems")),
+			connect.WithClientOptions(opts...),
+		),
+		adminReconcileItem: connect.NewClient[v1.AdminReconcileItemRequest, v1.AdminReconcileItemResponse](
+			httpClient,
+			baseURL+BidServiceAdminReconcileItemProcedure,
+			connect.WithSchema(bidServiceMethods.ByName("AdminReconcileItem")),
+			connect.WithClientOptions(opts...),
+		),
+		adminGetUserBidTotals: connect.NewClient[v1.AdminGetUserBidTotalsRequest, v1.AdminGetUserBidTotalsResponse](
+			httpClient,
+			baseURL+BidServiceAdminGetUserBidTotalsProcedure,
+			connect.WithSchema(bidServiceMethods.ByName("AdminGetUserBidTotals")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // bidServiceClient implements BidServiceClient.
 type bidServiceClient struct {
-	placeBid        *connect.Client[v1.PlaceBidRequest, v1.PlaceBidResponse]
-	createItem      *connect.Client[v1.CreateItemRequest, v1.CreateItemResponse]
-	getItem         *connect.Client[v1.GetItemRequest, v1.GetItemResponse]
-	listItems       *connect.Client[v1.ListItemsRequest, v1.ListItemsResponse]
-	listSellerItems *connect.Client[v1.ListSellerItemsRequest, v1.ListSellerItemsResponse]
-	updateItem      *connect.Client[v1.UpdateItemRequest, v1.UpdateItemResponse]
-	cancelItem      *connect.Client[v1.CancelItemRequest, v1.CancelItemResponse]
-	getItemBids     *connect.Client[v1.GetItemBidsRequest, v1.GetItemBidsResponse]
+	placeBid              *connect.Client[v1.PlaceBidRequest, v1.PlaceBidResponse]
+	getBid                *connect.Client[v1.GetBidRequest, v1.GetBidResponse]
+	buyNow                *connect.Client[v1.BuyNowRequest, v1.BuyNowResponse]
+	listUserBids          *connect.Client[v1.ListUserBidsRequest, v1.ListUserBidsResponse]
+	createItem            *connect.Client[v1.CreateItemRequest, v1.CreateItemResponse]
+	getItem               *connect.Client[v1.GetItemRequest, v1.GetItemResponse]
+	listItems             *connect.Client[v1.ListItemsRequest, v1.ListItemsResponse]
+	listEndingSoon        *connect.Client[v1.ListEndingSoonRequest, v1.ListEndingSoonResponse]
+	listSellerItems       *connect.Client[v1.ListSellerItemsRequest, v1.ListSellerItemsResponse]
+	listWonAuctions       *connect.Client[v1.ListWonAuctionsRequest, v1.ListWonAuctionsResponse]
+	getSellerDashboard    *connect.Client[v1.GetSellerDashboardRequest, v1.GetSellerDashboardResponse]
+	updateItem            *connect.Client[v1.UpdateItemRequest, v1.UpdateItemResponse]
+	cancelItem            *connect.Client[v1.CancelItemRequest, v1.CancelItemResponse]
+	bulkCancelItems       *connect.Client[v1.BulkCancelItemsRequest, v1.BulkCancelItemsResponse]
+	pauseItem             *connect.Client[v1.PauseItemRequest, v1.PauseItemResponse]
+	resumeItem            *connect.Client[v1.ResumeItemRequest, v1.ResumeItemResponse]
+	extendAuction         *connect.Client[v1.ExtendAuctionRequest, v1.ExtendAuctionResponse]
+	getItemBids           *connect.Client[v1.GetItemBidsRequest, v1.GetItemBidsResponse]
+	recordItemView        *connect.Client[v1.RecordItemViewRequest, v1.RecordItemViewResponse]
+	getCurrentPrice       *connect.Client[v1.GetCurrentPriceRequest, v1.GetCurrentPriceResponse]
+	getWinningBid         *connect.Client[v1.GetWinningBidRequest, v1.GetWinningBidResponse]
+	getBidPosition        *connect.Client[v1.GetBidPositionRequest, v1.GetBidPositionResponse]
+	listCategories        *connect.Client[v1.ListCategoriesRequest, v1.ListCategoriesResponse]
+	adminListItems        *connect.Client[v1.AdminListItemsRequest, v1.AdminListItemsResponse]
+	adminReconcileItem    *connect.Client[v1.AdminReconcileItemRequest, v1.AdminReconcileItemResponse]
+	adminGetUserBidTotals *connect.Client[v1.AdminGetUserBidTotalsRequest, v1.AdminGetUserBidTotalsResponse]
 }
 
 // PlaceBid calls bids.v1.BidService.PlaceBid.
@@ -146,6 +340,21 @@ func (c *bidServiceClient) PlaceBid(ctx context.Context, req *connect.Request[v1
 	return c.placeBid.CallUnary(ctx, req)
 }
 
+// GetBid calls bids.v1.BidService.GetBid.
+func (c *bidServiceClient) GetBid(ctx context.Context, req *connect.Request[v1.GetBidRequest]) (*connect.Response[v1.GetBidResponse], error) {
+	return c.getBid.CallUnary(ctx, req)
+}
+
+// BuyNow calls bids.v1.BidService.BuyNow.
+func (c *bidServiceClient) BuyNow(ctx context.Context, req *connect.Request[v1.BuyNowRequest]) (*connect.Response[v1.BuyNowResponse], error) {
+	return c.buyNow.CallUnary(ctx, req)
+}
+
+// ListUserBids calls bids.v1.BidService.ListUserBids.
+func (c *bidServiceClient) ListUserBids(ctx context.Context, req *connect.Request[v1.ListUserBidsRequest]) (*connect.Response[v1.ListUserBidsResponse], error) {
+	return c.listUserBids.CallUnary(ctx, req)
+}
+
 // CreateItem calls bids.v1.BidService.CreateItem.
 func (c *bidServiceClient) CreateItem(ctx context.Context, req *connect.Request[v1.CreateItemRequest]) (*connect.Response[v1.CreateItemResponse], error) {
 	return c.createItem.CallUnary(ctx, req)
@@ -161,11 +370,26 @@ func (c *bidServiceClient) ListItems(ctx context.Context, req *connect.Request[v
 	return c.listItems.CallUnary(ctx, req)
 }
 
+// ListEndingSoon calls bids.v1.BidService.ListEndingSoon.
+func (c *bidServiceClient) ListEndingSoon(ctx context.Context, req *connect.Request[v1.ListEndingSoonRequest]) (*connect.Response[v1.ListEndingSoonResponse], error) {
+	return c.listEndingSoon.CallUnary(ctx, req)
+}
+
 // ListSellerItems calls bids.v1.BidService.ListSellerItems.
 func (c *bidServiceClient) ListSellerItems(ctx context.Context, req *connect.Request[v1.ListSellerItemsRequest]) (*connect.Response[v1.ListSellerItemsResponse], error) {
 	return c.listSellerItems.CallUnary(ctx, req)
 }
 
+// ListWonAuctions calls bids.v1.BidService.ListWonAuctions.
+func (c *bidServiceClient) ListWonAuctions(ctx context.Context, req *connect.Request[v1.ListWonAuctionsRequest]) (*connect.Response[v1.ListWonAuctionsResponse], error) {
+	return c.listWonAuctions.CallUnary(ctx, req)
+}
+
+// GetSellerDashboard calls bids.v1.BidService.GetSellerDashboard.
+func (c *bidServiceClient) GetSellerDashboard(ctx context.Context, req *connect.Request[v1.GetSellerDashboardRequest]) (*connect.Response[v1.GetSellerDashboardResponse], error) {
+	return c.getSellerDashboard.CallUnary(ctx, req)
+}
+
 // UpdateItem calls bids.v1.BidService.UpdateItem.
 func (c *bidServiceClient) UpdateItem(ctx context.Context, req *connect.Request[v1.UpdateItemRequest]) (*connect.Response[v1.UpdateItemResponse], error) {
 	return c.updateItem.CallUnary(ctx, req)
@@ -176,22 +400,101 @@ func (c *bidServiceClient) CancelItem(ctx context.Context, req *connect.Request[
 	return c.cancelItem.CallUnary(ctx, req)
 }
 
+// BulkCancelItems calls bids.v1.BidService.BulkCancelItems.
+func (c *bidServiceClient) BulkCancelItems(ctx context.Context, req *connect.Request[v1.BulkCancelItemsRequest]) (*connect.Response[v1.BulkCancelItemsResponse], error) {
+	return c.bulkCancelItems.CallUnary(ctx, req)
+}
+
+// PauseItem calls bids.v1.BidService.PauseItem.
+func (c *bidServiceClient) PauseItem(ctx context.Context, req *connect.Request[v1.PauseItemRequest]) (*connect.Response[v1.PauseItemResponse], error) {
+	return c.pauseItem.CallUnary(ctx, req)
+}
+
+// ResumeItem calls bids.v1.BidService.ResumeItem.
+func (c *bidServiceClient) ResumeItem(ctx context.Context, req *connect.Request[v1.ResumeItemRequest]) (*connect.Response[v1.ResumeItemResponse], error) {
+	return c.resumeItem.CallUnary(ctx, req)
+}
+
+// ExtendAuction calls bids.v1.BidService.ExtendAuction.
+func (c *bidServiceClient) ExtendAuction(ctx context.Context, req *connect.Request[v1.ExtendAuctionRequest]) (*connect.Response[v1.ExtendAuctionResponse], error) {
+	return c.extendAuction.CallUnary(ctx, req)
+}
+
 // GetItemBids calls bids.v1.BidService.GetItemBids.
 func (c *bidServiceClient) GetItemBids(ctx context.Context, req *connect.Request[v1.GetItemBidsRequest]) (*connect.Response[v1.GetItemBidsResponse], error) {
 	return c.getItemBids.CallUnary(ctx, req)
 }
 
+// RecordItemView calls bids.v1.BidService.RecordItemView.
+func (c *bidServiceClient) RecordItemView(ctx context.Context, req *connect.Request[v1.RecordItemViewRequest]) (*connect.Response[v1.RecordItemViewResponse], error) {
+	return c.recordItemView.CallUnary(ctx, req)
+}
+
+// GetCurrentPrice calls bids.v1.BidService.GetCurrentPrice.
+func (c *bidServiceClient) GetCurrentPrice(ctx context.Context, req *connect.Request[v1.GetCurrentPriceRequest]) (*connect.Response[v1.GetCurrentPriceResponse], error) {
+	return c.getCurrentPrice.CallUnary(ctx, req)
+}
+
+// GetWinningBid calls bids.v1.BidService.GetWinningBid.
+func (c *bidServiceClient) GetWinningBid(ctx context.Context, req *connect.Request[v1.GetWinningBidRequest]) (*connect.Response[v1.GetWinningBidResponse], error) {
+	return c.getWinningBid.CallUnary(ctx, req)
+}
+
+// GetBidPosition calls bids.v1.BidService.GetBidPosition.
+func (c *bidServiceClient) GetBidPosition(ctx context.Context, req *connect.Request[v1.GetBidPositionRequest]) (*connect.Response[v1.GetBidPositionResponse], error) {
+	return c.getBidPosition.CallUnary(ctx, req)
+}
+
+// ListCategories calls bids.v1.BidService.ListCategories.
+func (c *bidServiceClient) ListCategories(ctx context.Context, req *connect.Request[v1.ListCategoriesRequest]) (*connect.Response[v1.ListCategoriesResponse], error) {
+	return c.listCategories.CallUnary(ctx, req)
+}
+
+// AdminListItems calls bids.v1.BidService.AdminListItems.
+func (c *bidServiceClient) AdminListItems(ctx context.Context, req *connect.Request[v1.AdminListItemsRequest]) (*connect.Response[v1.AdminListItemsResponse], error) {
+	return c.adminListItems.CallUnary(ctx, req)
+}
+
+// AdminReconcileItem calls bids.v1.BidService.AdminReconcileItem.
+func (c *bidServiceClient) AdminReconcileItem(ctx context.Context, req *connect.Request[v1.AdminReconcileItemRequest]) (*connect.Response[v1.AdminReconcileItemResponse], error) {
+	return c.adminReconcileItem.CallUnary(ctx, req)
+}
+
+// AdminGetUserBidTotals calls bids.v1.BidService.AdminGetUserBidTotals.
+func (c *bidServiceClient) AdminGetUserBidTotals(ctx context.Context, req *connect.Request[v1.AdminGetUserBidTotalsRequest]) (*connect.Response[v1.AdminGetUserBidTotalsResponse], error) {
+	return c.adminGetUserBidTotals.CallUnary(ctx, req)
+}
+
 // BidServiceHandler is an implementation of the bids.v1.BidService service.
 type BidServiceHandler interface {
 	PlaceBid(context.Context, *connect.Request[v1.PlaceBidRequest]) (*connect.Response[v1.PlaceBidResponse], error)
+	GetBid(context.Context, *connect.Request[v1.GetBidRequest]) (*connect.Response[v1.GetBidResponse], error)
+	BuyNow(context.Context, *connect.Request[v1.BuyNowRequest]) (*connect.Response[v1.BuyNowResponse], error)
+	ListUserBids(context.Context, *connect.Request[v1.ListUserBidsRequest]) (*connect.Response[v1.ListUserBidsResponse], error)
 	// Item management
 	CreateItem(context.Context, *connect.Request[v1.CreateItemRequest]) (*connect.Response[v1.CreateItemResponse], error)
 	GetItem(context.Context, *connect.Request[v1.GetItemRequest]) (*connect.Response[v1.GetItemResponse], error)
 	ListItems(context.Context, *connect.Request[v1.ListItemsRequest]) (*connect.Response[v1.ListItemsResponse], error)
+	ListEndingSoon(context.Context, *connect.Request[v1.ListEndingSoonRequest]) (*connect.Response[v1.ListEndingSoonResponse], error)
 	ListSellerItems(context.Context, *connect.Request[v1.ListSellerItemsRequest]) (*connect.Response[v1.ListSellerItemsResponse], error)
+	ListWonAuctions(context.Context, *connect.Request[v1.ListWonAuctionsRequest]) (*connect.Response[v1.ListWonAuctionsResponse], error)
+	GetSellerDashboard(context.Context, *connect.Request[v1.GetSellerDashboardRequest]) (*connect.Response[v1.GetSellerDashboardResponse], error)
 	UpdateItem(context.Context, *connect.Request[v1.UpdateItemRequest]) (*connect.Response[v1.UpdateItemResponse], error)
 	CancelItem(context.Context, *connect.Request[v1.CancelItemRequest]) (*connect.Response[v1.CancelItemResponse], error)
+	BulkCancelItems(context.Context, *connect.Request[v1.BulkCancelItemsRequest]) (*connect.Response[v1.BulkCancelItemsResponse], error)
+	PauseItem(context.Context, *connect.Request[v1.PauseItemRequest]) (*connect.Response[v1.PauseItemResponse], error)
+	ResumeItem(context.Context, *connect.Request[v1.ResumeItemRequest]) (*connect.Response[v1.ResumeItemResponse], error)
+	ExtendAuction(context.Context, *connect.Request[v1.ExtendAuctionRequest]) (*connect.Response[v1.ExtendAuctionResponse], error)
 	GetItemBids(context.Context, *connect.Request[v1.GetItemBidsRequest]) (*connect.Response[v1.GetItemBidsResponse], error)
+	RecordItemView(context.Context, *connect.Request[v1.RecordItemViewRequest]) (*connect.Response[v1.RecordItemViewResponse], error)
+	GetCurrentPrice(context.Context, *connect.Request[v1.GetCurrentPriceRequest]) (*connect.Response[v1.GetCurrentPriceResponse], error)
+	GetWinningBid(context.Context, *connect.Request[v1.GetWinningBidRequest]) (*connect.Response[v1.GetWinningBidResponse], error)
+	GetBidPosition(context.Context, *connect.Request[v1.GetBidPositionRequest]) (*connect.Response[v1.GetBidPositionResponse], error)
+	ListCategories(context.Context, *connect.Request[v1.ListCategoriesRequest]) (*connect.Response[v1.ListCategoriesResponse], error)
+	// Moderation (requires the "admin" permission)
+	AdminListItems(context.Context, *connect.Request[v1.AdminListItemsRequest]) (*connect.Response[v1.AdminListItemsResponse], error)
+	AdminReconcileItem(context.Context, *connect.Request[v1.AdminReconcileItemRequest]) (*connect.Response[v1.AdminReconcileItemResponse], error)
+	AdminGetUserBidTotals(context.Context, *connect.Request[v1.AdminGetUserBidTotalsRequest]) (*connect.Response[v1.AdminGetUserBidTotalsResponse], error)
 }
 
 // NewBidServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -207,6 +510,24 @@ func NewBidServiceHandler(svc BidServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(bidServiceMethods.ByName("PlaceBid")),
 		connect.WithHandlerOptions(opts...),
 	)
+	bidServiceGetBidHandler := connect.NewUnaryHandler(
+		BidServiceGetBidProcedure,
+		svc.GetBid,
+		connect.WithSchema(bidServiceMethods.ByName("GetBid")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bidServiceBuyNowHandler := connect.NewUnaryHandler(
+		BidServiceBuyNowProcedure,
+		svc.BuyNow,
+		connect.WithSchema(bidServiceMethods.ByName("BuyNow")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bidServiceListUserBidsHandler := connect.NewUnaryHandler(
+		BidServiceListUserBidsProcedure,
+		svc.ListUserBids,
+		connect.WithSchema(bidServiceMethods.ByName("ListUserBids")),
+		connect.WithHandlerOptions(opts...),
+	)
 	bidServiceCreateItemHandler := connect.NewUnaryHandler(
 		BidServiceCreateItemProcedure,
 		svc.CreateItem,
@@ -225,12 +546,30 @@ func NewBidServiceHandler(svc BidServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(bidServiceMethods.ByName("ListItems")),
 		connect.WithHandlerOptions(opts...),
 	)
+	bidServiceListEndingSoonHandler := connect.NewUnaryHandler(
+		BidServiceListEndingSoonProcedure,
+		svc.ListEndingSoon,
+		connect.WithSchema(bidServiceMethods.ByName("ListEndingSoon")),
+		connect.WithHandlerOptions(opts...),
+	)
 	bidServiceListSellerItemsHandler := connect.NewUnaryHandler(
 		BidServiceListSellerItemsProcedure,
 		svc.ListSellerItems,
 		connect.WithSchema(bidServiceMethods.ByName("ListSellerItems")),
 		connect.WithHandlerOptions(opts...),
 	)
+	bidServiceListWonAuctionsHandler := connect.NewUnaryHandler(
+		BidServiceListWonAuctionsProcedure,
+		svc.ListWonAuctions,
+		connect.WithSchema(bidServiceMethods.ByName("ListWonAuctions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bidServiceGetSellerDashboardHandler := connect.NewUnaryHandler(
+		BidServiceGetSellerDashboardProcedure,
+		svc.GetSellerDashboard,
+		connect.WithSchema(bidServiceMethods.ByName("GetSellerDashboard")),
+		connect.WithHandlerOptions(opts...),
+	)
 	bidServiceUpdateItemHandler := connect.NewUnaryHandler(
 		BidServiceUpdateItemProcedure,
 		svc.UpdateItem,
@@ -243,30 +582,138 @@ func NewBidServiceHandler(svc BidServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(bidServiceMethods.ByName("CancelItem")),
 		connect.WithHandlerOptions(opts...),
 	)
+	bidServiceBulkCancelItemsHandler := connect.NewUnaryHandler(
+		BidServiceBulkCancelItemsProcedure,
+		svc.BulkCancelItems,
+		connect.WithSchema(bidServiceMethods.ByName("BulkCancelItems")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bidServicePauseItemHandler := connect.NewUnaryHandler(
+		BidServicePauseItemProcedure,
+		svc.PauseItem,
+		connect.WithSchema(bidServiceMethods.ByName("PauseItem")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bidServiceResumeItemHandler := connect.NewUnaryHandler(
+		BidServiceResumeItemProcedure,
+		svc.ResumeItem,
+		connect.WithSchema(bidServiceMethods.ByName("ResumeItem")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bidServiceExtendAuctionHandler := connect.NewUnaryHandler(
+		BidServiceExtendAuctionProcedure,
+		svc.ExtendAuction,
+		connect.WithSchema(bidServiceMethods.ByName("ExtendAuction")),
+		connect.WithHandlerOptions(opts...),
+	)
 	bidServiceGetItemBidsHandler := connect.NewUnaryHandler(
 		BidServiceGetItemBidsProcedure,
 		svc.GetItemBids,
 		connect.WithSchema(bidServiceMethods.ByName("GetItemBids")),
 		connect.WithHandlerOptions(opts...),
 	)
+	bidServiceRecordItemViewHandler := connect.NewUnaryHandler(
+		BidServiceRecordItemViewProcedure,
+		svc.RecordItemView,
+		connect.WithSchema(bidServiceMethods.ByName("RecordItemView")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bidServiceGetCurrentPriceHandler := connect.NewUnaryHandler(
+		BidServiceGetCurrentPriceProcedure,
+		svc.GetCurrentPrice,
+		connect.WithSchema(bidServiceMethods.ByName("GetCurrentPrice")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bidServiceGetWinningBidHandler := connect.NewUnaryHandler(
+		BidServiceGetWinningBidProcedure,
+		svc.GetWinningBid,
+		connect.WithSchema(bidServiceMethods.ByName("GetWinningBid")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bidServiceGetBidPositionHandler := connect.NewUnaryHandler(
+		BidServiceGetBidPositionProcedure,
+		svc.GetBidPosition,
+		connect.WithSchema(bidServiceMethods.ByName("GetBidPosition")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bidServiceListCategoriesHandler := connect.NewUnaryHandler(
+		BidServiceListCategoriesProcedure,
+		svc.ListCategories,
+		connect.WithSchema(bidServiceMethods.ByName("ListCategories")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bidServiceAdminListItemsHandler := connect.NewUnaryHandler(
+		BidServiceAdminListItemsProcedure,
+		svc.AdminListItems,
+		connect.WithSchema(bidServiceMethods.ByName("AdminListItems")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bidServiceAdminReconcileItemHandler := connect.NewUnaryHandler(
+		BidServiceAdminReconcileItemProcedure,
+		svc.AdminReconcileItem,
+		connect.WithSchema(bidServiceMethods.ByName("AdminReconcileItem")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bidServiceAdminGetUserBidTotalsHandler := connect.NewUnaryHandler(
+		BidServiceAdminGetUserBidTotalsProcedure,
+		svc.AdminGetUserBidTotals,
+		connect.WithSchema(bidServiceMethods.ByName("AdminGetUserBidTotals")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/bids.v1.BidService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case BidServicePlaceBidProcedure:
 			bidServicePlaceBidHandler.ServeHTTP(w, r)
+		case BidServiceGetBidProcedure:
+			bidServiceGetBidHandler.ServeHTTP(w, r)
+		case BidServiceBuyNowProcedure:
+			bidServiceBuyNowHandler.ServeHTTP(w, r)
+		case BidServiceListUserBidsProcedure:
+			bidServiceListUserBidsHandler.ServeHTTP(w, r)
 		case BidServiceCreateItemProcedure:
 			bidServiceCreateItemHandler.ServeHTTP(w, r)
 		case BidServiceGetItemProcedure:
 			bidServiceGetItemHandler.ServeHTTP(w, r)
 		case BidServiceListItemsProcedure:
 			bidServiceListItemsHandler.ServeHTTP(w, r)
+		case BidServiceListEndingSoonProcedure:
+			bidServiceListEndingSoonHandler.ServeHTTP(w, r)
 		case BidServiceListSellerItemsProcedure:
 			bidServiceListSellerItemsHandler.ServeHTTP(w, r)
+		case BidServiceListWonAuctionsProcedure:
+			bidServiceListWonAuctionsHandler.ServeHTTP(w, r)
+		case BidServiceGetSellerDashboardProcedure:
+			bidServiceGetSellerDashboardHandler.ServeHTTP(w, r)
 		case BidServiceUpdateItemProcedure:
 			bidServiceUpdateItemHandler.ServeHTTP(w, r)
 		case BidServiceCancelItemProcedure:
 			bidServiceCancelItemHandler.ServeHTTP(w, r)
+		case BidServiceBulkCancelItemsProcedure:
+			bidServiceBulkCancelItemsHandler.ServeHTTP(w, r)
+		case BidServicePauseItemProcedure:
+			bidServicePauseItemHandler.ServeHTTP(w, r)
+		case BidServiceResumeItemProcedure:
+			bidServiceResumeItemHandler.ServeHTTP(w, r)
+		case BidServiceExtendAuctionProcedure:
+			bidServiceExtendAuctionHandler.ServeHTTP(w, r)
 		case BidServiceGetItemBidsProcedure:
 			bidServiceGetItemBidsHandler.ServeHTTP(w, r)
+		case BidServiceRecordItemViewProcedure:
+			bidServiceRecordItemViewHandler.ServeHTTP(w, r)
+		case BidServiceGetCurrentPriceProcedure:
+			bidServiceGetCurrentPriceHandler.ServeHTTP(w, r)
+		case BidServiceGetWinningBidProcedure:
+			bidServiceGetWinningBidHandler.ServeHTTP(w, r)
+		case BidServiceGetBidPositionProcedure:
+			bidServiceGetBidPositionHandler.ServeHTTP(w, r)
+		case BidServiceListCategoriesProcedure:
+			bidServiceListCategoriesHandler.ServeHTTP(w, r)
+		case BidServiceAdminListItemsProcedure:
+			bidServiceAdminListItemsHandler.ServeHTTP(w, r)
+		case BidServiceAdminReconcileItemProcedure:
+			bidServiceAdminReconcileItemHandler.ServeHTTP(w, r)
+		case BidServiceAdminGetUserBidTotalsProcedure:
+			bidServiceAdminGetUserBidTotalsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -280,6 +727,18 @@ func (UnimplementedBidServiceHandler) PlaceBid(context.Context, *connect.Request
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bids.v1.BidService.PlaceBid is not implemented"))
 }
 
+func (UnimplementedBidServiceHandler) GetBid(context.Context, *connect.Request[v1.GetBidRequest]) (*connect.Response[v1.GetBidResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bids.v1.BidService.GetBid is not implemented"))
+}
+
+func (UnimplementedBidServiceHandler) BuyNow(context.Context, *connect.Request[v1.BuyNowRequest]) (*connect.Response[v1.BuyNowResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bids.v1.BidService.BuyNow is not implemented"))
+}
+
+func (UnimplementedBidServiceHandler) ListUserBids(context.Context, *connect.Request[v1.ListUserBidsRequest]) (*connect.Response[v1.ListUserBidsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bids.v1.BidService.ListUserBids is not implemented"))
+}
+
 func (UnimplementedBidServiceHandler) CreateItem(context.Context, *connect.Request[v1.CreateItemRequest]) (*connect.Response[v1.CreateItemResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bids.v1.BidService.CreateItem is not implemented"))
 }
@@ -292,10 +751,22 @@ func (UnimplementedBidServiceHandler) ListItems(context.Context, *connect.Reques
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bids.v1.BidService.ListItems is not implemented"))
 }
 
+func (UnimplementedBidServiceHandler) ListEndingSoon(context.Context, *connect.Request[v1.ListEndingSoonRequest]) (*connect.Response[v1.ListEndingSoonResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bids.v1.BidService.ListEndingSoon is not implemented"))
+}
+
 func (UnimplementedBidServiceHandler) ListSellerItems(context.Context, *connect.Request[v1.ListSellerItemsRequest]) (*connect.Response[v1.ListSellerItemsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bids.v1.BidService.ListSellerItems is not implemented"))
 }
 
+func (UnimplementedBidServiceHandler) ListWonAuctions(context.Context, *connect.Request[v1.ListWonAuctionsRequest]) (*connect.Response[v1.ListWonAuctionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bids.v1.BidService.ListWonAuctions is not implemented"))
+}
+
+func (UnimplementedBidServiceHandler) GetSellerDashboard(context.Context, *connect.Request[v1.GetSellerDashboardRequest]) (*connect.Response[v1.GetSellerDashboardResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bids.v1.BidService.GetSellerDashboard is not implemented"))
+}
+
 func (UnimplementedBidServiceHandler) UpdateItem(context.Context, *connect.Request[v1.UpdateItemRequest]) (*connect.Response[v1.UpdateItemResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bids.v1.BidService.UpdateItem is not implemented"))
 }
@@ -304,6 +775,54 @@ func (UnimplementedBidServiceHandler) CancelItem(context.Context, *connect.Reque
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bids.v1.BidService.CancelItem is not implemented"))
 }
 
+func (UnimplementedBidServiceHandler) BulkCancelItems(context.Context, *connect.Request[v1.BulkCancelItemsRequest]) (*connect.Response[v1.BulkCancelItemsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bids.v1.BidService.BulkCancelItems is not implemented"))
+}
+
+func (UnimplementedBidServiceHandler) PauseItem(context.Context, *connect.Request[v1.PauseItemRequest]) (*connect.Response[v1.PauseItemResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bids.v1.BidService.PauseItem is not implemented"))
+}
+
+func (UnimplementedBidServiceHandler) ResumeItem(context.Context, *connect.Request[v1.ResumeItemRequest]) (*connect.Response[v1.ResumeItemResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bids.v1.BidService.ResumeItem is not implemented"))
+}
+
+func (UnimplementedBidServiceHandler) ExtendAuction(context.Context, *connect.Request[v1.ExtendAuctionRequest]) (*connect.Response[v1.ExtendAuctionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bids.v1.BidService.ExtendAuction is not implemented"))
+}
+
 func (UnimplementedBidServiceHandler) GetItemBids(context.Context, *connect.Request[v1.GetItemBidsRequest]) (*connect.Response[v1.GetItemBidsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bids.v1.BidService.GetItemBids is not implemented"))
 }
+
+func (UnimplementedBidServiceHandler) RecordItemView(context.Context, *connect.Request[v1.RecordItemViewRequest]) (*connect.Response[v1.RecordItemViewResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bids.v1.BidService.RecordItemView is not implemented"))
+}
+
+func (UnimplementedBidServiceHandler) GetCurrentPrice(context.Context, *connect.Request[v1.GetCurrentPriceRequest]) (*connect.Response[v1.GetCurrentPriceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bids.v1.BidService.GetCurrentPrice is not implemented"))
+}
+
+func (UnimplementedBidServiceHandler) GetWinningBid(context.Context, *connect.Request[v1.GetWinningBidRequest]) (*connect.Response[v1.GetWinningBidResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bids.v1.BidService.GetWinningBid is not implemented"))
+}
+
+func (UnimplementedBidServiceHandler) GetBidPosition(context.Context, *connect.Request[v1.GetBidPositionRequest]) (*connect.Response[v1.GetBidPositionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bids.v1.BidService.GetBidPosition is not implemented"))
+}
+
+func (UnimplementedBidServiceHandler) ListCategories(context.Context, *connect.Request[v1.ListCategoriesRequest]) (*connect.Response[v1.ListCategoriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bids.v1.BidService.ListCategories is not implemented"))
+}
+
+func (UnimplementedBidServiceHandler) AdminListItems(context.Context, *connect.Request[v1.AdminListItemsRequest]) (*connect.Response[v1.AdminListItemsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bids.v1.BidService.AdminListItems is not implemented"))
+}
+
+func (UnimplementedBidServiceHandler) AdminReconcileItem(context.Context, *connect.Request[v1.AdminReconcileItemRequest]) (*connect.Response[v1.AdminReconcileItemResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bids.v1.BidService.AdminReconcileItem is not implemented"))
+}
+
+func (UnimplementedBidServiceHandler) AdminGetUserBidTotals(context.Context, *connect.Request[v1.AdminGetUserBidTotalsRequest]) (*connect.Response[v1.AdminGetUserBidTotalsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bids.v1.BidService.AdminGetUserBidTotals is not implemented"))
+}
@@ -25,14 +25,16 @@ const (
 
 // BidPlaced event is published when a user places a bid on an item
 type BidPlaced struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	BidId         string                 `protobuf:"bytes,1,opt,name=bid_id,json=bidId,proto3" json:"bid_id,omitempty"`    // UUID of the bid
-	ItemId        string                 `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"` // UUID of the item being bid on
-	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // UUID of the user placing the bid
-	Amount        int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`              // Bid amount in cents/micros (BIGINT)
-	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`         // When the bid was placed
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	BidId                   string                 `protobuf:"bytes,1,opt,name=bid_id,json=bidId,proto3" json:"bid_id,omitempty"`                                                           // UUID of the bid
+	ItemId                  string                 `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`                                                        // UUID of the item being bid on
+	UserId                  string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                                        // UUID of the user placing the bid
+	Amount                  int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`                                                                     // Bid amount in cents/micros (BIGINT)
+	Timestamp               *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`                                                                // When the bid was placed
+	PreviousHighestBidderId string                 `protobuf:"bytes,6,opt,name=previous_highest_bidder_id,json=previousHighestBidderId,proto3" json:"previous_highest_bidder_id,omitempty"` // UUID of the user who was outbid, empty for the first bid
+	PreviousAmount          int64                  `protobuf:"varint,7,opt,name=previous_amount,json=previousAmount,proto3" json:"previous_amount,omitempty"`                               // Highest bid before this one, 0 for the first bid
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
 func (x *BidPlaced) Reset() {
@@ -100,6 +102,485 @@ func (x *BidPlaced) GetTimestamp() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *BidPlaced) GetPreviousHighestBidderId() string {
+	if x != nil {
+		return x.PreviousHighestBidderId
+	}
+	return ""
+}
+
+func (x *BidPlaced) GetPreviousAmount() int64 {
+	if x != nil {
+		return x.PreviousAmount
+	}
+	return 0
+}
+
+// AuctionEnded event is published when an auction closes
+type AuctionEnded struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`                     // UUID of the item
+	SellerId      string                 `protobuf:"bytes,2,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`               // UUID of the seller
+	WinnerId      string                 `protobuf:"bytes,3,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"`               // UUID of the winning bidder
+	WinningBidId  string                 `protobuf:"bytes,4,opt,name=winning_bid_id,json=winningBidId,proto3" json:"winning_bid_id,omitempty"` // UUID of the winning bid
+	Amount        int64                  `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`                                  // Winning amount in cents/micros
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`                             // When the auction ended
+	BuyNow        bool                   `protobuf:"varint,7,opt,name=buy_now,json=buyNow,proto3" json:"buy_now,omitempty"`                    // true when the winner bought the item at its buy-now price
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuctionEnded) Reset() {
+	*x = AuctionEnded{}
+	mi := &file_events_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuctionEnded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuctionEnded) ProtoMessage() {}
+
+func (x *AuctionEnded) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuctionEnded.ProtoReflect.Descriptor instead.
+func (*AuctionEnded) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AuctionEnded) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *AuctionEnded) GetSellerId() string {
+	if x != nil {
+		return x.SellerId
+	}
+	return ""
+}
+
+func (x *AuctionEnded) GetWinnerId() string {
+	if x != nil {
+		return x.WinnerId
+	}
+	return ""
+}
+
+func (x *AuctionEnded) GetWinningBidId() string {
+	if x != nil {
+		return x.WinningBidId
+	}
+	return ""
+}
+
+func (x *AuctionEnded) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *AuctionEnded) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *AuctionEnded) GetBuyNow() bool {
+	if x != nil {
+		return x.BuyNow
+	}
+	return false
+}
+
+// AuctionExtended event is published when a seller moves a live auction's end time back
+type AuctionExtended struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`       // UUID of the item
+	SellerId      string                 `protobuf:"bytes,2,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"` // UUID of the seller
+	PreviousEndAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=previous_end_at,json=previousEndAt,proto3" json:"previous_end_at,omitempty"`
+	NewEndAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=new_end_at,json=newEndAt,proto3" json:"new_end_at,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // When the extension was made
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuctionExtended) Reset() {
+	*x = AuctionExtended{}
+	mi := &file_events_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuctionExtended) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuctionExtended) ProtoMessage() {}
+
+func (x *AuctionExtended) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuctionExtended.ProtoReflect.Descriptor instead.
+func (*AuctionExtended) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AuctionExtended) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *AuctionExtended) GetSellerId() string {
+	if x != nil {
+		return x.SellerId
+	}
+	return ""
+}
+
+func (x *AuctionExtended) GetPreviousEndAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PreviousEndAt
+	}
+	return nil
+}
+
+func (x *AuctionExtended) GetNewEndAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NewEndAt
+	}
+	return nil
+}
+
+func (x *AuctionExtended) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+// ItemCreated event is published when a seller lists a new item
+type ItemCreated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`       // UUID of the item
+	SellerId      string                 `protobuf:"bytes,2,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"` // UUID of the seller
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Category      string                 `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`                             // Empty if uncategorized
+	StartPrice    int64                  `protobuf:"varint,6,opt,name=start_price,json=startPrice,proto3" json:"start_price,omitempty"`      // Opening price in cents/micros
+	BuyNowPrice   int64                  `protobuf:"varint,7,opt,name=buy_now_price,json=buyNowPrice,proto3" json:"buy_now_price,omitempty"` // 0 if the item has no buy-now price
+	StartAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	EndAt         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	Status        string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"` // "scheduled" or "active"
+	Images        []string               `protobuf:"bytes,11,rep,name=images,proto3" json:"images,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // When the item was created
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ItemCreated) Reset() {
+	*x = ItemCreated{}
+	mi := &file_events_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItemCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemCreated) ProtoMessage() {}
+
+func (x *ItemCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemCreated.ProtoReflect.Descriptor instead.
+func (*ItemCreated) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ItemCreated) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *ItemCreated) GetSellerId() string {
+	if x != nil {
+		return x.SellerId
+	}
+	return ""
+}
+
+func (x *ItemCreated) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ItemCreated) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ItemCreated) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ItemCreated) GetStartPrice() int64 {
+	if x != nil {
+		return x.StartPrice
+	}
+	return 0
+}
+
+func (x *ItemCreated) GetBuyNowPrice() int64 {
+	if x != nil {
+		return x.BuyNowPrice
+	}
+	return 0
+}
+
+func (x *ItemCreated) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+func (x *ItemCreated) GetEndAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndAt
+	}
+	return nil
+}
+
+func (x *ItemCreated) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ItemCreated) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *ItemCreated) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+// ItemUpdated event is published when a seller edits an item's listing details
+type ItemUpdated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`       // UUID of the item
+	SellerId      string                 `protobuf:"bytes,2,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"` // UUID of the seller
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Category      string                 `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
+	Images        []string               `protobuf:"bytes,6,rep,name=images,proto3" json:"images,omitempty"`
+	EndAtTimezone string                 `protobuf:"bytes,7,opt,name=end_at_timezone,json=endAtTimezone,proto3" json:"end_at_timezone,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // When the item was updated
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ItemUpdated) Reset() {
+	*x = ItemUpdated{}
+	mi := &file_events_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItemUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemUpdated) ProtoMessage() {}
+
+func (x *ItemUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemUpdated.ProtoReflect.Descriptor instead.
+func (*ItemUpdated) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ItemUpdated) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *ItemUpdated) GetSellerId() string {
+	if x != nil {
+		return x.SellerId
+	}
+	return ""
+}
+
+func (x *ItemUpdated) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ItemUpdated) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ItemUpdated) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ItemUpdated) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *ItemUpdated) GetEndAtTimezone() string {
+	if x != nil {
+		return x.EndAtTimezone
+	}
+	return ""
+}
+
+func (x *ItemUpdated) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+// ItemCancelled event is published when a seller cancels an item before it receives bids
+type ItemCancelled struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`       // UUID of the item
+	SellerId      string                 `protobuf:"bytes,2,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"` // UUID of the seller
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`               // When the item was cancelled
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ItemCancelled) Reset() {
+	*x = ItemCancelled{}
+	mi := &file_events_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItemCancelled) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemCancelled) ProtoMessage() {}
+
+func (x *ItemCancelled) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemCancelled.ProtoReflect.Descriptor instead.
+func (*ItemCancelled) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ItemCancelled) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *ItemCancelled) GetSellerId() string {
+	if x != nil {
+		return x.SellerId
+	}
+	return ""
+}
+
+func (x *ItemCancelled) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
 // UserCreated event is published when a new user registers
 type UserCreated struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -114,7 +595,7 @@ type UserCreated struct {
 
 func (x *UserCreated) Reset() {
 	*x = UserCreated{}
-	mi := &file_events_proto_msgTypes[1]
+	mi := &file_events_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -126,7 +607,7 @@ func (x *UserCreated) String() string {
 func (*UserCreated) ProtoMessage() {}
 
 func (x *UserCreated) ProtoReflect() protoreflect.Message {
-	mi := &file_events_proto_msgTypes[1]
+	mi := &file_events_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -139,7 +620,7 @@ func (x *UserCreated) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserCreated.ProtoReflect.Descriptor instead.
 func (*UserCreated) Descriptor() ([]byte, []int) {
-	return file_events_proto_rawDescGZIP(), []int{1}
+	return file_events_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UserCreated) GetUserId() string {
@@ -177,24 +658,127 @@ func (x *UserCreated) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+// UserDeleted event is published when a user deletes their account. Consumers erase or
+// anonymize whatever they hold about the user.
+type UserDeleted struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`          // UUID of the deleted user
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"` // When the account was deleted
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserDeleted) Reset() {
+	*x = UserDeleted{}
+	mi := &file_events_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDeleted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDeleted) ProtoMessage() {}
+
+func (x *UserDeleted) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDeleted.ProtoReflect.Descriptor instead.
+func (*UserDeleted) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UserDeleted) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserDeleted) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
 var File_events_proto protoreflect.FileDescriptor
 
 const file_events_proto_rawDesc = "" +
 	"\n" +
-	"\fevents.proto\x12\x06events\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa6\x01\n" +
+	"\fevents.proto\x12\x06events\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8c\x02\n" +
 	"\tBidPlaced\x12\x15\n" +
 	"\x06bid_id\x18\x01 \x01(\tR\x05bidId\x12\x17\n" +
 	"\aitem_id\x18\x02 \x01(\tR\x06itemId\x12\x17\n" +
 	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x16\n" +
 	"\x06amount\x18\x04 \x01(\x03R\x06amount\x128\n" +
-	"\ttimestamp\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\xb7\x01\n" +
+	"\ttimestamp\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12;\n" +
+	"\x1aprevious_highest_bidder_id\x18\x06 \x01(\tR\x17previousHighestBidderId\x12'\n" +
+	"\x0fprevious_amount\x18\a \x01(\x03R\x0epreviousAmount\"\xf2\x01\n" +
+	"\fAuctionEnded\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12\x1b\n" +
+	"\tseller_id\x18\x02 \x01(\tR\bsellerId\x12\x1b\n" +
+	"\twinner_id\x18\x03 \x01(\tR\bwinnerId\x12$\n" +
+	"\x0ewinning_bid_id\x18\x04 \x01(\tR\fwinningBidId\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x03R\x06amount\x128\n" +
+	"\ttimestamp\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x17\n" +
+	"\abuy_now\x18\a \x01(\bR\x06buyNow\"\xff\x01\n" +
+	"\x0fAuctionExtended\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12\x1b\n" +
+	"\tseller_id\x18\x02 \x01(\tR\bsellerId\x12B\n" +
+	"\x0fprevious_end_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\rpreviousEndAt\x128\n" +
+	"\n" +
+	"new_end_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bnewEndAt\x128\n" +
+	"\ttimestamp\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\xb0\x03\n" +
+	"\vItemCreated\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12\x1b\n" +
+	"\tseller_id\x18\x02 \x01(\tR\bsellerId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bcategory\x18\x05 \x01(\tR\bcategory\x12\x1f\n" +
+	"\vstart_price\x18\x06 \x01(\x03R\n" +
+	"startPrice\x12\"\n" +
+	"\rbuy_now_price\x18\a \x01(\x03R\vbuyNowPrice\x125\n" +
+	"\bstart_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\astartAt\x121\n" +
+	"\x06end_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x05endAt\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\tR\x06status\x12\x16\n" +
+	"\x06images\x18\v \x03(\tR\x06images\x128\n" +
+	"\ttimestamp\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\x91\x02\n" +
+	"\vItemUpdated\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12\x1b\n" +
+	"\tseller_id\x18\x02 \x01(\tR\bsellerId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bcategory\x18\x05 \x01(\tR\bcategory\x12\x16\n" +
+	"\x06images\x18\x06 \x03(\tR\x06images\x12&\n" +
+	"\x0fend_at_timezone\x18\a \x01(\tR\rendAtTimezone\x128\n" +
+	"\ttimestamp\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\x7f\n" +
+	"\rItemCancelled\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12\x1b\n" +
+	"\tseller_id\x18\x02 \x01(\tR\bsellerId\x128\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\xb7\x01\n" +
 	"\vUserCreated\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1b\n" +
 	"\tfull_name\x18\x03 \x01(\tR\bfullName\x12!\n" +
 	"\fcountry_code\x18\x04 \x01(\tR\vcountryCode\x129\n" +
 	"\n" +
-	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB&Z$github.com/floroz/gavel/pkg/proto;pbb\x06proto3"
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"a\n" +
+	"\vUserDeleted\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x129\n" +
+	"\n" +
+	"deleted_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAtB&Z$github.com/floroz/gavel/pkg/proto;pbb\x06proto3"
 
 var (
 	file_events_proto_rawDescOnce sync.Once
@@ -208,20 +792,36 @@ func file_events_proto_rawDescGZIP() []byte {
 	return file_events_proto_rawDescData
 }
 
-var file_events_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_events_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_events_proto_goTypes = []any{
 	(*BidPlaced)(nil),             // 0: events.BidPlaced
-	(*UserCreated)(nil),           // 1: events.UserCreated
-	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+	(*AuctionEnded)(nil),          // 1: events.AuctionEnded
+	(*AuctionExtended)(nil),       // 2: events.AuctionExtended
+	(*ItemCreated)(nil),           // 3: events.ItemCreated
+	(*ItemUpdated)(nil),           // 4: events.ItemUpdated
+	(*ItemCancelled)(nil),         // 5: events.ItemCancelled
+	(*UserCreated)(nil),           // 6: events.UserCreated
+	(*UserDeleted)(nil),           // 7: events.UserDeleted
+	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
 }
 var file_events_proto_depIdxs = []int32{
-	2, // 0: events.BidPlaced.timestamp:type_name -> google.protobuf.Timestamp
-	2, // 1: events.UserCreated.created_at:type_name -> google.protobuf.Timestamp
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	8,  // 0: events.BidPlaced.timestamp:type_name -> google.protobuf.Timestamp
+	8,  // 1: events.AuctionEnded.timestamp:type_name -> google.protobuf.Timestamp
+	8,  // 2: events.AuctionExtended.previous_end_at:type_name -> google.protobuf.Timestamp
+	8,  // 3: events.AuctionExtended.new_end_at:type_name -> google.protobuf.Timestamp
+	8,  // 4: events.AuctionExtended.timestamp:type_name -> google.protobuf.Timestamp
+	8,  // 5: events.ItemCreated.start_at:type_name -> google.protobuf.Timestamp
+	8,  // 6: events.ItemCreated.end_at:type_name -> google.protobuf.Timestamp
+	8,  // 7: events.ItemCreated.timestamp:type_name -> google.protobuf.Timestamp
+	8,  // 8: events.ItemUpdated.timestamp:type_name -> google.protobuf.Timestamp
+	8,  // 9: events.ItemCancelled.timestamp:type_name -> google.protobuf.Timestamp
+	8,  // 10: events.UserCreated.created_at:type_name -> google.protobuf.Timestamp
+	8,  // 11: events.UserDeleted.deleted_at:type_name -> google.protobuf.Timestamp
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_events_proto_init() }
@@ -235,7 +835,7 @@ func file_events_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_proto_rawDesc), len(file_events_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,37 @@
+// Package recovery keeps a panicking RPC handler from taking down the whole process.
+package recovery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+
+	"connectrpc.com/connect"
+)
+
+// errInternal is what clients see in place of the panic value, which may carry
+// details that should stay on the server
+var errInternal = errors.New("internal error")
+
+// NewInterceptor turns a panic in any later interceptor or handler into a
+// CodeInternal error and logs it with the stack. It must come first in the
+// interceptor chain so that it also covers the ones after it.
+func NewInterceptor(logger *slog.Logger) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (res connect.AnyResponse, err error) {
+			defer func() {
+				if r := recover(); r != nil {
+					logger.ErrorContext(ctx, "recovered from panic in handler",
+						"procedure", req.Spec().Procedure,
+						"panic", fmt.Sprint(r),
+						"stack", string(debug.Stack()),
+					)
+					res, err = nil, connect.NewError(connect.CodeInternal, errInternal)
+				}
+			}()
+			return next(ctx, req)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package recovery
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const panicProcedure = "/test.v1.TestService/Panic"
+
+func TestInterceptor(t *testing.T) {
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&logs, nil))
+
+	calls := 0
+	mux := http.NewServeMux()
+	mux.Handle(panicProcedure, connect.NewUnaryHandler(panicProcedure,
+		func(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error) {
+			calls++
+			if calls == 1 {
+				panic("nil map write")
+			}
+			return connect.NewResponse(&emptypb.Empty{}), nil
+		},
+		connect.WithInterceptors(NewInterceptor(logger)),
+	))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client := connect.NewClient[emptypb.Empty, emptypb.Empty](server.Client(), server.URL+panicProcedure)
+	ctx := context.Background()
+
+	t.Run("a panic becomes an internal error", func(t *testing.T) {
+		_, err := client.CallUnary(ctx, connect.NewRequest(&emptypb.Empty{}))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeInternal, connect.CodeOf(err))
+		assert.NotContains(t, err.Error(), "nil map write", "panic values must not reach the client")
+
+		assert.Contains(t, logs.String(), "nil map write")
+		assert.Contains(t, logs.String(), panicProcedure)
+		assert.Contains(t, logs.String(), "goroutine")
+	})
+
+	t.Run("the server keeps serving", func(t *testing.T) {
+		_, err := client.CallUnary(ctx, connect.NewRequest(&emptypb.Empty{}))
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,67 @@
+// Package sanitize cleans user-provided text before it is stored. It removes bytes that
+// have no business in plain text and tidies whitespace, but leaves markup alone:
+// escaping is the renderer's job.
+package sanitize
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// maxBlankLines is how many empty lines in a row Text keeps between paragraphs
+const maxBlankLines = 1
+
+// Line cleans single-line text such as titles and names. Control and bidi override
+// characters are dropped, every run of whitespace (line breaks included) becomes one
+// space, and the ends are trimmed.
+func Line(s string) string {
+	return strings.Join(strings.Fields(strip(s)), " ")
+}
+
+// Text cleans multi-line text such as descriptions. It keeps line breaks, cleans each
+// line like Line and allows at most one blank line between paragraphs.
+func Text(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	s = strings.ReplaceAll(s, "\r", "\n")
+
+	var lines []string
+	blank := 0
+	for _, line := range strings.Split(s, "\n") {
+		line = Line(line)
+		if line == "" {
+			blank++
+			if blank > maxBlankLines {
+				continue
+			}
+		} else {
+			blank = 0
+		}
+		lines = append(lines, line)
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+// strip drops invalid UTF-8 and every control or bidi formatting character except the
+// whitespace ones, which are turned into spaces or line breaks by the callers
+func strip(s string) string {
+	if !utf8.ValidString(s) {
+		s = strings.ToValidUTF8(s, "")
+	}
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r == '\n' || r == '\t':
+			return r
+		case unicode.IsControl(r), isBidiControl(r):
+			return -1
+		default:
+			return r
+		}
+	}, s)
+}
+
+// isBidiControl reports the embedding, override and isolate characters that can make
+// text display in a different order than it is stored
+func isBidiControl(r rune) bool {
+	return (r >= '\u202a' && r <= '\u202e') || (r >= '\u2066' && r <= '\u2069')
+}
@@ -0,0 +1,52 @@
+package sanitize
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLine(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"plain text is unchanged", "Vintage camera", "Vintage camera"},
+		{"trims and collapses whitespace", "  Vintage \t\t  camera  ", "Vintage camera"},
+		{"line breaks become spaces", "Vintage\ncamera\r\nlens", "Vintage camera lens"},
+		{"strips control characters", "Vin\x00tage\x07 cam\x1bera\x7f", "Vintage camera"},
+		{"strips C1 controls", "Vintage\u0085\u009b camera", "Vintage camera"},
+		{"strips bidi overrides", "file\u202egnp.exe", "filegnp.exe"},
+		{"drops invalid UTF-8", "Vintage \xff\xfecamera", "Vintage camera"},
+		{"keeps markup for the renderer to escape", "<b>Bold</b> & more", "<b>Bold</b> & more"},
+		{"keeps emoji sequences", "Family 👨\u200d👩\u200d👧", "Family 👨\u200d👩\u200d👧"},
+		{"whitespace only becomes empty", " \t\n  ", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Line(tt.in))
+		})
+	}
+}
+
+func TestText(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"keeps paragraphs", "First line\nSecond line\n\nNew paragraph", "First line\nSecond line\n\nNew paragraph"},
+		{"normalizes line endings", "One\r\nTwo\rThree", "One\nTwo\nThree"},
+		{"collapses blank line runs", "Top\n\n\n\n\n\nBottom", "Top\n\nBottom"},
+		{"whitespace-only lines count as blank", "Top\n  \n\t\n \nBottom", "Top\n\nBottom"},
+		{"collapses whitespace within lines", "Mint    condition,\t\tbarely   used  ", "Mint condition, barely used"},
+		{"trims leading and trailing blank lines", "\n\n  Body  \n\n\n", "Body"},
+		{"strips control characters", "Body\x00 text\x1b[31m red", "Body text[31m red"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Text(tt.in))
+		})
+	}
+}
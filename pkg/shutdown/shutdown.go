@@ -0,0 +1,58 @@
+// Package shutdown bounds how long a worker waits for its loops to wind down after a
+// stop signal.
+package shutdown
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+)
+
+// EnvShutdownTimeout overrides DefaultTimeout (Go duration, e.g. "20s")
+const EnvShutdownTimeout = "SHUTDOWN_TIMEOUT"
+
+// DefaultTimeout leaves a consumer enough time to finish a message and the relay a batch,
+// and stays under the 30s grace period Kubernetes and Docker give before SIGKILL.
+const DefaultTimeout = 25 * time.Second
+
+// ErrTimeout is returned by Wait when the loops are still running once the timeout passes
+var ErrTimeout = errors.New("shutdown timed out with work still in flight")
+
+// TimeoutFromEnv returns SHUTDOWN_TIMEOUT, or DefaultTimeout if unset
+func TimeoutFromEnv() (time.Duration, error) {
+	v := os.Getenv(EnvShutdownTimeout)
+	if v == "" {
+		return DefaultTimeout, nil
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid %s %q: must be a positive duration", EnvShutdownTimeout, v)
+	}
+	return d, nil
+}
+
+// Wait calls wait and returns its result. wait may block for as long as ctx is live;
+// once ctx is cancelled it gets at most timeout more to return before Wait gives up
+// with ErrTimeout, leaving it running.
+func Wait(ctx context.Context, timeout time.Duration, wait func() error) error {
+	done := make(chan error, 1)
+	go func() { done <- wait() }()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case err := <-done:
+		return err
+	case <-timer.C:
+		return ErrTimeout
+	}
+}
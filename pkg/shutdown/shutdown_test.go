@@ -0,0 +1,79 @@
+package shutdown
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeoutFromEnv(t *testing.T) {
+	t.Run("defaults when unset", func(t *testing.T) {
+		d, err := TimeoutFromEnv()
+		require.NoError(t, err)
+		assert.Equal(t, DefaultTimeout, d)
+	})
+
+	t.Run("applies env setting", func(t *testing.T) {
+		t.Setenv(EnvShutdownTimeout, "40s")
+		d, err := TimeoutFromEnv()
+		require.NoError(t, err)
+		assert.Equal(t, 40*time.Second, d)
+	})
+
+	t.Run("rejects invalid values", func(t *testing.T) {
+		for _, v := range []string{"soon", "0s", "-5s", "30"} {
+			t.Run(v, func(t *testing.T) {
+				t.Setenv(EnvShutdownTimeout, v)
+				_, err := TimeoutFromEnv()
+				assert.ErrorContains(t, err, EnvShutdownTimeout)
+			})
+		}
+	})
+}
+
+func TestWait(t *testing.T) {
+	t.Run("returns the result without a shutdown", func(t *testing.T) {
+		failed := errors.New("consumer failed")
+		err := Wait(context.Background(), time.Millisecond, func() error { return failed })
+		assert.ErrorIs(t, err, failed)
+	})
+
+	t.Run("waits for in-flight work after cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		release := make(chan struct{})
+		finished := false
+
+		go func() {
+			cancel()
+			time.Sleep(50 * time.Millisecond)
+			close(release)
+		}()
+
+		err := Wait(ctx, time.Second, func() error {
+			<-release
+			finished = true
+			return nil
+		})
+		require.NoError(t, err)
+		assert.True(t, finished, "Wait returned before the work finished")
+	})
+
+	t.Run("gives up after the timeout", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		block := make(chan struct{})
+		defer close(block)
+
+		start := time.Now()
+		err := Wait(ctx, 20*time.Millisecond, func() error {
+			<-block
+			return nil
+		})
+		assert.ErrorIs(t, err, ErrTimeout)
+		assert.Less(t, time.Since(start), time.Second)
+	})
+}
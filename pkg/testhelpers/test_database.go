@@ -5,9 +5,12 @@ import (
 	"database/sql"
 	"fmt"
 	"path/filepath"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	_ "github.com/jackc/pgx/v5/stdlib"
 	"github.com/pressly/goose/v3"
@@ -20,6 +23,9 @@ type TestDatabase struct {
 	Container *postgres.PostgresContainer
 	Pool      *pgxpool.Pool
 	ConnStr   string
+
+	// seeded holds the tables the migrations left rows in, such as reference data
+	seeded []string
 }
 
 func NewTestDatabase(t *testing.T, migrationsPath string) *TestDatabase {
@@ -76,11 +82,26 @@ func NewTestDatabase(t *testing.T, migrationsPath string) *TestDatabase {
 		t.Fatalf("failed to run migrations: %s", err)
 	}
 
-	return &TestDatabase{
+	td := &TestDatabase{
 		Container: pgContainer,
 		Pool:      pool,
 		ConnStr:   connStr,
 	}
+	tables, err := td.listTables(ctx)
+	if err != nil {
+		t.Fatalf("failed to list tables: %s", err)
+	}
+	for _, table := range tables {
+		var hasRows bool
+		query := "SELECT EXISTS (SELECT 1 FROM " + pgx.Identifier{table}.Sanitize() + ")"
+		if err := pool.QueryRow(ctx, query).Scan(&hasRows); err != nil {
+			t.Fatalf("failed to inspect table %s: %s", table, err)
+		}
+		if hasRows {
+			td.seeded = append(td.seeded, table)
+		}
+	}
+	return td
 }
 
 func (td *TestDatabase) Close() {
@@ -91,3 +112,48 @@ func (td *TestDatabase) Close() {
 		fmt.Printf("failed to terminate container: %v\n", termErr)
 	}
 }
+
+// CleanDatabase empties tables between cases that share a database. With no tables given
+// it truncates every table in the current schema except goose's version table and the
+// tables the migrations seeded, so it works for any service's migrations and keeps their
+// reference data. Name a seeded table explicitly to empty it. Identity sequences restart
+// and dependent rows are removed through foreign keys.
+func (td *TestDatabase) CleanDatabase(t *testing.T, tables ...string) {
+	t.Helper()
+	ctx := context.Background()
+
+	if len(tables) == 0 {
+		all, err := td.listTables(ctx)
+		if err != nil {
+			t.Fatalf("failed to list tables: %s", err)
+		}
+		for _, table := range all {
+			if !slices.Contains(td.seeded, table) {
+				tables = append(tables, table)
+			}
+		}
+		if len(tables) == 0 {
+			return
+		}
+	}
+
+	quoted := make([]string, len(tables))
+	for i, table := range tables {
+		quoted[i] = pgx.Identifier{table}.Sanitize()
+	}
+	if _, err := td.Pool.Exec(ctx, "TRUNCATE "+strings.Join(quoted, ", ")+" RESTART IDENTITY CASCADE"); err != nil {
+		t.Fatalf("failed to truncate tables: %s", err)
+	}
+}
+
+// listTables returns the tables in the current schema, leaving out goose's version table
+func (td *TestDatabase) listTables(ctx context.Context) ([]string, error) {
+	rows, err := td.Pool.Query(ctx, `
+		SELECT tablename FROM pg_tables
+		WHERE schemaname = current_schema() AND tablename <> $1
+	`, goose.TableName())
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowTo[string])
+}
@@ -0,0 +1,87 @@
+//go:build integration
+
+package testhelpers
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const cleanMigration = `-- +goose Up
+CREATE TABLE accounts (id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY, name TEXT NOT NULL);
+CREATE TABLE orders (id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY, account_id BIGINT NOT NULL REFERENCES accounts(id));
+CREATE TABLE "Audit Log" (entry TEXT NOT NULL);
+CREATE TABLE plans (name TEXT PRIMARY KEY);
+INSERT INTO plans (name) VALUES ('free'), ('pro');
+
+-- +goose Down
+DROP TABLE plans;
+DROP TABLE "Audit Log";
+DROP TABLE orders;
+DROP TABLE accounts;
+`
+
+func TestCleanDatabase(t *testing.T) {
+	ctx := context.Background()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "00001_init.sql"), []byte(cleanMigration), 0o600))
+
+	testDB := NewTestDatabase(t, dir)
+	t.Cleanup(testDB.Close)
+
+	seed := func(t *testing.T) {
+		t.Helper()
+		_, err := testDB.Pool.Exec(ctx, `
+			INSERT INTO accounts (name) VALUES ('alice');
+			INSERT INTO orders (account_id) SELECT id FROM accounts;
+			INSERT INTO "Audit Log" (entry) VALUES ('seeded');
+		`)
+		require.NoError(t, err)
+	}
+	count := func(t *testing.T, table string) int {
+		t.Helper()
+		var n int
+		require.NoError(t, testDB.Pool.QueryRow(ctx, "SELECT count(*) FROM "+table).Scan(&n))
+		return n
+	}
+
+	t.Run("empties every table but the migration history and seeded data", func(t *testing.T) {
+		seed(t)
+		migrations := count(t, "goose_db_version")
+
+		testDB.CleanDatabase(t)
+
+		for _, table := range []string{"accounts", "orders", `"Audit Log"`} {
+			assert.Zero(t, count(t, table), table)
+		}
+		assert.Equal(t, migrations, count(t, "goose_db_version"))
+		assert.Equal(t, 2, count(t, "plans"), "rows inserted by migrations are kept")
+
+		var id int64
+		require.NoError(t, testDB.Pool.QueryRow(ctx, "INSERT INTO accounts (name) VALUES ('bob') RETURNING id").Scan(&id))
+		assert.Equal(t, int64(1), id, "identity sequences restart")
+	})
+
+	t.Run("truncates only the tables given", func(t *testing.T) {
+		testDB.CleanDatabase(t)
+		seed(t)
+
+		testDB.CleanDatabase(t, "Audit Log")
+
+		assert.Zero(t, count(t, `"Audit Log"`))
+		assert.Equal(t, 1, count(t, "accounts"))
+		assert.Equal(t, 1, count(t, "orders"))
+	})
+
+	t.Run("truncates a seeded table when named", func(t *testing.T) {
+		testDB.CleanDatabase(t, "plans")
+
+		assert.Zero(t, count(t, "plans"))
+	})
+}
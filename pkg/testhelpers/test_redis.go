@@ -0,0 +1,34 @@
+package testhelpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/redis/go-redis/v9"
+	tcredis "github.com/testcontainers/testcontainers-go/modules/redis"
+)
+
+// NewTestRedis starts a Redis container and returns a connected client, both closed when the test ends
+func NewTestRedis(t *testing.T) *redis.Client {
+	t.Helper()
+	ctx := context.Background()
+
+	container, err := tcredis.Run(ctx, "redis:7-alpine")
+	if err != nil {
+		t.Fatalf("failed to start redis container: %s", err)
+	}
+	t.Cleanup(func() { _ = container.Terminate(ctx) })
+
+	connStr, err := container.ConnectionString(ctx)
+	if err != nil {
+		t.Fatalf("failed to get redis connection string: %s", err)
+	}
+	opts, err := redis.ParseURL(connStr)
+	if err != nil {
+		t.Fatalf("failed to parse redis connection string: %s", err)
+	}
+
+	rdb := redis.NewClient(opts)
+	t.Cleanup(func() { _ = rdb.Close() })
+	return rdb
+}
@@ -0,0 +1,43 @@
+package tracing
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// NewInterceptor creates a server span for every unary RPC, continuing any trace
+// propagated in the request headers. It should sit just inside the recovery
+// interceptor so that rejected requests (e.g. unauthenticated) are traced too.
+func NewInterceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			ctx = otel.GetTextMapPropagator().Extract(ctx, propagation.HeaderCarrier(req.Header()))
+
+			service, method := splitProcedure(req.Spec().Procedure)
+			ctx, span := otel.Tracer(InstrumentationName).Start(ctx, req.Spec().Procedure,
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(
+					semconv.RPCSystemKey.String("connect_rpc"),
+					semconv.RPCService(service),
+					semconv.RPCMethod(method),
+				),
+			)
+			defer span.End()
+
+			res, err := next(ctx, req)
+			if err != nil {
+				span.SetAttributes(attribute.String("rpc.connect_rpc.error_code", connect.CodeOf(err).String()))
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+			return res, err
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestSplitProcedure(t *testing.T) {
+	service, method := splitProcedure("/bids.v1.BidService/PlaceBid")
+	assert.Equal(t, "bids.v1.BidService", service)
+	assert.Equal(t, "PlaceBid", method)
+}
+
+func TestInterceptor(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	interceptor := NewInterceptor()
+
+	t.Run("handler runs inside a server span", func(t *testing.T) {
+		var inner trace.SpanContext
+		handler := func(ctx context.Context, _ connect.AnyRequest) (connect.AnyResponse, error) {
+			inner = trace.SpanContextFromContext(ctx)
+			return connect.NewResponse(&struct{}{}), nil
+		}
+
+		_, err := interceptor(handler)(context.Background(), connect.NewRequest(&struct{}{}))
+		require.NoError(t, err)
+
+		spans := recorder.Ended()
+		require.NotEmpty(t, spans)
+		span := spans[len(spans)-1]
+		assert.Equal(t, trace.SpanKindServer, span.SpanKind())
+		assert.Equal(t, span.SpanContext().SpanID(), inner.SpanID())
+		assert.Equal(t, codes.Unset, span.Status().Code)
+	})
+
+	t.Run("errors mark the span as failed", func(t *testing.T) {
+		handler := func(context.Context, connect.AnyRequest) (connect.AnyResponse, error) {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("missing"))
+		}
+
+		_, err := interceptor(handler)(context.Background(), connect.NewRequest(&struct{}{}))
+		require.Error(t, err)
+
+		spans := recorder.Ended()
+		span := spans[len(spans)-1]
+		assert.Equal(t, codes.Error, span.Status().Code)
+	})
+}
@@ -0,0 +1,65 @@
+// Package tracing configures OpenTelemetry tracing for service binaries and
+// provides a Connect interceptor that opens a server span per RPC.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// InstrumentationName identifies spans created by this repository's instrumentation
+const InstrumentationName = "github.com/floroz/gavel"
+
+// Setup installs a global tracer provider exporting over OTLP/HTTP.
+// The exporter is configured by the standard OTEL_EXPORTER_OTLP_* variables; if neither
+// OTEL_EXPORTER_OTLP_ENDPOINT nor OTEL_EXPORTER_OTLP_TRACES_ENDPOINT is set, tracing stays
+// a no-op. The returned function flushes pending spans and must be called on shutdown.
+func Setup(ctx context.Context, serviceName string) (func(context.Context) error, error) {
+	if os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") == "" && os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := otlptracehttp.New(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP exporter: %w", err)
+	}
+
+	res, err := resource.New(ctx,
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+		resource.WithAttributes(semconv.ServiceName(serviceName)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create trace resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	return provider.Shutdown, nil
+}
+
+// splitProcedure splits "/bids.v1.BidService/PlaceBid" into service and method
+func splitProcedure(procedure string) (string, string) {
+	procedure = strings.TrimPrefix(procedure, "/")
+	if i := strings.LastIndex(procedure, "/"); i >= 0 {
+		return procedure[:i], procedure[i+1:]
+	}
+	return procedure, ""
+}
@@ -0,0 +1,70 @@
+// Package validation turns domain validation errors into Connect errors that
+// tell clients which request fields were rejected.
+package validation
+
+import (
+	"errors"
+
+	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// Field ties a domain validation error to the request field it concerns.
+// Field names use the proto field name, e.g. "start_price".
+type Field struct {
+	Err   error
+	Field string
+}
+
+// InvalidArgument returns a CodeInvalidArgument error for err. It carries a
+// google.rpc.BadRequest detail with one field violation per mapping that err
+// matches (errors.Is), so a joined error can report several fields at once.
+// Without a match the error is returned without details.
+func InvalidArgument(err error, fields ...Field) *connect.Error {
+	connectErr := connect.NewError(connect.CodeInvalidArgument, err)
+
+	var violations []*errdetails.BadRequest_FieldViolation
+	seen := make(map[string]bool)
+	for _, f := range fields {
+		if seen[f.Field] || !errors.Is(err, f.Err) {
+			continue
+		}
+		seen[f.Field] = true
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       f.Field,
+			Description: f.Err.Error(),
+		})
+	}
+	if len(violations) == 0 {
+		return connectErr
+	}
+
+	detail, detailErr := connect.NewErrorDetail(&errdetails.BadRequest{FieldViolations: violations})
+	if detailErr == nil {
+		connectErr.AddDetail(detail)
+	}
+	return connectErr
+}
+
+// FieldViolations returns the field violations attached to err by InvalidArgument,
+// keyed by field name. It is meant for clients and tests.
+func FieldViolations(err error) map[string]string {
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) {
+		return nil
+	}
+
+	violations := make(map[string]string)
+	for _, detail := range connectErr.Details() {
+		msg, valueErr := detail.Value()
+		if valueErr != nil {
+			continue
+		}
+		if badRequest, ok := msg.(*errdetails.BadRequest); ok {
+			for _, v := range badRequest.FieldViolations {
+				violations[v.Field] = v.Description
+			}
+		}
+	}
+	return violations
+}
@@ -0,0 +1,54 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	errBadPrice = errors.New("price must be positive")
+	errBadTitle = errors.New("title is required")
+)
+
+var testFields = []Field{
+	{Err: errBadPrice, Field: "start_price"},
+	{Err: errBadTitle, Field: "title"},
+}
+
+func TestInvalidArgument(t *testing.T) {
+	t.Run("attaches the matching field", func(t *testing.T) {
+		err := InvalidArgument(fmt.Errorf("invalid input: %w", errBadPrice), testFields...)
+
+		assert.Equal(t, connect.CodeInvalidArgument, err.Code())
+		assert.Equal(t, map[string]string{"start_price": errBadPrice.Error()}, FieldViolations(err))
+	})
+
+	t.Run("reports every field of a joined error", func(t *testing.T) {
+		err := InvalidArgument(errors.Join(errBadPrice, errBadTitle), testFields...)
+
+		violations := FieldViolations(err)
+		assert.Len(t, violations, 2)
+		assert.Contains(t, violations, "start_price")
+		assert.Contains(t, violations, "title")
+	})
+
+	t.Run("one violation per field", func(t *testing.T) {
+		errShort := errors.New("title is too short")
+		fields := append(testFields, Field{Err: errShort, Field: "title"})
+
+		err := InvalidArgument(errors.Join(errBadTitle, errShort), fields...)
+		assert.Len(t, FieldViolations(err), 1)
+	})
+
+	t.Run("no details without a match", func(t *testing.T) {
+		err := InvalidArgument(errors.New("something else"), testFields...)
+
+		assert.Equal(t, connect.CodeInvalidArgument, err.Code())
+		assert.Empty(t, err.Details())
+		assert.Empty(t, FieldViolations(err))
+	})
+}
@@ -4,9 +4,21 @@ set -e
 KEY_DIR=".data/keys"
 PRIVATE_KEY="$KEY_DIR/private.pem"
 PUBLIC_KEY="$KEY_DIR/public.pem"
+BIDDER_LABEL_KEY="$KEY_DIR/bidder_label.key"
+PAGE_TOKEN_KEY="$KEY_DIR/page_token.key"
 
 mkdir -p "$KEY_DIR"
 
+if [ ! -f "$BIDDER_LABEL_KEY" ]; then
+    echo "Generating bidder label key in $KEY_DIR..."
+    openssl rand -hex 32 > "$BIDDER_LABEL_KEY"
+fi
+
+if [ ! -f "$PAGE_TOKEN_KEY" ]; then
+    echo "Generating page token key in $KEY_DIR..."
+    openssl rand -hex 32 > "$PAGE_TOKEN_KEY"
+fi
+
 if [ -f "$PRIVATE_KEY" ] && [ -f "$PUBLIC_KEY" ]; then
     echo "Keys already exist in $KEY_DIR"
     exit 0
@@ -5,35 +5,64 @@ import (
 	"log/slog"
 	"net/http"
 	"os"
+	"syscall"
 	"time"
 
-	"github.com/jackc/pgx/v5/pgxpool"
+	"connectrpc.com/connect"
 	"github.com/joho/godotenv"
-	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/redis/go-redis/v9"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 
 	"github.com/floroz/gavel/pkg/auth"
+	"github.com/floroz/gavel/pkg/clientip"
 	pkgdb "github.com/floroz/gavel/pkg/database"
+	"github.com/floroz/gavel/pkg/dial"
 	pkgevents "github.com/floroz/gavel/pkg/events"
+	"github.com/floroz/gavel/pkg/limits"
+	"github.com/floroz/gavel/pkg/logging"
+	"github.com/floroz/gavel/pkg/maintenance"
 	"github.com/floroz/gavel/pkg/proto/auth/v1/authv1connect"
+	"github.com/floroz/gavel/pkg/proto/bids/v1/bidsv1connect"
+	"github.com/floroz/gavel/pkg/proto/userstats/v1/userstatsv1connect"
+	"github.com/floroz/gavel/pkg/recovery"
+	"github.com/floroz/gavel/pkg/tracing"
 	"github.com/floroz/gavel/services/auth-service/internal/adapters/api"
+	"github.com/floroz/gavel/services/auth-service/internal/adapters/bidclient"
 	"github.com/floroz/gavel/services/auth-service/internal/adapters/database"
+	"github.com/floroz/gavel/services/auth-service/internal/adapters/metrics"
+	"github.com/floroz/gavel/services/auth-service/internal/adapters/ratelimit"
+	"github.com/floroz/gavel/services/auth-service/internal/adapters/statsclient"
 
 	"github.com/floroz/gavel/services/auth-service/internal/domain/users"
 )
 
 func main() {
-	// Initialize structured logger
-	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
-	slog.SetDefault(logger)
-
 	// Load environment variables (local overrides .env)
 	_ = godotenv.Load(".env.local")
 	_ = godotenv.Load()
 
+	// Initialize structured logger (LOG_LEVEL, LOG_FORMAT)
+	logger := logging.NewFromEnv()
+	slog.SetDefault(logger)
+
 	ctx := context.Background()
 
+	// Tracing is a no-op unless an OTLP endpoint is configured
+	shutdownTracing, err := tracing.Setup(ctx, "auth-service")
+	if err != nil {
+		logger.Error("Failed to set up tracing", "error", err)
+		os.Exit(1)
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			logger.Error("Failed to flush traces", "error", err)
+		}
+	}()
+
 	// 1. Load Keys
 	privateKeyPath := os.Getenv("JWT_PRIVATE_KEY_PATH")
 	publicKeyPath := os.Getenv("JWT_PUBLIC_KEY_PATH")
@@ -61,11 +90,15 @@ func main() {
 		os.Exit(1)
 	}
 
-	signer, err := auth.NewSigner(privateKeyPEM, publicKeyPEM, issuer)
+	signer, err := auth.NewSigner(privateKeyPEM, publicKeyPEM, issuer, nil)
 	if err != nil {
 		logger.Error("Failed to create signer", "error", err)
 		os.Exit(1)
 	}
+	if err := signer.SelfTest(); err != nil {
+		logger.Error("JWT key check failed", "error", err)
+		os.Exit(1)
+	}
 
 	// 2. Initialize Postgres Connection Pool
 	dbURL := os.Getenv("AUTH_DB_URL")
@@ -74,13 +107,7 @@ func main() {
 		os.Exit(1)
 	}
 
-	dbConfig, err := pgxpool.ParseConfig(dbURL)
-	if err != nil {
-		logger.Error("Unable to parse database config", "error", err)
-		os.Exit(1)
-	}
-
-	pool, err := pgxpool.NewWithConfig(ctx, dbConfig)
+	pool, err := pkgdb.NewPool(ctx, dbURL)
 	if err != nil {
 		logger.Error("Unable to create connection pool", "error", err)
 		os.Exit(1)
@@ -93,13 +120,19 @@ func main() {
 	}
 	logger.Info("Postgres Connected")
 
+	if migrateErr := pkgdb.MigrateFromEnv(ctx, dbURL, logger); migrateErr != nil {
+		logger.Error("Unable to run database migrations", "error", migrateErr)
+		os.Exit(1)
+	}
+
 	// 3. Initialize RabbitMQ
-	rabbitURL := os.Getenv("RABBITMQ_URL")
-	if rabbitURL == "" {
-		logger.Error("RABBITMQ_URL is not set")
+	// RABBITMQ_URL, optionally RABBITMQ_USERNAME/RABBITMQ_PASSWORD and RABBITMQ_TLS*
+	rabbitCfg, err := dial.RabbitMQFromEnv()
+	if err != nil {
+		logger.Error("Invalid RabbitMQ configuration", "error", err)
 		os.Exit(1)
 	}
-	amqpConn, err := amqp.Dial(rabbitURL)
+	amqpConn, err := rabbitCfg.Dial()
 	if err != nil {
 		logger.Error("Failed to connect to RabbitMQ", "error", err)
 		os.Exit(1)
@@ -116,21 +149,43 @@ func main() {
 
 	// 4. Initialize Repositories
 	txManager := pkgdb.NewPostgresTransactionManager(pool, 5*time.Second)
-	userRepo := database.NewPostgresUserRepository(pool)
-	tokenRepo := database.NewPostgresTokenRepository(pool)
-	outboxRepo := database.NewPostgresOutboxRepository(pool)
+	userRepo := database.NewPostgresUserRepository(pool, pkgdb.DefaultQueryTimeout)
+	tokenRepo := database.NewPostgresTokenRepository(pool, pkgdb.DefaultQueryTimeout)
+	outboxRepo := database.NewPostgresOutboxRepository(pool, pkgdb.DefaultQueryTimeout)
 
-	// 5. Initialize Service
-	authService := users.NewService(userRepo, tokenRepo, outboxRepo, signer, txManager)
+	// 5. Initialize Service (metrics are served on /metrics)
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+	authMetrics := metrics.NewPrometheus(registry)
+	registry.MustRegister(pkgdb.NewPoolCollector(pool))
+
+	passwordPolicy, err := auth.PasswordPolicyFromEnv()
+	if err != nil {
+		logger.Error("Invalid password policy", "error", err)
+		os.Exit(1)
+	}
+	maxPasswordHashes, passwordHashWait, err := auth.PasswordHashLimitFromEnv()
+	if err != nil {
+		logger.Error("Invalid password hash limit", "error", err)
+		os.Exit(1)
+	}
+	auth.SetPasswordHashLimit(maxPasswordHashes, passwordHashWait)
+	// LOGOUT_REQUIRES_ACCESS_TOKEN=true only lets a token's owner revoke it
+	logoutRequiresJWT := os.Getenv("LOGOUT_REQUIRES_ACCESS_TOKEN") == "true"
+	authService := users.NewService(userRepo, tokenRepo, outboxRepo, signer, txManager, passwordPolicy, logoutRequiresJWT, authMetrics)
 
 	// 6. Start Outbox Relay
 	outboxRelay := pkgevents.NewOutboxRelay(
 		outboxRepo,
 		rabbitPublisher,
 		txManager,
-		10,               // batch size
-		5*time.Second,    // interval
-		"auction.events", // exchange
+		10,            // batch size
+		5*time.Second, // interval
+		pkgevents.AuctionEventsExchange,
+		nil, // no transient event types, nothing expires
 		logger,
 	)
 
@@ -142,12 +197,98 @@ func main() {
 		}
 	}()
 
-	// 7. Initialize API Handler (ConnectRPC)
-	authHandler := api.NewAuthServiceHandler(authService)
-	path, connectHandler := authv1connect.NewAuthServiceHandler(authHandler)
+	// 7. Data export across services (Optional: BID_SERVICE_URL and USER_STATS_SERVICE_URL).
+	// Downstream calls carry the requester's own token, so each service enforces self-or-admin too.
+	var exporter *users.Exporter
+	bidURL, statsURL := os.Getenv("BID_SERVICE_URL"), os.Getenv("USER_STATS_SERVICE_URL")
+	if bidURL != "" && statsURL != "" {
+		httpClient := &http.Client{Timeout: 10 * time.Second}
+		forwardToken := connect.WithInterceptors(auth.NewForwardTokenInterceptor())
+		exporter = users.NewExporter(
+			userRepo,
+			bidclient.NewBidHistory(bidsv1connect.NewBidServiceClient(httpClient, bidURL, forwardToken)),
+			statsclient.NewStatsSource(userstatsv1connect.NewUserStatsServiceClient(httpClient, statsURL, forwardToken)),
+		)
+	} else {
+		logger.Warn("BID_SERVICE_URL or USER_STATS_SERVICE_URL is not set, data export disabled")
+	}
+
+	// 8. Initialize API Handler (ConnectRPC)
+	authHandler := api.NewAuthServiceHandler(authService, exporter)
+
+	// These RPCs are public; the interceptor only attaches claims when a valid access token is sent.
+	// GetDisplayNames is not: it would let anyone turn the user ids behind bidder pseudonyms into names.
+	publicRoutes := map[string]bool{
+		authv1connect.AuthServiceRegisterProcedure:   true,
+		authv1connect.AuthServiceLoginProcedure:      true,
+		authv1connect.AuthServiceRefreshProcedure:    true,
+		authv1connect.AuthServiceLogoutProcedure:     true,
+		authv1connect.AuthServiceGetProfileProcedure: true,
+	}
+	// MAX_REQUEST_BYTES caps request messages; oversized ones get CodeResourceExhausted
+	maxRequestBytes, err := limits.MaxRequestBytesFromEnv()
+	if err != nil {
+		logger.Error("Invalid request size limit", "error", err)
+		os.Exit(1)
+	}
+	// TRUSTED_PROXY_COUNT proxies append to X-Forwarded-For; the client IP recorded on
+	// sessions and used for throttling is resolved from it rather than taken from the body
+	trustedProxies, err := clientip.TrustedProxiesFromEnv()
+	if err != nil {
+		logger.Error("Invalid trusted proxy count", "error", err)
+		os.Exit(1)
+	}
+	// MAINTENANCE_MODE rejects writes with CodeUnavailable; SIGUSR1 toggles it at runtime
+	maintenanceMode, err := maintenance.FromEnv()
+	if err != nil {
+		logger.Error("Invalid maintenance mode", "error", err)
+		os.Exit(1)
+	}
+	maintenanceMode.ToggleOnSignal(ctx, logger, syscall.SIGUSR1)
+	if maintenanceMode.Enabled() {
+		logger.Warn("Starting in maintenance mode, writes are rejected")
+	}
+	authInterceptor := auth.NewAuthInterceptorWithPublicRoutes(signer, publicRoutes)
+	interceptors := []connect.Interceptor{
+		recovery.NewInterceptor(logger),
+		tracing.NewInterceptor(),
+		maintenance.NewInterceptor(maintenanceMode),
+		clientip.NewInterceptor(trustedProxies),
+		authInterceptor,
+	}
+
+	// Register throttling per client IP (Optional: REDIS_URL; REGISTER_RATE_LIMIT_BURST, REGISTER_RATE_LIMIT_INTERVAL)
+	redisOpts, err := dial.RedisOptionsFromEnv()
+	if err != nil {
+		logger.Error("Invalid Redis configuration", "error", err)
+		os.Exit(1)
+	}
+	if redisOpts != nil {
+		rateCfg, cfgErr := ratelimit.RegisterConfigFromEnv()
+		if cfgErr != nil {
+			logger.Error("Invalid register rate limit", "error", cfgErr)
+			os.Exit(1)
+		}
+		rdb := redis.NewClient(redisOpts)
+		defer rdb.Close()
+		limiter := ratelimit.NewRedisLimiter(rdb, "register", rateCfg, nil)
+		interceptors = append(interceptors, ratelimit.NewInterceptor(
+			limiter,
+			map[string]bool{authv1connect.AuthServiceRegisterProcedure: true},
+			ratelimit.ClientIP,
+		))
+	} else {
+		logger.Warn("REDIS_URL is not set, Register is not rate limited")
+	}
+
+	path, connectHandler := authv1connect.NewAuthServiceHandler(
+		authHandler,
+		connect.WithInterceptors(interceptors...),
+		connect.WithReadMaxBytes(maxRequestBytes),
+	)
 
 	mux := http.NewServeMux()
-	mux.Handle(path, connectHandler)
+	mux.Handle(path, limits.MaxBytesHandler(connectHandler, maxRequestBytes))
 
 	// Health check
 	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
@@ -155,6 +296,8 @@ func main() {
 		_, _ = w.Write([]byte("OK"))
 	})
 
+	mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+
 	// Expose Public Key (JWKS)
 	// For simplicity, we just serve the PEM file content for now on a specific endpoint
 	// In a real system, we'd serve a standard JWKS JSON.
@@ -0,0 +1,83 @@
+// Command keygen generates the RSA keypair the auth service signs JWTs with.
+//
+// Usage:
+//
+//	go run ./services/auth-service/cmd/keygen -out .data/keys
+//
+// It writes private.pem (0600) and public.pem (0644) to the output directory and
+// prints the key ID. Point JWT_PRIVATE_KEY_PATH and JWT_PUBLIC_KEY_PATH at the files.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/floroz/gavel/pkg/auth"
+)
+
+func main() {
+	outDir := flag.String("out", ".data/keys", "directory to write private.pem and public.pem to")
+	bits := flag.Int("bits", 2048, "RSA key size in bits")
+	force := flag.Bool("force", false, "overwrite existing key files")
+	flag.Parse()
+
+	if err := run(*outDir, *bits, *force); err != nil {
+		fmt.Fprintf(os.Stderr, "keygen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(outDir string, bits int, force bool) error {
+	privatePath := filepath.Join(outDir, "private.pem")
+	publicPath := filepath.Join(outDir, "public.pem")
+
+	if !force {
+		for _, path := range []string{privatePath, publicPath} {
+			if _, err := os.Stat(path); err == nil {
+				return fmt.Errorf("%s already exists (use -force to overwrite)", path)
+			} else if !errors.Is(err, fs.ErrNotExist) {
+				return err
+			}
+		}
+	}
+
+	privPEM, pubPEM, err := auth.GenerateKeyPair(bits)
+	if err != nil {
+		return err
+	}
+	kid, err := auth.KeyID(pubPEM)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(outDir, 0o700); err != nil {
+		return fmt.Errorf("failed to create %s: %w", outDir, err)
+	}
+	if err := writeFile(privatePath, privPEM, 0o600); err != nil {
+		return err
+	}
+	if err := writeFile(publicPath, pubPEM, 0o644); err != nil {
+		return err
+	}
+
+	fmt.Printf("private key: %s\n", privatePath)
+	fmt.Printf("public key:  %s\n", publicPath)
+	fmt.Printf("kid:         %s\n", kid)
+	return nil
+}
+
+// writeFile writes data with perm, tightening the mode of an existing file too
+// (os.WriteFile only applies perm when it creates the file)
+func writeFile(path string, data []byte, perm os.FileMode) error {
+	if err := os.WriteFile(path, data, perm); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	if err := os.Chmod(path, perm); err != nil {
+		return fmt.Errorf("failed to set permissions on %s: %w", path, err)
+	}
+	return nil
+}
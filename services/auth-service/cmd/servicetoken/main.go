@@ -0,0 +1,106 @@
+// Command servicetoken mints a service-to-service token with the auth service's signing key.
+//
+// Usage:
+//
+//	go run ./services/auth-service/cmd/servicetoken -service bid-service -ttl 1h -out /var/run/gavel/service-token
+//
+// It reads the same JWT_PRIVATE_KEY_PATH, JWT_PUBLIC_KEY_PATH and JWT_ISSUER as the auth service.
+// Run it on a schedule shorter than -ttl and point the calling service's SERVICE_TOKEN_PATH at
+// the output file; the file is replaced atomically, so readers never see a partial token.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/floroz/gavel/pkg/auth"
+)
+
+func main() {
+	service := flag.String("service", "", "name of the calling service (required)")
+	permissions := flag.String("permissions", "", "comma-separated permissions to grant")
+	ttl := flag.Duration("ttl", auth.DefaultServiceTokenTTL, "token lifetime")
+	out := flag.String("out", "", "file to write the token to (default stdout)")
+	flag.Parse()
+
+	if err := run(*service, *permissions, *ttl, *out); err != nil {
+		fmt.Fprintf(os.Stderr, "servicetoken: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(service, permissions string, ttl time.Duration, out string) error {
+	if service == "" {
+		return errors.New("-service is required")
+	}
+
+	privateKeyPEM, err := os.ReadFile(os.Getenv("JWT_PRIVATE_KEY_PATH"))
+	if err != nil {
+		return fmt.Errorf("failed to read JWT_PRIVATE_KEY_PATH: %w", err)
+	}
+	publicKeyPEM, err := os.ReadFile(os.Getenv("JWT_PUBLIC_KEY_PATH"))
+	if err != nil {
+		return fmt.Errorf("failed to read JWT_PUBLIC_KEY_PATH: %w", err)
+	}
+	issuer := os.Getenv("JWT_ISSUER")
+	if issuer == "" {
+		return errors.New("JWT_ISSUER is not set")
+	}
+
+	signer, err := auth.NewSigner(privateKeyPEM, publicKeyPEM, issuer, nil)
+	if err != nil {
+		return err
+	}
+
+	var perms []string
+	for p := range strings.SplitSeq(permissions, ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			perms = append(perms, p)
+		}
+	}
+
+	token, expiry, err := signer.GenerateServiceToken(service, perms, ttl)
+	if err != nil {
+		return err
+	}
+
+	if out == "" {
+		fmt.Println(token)
+		return nil
+	}
+	if err := writeAtomic(out, []byte(token+"\n")); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "wrote token for %s to %s, expires %s\n", service, out, expiry.Format(time.RFC3339))
+	return nil
+}
+
+// writeAtomic writes data to a temporary file next to path and renames it into place
+func writeAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".servicetoken-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := tmp.Chmod(0o600); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to set permissions: %w", err)
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write token: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write token: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to move token into place: %w", err)
+	}
+	return nil
+}
@@ -2,47 +2,79 @@ package api
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"time"
 
 	"connectrpc.com/connect"
 	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/floroz/gavel/pkg/auth"
+	"github.com/floroz/gavel/pkg/clientip"
 	authv1 "github.com/floroz/gavel/pkg/proto/auth/v1"
 	"github.com/floroz/gavel/pkg/proto/auth/v1/authv1connect"
+	"github.com/floroz/gavel/pkg/validation"
 	"github.com/floroz/gavel/services/auth-service/internal/domain/users"
 )
 
 type AuthServiceHandler struct {
 	authv1connect.UnimplementedAuthServiceHandler
-	service users.AuthService
+	service  users.AuthService
+	exporter *users.Exporter
 }
 
-func NewAuthServiceHandler(service users.AuthService) *AuthServiceHandler {
+// NewAuthServiceHandler creates the handler.
+// exporter may be nil, in which case ExportUserData is unimplemented.
+func NewAuthServiceHandler(service users.AuthService, exporter *users.Exporter) *AuthServiceHandler {
 	return &AuthServiceHandler{
-		service: service,
+		service:  service,
+		exporter: exporter,
 	}
 }
 
+// registerFields maps Register validation failures to the request field at fault
+var registerFields = []validation.Field{
+	{Err: users.ErrInvalidEmail, Field: "email"},
+	{Err: auth.ErrPasswordTooShort, Field: "password"},
+	{Err: auth.ErrPasswordNeedsMixedCase, Field: "password"},
+	{Err: auth.ErrPasswordNeedsDigit, Field: "password"},
+	{Err: auth.ErrPasswordNeedsSymbol, Field: "password"},
+	{Err: auth.ErrPasswordTooCommon, Field: "password"},
+	{Err: users.ErrEmptyFullName, Field: "full_name"},
+	{Err: users.ErrInvalidPhone, Field: "phone_number"},
+	{Err: users.ErrInvalidCountryCode, Field: "country_code"},
+	{Err: users.ErrInvalidLocale, Field: "locale"},
+}
+
 func (h *AuthServiceHandler) Register(
 	ctx context.Context,
 	req *connect.Request[authv1.RegisterRequest],
 ) (*connect.Response[authv1.RegisterResponse], error) {
+	// An explicit country_code wins; otherwise the locale's region stands in for it
+	countryCode, err := users.ResolveCountryCode(req.Msg.CountryCode, req.Msg.Locale)
+	if err != nil {
+		return nil, validation.InvalidArgument(err, registerFields...)
+	}
+
 	user, err := h.service.Register(
 		ctx,
 		req.Msg.Email,
 		req.Msg.Password,
 		req.Msg.FullName,
 		req.Msg.PhoneNumber,
-		req.Msg.CountryCode,
+		countryCode,
 	)
 	if err != nil {
 		if errors.Is(err, users.ErrUserAlreadyExists) {
 			return nil, connect.NewError(connect.CodeAlreadyExists, err)
 		}
 		if errors.Is(err, users.ErrInvalidInput) {
-			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+			return nil, validation.InvalidArgument(err, registerFields...)
+		}
+		if errors.Is(err, auth.ErrPasswordHashBusy) {
+			return nil, connect.NewError(connect.CodeUnavailable, err)
 		}
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
@@ -56,9 +88,8 @@ func (h *AuthServiceHandler) Login(
 	ctx context.Context,
 	req *connect.Request[authv1.LoginRequest],
 ) (*connect.Response[authv1.LoginResponse], error) {
-	// Extract IP and User Agent if not provided in body (though proto has fields for them)
-	// Ideally, the gateway or client populates these.
-	ip := req.Msg.IpAddress
+	// The session records the IP resolved by the clientip interceptor; the body's is spoofable
+	ip := clientip.FromContext(ctx)
 	ua := req.Msg.UserAgent
 
 	accessToken, refreshToken, err := h.service.Login(ctx, req.Msg.Email, req.Msg.Password, ua, ip)
@@ -66,6 +97,9 @@ func (h *AuthServiceHandler) Login(
 		if errors.Is(err, users.ErrInvalidCredentials) {
 			return nil, connect.NewError(connect.CodeUnauthenticated, err)
 		}
+		if errors.Is(err, auth.ErrPasswordHashBusy) {
+			return nil, connect.NewError(connect.CodeUnavailable, err)
+		}
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
@@ -84,7 +118,7 @@ func (h *AuthServiceHandler) Refresh(
 	ctx context.Context,
 	req *connect.Request[authv1.RefreshRequest],
 ) (*connect.Response[authv1.RefreshResponse], error) {
-	accessToken, refreshToken, err := h.service.Refresh(ctx, req.Msg.RefreshToken, req.Msg.UserAgent, req.Msg.IpAddress)
+	accessToken, refreshToken, err := h.service.Refresh(ctx, req.Msg.RefreshToken, req.Msg.UserAgent, clientip.FromContext(ctx))
 	if err != nil {
 		if errors.Is(err, users.ErrInvalidToken) || errors.Is(err, users.ErrUserNotFound) {
 			return nil, connect.NewError(connect.CodeUnauthenticated, err)
@@ -103,10 +137,24 @@ func (h *AuthServiceHandler) Logout(
 	ctx context.Context,
 	req *connect.Request[authv1.LogoutRequest],
 ) (*connect.Response[authv1.LogoutResponse], error) {
-	err := h.service.Logout(ctx, req.Msg.RefreshToken)
+	// Logout is a public route; an access token, when sent, scopes it to its owner
+	callerID := uuid.Nil
+	if sub, ok := auth.GetUserID(ctx); ok {
+		parsed, err := uuid.Parse(sub)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("invalid user id in token"))
+		}
+		callerID = parsed
+	}
+
+	err := h.service.Logout(ctx, req.Msg.RefreshToken, callerID)
 	if err != nil {
-		// Even if error (e.g. not found), we usually return OK for logout to not leak info
-		// But logging it is good.
+		switch {
+		case errors.Is(err, users.ErrLogoutUnauthorized):
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		case errors.Is(err, users.ErrTokenNotOwned):
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		}
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 	return connect.NewResponse(&authv1.LogoutResponse{}), nil
@@ -146,3 +194,156 @@ func (h *AuthServiceHandler) GetProfile(
 		CreatedAt:   timestamppb.New(user.CreatedAt),
 	}), nil
 }
+
+// GetDisplayNames resolves user ids to display names for other services. It requires a
+// service token or the "admin" permission, since the ids behind bidder pseudonyms must not
+// be mapped back to names by just anyone.
+func (h *AuthServiceHandler) GetDisplayNames(
+	ctx context.Context,
+	req *connect.Request[authv1.GetDisplayNamesRequest],
+) (*connect.Response[authv1.GetDisplayNamesResponse], error) {
+	if !auth.IsServiceCaller(ctx) {
+		if err := auth.RequirePermission(ctx, auth.PermissionAdmin); err != nil {
+			return nil, err
+		}
+	}
+
+	userIDs := make([]uuid.UUID, len(req.Msg.UserIds))
+	for i, raw := range req.Msg.UserIds {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid user_id %q", raw))
+		}
+		userIDs[i] = id
+	}
+
+	names, err := h.service.GetDisplayNames(ctx, userIDs)
+	if err != nil {
+		if errors.Is(err, users.ErrInvalidInput) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	res := &authv1.GetDisplayNamesResponse{DisplayNames: make(map[string]string, len(names))}
+	for id, name := range names {
+		res.DisplayNames[id.String()] = name
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (h *AuthServiceHandler) AdminGetUser(
+	ctx context.Context,
+	req *connect.Request[authv1.AdminGetUserRequest],
+) (*connect.Response[authv1.AdminGetUserResponse], error) {
+	if err := auth.RequirePermission(ctx, auth.PermissionAdmin); err != nil {
+		return nil, err
+	}
+
+	var user *users.User
+	var err error
+	switch lookup := req.Msg.Lookup.(type) {
+	case *authv1.AdminGetUserRequest_UserId:
+		userID, parseErr := uuid.Parse(lookup.UserId)
+		if parseErr != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid user_id"))
+		}
+		user, err = h.service.GetProfile(ctx, userID)
+	case *authv1.AdminGetUserRequest_Email:
+		if lookup.Email == "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("email cannot be empty"))
+		}
+		user, err = h.service.GetUserByEmail(ctx, lookup.Email)
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("user_id or email is required"))
+	}
+	if err != nil {
+		if errors.Is(err, users.ErrUserNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// Only non-sensitive fields are mapped; the password hash never leaves the domain
+	return connect.NewResponse(&authv1.AdminGetUserResponse{
+		Id:          user.ID.String(),
+		Email:       user.Email,
+		FullName:    user.FullName,
+		AvatarUrl:   user.AvatarURL,
+		CountryCode: user.CountryCode,
+		PhoneNumber: user.PhoneNumber,
+		CreatedAt:   timestamppb.New(user.CreatedAt),
+		UpdatedAt:   timestamppb.New(user.UpdatedAt),
+	}), nil
+}
+
+// ExportUserData returns a JSON document of everything held about a user
+func (h *AuthServiceHandler) ExportUserData(
+	ctx context.Context,
+	req *connect.Request[authv1.ExportUserDataRequest],
+) (*connect.Response[authv1.ExportUserDataResponse], error) {
+	if h.exporter == nil {
+		return nil, connect.NewError(connect.CodeUnimplemented, errors.New("data export is not configured"))
+	}
+
+	userID, err := selfOrAdminTarget(ctx, req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	export, err := h.exporter.Export(ctx, userID)
+	if err != nil {
+		if errors.Is(err, users.ErrUserNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	doc, err := json.Marshal(export)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to encode export: %w", err))
+	}
+
+	return connect.NewResponse(&authv1.ExportUserDataResponse{Document: doc}), nil
+}
+
+// DeleteAccount deletes a user; the other services erase their data from the user.deleted event
+func (h *AuthServiceHandler) DeleteAccount(
+	ctx context.Context,
+	req *connect.Request[authv1.DeleteAccountRequest],
+) (*connect.Response[authv1.DeleteAccountResponse], error) {
+	userID, err := selfOrAdminTarget(ctx, req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.service.DeleteAccount(ctx, userID); err != nil {
+		if errors.Is(err, users.ErrUserNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&authv1.DeleteAccountResponse{}), nil
+}
+
+// selfOrAdminTarget resolves the user a request acts on: requested, or the caller when empty.
+// Acting on anyone but the caller requires the admin permission.
+func selfOrAdminTarget(ctx context.Context, requested string) (uuid.UUID, error) {
+	callerID, err := uuid.Parse(auth.MustGetUserID(ctx))
+	if err != nil {
+		return uuid.Nil, connect.NewError(connect.CodeInternal, errors.New("invalid user_id in token"))
+	}
+	if requested == "" {
+		return callerID, nil
+	}
+	userID, err := uuid.Parse(requested)
+	if err != nil {
+		return uuid.Nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid user_id"))
+	}
+	if userID != callerID {
+		if err := auth.RequirePermission(ctx, auth.PermissionAdmin); err != nil {
+			return uuid.Nil, err
+		}
+	}
+	return userID, nil
+}
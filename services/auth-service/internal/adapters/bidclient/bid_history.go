@@ -0,0 +1,76 @@
+package bidclient
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	bidsv1 "github.com/floroz/gavel/pkg/proto/bids/v1"
+	"github.com/floroz/gavel/pkg/proto/bids/v1/bidsv1connect"
+	"github.com/floroz/gavel/services/auth-service/internal/domain/users"
+)
+
+// pageSize is the largest page ListUserBids serves
+const pageSize = 200
+
+// BidHistory implements users.BidHistory with the bid service's ListUserBids RPC.
+// The client must forward the caller's token (auth.NewForwardTokenInterceptor) so the
+// bid service can check the caller may read userID's bids.
+type BidHistory struct {
+	client bidsv1connect.BidServiceClient
+}
+
+var _ users.BidHistory = (*BidHistory)(nil)
+
+// NewBidHistory creates a bid history backed by client
+func NewBidHistory(client bidsv1connect.BidServiceClient) *BidHistory {
+	return &BidHistory{client: client}
+}
+
+// ListUserBids pages through every bid userID placed
+func (h *BidHistory) ListUserBids(ctx context.Context, userID uuid.UUID) ([]users.ExportedBid, error) {
+	var out []users.ExportedBid
+	token := ""
+	for {
+		res, err := h.client.ListUserBids(ctx, connect.NewRequest(&bidsv1.ListUserBidsRequest{
+			UserId:    userID.String(),
+			PageSize:  pageSize,
+			PageToken: token,
+		}))
+		if err != nil {
+			return nil, fmt.Errorf("failed to list user bids: %w", err)
+		}
+
+		for _, b := range res.Msg.Bids {
+			bid, err := exportedBid(b)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, bid)
+		}
+
+		token = res.Msg.NextPageToken
+		if token == "" {
+			return out, nil
+		}
+	}
+}
+
+func exportedBid(b *bidsv1.Bid) (users.ExportedBid, error) {
+	id, err := uuid.Parse(b.Id)
+	if err != nil {
+		return users.ExportedBid{}, fmt.Errorf("bid service returned invalid bid id %q", b.Id)
+	}
+	itemID, err := uuid.Parse(b.ItemId)
+	if err != nil {
+		return users.ExportedBid{}, fmt.Errorf("bid service returned invalid item_id %q", b.ItemId)
+	}
+	createdAt, err := time.Parse(time.RFC3339, b.CreatedAt)
+	if err != nil {
+		return users.ExportedBid{}, fmt.Errorf("bid service returned invalid created_at %q", b.CreatedAt)
+	}
+	return users.ExportedBid{ID: id, ItemID: itemID, Amount: b.Amount, CreatedAt: createdAt}, nil
+}
@@ -10,19 +10,25 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	pkgevents "github.com/floroz/gavel/pkg/events"
+
+	pkgdb "github.com/floroz/gavel/pkg/database"
 )
 
 // PostgresOutboxRepository implements pkgevents.OutboxRepository
 type PostgresOutboxRepository struct {
-	pool *pgxpool.Pool
+	pool         *pgxpool.Pool
+	queryTimeout time.Duration
 }
 
-func NewPostgresOutboxRepository(pool *pgxpool.Pool) *PostgresOutboxRepository {
-	return &PostgresOutboxRepository{pool: pool}
+func NewPostgresOutboxRepository(pool *pgxpool.Pool, queryTimeout time.Duration) *PostgresOutboxRepository {
+	return &PostgresOutboxRepository{pool: pool, queryTimeout: queryTimeout}
 }
 
 // CreateEvent persists an event to the outbox table in the same transaction as the business logic
 func (r *PostgresOutboxRepository) CreateEvent(ctx context.Context, tx pgx.Tx, event *pkgevents.OutboxEvent) error {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
 	query := `
 		INSERT INTO outbox_events (id, event_type, payload, status, created_at)
 		VALUES ($1, $2, $3, $4::outbox_status, $5)
@@ -41,6 +47,9 @@ func (r *PostgresOutboxRepository) CreateEvent(ctx context.Context, tx pgx.Tx, e
 }
 
 func (r *PostgresOutboxRepository) GetPendingEvents(ctx context.Context, tx pgx.Tx, limit int) ([]*pkgevents.OutboxEvent, error) {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
 	query := `
 		SELECT id, event_type, payload, status, created_at, processed_at
 		FROM outbox_events
@@ -74,6 +83,9 @@ func (r *PostgresOutboxRepository) GetPendingEvents(ctx context.Context, tx pgx.
 }
 
 func (r *PostgresOutboxRepository) UpdateEventStatus(ctx context.Context, tx pgx.Tx, id uuid.UUID, status pkgevents.OutboxStatus) error {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
 	query := `
 		UPDATE outbox_events
 		SET status = $1::outbox_status, processed_at = $2
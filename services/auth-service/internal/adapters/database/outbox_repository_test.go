@@ -1,3 +1,5 @@
+//go:build integration
+
 package database_test
 
 import (
@@ -9,23 +11,20 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	pkgdb "github.com/floroz/gavel/pkg/database"
 	"github.com/floroz/gavel/pkg/events"
 	"github.com/floroz/gavel/pkg/testhelpers"
 	"github.com/floroz/gavel/services/auth-service/internal/adapters/database"
 )
 
 func TestOutboxRepository_Integration(t *testing.T) {
-	if testing.Short() {
-		t.Skip("skipping integration test in short mode")
-	}
-
 	// 1. Setup Database
 	// Path to migrations relative to this file
 	migrationsPath := "../../../migrations"
 	td := testhelpers.NewTestDatabase(t, migrationsPath)
 	defer td.Close()
 
-	repo := database.NewPostgresOutboxRepository(td.Pool)
+	repo := database.NewPostgresOutboxRepository(td.Pool, pkgdb.DefaultQueryTimeout)
 	ctx := context.Background()
 
 	t.Run("CreateEvent_Success", func(t *testing.T) {
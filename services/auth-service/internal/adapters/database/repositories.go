@@ -4,24 +4,30 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 
+	pkgdb "github.com/floroz/gavel/pkg/database"
 	"github.com/floroz/gavel/services/auth-service/internal/domain/users"
 )
 
 // PostgresUserRepository implements users.UserRepository
 type PostgresUserRepository struct {
-	pool *pgxpool.Pool
+	pool         *pgxpool.Pool
+	queryTimeout time.Duration
 }
 
-func NewPostgresUserRepository(pool *pgxpool.Pool) *PostgresUserRepository {
-	return &PostgresUserRepository{pool: pool}
+func NewPostgresUserRepository(pool *pgxpool.Pool, queryTimeout time.Duration) *PostgresUserRepository {
+	return &PostgresUserRepository{pool: pool, queryTimeout: queryTimeout}
 }
 
 func (r *PostgresUserRepository) CreateUser(ctx context.Context, tx pgx.Tx, user *users.User) error {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
 	query := `
 		INSERT INTO users (id, email, password_hash, full_name, avatar_url, phone_number, country_code, created_at, updated_at)
 		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
@@ -38,12 +44,18 @@ func (r *PostgresUserRepository) CreateUser(ctx context.Context, tx pgx.Tx, user
 		user.UpdatedAt,
 	)
 	if err != nil {
+		if pkgdb.IsUniqueViolation(err) {
+			return users.ErrUserAlreadyExists
+		}
 		return fmt.Errorf("failed to create user: %w", err)
 	}
 	return nil
 }
 
 func (r *PostgresUserRepository) GetUserByID(ctx context.Context, id uuid.UUID) (*users.User, error) {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
 	query := `
 		SELECT id, email, password_hash, full_name, avatar_url, phone_number, country_code, created_at, updated_at
 		FROM users
@@ -70,7 +82,50 @@ func (r *PostgresUserRepository) GetUserByID(ctx context.Context, id uuid.UUID)
 	return &user, nil
 }
 
+// GetUsersByIDs returns the users that exist among ids, in no particular order
+func (r *PostgresUserRepository) GetUsersByIDs(ctx context.Context, ids []uuid.UUID) ([]*users.User, error) {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	query := `
+		SELECT id, email, password_hash, full_name, avatar_url, phone_number, country_code, created_at, updated_at
+		FROM users
+		WHERE id = ANY($1)
+	`
+	rows, err := r.pool.Query(ctx, query, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users by ids: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*users.User
+	for rows.Next() {
+		var user users.User
+		if err := rows.Scan(
+			&user.ID,
+			&user.Email,
+			&user.PasswordHash,
+			&user.FullName,
+			&user.AvatarURL,
+			&user.PhoneNumber,
+			&user.CountryCode,
+			&user.CreatedAt,
+			&user.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan user: %w", err)
+		}
+		result = append(result, &user)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get users by ids: %w", err)
+	}
+	return result, nil
+}
+
 func (r *PostgresUserRepository) GetUserByEmail(ctx context.Context, email string) (*users.User, error) {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
 	query := `
 		SELECT id, email, password_hash, full_name, avatar_url, phone_number, country_code, created_at, updated_at
 		FROM users
@@ -97,16 +152,44 @@ func (r *PostgresUserRepository) GetUserByEmail(ctx context.Context, email strin
 	return &user, nil
 }
 
+func (r *PostgresUserRepository) UpdatePasswordHash(ctx context.Context, id uuid.UUID, oldHash, newHash string) (bool, error) {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	query := `UPDATE users SET password_hash = $3 WHERE id = $1 AND password_hash = $2`
+	tag, err := r.pool.Exec(ctx, query, id, oldHash, newHash)
+	if err != nil {
+		return false, fmt.Errorf("failed to update password hash: %w", err)
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
+// DeleteUser deletes the user row; refresh_tokens rows go with it via ON DELETE CASCADE
+func (r *PostgresUserRepository) DeleteUser(ctx context.Context, tx pgx.Tx, id uuid.UUID) (bool, error) {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	tag, err := tx.Exec(ctx, `DELETE FROM users WHERE id = $1`, id)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete user: %w", err)
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
 // PostgresTokenRepository implements users.TokenRepository
 type PostgresTokenRepository struct {
-	pool *pgxpool.Pool
+	pool         *pgxpool.Pool
+	queryTimeout time.Duration
 }
 
-func NewPostgresTokenRepository(pool *pgxpool.Pool) *PostgresTokenRepository {
-	return &PostgresTokenRepository{pool: pool}
+func NewPostgresTokenRepository(pool *pgxpool.Pool, queryTimeout time.Duration) *PostgresTokenRepository {
+	return &PostgresTokenRepository{pool: pool, queryTimeout: queryTimeout}
 }
 
 func (r *PostgresTokenRepository) CreateRefreshToken(ctx context.Context, tx pgx.Tx, token *users.RefreshToken) error {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
 	query := `
 		INSERT INTO refresh_tokens (token_hash, user_id, expires_at, revoked, created_at, user_agent, ip_address)
 		VALUES ($1, $2, $3, $4, $5, $6, $7)
@@ -127,6 +210,9 @@ func (r *PostgresTokenRepository) CreateRefreshToken(ctx context.Context, tx pgx
 }
 
 func (r *PostgresTokenRepository) GetRefreshToken(ctx context.Context, tokenHash []byte) (*users.RefreshToken, error) {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
 	query := `
 		SELECT token_hash, user_id, expires_at, revoked, created_at, user_agent, ip_address
 		FROM refresh_tokens
@@ -151,16 +237,22 @@ func (r *PostgresTokenRepository) GetRefreshToken(ctx context.Context, tokenHash
 	return &token, nil
 }
 
-func (r *PostgresTokenRepository) RevokeRefreshToken(ctx context.Context, tx pgx.Tx, tokenHash []byte) error {
-	query := `UPDATE refresh_tokens SET revoked = true WHERE token_hash = $1`
-	_, err := tx.Exec(ctx, query, tokenHash)
+func (r *PostgresTokenRepository) RevokeRefreshToken(ctx context.Context, tx pgx.Tx, tokenHash []byte, userID uuid.UUID) (bool, error) {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	query := `UPDATE refresh_tokens SET revoked = true WHERE token_hash = $1 AND user_id = $2 AND revoked = false`
+	tag, err := tx.Exec(ctx, query, tokenHash, userID)
 	if err != nil {
-		return fmt.Errorf("failed to revoke refresh token: %w", err)
+		return false, fmt.Errorf("failed to revoke refresh token: %w", err)
 	}
-	return nil
+	return tag.RowsAffected() == 1, nil
 }
 
 func (r *PostgresTokenRepository) RevokeAllUserTokens(ctx context.Context, tx pgx.Tx, userID uuid.UUID) error {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
 	query := `UPDATE refresh_tokens SET revoked = true WHERE user_id = $1`
 	_, err := tx.Exec(ctx, query, userID)
 	if err != nil {
@@ -0,0 +1,68 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Result labels for logins
+const (
+	resultSuccess = "success"
+	resultFailure = "failure"
+)
+
+// Prometheus implements users.Metrics with Prometheus collectors
+type Prometheus struct {
+	logins              *prometheus.CounterVec
+	registrations       prometheus.Counter
+	refreshes           prometheus.Counter
+	refreshReuses       prometheus.Counter
+	passwordHashSeconds prometheus.Histogram
+}
+
+// NewPrometheus creates the auth metrics and registers them with the given registerer
+func NewPrometheus(reg prometheus.Registerer) *Prometheus {
+	m := &Prometheus{
+		logins: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "auth",
+			Name:      "logins_total",
+			Help:      "Number of login attempts, by result (success or failure). Failures are wrong email or password.",
+		}, []string{"result"}),
+		registrations: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "auth",
+			Name:      "registrations_total",
+			Help:      "Number of users registered.",
+		}),
+		refreshes: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "auth",
+			Name:      "token_refreshes_total",
+			Help:      "Number of refresh tokens successfully rotated.",
+		}),
+		refreshReuses: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "auth",
+			Name:      "refresh_token_reuse_total",
+			Help:      "Number of already rotated refresh tokens presented again; each one revokes all of the owner's sessions.",
+		}),
+		// argon2id is tuned to take tens of milliseconds, so the buckets focus there
+		passwordHashSeconds: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: "auth",
+			Name:      "password_hash_duration_seconds",
+			Help:      "Time spent hashing a new password.",
+			Buckets:   []float64{.005, .01, .025, .05, .075, .1, .15, .25, .5, 1},
+		}),
+	}
+
+	reg.MustRegister(m.logins, m.registrations, m.refreshes, m.refreshReuses, m.passwordHashSeconds)
+	return m
+}
+
+func (m *Prometheus) LoginSucceeded()     { m.logins.WithLabelValues(resultSuccess).Inc() }
+func (m *Prometheus) LoginFailed()        { m.logins.WithLabelValues(resultFailure).Inc() }
+func (m *Prometheus) Registered()         { m.registrations.Inc() }
+func (m *Prometheus) TokenRefreshed()     { m.refreshes.Inc() }
+func (m *Prometheus) RefreshTokenReused() { m.refreshReuses.Inc() }
+
+func (m *Prometheus) ObservePasswordHash(d time.Duration) {
+	m.passwordHashSeconds.Observe(d.Seconds())
+}
@@ -0,0 +1,38 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/floroz/gavel/services/auth-service/internal/domain/users"
+)
+
+var _ users.Metrics = (*Prometheus)(nil)
+
+func TestPrometheus(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	m := NewPrometheus(reg)
+
+	m.LoginSucceeded()
+	m.LoginFailed()
+	m.LoginFailed()
+	m.Registered()
+	m.TokenRefreshed()
+	m.RefreshTokenReused()
+	m.ObservePasswordHash(40 * time.Millisecond)
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(m.logins.WithLabelValues(resultSuccess)))
+	assert.Equal(t, float64(2), testutil.ToFloat64(m.logins.WithLabelValues(resultFailure)))
+	assert.Equal(t, float64(1), testutil.ToFloat64(m.registrations))
+	assert.Equal(t, float64(1), testutil.ToFloat64(m.refreshes))
+	assert.Equal(t, float64(1), testutil.ToFloat64(m.refreshReuses))
+
+	count, err := testutil.GatherAndCount(reg, "auth_password_hash_duration_seconds")
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
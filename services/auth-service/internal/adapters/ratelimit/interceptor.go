@@ -0,0 +1,52 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"connectrpc.com/connect"
+
+	"github.com/floroz/gavel/pkg/clientip"
+)
+
+// ErrRateLimited is returned, as CodeResourceExhausted, to throttled callers
+var ErrRateLimited = errors.New("too many requests, try again later")
+
+// Limiter decides whether the caller identified by key may proceed
+type Limiter interface {
+	Allow(ctx context.Context, key string) (bool, error)
+}
+
+// KeyFunc identifies the caller a request is counted against
+type KeyFunc func(ctx context.Context, req connect.AnyRequest) string
+
+// ClientIP keys requests by the client IP resolved by clientip.NewInterceptor, which
+// must run earlier in the chain
+func ClientIP(ctx context.Context, _ connect.AnyRequest) string {
+	return clientip.FromContext(ctx)
+}
+
+// NewInterceptor throttles the given procedures per key with CodeResourceExhausted.
+// If the limiter itself fails, requests are let through: an outage of the limiter
+// backend must not take the procedures down with it.
+func NewInterceptor(limiter Limiter, procedures map[string]bool, key KeyFunc) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if !procedures[req.Spec().Procedure] {
+				return next(ctx, req)
+			}
+
+			allowed, err := limiter.Allow(ctx, key(ctx, req))
+			if err != nil {
+				slog.WarnContext(ctx, "Rate limiter unavailable, allowing request",
+					"procedure", req.Spec().Procedure, "error", err)
+				return next(ctx, req)
+			}
+			if !allowed {
+				return nil, connect.NewError(connect.CodeResourceExhausted, ErrRateLimited)
+			}
+			return next(ctx, req)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/floroz/gavel/pkg/clientip"
+	authv1 "github.com/floroz/gavel/pkg/proto/auth/v1"
+	"github.com/floroz/gavel/pkg/proto/auth/v1/authv1connect"
+)
+
+// quotaLimiter allows each key a fixed number of calls and records the keys it saw
+type quotaLimiter struct {
+	quota int
+	used  map[string]int
+	err   error
+}
+
+func (l *quotaLimiter) Allow(_ context.Context, key string) (bool, error) {
+	if l.err != nil {
+		return false, l.err
+	}
+	l.used[key]++
+	return l.used[key] <= l.quota, nil
+}
+
+type registerHandler struct {
+	authv1connect.UnimplementedAuthServiceHandler
+}
+
+func (registerHandler) Register(context.Context, *connect.Request[authv1.RegisterRequest]) (*connect.Response[authv1.RegisterResponse], error) {
+	return connect.NewResponse(&authv1.RegisterResponse{UserId: "new-user"}), nil
+}
+
+func (registerHandler) GetDisplayNames(context.Context, *connect.Request[authv1.GetDisplayNamesRequest]) (*connect.Response[authv1.GetDisplayNamesResponse], error) {
+	return connect.NewResponse(&authv1.GetDisplayNamesResponse{}), nil
+}
+
+func newTestClient(t *testing.T, limiter Limiter) authv1connect.AuthServiceClient {
+	t.Helper()
+	interceptor := NewInterceptor(limiter, map[string]bool{authv1connect.AuthServiceRegisterProcedure: true}, ClientIP)
+	mux := http.NewServeMux()
+	mux.Handle(authv1connect.NewAuthServiceHandler(registerHandler{},
+		connect.WithInterceptors(clientip.NewInterceptor(1), interceptor)))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return authv1connect.NewAuthServiceClient(server.Client(), server.URL)
+}
+
+func register(client authv1connect.AuthServiceClient, ip string) error {
+	req := connect.NewRequest(&authv1.RegisterRequest{})
+	if ip != "" {
+		req.Header().Set("X-Forwarded-For", ip)
+	}
+	_, err := client.Register(context.Background(), req)
+	return err
+}
+
+func TestInterceptor(t *testing.T) {
+	t.Run("throttles per client IP", func(t *testing.T) {
+		limiter := &quotaLimiter{quota: 2, used: map[string]int{}}
+		client := newTestClient(t, limiter)
+
+		require.NoError(t, register(client, "203.0.113.7"))
+		require.NoError(t, register(client, "203.0.113.7"))
+		err := register(client, "203.0.113.7")
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err))
+
+		// Another address has its own budget
+		require.NoError(t, register(client, "198.51.100.1"))
+	})
+
+	t.Run("falls back to the remote address", func(t *testing.T) {
+		limiter := &quotaLimiter{quota: 1, used: map[string]int{}}
+		client := newTestClient(t, limiter)
+
+		require.NoError(t, register(client, ""))
+		assert.Equal(t, map[string]int{"127.0.0.1": 1}, limiter.used)
+	})
+
+	t.Run("leaves other procedures alone", func(t *testing.T) {
+		limiter := &quotaLimiter{quota: 0, used: map[string]int{}}
+		client := newTestClient(t, limiter)
+
+		_, err := client.GetDisplayNames(context.Background(), connect.NewRequest(&authv1.GetDisplayNamesRequest{}))
+		require.NoError(t, err)
+		assert.Empty(t, limiter.used)
+	})
+
+	t.Run("fails open when the limiter errors", func(t *testing.T) {
+		client := newTestClient(t, &quotaLimiter{err: errors.New("redis down")})
+		require.NoError(t, register(client, "203.0.113.7"))
+	})
+}
@@ -0,0 +1,108 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Environment variables read by RegisterConfigFromEnv
+const (
+	EnvRegisterRateBurst    = "REGISTER_RATE_LIMIT_BURST"
+	EnvRegisterRateInterval = "REGISTER_RATE_LIMIT_INTERVAL" // Go duration, e.g. "1m"
+)
+
+// Config shapes a token bucket: up to Burst requests at once, then one more every Interval
+type Config struct {
+	Burst    int
+	Interval time.Duration
+}
+
+// DefaultRegisterConfig allows 5 signups from one IP at once and one a minute after that
+var DefaultRegisterConfig = Config{Burst: 5, Interval: time.Minute}
+
+// RegisterConfigFromEnv starts from DefaultRegisterConfig and applies the REGISTER_RATE_LIMIT_* variables
+func RegisterConfigFromEnv() (Config, error) {
+	cfg := DefaultRegisterConfig
+
+	if v := os.Getenv(EnvRegisterRateBurst); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			return cfg, fmt.Errorf("invalid %s %q: must be a positive integer", EnvRegisterRateBurst, v)
+		}
+		cfg.Burst = n
+	}
+	if v := os.Getenv(EnvRegisterRateInterval); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return cfg, fmt.Errorf("invalid %s %q: must be a positive duration", EnvRegisterRateInterval, v)
+		}
+		cfg.Interval = d
+	}
+	return cfg, nil
+}
+
+// takeToken refills the bucket for the time elapsed since its last update, then takes a
+// token if one is available. The caller passes the time so that every instance and every
+// test agrees on the clock. Idle buckets expire once they would be full again.
+var takeToken = redis.NewScript(`
+local burst = tonumber(ARGV[1])
+local interval = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+
+local state = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(state[1])
+local ts = tonumber(state[2])
+if tokens == nil or ts == nil then
+	tokens = burst
+	ts = now
+end
+if now > ts then
+	tokens = math.min(burst, tokens + (now - ts) / interval)
+	ts = now
+end
+
+local allowed = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+end
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', ts)
+redis.call('PEXPIRE', KEYS[1], math.ceil(burst * interval))
+return allowed
+`)
+
+// RedisLimiter is a token bucket per key, stored in Redis so every instance shares it
+type RedisLimiter struct {
+	rdb    *redis.Client
+	prefix string
+	cfg    Config
+	now    func() time.Time
+}
+
+// NewRedisLimiter creates a limiter whose buckets live under "ratelimit:<prefix>:".
+// now is optional, nil means time.Now.
+func NewRedisLimiter(rdb *redis.Client, prefix string, cfg Config, now func() time.Time) *RedisLimiter {
+	if now == nil {
+		now = time.Now
+	}
+	return &RedisLimiter{rdb: rdb, prefix: prefix, cfg: cfg, now: now}
+}
+
+// Allow takes a token from key's bucket and reports whether one was available
+func (l *RedisLimiter) Allow(ctx context.Context, key string) (bool, error) {
+	allowed, err := takeToken.Run(ctx, l.rdb,
+		[]string{"ratelimit:" + l.prefix + ":" + key},
+		l.cfg.Burst,
+		l.cfg.Interval.Milliseconds(),
+		l.now().UnixMilli(),
+	).Int()
+	if err != nil {
+		return false, fmt.Errorf("failed to take rate limit token: %w", err)
+	}
+	return allowed == 1, nil
+}
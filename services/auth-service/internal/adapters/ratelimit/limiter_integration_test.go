@@ -0,0 +1,62 @@
+//go:build integration
+
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/floroz/gavel/pkg/testhelpers"
+)
+
+// fakeClock is a manually advanced time source
+type fakeClock struct{ now time.Time }
+
+func (c *fakeClock) Now() time.Time          { return c.now }
+func (c *fakeClock) Advance(d time.Duration) { c.now = c.now.Add(d) }
+
+func TestRedisLimiter_Integration(t *testing.T) {
+	rdb := testhelpers.NewTestRedis(t)
+	ctx := context.Background()
+	clock := &fakeClock{now: time.Unix(1_700_000_000, 0)}
+	limiter := NewRedisLimiter(rdb, "register", Config{Burst: 3, Interval: time.Minute}, clock.Now)
+
+	allow := func(key string) bool {
+		allowed, err := limiter.Allow(ctx, key)
+		require.NoError(t, err)
+		return allowed
+	}
+
+	t.Run("allows a burst then throttles", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			assert.True(t, allow("203.0.113.7"), "request %d is within the burst", i+1)
+		}
+		assert.False(t, allow("203.0.113.7"))
+	})
+
+	t.Run("refills one token per interval", func(t *testing.T) {
+		clock.Advance(30 * time.Second)
+		assert.False(t, allow("203.0.113.7"), "half an interval is not a whole token")
+
+		clock.Advance(30 * time.Second)
+		assert.True(t, allow("203.0.113.7"))
+		assert.False(t, allow("203.0.113.7"))
+	})
+
+	t.Run("refill is capped at the burst", func(t *testing.T) {
+		clock.Advance(24 * time.Hour)
+		for i := 0; i < 3; i++ {
+			assert.True(t, allow("203.0.113.7"))
+		}
+		assert.False(t, allow("203.0.113.7"))
+	})
+
+	t.Run("keys have separate buckets", func(t *testing.T) {
+		assert.True(t, allow("198.51.100.1"))
+		assert.False(t, allow("203.0.113.7"))
+	})
+}
@@ -0,0 +1,41 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterConfigFromEnv(t *testing.T) {
+	t.Run("keeps defaults", func(t *testing.T) {
+		cfg, err := RegisterConfigFromEnv()
+		require.NoError(t, err)
+		assert.Equal(t, DefaultRegisterConfig, cfg)
+	})
+
+	t.Run("applies env settings", func(t *testing.T) {
+		t.Setenv(EnvRegisterRateBurst, "20")
+		t.Setenv(EnvRegisterRateInterval, "10s")
+
+		cfg, err := RegisterConfigFromEnv()
+		require.NoError(t, err)
+		assert.Equal(t, Config{Burst: 20, Interval: 10 * time.Second}, cfg)
+	})
+
+	t.Run("rejects invalid values", func(t *testing.T) {
+		for _, tt := range []struct{ key, value string }{
+			{EnvRegisterRateBurst, "0"},
+			{EnvRegisterRateBurst, "many"},
+			{EnvRegisterRateInterval, "-1s"},
+			{EnvRegisterRateInterval, "60"},
+		} {
+			t.Run(tt.key+"="+tt.value, func(t *testing.T) {
+				t.Setenv(tt.key, tt.value)
+				_, err := RegisterConfigFromEnv()
+				assert.ErrorContains(t, err, tt.key)
+			})
+		}
+	})
+}
@@ -0,0 +1,50 @@
+package statsclient
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	userstatsv1 "github.com/floroz/gavel/pkg/proto/userstats/v1"
+	"github.com/floroz/gavel/pkg/proto/userstats/v1/userstatsv1connect"
+	"github.com/floroz/gavel/services/auth-service/internal/domain/users"
+)
+
+// StatsSource implements users.StatsSource with the user-stats service's GetUserStats RPC.
+// The client must forward the caller's token (auth.NewForwardTokenInterceptor).
+type StatsSource struct {
+	client userstatsv1connect.UserStatsServiceClient
+}
+
+var _ users.StatsSource = (*StatsSource)(nil)
+
+// NewStatsSource creates a stats source backed by client
+func NewStatsSource(client userstatsv1connect.UserStatsServiceClient) *StatsSource {
+	return &StatsSource{client: client}
+}
+
+// GetUserStats returns nil if the user-stats service has no stats for userID
+func (s *StatsSource) GetUserStats(ctx context.Context, userID uuid.UUID) (*users.ExportedStats, error) {
+	res, err := s.client.GetUserStats(ctx, connect.NewRequest(&userstatsv1.GetUserStatsRequest{
+		UserId: userID.String(),
+	}))
+	if err != nil {
+		if connect.CodeOf(err) == connect.CodeNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get user stats: %w", err)
+	}
+
+	lastBidAt, err := time.Parse(time.RFC3339, res.Msg.Stats.LastUpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("user-stats service returned invalid last_updated_at %q", res.Msg.Stats.LastUpdatedAt)
+	}
+	return &users.ExportedStats{
+		TotalBids:   res.Msg.Stats.TotalBids,
+		TotalAmount: res.Msg.Stats.TotalAmount,
+		LastBidAt:   lastBidAt,
+	}, nil
+}
@@ -0,0 +1,91 @@
+package users
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ExportedBid is one bid in a data export, as recorded by the bid service
+type ExportedBid struct {
+	ID        uuid.UUID `json:"id"`
+	ItemID    uuid.UUID `json:"item_id"`
+	Amount    int64     `json:"amount"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ExportedStats are the aggregates the user-stats service keeps for a user
+type ExportedStats struct {
+	TotalBids   int64     `json:"total_bids"`
+	TotalAmount int64     `json:"total_amount"`
+	LastBidAt   time.Time `json:"last_bid_at"`
+}
+
+// UserDataExport is everything the platform holds about one user. Each section comes
+// from the service that owns it: the profile from this one, bids from the bid service
+// and stats from the user-stats service.
+type UserDataExport struct {
+	ExportedAt time.Time      `json:"exported_at"`
+	Profile    *User          `json:"profile"`
+	Bids       []ExportedBid  `json:"bids"`
+	Stats      *ExportedStats `json:"stats"` // null if the user has never bid
+}
+
+// BidHistory reads every bid a user placed from the bid service
+type BidHistory interface {
+	ListUserBids(ctx context.Context, userID uuid.UUID) ([]ExportedBid, error)
+}
+
+// StatsSource reads a user's aggregates from the user-stats service.
+// It returns nil without an error for users it has no stats for.
+type StatsSource interface {
+	GetUserStats(ctx context.Context, userID uuid.UUID) (*ExportedStats, error)
+}
+
+// Exporter assembles UserDataExports. It does no authorization itself: the caller checks
+// the requester may export userID, and the sources forward the requester's credentials
+// so the owning services apply the same rule.
+type Exporter struct {
+	userRepo UserRepository
+	bids     BidHistory
+	stats    StatsSource
+}
+
+// NewExporter creates an exporter reading profiles from userRepo
+func NewExporter(userRepo UserRepository, bids BidHistory, stats StatsSource) *Exporter {
+	return &Exporter{userRepo: userRepo, bids: bids, stats: stats}
+}
+
+// Export gathers every section for userID. Any failing section fails the whole export,
+// since a document that silently misses data would be an incomplete disclosure.
+func (e *Exporter) Export(ctx context.Context, userID uuid.UUID) (*UserDataExport, error) {
+	user, err := e.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+
+	bids, err := e.bids.ListUserBids(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export bids: %w", err)
+	}
+	if bids == nil {
+		bids = []ExportedBid{}
+	}
+
+	stats, err := e.stats.GetUserStats(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export stats: %w", err)
+	}
+
+	return &UserDataExport{
+		ExportedAt: time.Now().UTC(),
+		Profile:    user,
+		Bids:       bids,
+		Stats:      stats,
+	}, nil
+}
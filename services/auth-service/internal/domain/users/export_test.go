@@ -0,0 +1,117 @@
+package users
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubUserRepo struct {
+	UserRepository
+	user *User
+}
+
+func (r stubUserRepo) GetUserByID(context.Context, uuid.UUID) (*User, error) { return r.user, nil }
+
+type stubBidHistory struct {
+	bids []ExportedBid
+	err  error
+}
+
+func (s stubBidHistory) ListUserBids(context.Context, uuid.UUID) ([]ExportedBid, error) {
+	return s.bids, s.err
+}
+
+type stubStatsSource struct{ stats *ExportedStats }
+
+func (s stubStatsSource) GetUserStats(context.Context, uuid.UUID) (*ExportedStats, error) {
+	return s.stats, nil
+}
+
+func TestExporter_Export(t *testing.T) {
+	ctx := context.Background()
+	user := &User{
+		ID:           uuid.New(),
+		Email:        "jane@example.com",
+		PasswordHash: "secret-hash",
+		FullName:     "Jane Doe",
+		PhoneNumber:  "+15550001111",
+		CountryCode:  "US",
+		CreatedAt:    time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+	bid := ExportedBid{ID: uuid.New(), ItemID: uuid.New(), Amount: 1500, CreatedAt: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)}
+
+	t.Run("combines every section into one document", func(t *testing.T) {
+		exporter := NewExporter(
+			stubUserRepo{user: user},
+			stubBidHistory{bids: []ExportedBid{bid}},
+			stubStatsSource{stats: &ExportedStats{TotalBids: 1, TotalAmount: 1500, LastBidAt: bid.CreatedAt}},
+		)
+
+		export, err := exporter.Export(ctx, user.ID)
+		require.NoError(t, err)
+		doc, err := json.Marshal(export)
+		require.NoError(t, err)
+
+		var got map[string]any
+		require.NoError(t, json.Unmarshal(doc, &got))
+		assert.ElementsMatch(t, []string{"exported_at", "profile", "bids", "stats"}, keys(got))
+
+		profile := got["profile"].(map[string]any)
+		assert.Equal(t, "jane@example.com", profile["email"])
+		assert.Equal(t, "+15550001111", profile["phone_number"])
+		assert.NotContains(t, string(doc), "secret-hash", "credentials must never be exported")
+
+		bids := got["bids"].([]any)
+		require.Len(t, bids, 1)
+		assert.Equal(t, map[string]any{
+			"id":         bid.ID.String(),
+			"item_id":    bid.ItemID.String(),
+			"amount":     float64(1500),
+			"created_at": "2026-02-01T00:00:00Z",
+		}, bids[0])
+
+		stats := got["stats"].(map[string]any)
+		assert.Equal(t, float64(1), stats["total_bids"])
+		assert.Equal(t, float64(1500), stats["total_amount"])
+	})
+
+	t.Run("a user without activity gets empty sections", func(t *testing.T) {
+		exporter := NewExporter(stubUserRepo{user: user}, stubBidHistory{}, stubStatsSource{})
+
+		export, err := exporter.Export(ctx, user.ID)
+		require.NoError(t, err)
+		doc, err := json.Marshal(export)
+		require.NoError(t, err)
+		assert.Contains(t, string(doc), `"bids":[]`)
+		assert.Contains(t, string(doc), `"stats":null`)
+	})
+
+	t.Run("a failing source fails the export", func(t *testing.T) {
+		exporter := NewExporter(stubUserRepo{user: user}, stubBidHistory{err: errors.New("bid service down")}, stubStatsSource{})
+
+		_, err := exporter.Export(ctx, user.ID)
+		assert.ErrorContains(t, err, "bid service down")
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		exporter := NewExporter(stubUserRepo{}, stubBidHistory{}, stubStatsSource{})
+
+		_, err := exporter.Export(ctx, uuid.New())
+		assert.ErrorIs(t, err, ErrUserNotFound)
+	})
+}
+
+func keys(m map[string]any) []string {
+	out := make([]string, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	return out
+}
@@ -0,0 +1,49 @@
+package users
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidLocale is returned for locales that do not name a country
+var ErrInvalidLocale = errors.New("invalid locale")
+
+// CountryFromLocale returns the ISO 3166-1 alpha-2 region of a BCP 47 locale such as
+// "en-US" or "zh-Hant-TW". POSIX-style underscores ("pt_BR") are accepted too.
+// Locales without a region, or with a numeric UN M.49 region such as "es-419", are
+// rejected rather than guessed at.
+func CountryFromLocale(locale string) (string, error) {
+	subtags := strings.FieldsFunc(locale, func(r rune) bool { return r == '-' || r == '_' })
+	if len(subtags) == 0 || !isAlpha(subtags[0]) || len(subtags[0]) < 2 || len(subtags[0]) > 3 {
+		return "", fmt.Errorf("%w: %q does not start with a language", ErrInvalidLocale, locale)
+	}
+
+	rest := subtags[1:]
+	// An optional four-letter script comes between the language and the region
+	if len(rest) > 0 && len(rest[0]) == 4 && isAlpha(rest[0]) {
+		rest = rest[1:]
+	}
+	if len(rest) == 0 || len(rest[0]) != 2 || !isAlpha(rest[0]) {
+		return "", fmt.Errorf("%w: %q has no country region", ErrInvalidLocale, locale)
+	}
+	return strings.ToUpper(rest[0]), nil
+}
+
+// ResolveCountryCode returns countryCode, or the region of locale when countryCode is
+// empty. The result still goes through Register's country code validation.
+func ResolveCountryCode(countryCode, locale string) (string, error) {
+	if countryCode != "" || locale == "" {
+		return countryCode, nil
+	}
+	return CountryFromLocale(locale)
+}
+
+func isAlpha(s string) bool {
+	for _, r := range s {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,62 @@
+package users
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCountryFromLocale(t *testing.T) {
+	tests := []struct {
+		locale string
+		want   string
+	}{
+		{"en-US", "US"},
+		{"en-gb", "GB"},
+		{"pt_BR", "BR"},
+		{"zh-Hant-TW", "TW"},
+		{"fil-PH", "PH"},
+		{"de-DE-1996", "DE"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.locale, func(t *testing.T) {
+			got, err := CountryFromLocale(tt.locale)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	for _, locale := range []string{"", "en", "zh-Hant", "es-419", "english-US", "e-US", "en-U5", "-US"} {
+		t.Run("rejects "+locale, func(t *testing.T) {
+			_, err := CountryFromLocale(locale)
+			assert.ErrorIs(t, err, ErrInvalidLocale)
+		})
+	}
+}
+
+func TestResolveCountryCode(t *testing.T) {
+	t.Run("explicit country code wins", func(t *testing.T) {
+		got, err := ResolveCountryCode("FR", "en-US")
+		require.NoError(t, err)
+		assert.Equal(t, "FR", got)
+	})
+
+	t.Run("falls back to the locale", func(t *testing.T) {
+		got, err := ResolveCountryCode("", "en-US")
+		require.NoError(t, err)
+		assert.Equal(t, "US", got)
+	})
+
+	t.Run("an unused locale is not validated", func(t *testing.T) {
+		got, err := ResolveCountryCode("FR", "not a locale")
+		require.NoError(t, err)
+		assert.Equal(t, "FR", got)
+	})
+
+	t.Run("neither leaves validation to Register", func(t *testing.T) {
+		got, err := ResolveCountryCode("", "")
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+}
@@ -1,7 +1,10 @@
 package users
 
 import (
+	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 )
@@ -18,6 +21,20 @@ type User struct {
 	CountryCode  string    `json:"country_code" db:"country_code"`
 }
 
+// DisplayName is the name shown to other users: the first name and the initial of the last,
+// e.g. "Jane D." for "Jane Doe", so listings never expose a full legal name.
+func (u *User) DisplayName() string {
+	parts := strings.Fields(u.FullName)
+	switch len(parts) {
+	case 0:
+		return ""
+	case 1:
+		return parts[0]
+	}
+	last, _ := utf8.DecodeRuneInString(parts[len(parts)-1])
+	return parts[0] + " " + string(unicode.ToUpper(last)) + "."
+}
+
 type RefreshToken struct {
 	TokenHash []byte    `db:"token_hash"`
 	UserID    uuid.UUID `db:"user_id"`
@@ -0,0 +1,27 @@
+package users
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUser_DisplayName(t *testing.T) {
+	tests := []struct {
+		fullName string
+		want     string
+	}{
+		{fullName: "Jane Doe", want: "Jane D."},
+		{fullName: "  jane   van der berg ", want: "jane B."},
+		{fullName: "Cher", want: "Cher"},
+		{fullName: "Zoë Ölander", want: "Zoë Ö."},
+		{fullName: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fullName, func(t *testing.T) {
+			u := &User{FullName: tt.fullName}
+			assert.Equal(t, tt.want, u.DisplayName())
+		})
+	}
+}
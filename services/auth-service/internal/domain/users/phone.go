@@ -0,0 +1,115 @@
+package users
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidPhone is returned for phone numbers that cannot be normalized to E.164
+var ErrInvalidPhone = errors.New("invalid phone number")
+
+// maxE164Digits is the most digits an E.164 number may have, country code included
+const maxE164Digits = 15
+
+// phoneCountry describes national numbering for one ISO 3166-1 alpha-2 country
+type phoneCountry struct {
+	callingCode string
+	trunkPrefix string // dropped from national numbers, e.g. the leading 0 in the UK
+	minLen      int    // national significant number length, without the trunk prefix
+	maxLen      int
+}
+
+// phoneCountries covers the markets we operate in. Numbers for other countries
+// are accepted only in international (+...) form.
+var phoneCountries = map[string]phoneCountry{
+	"US": {callingCode: "1", trunkPrefix: "1", minLen: 10, maxLen: 10},
+	"CA": {callingCode: "1", trunkPrefix: "1", minLen: 10, maxLen: 10},
+	"GB": {callingCode: "44", trunkPrefix: "0", minLen: 9, maxLen: 10},
+	"IE": {callingCode: "353", trunkPrefix: "0", minLen: 7, maxLen: 9},
+	"FR": {callingCode: "33", trunkPrefix: "0", minLen: 9, maxLen: 9},
+	"DE": {callingCode: "49", trunkPrefix: "0", minLen: 6, maxLen: 13},
+	"NL": {callingCode: "31", trunkPrefix: "0", minLen: 9, maxLen: 9},
+	"CH": {callingCode: "41", trunkPrefix: "0", minLen: 9, maxLen: 9},
+	"SE": {callingCode: "46", trunkPrefix: "0", minLen: 7, maxLen: 9},
+	"ES": {callingCode: "34", minLen: 9, maxLen: 9},
+	"PT": {callingCode: "351", minLen: 9, maxLen: 9},
+	"PL": {callingCode: "48", minLen: 9, maxLen: 9},
+	// Italian landlines keep their leading 0 after the country code
+	"IT": {callingCode: "39", minLen: 6, maxLen: 11},
+	"AU": {callingCode: "61", trunkPrefix: "0", minLen: 9, maxLen: 9},
+	"IN": {callingCode: "91", trunkPrefix: "0", minLen: 10, maxLen: 10},
+	"JP": {callingCode: "81", trunkPrefix: "0", minLen: 9, maxLen: 10},
+	"BR": {callingCode: "55", trunkPrefix: "0", minLen: 10, maxLen: 11},
+	"MX": {callingCode: "52", minLen: 10, maxLen: 10},
+}
+
+// ValidatePhone normalizes number to E.164 (e.g. "+447911123456").
+// National numbers are interpreted in country; numbers starting with + or 00 are
+// taken as international. Spaces, dashes, dots and parentheses are ignored.
+func ValidatePhone(number, country string) (string, error) {
+	cleaned := strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '.', '(', ')':
+			return -1
+		}
+		return r
+	}, strings.TrimSpace(number))
+
+	international := false
+	switch {
+	case strings.HasPrefix(cleaned, "+"):
+		cleaned, international = cleaned[1:], true
+	case strings.HasPrefix(cleaned, "00"):
+		cleaned, international = cleaned[2:], true
+	}
+
+	if cleaned == "" {
+		return "", fmt.Errorf("%w: no digits", ErrInvalidPhone)
+	}
+	for _, r := range cleaned {
+		if r < '0' || r > '9' {
+			return "", fmt.Errorf("%w: unexpected character %q", ErrInvalidPhone, r)
+		}
+	}
+
+	info, known := phoneCountries[country]
+
+	var digits string
+	switch {
+	case international:
+		digits = cleaned
+		// Only a number in the user's own country can be checked against its numbering plan
+		if known && strings.HasPrefix(digits, info.callingCode) {
+			if err := info.checkLength(digits[len(info.callingCode):]); err != nil {
+				return "", err
+			}
+		}
+	case known:
+		national := cleaned
+		if info.trunkPrefix != "" {
+			national = strings.TrimPrefix(national, info.trunkPrefix)
+		}
+		if err := info.checkLength(national); err != nil {
+			return "", err
+		}
+		digits = info.callingCode + national
+	default:
+		return "", fmt.Errorf("%w: use international format (+<country code>) for country %s", ErrInvalidPhone, country)
+	}
+
+	if digits[0] == '0' {
+		return "", fmt.Errorf("%w: country code cannot start with 0", ErrInvalidPhone)
+	}
+	if len(digits) > maxE164Digits {
+		return "", fmt.Errorf("%w: more than %d digits", ErrInvalidPhone, maxE164Digits)
+	}
+	return "+" + digits, nil
+}
+
+func (c phoneCountry) checkLength(national string) error {
+	if len(national) < c.minLen || len(national) > c.maxLen {
+		return fmt.Errorf("%w: expected %d-%d digits after +%s, got %d", ErrInvalidPhone, c.minLen, c.maxLen, c.callingCode, len(national))
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package users
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatePhone(t *testing.T) {
+	tests := []struct {
+		name    string
+		number  string
+		country string
+		want    string
+	}{
+		{name: "US already in E.164", number: "+15558888888", country: "US", want: "+15558888888"},
+		{name: "US national with punctuation", number: "(555) 888-8888", country: "US", want: "+15558888888"},
+		{name: "US national with leading 1", number: "1-555-888-8888", country: "US", want: "+15558888888"},
+		{name: "UK mobile with trunk 0", number: "07911 123456", country: "GB", want: "+447911123456"},
+		{name: "UK international with spaces", number: "+44 7911 123456", country: "GB", want: "+447911123456"},
+		{name: "Germany with 00 prefix", number: "0049 30 123456", country: "DE", want: "+4930123456"},
+		{name: "France with dots", number: "06.12.34.56.78", country: "FR", want: "+33612345678"},
+		{name: "Italy keeps leading 0", number: "06 1234 5678", country: "IT", want: "+390612345678"},
+		{name: "Spain without trunk prefix", number: "612-345-678", country: "ES", want: "+34612345678"},
+		{name: "Foreign number for a known country", number: "+33 6 12 34 56 78", country: "US", want: "+33612345678"},
+		{name: "Unknown country in international form", number: "+254 712 345678", country: "KE", want: "+254712345678"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ValidatePhone(tt.number, tt.country)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestValidatePhone_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		number  string
+		country string
+	}{
+		{name: "Empty", number: "", country: "US"},
+		{name: "Only punctuation", number: "( ) -", country: "US"},
+		{name: "Letters", number: "555-CALL-NOW", country: "US"},
+		{name: "US too short", number: "555 1234", country: "US"},
+		{name: "US too long", number: "+1 555 888 88889", country: "US"},
+		{name: "UK too short", number: "07911 123", country: "GB"},
+		{name: "National number for unknown country", number: "0712 345678", country: "KE"},
+		{name: "Country code starting with 0", number: "+0123456789", country: "US"},
+		{name: "More than 15 digits", number: "+2541234567890123", country: "KE"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ValidatePhone(tt.number, tt.country)
+			assert.ErrorIs(t, err, ErrInvalidPhone)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package users
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -13,12 +14,22 @@ type UserRepository interface {
 	CreateUser(ctx context.Context, tx pgx.Tx, user *User) error
 	GetUserByID(ctx context.Context, id uuid.UUID) (*User, error)
 	GetUserByEmail(ctx context.Context, email string) (*User, error)
+	// GetUsersByIDs returns the users that exist among ids; missing ids are skipped
+	GetUsersByIDs(ctx context.Context, ids []uuid.UUID) ([]*User, error)
+	// UpdatePasswordHash replaces the user's hash only while it still equals oldHash,
+	// so a concurrent password change is never overwritten. It reports whether it did.
+	UpdatePasswordHash(ctx context.Context, id uuid.UUID, oldHash, newHash string) (bool, error)
+	// DeleteUser deletes the user and, through the foreign key cascade, their refresh tokens.
+	// It reports whether the user existed.
+	DeleteUser(ctx context.Context, tx pgx.Tx, id uuid.UUID) (bool, error)
 }
 
 type TokenRepository interface {
 	CreateRefreshToken(ctx context.Context, tx pgx.Tx, token *RefreshToken) error
 	GetRefreshToken(ctx context.Context, tokenHash []byte) (*RefreshToken, error)
-	RevokeRefreshToken(ctx context.Context, tx pgx.Tx, tokenHash []byte) error
+	// RevokeRefreshToken revokes the token only if it is active and owned by userID.
+	// It reports whether a token was revoked.
+	RevokeRefreshToken(ctx context.Context, tx pgx.Tx, tokenHash []byte, userID uuid.UUID) (bool, error)
 	// RevokeAllUserTokens is useful for "logout from all devices" functionality
 	RevokeAllUserTokens(ctx context.Context, tx pgx.Tx, userID uuid.UUID) error
 }
@@ -28,6 +39,17 @@ type OutboxRepository interface {
 	events.OutboxRepository
 }
 
+// Metrics records the outcome of auth operations
+type Metrics interface {
+	LoginSucceeded()
+	LoginFailed()
+	Registered()
+	TokenRefreshed()
+	// RefreshTokenReused counts rotated refresh tokens presented again, which ends all of the owner's sessions
+	RefreshTokenReused()
+	ObservePasswordHash(d time.Duration)
+}
+
 type EventPublisher interface {
 	Publish(ctx context.Context, exchange, routingKey string, body []byte) error
 }
@@ -36,6 +58,9 @@ type AuthService interface {
 	Register(ctx context.Context, email, password, fullName, phoneNumber, countryCode string) (*User, error)
 	Login(ctx context.Context, email, password, userAgent, ip string) (accessToken, refreshToken string, err error)
 	Refresh(ctx context.Context, refreshToken, userAgent, ip string) (newAccess, newRefresh string, err error)
-	Logout(ctx context.Context, refreshToken string) error
+	Logout(ctx context.Context, refreshToken string, callerID uuid.UUID) error
 	GetProfile(ctx context.Context, userID uuid.UUID) (*User, error)
+	GetUserByEmail(ctx context.Context, email string) (*User, error)
+	GetDisplayNames(ctx context.Context, userIDs []uuid.UUID) (map[uuid.UUID]string, error)
+	DeleteAccount(ctx context.Context, userID uuid.UUID) error
 }
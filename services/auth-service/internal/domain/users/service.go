@@ -5,6 +5,7 @@ import (
 	"crypto/sha256"
 	"errors"
 	"fmt"
+	"log/slog"
 	"strings"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/floroz/gavel/pkg/database"
 	"github.com/floroz/gavel/pkg/events"
 	pb "github.com/floroz/gavel/pkg/proto"
+	"github.com/floroz/gavel/pkg/sanitize"
 )
 
 var (
@@ -24,38 +26,82 @@ var (
 	ErrInvalidToken       = errors.New("invalid or expired refresh token")
 	ErrUserNotFound       = errors.New("user not found")
 	ErrInvalidInput       = errors.New("invalid input")
+	ErrTokenNotOwned      = errors.New("refresh token belongs to another user")
+	ErrLogoutUnauthorized = errors.New("logout requires an access token")
 )
 
+// Registration validation errors, always wrapped in ErrInvalidInput
+var (
+	ErrInvalidEmail       = errors.New("invalid email format")
+	ErrEmptyFullName      = errors.New("full name cannot be empty")
+	ErrInvalidCountryCode = errors.New("invalid country code")
+)
+
+// NopMetrics discards every measurement
+type NopMetrics struct{}
+
+func (NopMetrics) LoginSucceeded()                   {}
+func (NopMetrics) LoginFailed()                      {}
+func (NopMetrics) Registered()                       {}
+func (NopMetrics) TokenRefreshed()                   {}
+func (NopMetrics) RefreshTokenReused()               {}
+func (NopMetrics) ObservePasswordHash(time.Duration) {}
+
 type Service struct {
-	userRepo   UserRepository
-	tokenRepo  TokenRepository
-	outboxRepo OutboxRepository
-	signer     *auth.Signer
-	txManager  database.TransactionManager
+	userRepo          UserRepository
+	tokenRepo         TokenRepository
+	outboxRepo        OutboxRepository
+	signer            *auth.Signer
+	txManager         database.TransactionManager
+	passwordPolicy    auth.PasswordPolicy
+	logoutRequiresJWT bool
+	metrics           Metrics
 }
 
+// NewService creates the auth service.
+// passwordPolicy: rules new passwords must meet (see auth.DefaultPasswordPolicy)
+// logoutRequiresJWT: reject Logout calls that do not carry the owner's access token
+// metrics: optional, nil means NopMetrics
 func NewService(
 	userRepo UserRepository,
 	tokenRepo TokenRepository,
 	outboxRepo OutboxRepository,
 	signer *auth.Signer,
 	txManager database.TransactionManager,
+	passwordPolicy auth.PasswordPolicy,
+	logoutRequiresJWT bool,
+	metrics Metrics,
 ) *Service {
+	if metrics == nil {
+		metrics = NopMetrics{}
+	}
 	return &Service{
-		userRepo:   userRepo,
-		tokenRepo:  tokenRepo,
-		outboxRepo: outboxRepo,
-		signer:     signer,
-		txManager:  txManager,
+		userRepo:          userRepo,
+		tokenRepo:         tokenRepo,
+		outboxRepo:        outboxRepo,
+		signer:            signer,
+		txManager:         txManager,
+		passwordPolicy:    passwordPolicy,
+		logoutRequiresJWT: logoutRequiresJWT,
+		metrics:           metrics,
 	}
 }
 
 func (s *Service) Register(ctx context.Context, email, password, fullName, phoneNumber, countryCode string) (*User, error) {
-	if err := validateUser(email, password, fullName, phoneNumber, countryCode); err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	fullName = sanitize.Line(fullName)
+	if err := validateUser(email, fullName, countryCode); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+	if err := s.passwordPolicy.Validate(password); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+	phoneNumber, err := ValidatePhone(phoneNumber, countryCode)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
 	}
 
-	// Check if user already exists
+	// Fast path for the common case; the unique constraint on email is what actually
+	// guarantees uniqueness when registrations race
 	existing, err := s.userRepo.GetUserByEmail(ctx, email)
 	if err != nil {
 		return nil, fmt.Errorf("failed to check existing user: %w", err)
@@ -65,7 +111,9 @@ func (s *Service) Register(ctx context.Context, email, password, fullName, phone
 	}
 
 	// Hash password
+	hashStart := time.Now()
 	hash, err := auth.HashPassword(password)
+	s.metrics.ObservePasswordHash(time.Since(hashStart))
 	if err != nil {
 		return nil, fmt.Errorf("failed to hash password: %w", err)
 	}
@@ -91,6 +139,9 @@ func (s *Service) Register(ctx context.Context, email, password, fullName, phone
 	defer tx.Rollback(ctx)
 
 	if err := s.userRepo.CreateUser(ctx, tx, user); err != nil {
+		if errors.Is(err, ErrUserAlreadyExists) {
+			return nil, ErrUserAlreadyExists
+		}
 		return nil, fmt.Errorf("failed to create user: %w", err)
 	}
 
@@ -123,10 +174,23 @@ func (s *Service) Register(ctx context.Context, email, password, fullName, phone
 		return nil, fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
+	s.metrics.Registered()
 	return user, nil
 }
 
 func (s *Service) Login(ctx context.Context, email, password, userAgent, ip string) (string, string, error) {
+	access, refresh, err := s.login(ctx, email, password, userAgent, ip)
+	if err != nil {
+		if errors.Is(err, ErrInvalidCredentials) {
+			s.metrics.LoginFailed()
+		}
+		return "", "", err
+	}
+	s.metrics.LoginSucceeded()
+	return access, refresh, nil
+}
+
+func (s *Service) login(ctx context.Context, email, password, userAgent, ip string) (string, string, error) {
 	user, err := s.userRepo.GetUserByEmail(ctx, email)
 	if err != nil {
 		return "", "", fmt.Errorf("failed to get user: %w", err)
@@ -144,6 +208,10 @@ func (s *Service) Login(ctx context.Context, email, password, userAgent, ip stri
 		return "", "", ErrInvalidCredentials
 	}
 
+	if auth.NeedsRehash(user.PasswordHash) {
+		s.upgradePasswordHash(ctx, user, password)
+	}
+
 	return s.generateAndSaveTokens(ctx, user, userAgent, ip)
 }
 
@@ -162,9 +230,11 @@ func (s *Service) Refresh(ctx context.Context, refreshToken, userAgent, ip strin
 
 	// Check validity
 	if storedToken.Revoked {
-		// Potential reuse attack!
-		// We should probably revoke all user tokens here for security.
-		// For now, just return error.
+		// A rotated token being presented again means it leaked: end every session of its owner
+		s.metrics.RefreshTokenReused()
+		if err := s.revokeAllUserTokens(ctx, storedToken.UserID); err != nil {
+			return "", "", err
+		}
 		return "", "", ErrInvalidToken
 	}
 	if time.Now().After(storedToken.ExpiresAt) {
@@ -179,6 +249,10 @@ func (s *Service) Refresh(ctx context.Context, refreshToken, userAgent, ip strin
 	if user == nil {
 		return "", "", ErrUserNotFound
 	}
+	// The new tokens must be issued to the user the token row is bound to, never anyone else
+	if user.ID != storedToken.UserID {
+		return "", "", ErrInvalidToken
+	}
 
 	// Rotate tokens: Revoke old one, issue new ones
 	tx, err := s.txManager.BeginTx(ctx)
@@ -187,10 +261,14 @@ func (s *Service) Refresh(ctx context.Context, refreshToken, userAgent, ip strin
 	}
 	defer tx.Rollback(ctx)
 
-	// Revoke old token
-	if err := s.tokenRepo.RevokeRefreshToken(ctx, tx, tokenHash); err != nil {
+	// Revoke old token. Losing this race to a concurrent refresh means the token was already used.
+	revoked, err := s.tokenRepo.RevokeRefreshToken(ctx, tx, tokenHash, user.ID)
+	if err != nil {
 		return "", "", fmt.Errorf("failed to revoke token: %w", err)
 	}
+	if !revoked {
+		return "", "", ErrInvalidToken
+	}
 
 	// Generate and save new tokens (inside the same transaction)
 	// We duplicate generateAndSaveTokens logic slightly here to use the existing tx
@@ -218,19 +296,38 @@ func (s *Service) Refresh(ctx context.Context, refreshToken, userAgent, ip strin
 		return "", "", fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
+	s.metrics.TokenRefreshed()
 	return tokenPair.AccessToken, tokenPair.RefreshToken, nil
 }
 
-func (s *Service) Logout(ctx context.Context, refreshToken string) error {
+// Logout revokes refreshToken. callerID is the user from the request's access token,
+// or uuid.Nil if none was sent; when set, only that user's token can be revoked.
+// Unknown or already revoked tokens are a no-op so logout stays idempotent.
+func (s *Service) Logout(ctx context.Context, refreshToken string, callerID uuid.UUID) error {
+	if callerID == uuid.Nil && s.logoutRequiresJWT {
+		return ErrLogoutUnauthorized
+	}
+
 	tokenHash := hashToken(refreshToken)
 
+	storedToken, err := s.tokenRepo.GetRefreshToken(ctx, tokenHash)
+	if err != nil {
+		return fmt.Errorf("failed to get refresh token: %w", err)
+	}
+	if storedToken == nil {
+		return nil
+	}
+	if callerID != uuid.Nil && storedToken.UserID != callerID {
+		return ErrTokenNotOwned
+	}
+
 	tx, err := s.txManager.BeginTx(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
 	defer tx.Rollback(ctx)
 
-	if err := s.tokenRepo.RevokeRefreshToken(ctx, tx, tokenHash); err != nil {
+	if _, err := s.tokenRepo.RevokeRefreshToken(ctx, tx, tokenHash, storedToken.UserID); err != nil {
 		return fmt.Errorf("failed to revoke token: %w", err)
 	}
 
@@ -248,6 +345,84 @@ func (s *Service) GetProfile(ctx context.Context, userID uuid.UUID) (*User, erro
 	return user, nil
 }
 
+// GetUserByEmail is the admin lookup by email; callers must check authorization
+func (s *Service) GetUserByEmail(ctx context.Context, email string) (*User, error) {
+	user, err := s.userRepo.GetUserByEmail(ctx, email)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+	return user, nil
+}
+
+// MaxDisplayNameLookup caps how many users one GetDisplayNames call may resolve
+const MaxDisplayNameLookup = 100
+
+// GetDisplayNames maps each known user id to its DisplayName; unknown ids are left out
+func (s *Service) GetDisplayNames(ctx context.Context, userIDs []uuid.UUID) (map[uuid.UUID]string, error) {
+	if len(userIDs) > MaxDisplayNameLookup {
+		return nil, fmt.Errorf("%w: at most %d user ids per lookup", ErrInvalidInput, MaxDisplayNameLookup)
+	}
+	names := make(map[uuid.UUID]string, len(userIDs))
+	if len(userIDs) == 0 {
+		return names, nil
+	}
+
+	found, err := s.userRepo.GetUsersByIDs(ctx, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users: %w", err)
+	}
+	for _, user := range found {
+		names[user.ID] = user.DisplayName()
+	}
+	return names, nil
+}
+
+// DeleteAccount deletes the user and their refresh tokens and emits user.deleted so the
+// other services erase their copies. Access tokens already issued stay valid until they expire.
+func (s *Service) DeleteAccount(ctx context.Context, userID uuid.UUID) error {
+	tx, err := s.txManager.BeginTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	deleted, err := s.userRepo.DeleteUser(ctx, tx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete user: %w", err)
+	}
+	if !deleted {
+		return ErrUserNotFound
+	}
+
+	now := time.Now()
+	payload, err := proto.Marshal(&pb.UserDeleted{
+		UserId:    userID.String(),
+		DeletedAt: timestamppb.New(now),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	outboxEvent := &events.OutboxEvent{
+		ID:        uuid.New(),
+		EventType: "user.deleted",
+		Payload:   payload,
+		Status:    events.OutboxStatusPending,
+		CreatedAt: now,
+	}
+	if err := s.outboxRepo.CreateEvent(ctx, tx, outboxEvent); err != nil {
+		return fmt.Errorf("failed to create outbox event: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
 // Helpers
 
 func (s *Service) generateAndSaveTokens(ctx context.Context, user *User, userAgent, ip string) (string, string, error) {
@@ -286,30 +461,54 @@ func (s *Service) generateAndSaveTokens(ctx context.Context, user *User, userAge
 	return tokenPair.AccessToken, tokenPair.RefreshToken, nil
 }
 
+// upgradePasswordHash rehashes a migrated user's legacy (bcrypt) hash with argon2id while the
+// plaintext is at hand. It is best effort: on failure the old hash keeps working and the
+// upgrade is retried on the next login.
+func (s *Service) upgradePasswordHash(ctx context.Context, user *User, password string) {
+	hashStart := time.Now()
+	hash, err := auth.HashPassword(password)
+	s.metrics.ObservePasswordHash(time.Since(hashStart))
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to rehash legacy password", "user_id", user.ID, "error", err)
+		return
+	}
+	if _, err := s.userRepo.UpdatePasswordHash(ctx, user.ID, user.PasswordHash, hash); err != nil {
+		slog.WarnContext(ctx, "Failed to store upgraded password hash", "user_id", user.ID, "error", err)
+		return
+	}
+}
+
+func (s *Service) revokeAllUserTokens(ctx context.Context, userID uuid.UUID) error {
+	tx, err := s.txManager.BeginTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := s.tokenRepo.RevokeAllUserTokens(ctx, tx, userID); err != nil {
+		return fmt.Errorf("failed to revoke user tokens: %w", err)
+	}
+	return tx.Commit(ctx)
+}
+
 func hashToken(token string) []byte {
 	hash := sha256.Sum256([]byte(token))
 	return hash[:]
 }
 
-func validateUser(email, password, fullName, phoneNumber, countryCode string) error {
+func validateUser(email, fullName, countryCode string) error {
 	if !strings.Contains(email, "@") || len(email) < 3 {
-		return errors.New("invalid email format")
-	}
-	if len(password) < 8 {
-		return errors.New("password must be at least 8 characters")
+		return ErrInvalidEmail
 	}
 	if strings.TrimSpace(fullName) == "" {
-		return errors.New("full name cannot be empty")
-	}
-	if strings.TrimSpace(phoneNumber) == "" {
-		return errors.New("phone number cannot be empty")
+		return ErrEmptyFullName
 	}
 	if len(countryCode) != 2 || countryCode != strings.ToUpper(countryCode) {
-		return errors.New("country code must be 2 uppercase letters (ISO 3166-1 alpha-2)")
+		return fmt.Errorf("%w: must be 2 uppercase letters (ISO 3166-1 alpha-2)", ErrInvalidCountryCode)
 	}
 	for _, r := range countryCode {
 		if r < 'A' || r > 'Z' {
-			return errors.New("country code must contain only letters")
+			return fmt.Errorf("%w: must contain only letters", ErrInvalidCountryCode)
 		}
 	}
 	return nil
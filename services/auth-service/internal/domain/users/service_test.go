@@ -0,0 +1,210 @@
+package users
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/floroz/gavel/pkg/auth"
+	"github.com/floroz/gavel/pkg/events"
+)
+
+// memUserRepo keeps users in memory, keyed by email
+type memUserRepo struct {
+	UserRepository
+	users map[string]*User
+}
+
+func (r *memUserRepo) CreateUser(_ context.Context, _ pgx.Tx, user *User) error {
+	r.users[user.Email] = user
+	return nil
+}
+
+func (r *memUserRepo) GetUserByEmail(_ context.Context, email string) (*User, error) {
+	return r.users[email], nil
+}
+
+func (r *memUserRepo) UpdatePasswordHash(_ context.Context, id uuid.UUID, oldHash, newHash string) (bool, error) {
+	for _, user := range r.users {
+		if user.ID == id && user.PasswordHash == oldHash {
+			user.PasswordHash = newHash
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *memUserRepo) GetUserByID(_ context.Context, id uuid.UUID) (*User, error) {
+	for _, user := range r.users {
+		if user.ID == id {
+			return user, nil
+		}
+	}
+	return nil, nil
+}
+
+// memTokenRepo keeps refresh tokens in memory, keyed by hash
+type memTokenRepo struct {
+	tokens map[string]*RefreshToken
+}
+
+func (r *memTokenRepo) CreateRefreshToken(_ context.Context, _ pgx.Tx, token *RefreshToken) error {
+	r.tokens[string(token.TokenHash)] = token
+	return nil
+}
+
+func (r *memTokenRepo) GetRefreshToken(_ context.Context, tokenHash []byte) (*RefreshToken, error) {
+	return r.tokens[string(tokenHash)], nil
+}
+
+func (r *memTokenRepo) RevokeRefreshToken(_ context.Context, _ pgx.Tx, tokenHash []byte, userID uuid.UUID) (bool, error) {
+	token := r.tokens[string(tokenHash)]
+	if token == nil || token.Revoked || token.UserID != userID {
+		return false, nil
+	}
+	token.Revoked = true
+	return true, nil
+}
+
+func (r *memTokenRepo) RevokeAllUserTokens(_ context.Context, _ pgx.Tx, userID uuid.UUID) error {
+	for _, token := range r.tokens {
+		if token.UserID == userID {
+			token.Revoked = true
+		}
+	}
+	return nil
+}
+
+type nopOutboxRepo struct {
+	OutboxRepository
+}
+
+func (nopOutboxRepo) CreateEvent(context.Context, pgx.Tx, *events.OutboxEvent) error { return nil }
+
+type nopTx struct{ pgx.Tx }
+
+func (nopTx) Commit(context.Context) error   { return nil }
+func (nopTx) Rollback(context.Context) error { return nil }
+
+type nopTxManager struct{}
+
+func (nopTxManager) BeginTx(context.Context) (pgx.Tx, error) { return nopTx{}, nil }
+
+// countingMetrics counts each Metrics call
+type countingMetrics struct {
+	loginsOK, loginsFailed, registrations, refreshes, reuses, hashes int
+}
+
+func (m *countingMetrics) LoginSucceeded()                   { m.loginsOK++ }
+func (m *countingMetrics) LoginFailed()                      { m.loginsFailed++ }
+func (m *countingMetrics) Registered()                       { m.registrations++ }
+func (m *countingMetrics) TokenRefreshed()                   { m.refreshes++ }
+func (m *countingMetrics) RefreshTokenReused()               { m.reuses++ }
+func (m *countingMetrics) ObservePasswordHash(time.Duration) { m.hashes++ }
+
+func newTestService(t *testing.T) (*Service, *countingMetrics) {
+	service, _, metrics := newTestServiceWithRepo(t)
+	return service, metrics
+}
+
+func newTestServiceWithRepo(t *testing.T) (*Service, *memUserRepo, *countingMetrics) {
+	t.Helper()
+	privPEM, pubPEM, err := auth.GenerateKeyPair(auth.MinRSAKeyBits)
+	require.NoError(t, err)
+	signer, err := auth.NewSigner(privPEM, pubPEM, "gavel-auth-service", nil)
+	require.NoError(t, err)
+
+	metrics := &countingMetrics{}
+	userRepo := &memUserRepo{users: map[string]*User{}}
+	service := NewService(
+		userRepo,
+		&memTokenRepo{tokens: map[string]*RefreshToken{}},
+		nopOutboxRepo{},
+		signer,
+		nopTxManager{},
+		auth.DefaultPasswordPolicy(),
+		false,
+		metrics,
+	)
+	return service, userRepo, metrics
+}
+
+func TestService_Register_SanitizesFullName(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestService(t)
+
+	user, err := service.Register(ctx, "clean@example.com", "Correct-horse-9", "  Ada\x00\n\t  Lovelace\u202e ", "+15550001111", "GB")
+	require.NoError(t, err)
+	assert.Equal(t, "Ada Lovelace", user.FullName)
+
+	_, err = service.Register(ctx, "blank@example.com", "Correct-horse-9", "\x07\x1b \n ", "+15550001111", "GB")
+	assert.ErrorIs(t, err, ErrEmptyFullName, "a name of only control characters and whitespace is empty")
+}
+
+func TestService_Metrics(t *testing.T) {
+	ctx := context.Background()
+	service, metrics := newTestService(t)
+	const password = "Correct-horse-9"
+
+	_, err := service.Register(ctx, "metrics@example.com", password, "Metric User", "+15550001111", "US")
+	require.NoError(t, err)
+	assert.Equal(t, 1, metrics.registrations)
+	assert.Equal(t, 1, metrics.hashes)
+
+	// A rejected registration is not counted
+	_, err = service.Register(ctx, "metrics@example.com", password, "Metric User", "+15550001111", "US")
+	require.ErrorIs(t, err, ErrUserAlreadyExists)
+	assert.Equal(t, 1, metrics.registrations)
+
+	_, refresh, err := service.Login(ctx, "metrics@example.com", password, "test", "127.0.0.1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, metrics.loginsOK)
+
+	_, _, err = service.Login(ctx, "metrics@example.com", "wrong-password", "test", "127.0.0.1")
+	require.ErrorIs(t, err, ErrInvalidCredentials)
+	_, _, err = service.Login(ctx, "nobody@example.com", password, "test", "127.0.0.1")
+	require.ErrorIs(t, err, ErrInvalidCredentials)
+	assert.Equal(t, 2, metrics.loginsFailed)
+
+	_, _, err = service.Refresh(ctx, refresh, "test", "127.0.0.1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, metrics.refreshes)
+	assert.Zero(t, metrics.reuses)
+
+	// Presenting the rotated token again is reuse
+	_, _, err = service.Refresh(ctx, refresh, "test", "127.0.0.1")
+	require.ErrorIs(t, err, ErrInvalidToken)
+	assert.Equal(t, 1, metrics.reuses)
+	assert.Equal(t, 1, metrics.refreshes)
+}
+
+func TestService_Login_UpgradesBcryptHash(t *testing.T) {
+	ctx := context.Background()
+	service, userRepo, _ := newTestServiceWithRepo(t)
+	const password = "imported-password"
+
+	legacy, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	require.NoError(t, err)
+	user := &User{ID: uuid.New(), Email: "migrated@example.com", FullName: "Migrated User", PasswordHash: string(legacy)}
+	userRepo.users[user.Email] = user
+
+	_, _, err = service.Login(ctx, user.Email, "wrong-password", "test", "127.0.0.1")
+	require.ErrorIs(t, err, ErrInvalidCredentials)
+	assert.Equal(t, string(legacy), user.PasswordHash, "a failed login must not touch the hash")
+
+	_, _, err = service.Login(ctx, user.Email, password, "test", "127.0.0.1")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(user.PasswordHash, "$argon2id$"), "hash should be upgraded, got %q", user.PasswordHash)
+	assert.False(t, auth.NeedsRehash(user.PasswordHash))
+
+	// The upgraded hash keeps working
+	_, _, err = service.Login(ctx, user.Email, password, "test", "127.0.0.1")
+	require.NoError(t, err)
+}
@@ -0,0 +1,95 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/floroz/gavel/pkg/auth"
+	authv1 "github.com/floroz/gavel/pkg/proto/auth/v1"
+	"github.com/floroz/gavel/pkg/testhelpers"
+)
+
+func adminGetUserRequest(msg *authv1.AdminGetUserRequest, accessToken string) *connect.Request[authv1.AdminGetUserRequest] {
+	req := connect.NewRequest(msg)
+	if accessToken != "" {
+		req.Header().Set("Authorization", "Bearer "+accessToken)
+	}
+	return req
+}
+
+func TestAuth_AdminGetUser(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+
+	client, signer := startAuthServer(t, testDB.Pool, false)
+	ctx := context.Background()
+
+	target := registerAndLogin(t, client, "support-target@example.com")
+
+	// Admin permissions are granted out of band, so mint the token directly
+	adminTokens, err := signer.GenerateTokens(uuid.New(), "admin@example.com", "Support Admin", []string{auth.PermissionAdmin})
+	require.NoError(t, err)
+	adminToken := adminTokens.AccessToken
+
+	t.Run("Unauthenticated", func(t *testing.T) {
+		_, err := client.AdminGetUser(ctx, adminGetUserRequest(&authv1.AdminGetUserRequest{
+			Lookup: &authv1.AdminGetUserRequest_UserId{UserId: target.userID},
+		}, ""))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	})
+
+	t.Run("Non-admin is denied", func(t *testing.T) {
+		_, err := client.AdminGetUser(ctx, adminGetUserRequest(&authv1.AdminGetUserRequest{
+			Lookup: &authv1.AdminGetUserRequest_UserId{UserId: target.userID},
+		}, target.accessToken))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	})
+
+	t.Run("Admin by id", func(t *testing.T) {
+		res, err := client.AdminGetUser(ctx, adminGetUserRequest(&authv1.AdminGetUserRequest{
+			Lookup: &authv1.AdminGetUserRequest_UserId{UserId: target.userID},
+		}, adminToken))
+		require.NoError(t, err)
+		assert.Equal(t, target.userID, res.Msg.Id)
+		assert.Equal(t, "support-target@example.com", res.Msg.Email)
+		assert.Equal(t, "+15550001111", res.Msg.PhoneNumber)
+		assert.NotNil(t, res.Msg.CreatedAt)
+	})
+
+	t.Run("Admin by email", func(t *testing.T) {
+		res, err := client.AdminGetUser(ctx, adminGetUserRequest(&authv1.AdminGetUserRequest{
+			Lookup: &authv1.AdminGetUserRequest_Email{Email: "support-target@example.com"},
+		}, adminToken))
+		require.NoError(t, err)
+		assert.Equal(t, target.userID, res.Msg.Id)
+	})
+
+	t.Run("Unknown user", func(t *testing.T) {
+		_, err := client.AdminGetUser(ctx, adminGetUserRequest(&authv1.AdminGetUserRequest{
+			Lookup: &authv1.AdminGetUserRequest_UserId{UserId: uuid.NewString()},
+		}, adminToken))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+		_, err = client.AdminGetUser(ctx, adminGetUserRequest(&authv1.AdminGetUserRequest{
+			Lookup: &authv1.AdminGetUserRequest_Email{Email: "nobody@example.com"},
+		}, adminToken))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	})
+
+	t.Run("Missing lookup", func(t *testing.T) {
+		_, err := client.AdminGetUser(ctx, adminGetUserRequest(&authv1.AdminGetUserRequest{}, adminToken))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+}
@@ -1,7 +1,11 @@
+//go:build integration
+
 package tests
 
 import (
 	"context"
+	"fmt"
+	"sync"
 	"testing"
 
 	"connectrpc.com/connect"
@@ -69,6 +73,44 @@ func TestAuth_Flows(t *testing.T) {
 		assert.Equal(t, connect.CodeAlreadyExists, connect.CodeOf(err))
 	})
 
+	t.Run("Register_ConcurrentDuplicateEmail", func(t *testing.T) {
+		// Concurrent requests can all pass the existence check; the unique constraint decides
+		const attempts = 8
+		codes := make(chan connect.Code, attempts)
+
+		var wg sync.WaitGroup
+		for i := range attempts {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := client.Register(context.Background(), connect.NewRequest(&authv1.RegisterRequest{
+					Email:       "race@example.com",
+					Password:    "password123",
+					FullName:    fmt.Sprintf("Racer %d", i),
+					PhoneNumber: "+15553333333",
+					CountryCode: "US",
+				}))
+				if err != nil {
+					codes <- connect.CodeOf(err)
+					return
+				}
+				codes <- 0
+			}()
+		}
+		wg.Wait()
+		close(codes)
+
+		succeeded := 0
+		for code := range codes {
+			if code == 0 {
+				succeeded++
+				continue
+			}
+			assert.Equal(t, connect.CodeAlreadyExists, code)
+		}
+		assert.Equal(t, 1, succeeded)
+	})
+
 	t.Run("Register_InvalidEmail", func(t *testing.T) {
 		req := connect.NewRequest(&authv1.RegisterRequest{
 			Email:       "bad-email",
@@ -145,6 +187,35 @@ func TestAuth_Flows(t *testing.T) {
 		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
 	})
 
+	t.Run("Register_NormalizesPhoneNumber", func(t *testing.T) {
+		req := connect.NewRequest(&authv1.RegisterRequest{
+			Email:       "ukphone@example.com",
+			Password:    "password123",
+			FullName:    "UK Phone",
+			PhoneNumber: "07911 123-456",
+			CountryCode: "GB",
+		})
+		_, err := client.Register(context.Background(), req)
+		require.NoError(t, err)
+
+		user := verifyUserExists(t, pool, "ukphone@example.com")
+		require.NotNil(t, user)
+		assert.Equal(t, "+447911123456", user.PhoneNumber)
+	})
+
+	t.Run("Register_InvalidPhoneNumber", func(t *testing.T) {
+		req := connect.NewRequest(&authv1.RegisterRequest{
+			Email:       "badphone@example.com",
+			Password:    "password123",
+			FullName:    "Bad Phone",
+			PhoneNumber: "555-CALL-NOW",
+			CountryCode: "US",
+		})
+		_, err := client.Register(context.Background(), req)
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+
 	t.Run("Login_Success", func(t *testing.T) {
 		// Register first
 		email := "loginuser@example.com"
@@ -163,8 +234,9 @@ func TestAuth_Flows(t *testing.T) {
 			Email:     email,
 			Password:  password,
 			UserAgent: "TestAgent/1.0",
-			IpAddress: "127.0.0.1",
+			IpAddress: "198.51.100.66", // spoofed, must be ignored
 		})
+		loginReq.Header().Set("X-Forwarded-For", "203.0.113.7")
 		res, err := client.Login(context.Background(), loginReq)
 		require.NoError(t, err)
 		assert.NotEmpty(t, res.Msg.AccessToken)
@@ -173,6 +245,10 @@ func TestAuth_Flows(t *testing.T) {
 		// Verify Refresh Token in DB
 		user := verifyUserExists(t, pool, email)
 		require.NotNil(t, user)
+		var recordedIP string
+		require.NoError(t, pool.QueryRow(context.Background(),
+			`SELECT ip_address FROM refresh_tokens WHERE user_id = $1`, user.ID).Scan(&recordedIP))
+		assert.Equal(t, "203.0.113.7", recordedIP, "the session records the resolved client IP")
 		exists := verifyTokenExists(t, pool, user.ID)
 		assert.True(t, exists, "Refresh token should be saved")
 	})
@@ -0,0 +1,92 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/floroz/gavel/pkg/auth"
+	pb "github.com/floroz/gavel/pkg/proto"
+	authv1 "github.com/floroz/gavel/pkg/proto/auth/v1"
+	"github.com/floroz/gavel/pkg/testhelpers"
+)
+
+func deleteAccountRequest(userID, accessToken string) *connect.Request[authv1.DeleteAccountRequest] {
+	req := connect.NewRequest(&authv1.DeleteAccountRequest{UserId: userID})
+	if accessToken != "" {
+		req.Header().Set("Authorization", "Bearer "+accessToken)
+	}
+	return req
+}
+
+func TestAuth_DeleteAccount(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+
+	client, signer := startAuthServer(t, testDB.Pool, false)
+	ctx := context.Background()
+
+	countRows := func(query string, args ...any) int {
+		var n int
+		require.NoError(t, testDB.Pool.QueryRow(ctx, query, args...).Scan(&n))
+		return n
+	}
+
+	t.Run("Unauthenticated", func(t *testing.T) {
+		_, err := client.DeleteAccount(ctx, deleteAccountRequest("", ""))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	})
+
+	t.Run("Self deletion removes the user and tokens and emits user.deleted", func(t *testing.T) {
+		victim := registerAndLogin(t, client, "delete-me@example.com")
+
+		_, err := client.DeleteAccount(ctx, deleteAccountRequest("", victim.accessToken))
+		require.NoError(t, err)
+
+		assert.Zero(t, countRows("SELECT COUNT(*) FROM users WHERE id = $1", victim.userID))
+		assert.Zero(t, countRows("SELECT COUNT(*) FROM refresh_tokens WHERE user_id = $1", victim.userID))
+
+		var payload []byte
+		require.NoError(t, testDB.Pool.QueryRow(ctx,
+			"SELECT payload FROM outbox_events WHERE event_type = 'user.deleted' ORDER BY created_at DESC LIMIT 1",
+		).Scan(&payload))
+		var event pb.UserDeleted
+		require.NoError(t, proto.Unmarshal(payload, &event))
+		assert.Equal(t, victim.userID, event.UserId)
+		assert.NotNil(t, event.DeletedAt)
+
+		// The refresh token died with the account
+		_, err = client.Refresh(ctx, connect.NewRequest(&authv1.RefreshRequest{RefreshToken: victim.refreshToken}))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+
+		// A second delete finds nothing
+		_, err = client.DeleteAccount(ctx, deleteAccountRequest("", victim.accessToken))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	})
+
+	t.Run("Deleting another user requires admin", func(t *testing.T) {
+		target := registerAndLogin(t, client, "delete-target@example.com")
+		other := registerAndLogin(t, client, "delete-other@example.com")
+
+		_, err := client.DeleteAccount(ctx, deleteAccountRequest(target.userID, other.accessToken))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+		assert.Equal(t, 1, countRows("SELECT COUNT(*) FROM users WHERE id = $1", target.userID))
+
+		adminTokens, err := signer.GenerateTokens(uuid.New(), "admin@example.com", "Support Admin", []string{auth.PermissionAdmin})
+		require.NoError(t, err)
+		_, err = client.DeleteAccount(ctx, deleteAccountRequest(target.userID, adminTokens.AccessToken))
+		require.NoError(t, err)
+		assert.Zero(t, countRows("SELECT COUNT(*) FROM users WHERE id = $1", target.userID))
+	})
+}
@@ -0,0 +1,77 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/floroz/gavel/pkg/auth"
+	authv1 "github.com/floroz/gavel/pkg/proto/auth/v1"
+	"github.com/floroz/gavel/pkg/testhelpers"
+)
+
+func TestAuth_GetDisplayNames(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+
+	client, signer := startAuthServer(t, testDB.Pool, false)
+	ctx := context.Background()
+
+	first := registerAndLogin(t, client, "names-first@example.com")
+	second := registerAndLogin(t, client, "names-second@example.com")
+	unknown := uuid.NewString()
+
+	adminTokens, err := signer.GenerateTokens(uuid.New(), "admin@example.com", "Support Admin", []string{auth.PermissionAdmin})
+	require.NoError(t, err)
+	getDisplayNames := func(token string, userIDs ...string) (*authv1.GetDisplayNamesResponse, error) {
+		req := connect.NewRequest(&authv1.GetDisplayNamesRequest{UserIds: userIDs})
+		if token != "" {
+			req.Header().Set("Authorization", "Bearer "+token)
+		}
+		res, err := client.GetDisplayNames(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return res.Msg, nil
+	}
+
+	res, err := getDisplayNames(adminTokens.AccessToken, first.userID, second.userID, unknown)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		first.userID:  "Token O.",
+		second.userID: "Token O.",
+	}, res.DisplayNames)
+
+	t.Run("rejects invalid ids", func(t *testing.T) {
+		_, err := getDisplayNames(adminTokens.AccessToken, "not-a-uuid")
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+
+	t.Run("accepts service tokens", func(t *testing.T) {
+		serviceToken, _, err := signer.GenerateServiceToken("bid-service", nil, 0)
+		require.NoError(t, err)
+
+		res, err := getDisplayNames(serviceToken, first.userID)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{first.userID: "Token O."}, res.DisplayNames)
+	})
+
+	t.Run("requires authentication", func(t *testing.T) {
+		_, err := getDisplayNames("", first.userID)
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	})
+
+	t.Run("denies ordinary users", func(t *testing.T) {
+		_, err := getDisplayNames(first.accessToken, second.userID)
+		require.Error(t, err)
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	})
+}
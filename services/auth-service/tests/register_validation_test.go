@@ -0,0 +1,102 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	authv1 "github.com/floroz/gavel/pkg/proto/auth/v1"
+	"github.com/floroz/gavel/pkg/testhelpers"
+	"github.com/floroz/gavel/pkg/validation"
+)
+
+func TestAuth_Register_FieldViolations(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+
+	client, _ := setupAuthApp(t, testDB.Pool)
+
+	valid := func() *authv1.RegisterRequest {
+		return &authv1.RegisterRequest{
+			Email:       "fields@example.com",
+			Password:    "password123",
+			FullName:    "Field Test",
+			PhoneNumber: "+15550001111",
+			CountryCode: "US",
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*authv1.RegisterRequest)
+		field  string
+	}{
+		{"email", func(r *authv1.RegisterRequest) { r.Email = "nope" }, "email"},
+		{"password", func(r *authv1.RegisterRequest) { r.Password = "short" }, "password"},
+		{"full name", func(r *authv1.RegisterRequest) { r.FullName = "  " }, "full_name"},
+		{"phone", func(r *authv1.RegisterRequest) { r.PhoneNumber = "555-CALL-NOW" }, "phone_number"},
+		{"country", func(r *authv1.RegisterRequest) { r.CountryCode = "usa" }, "country_code"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := valid()
+			tt.modify(msg)
+
+			_, err := client.Register(context.Background(), connect.NewRequest(msg))
+			require.Error(t, err)
+			assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+			violations := validation.FieldViolations(err)
+			require.Len(t, violations, 1, "got %v", violations)
+			assert.Contains(t, violations, tt.field)
+		})
+	}
+}
+
+func TestAuth_Register_CountryFromLocale(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+
+	client, pool := setupAuthApp(t, testDB.Pool)
+	ctx := context.Background()
+
+	register := func(email, countryCode, locale string) error {
+		_, err := client.Register(ctx, connect.NewRequest(&authv1.RegisterRequest{
+			Email:       email,
+			Password:    "password123",
+			FullName:    "Locale Test",
+			PhoneNumber: "+15550001111",
+			CountryCode: countryCode,
+			Locale:      locale,
+		}))
+		return err
+	}
+
+	t.Run("country derived from the locale", func(t *testing.T) {
+		require.NoError(t, register("locale@example.com", "", "en-GB"))
+		user := verifyUserExists(t, pool, "locale@example.com")
+		require.NotNil(t, user)
+		assert.Equal(t, "GB", user.CountryCode)
+	})
+
+	t.Run("explicit country code overrides the locale", func(t *testing.T) {
+		require.NoError(t, register("override@example.com", "FR", "en-GB"))
+		user := verifyUserExists(t, pool, "override@example.com")
+		require.NotNil(t, user)
+		assert.Equal(t, "FR", user.CountryCode)
+	})
+
+	t.Run("invalid locale", func(t *testing.T) {
+		err := register("badlocale@example.com", "", "english")
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+		assert.Contains(t, validation.FieldViolations(err), "locale")
+		assert.Nil(t, verifyUserExists(t, pool, "badlocale@example.com"))
+	})
+}
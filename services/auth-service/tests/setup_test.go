@@ -1,3 +1,5 @@
+//go:build integration
+
 package tests
 
 import (
@@ -11,11 +13,13 @@ import (
 	"testing"
 	"time"
 
+	"connectrpc.com/connect"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/stretchr/testify/require"
 
 	"github.com/floroz/gavel/pkg/auth"
+	"github.com/floroz/gavel/pkg/clientip"
 	"github.com/floroz/gavel/pkg/database"
 	"github.com/floroz/gavel/pkg/proto/auth/v1/authv1connect"
 	"github.com/floroz/gavel/services/auth-service/internal/adapters/api"
@@ -25,11 +29,23 @@ import (
 
 // setupAuthApp wires up the application for testing using a real database connection.
 func setupAuthApp(t *testing.T, pool *pgxpool.Pool) (authv1connect.AuthServiceClient, *pgxpool.Pool) {
+	return setupAuthAppWithOptions(t, pool, false)
+}
+
+// setupAuthAppWithOptions is setupAuthApp with control over whether Logout requires an access token.
+func setupAuthAppWithOptions(t *testing.T, pool *pgxpool.Pool, logoutRequiresJWT bool) (authv1connect.AuthServiceClient, *pgxpool.Pool) {
+	client, _ := startAuthServer(t, pool, logoutRequiresJWT)
+	return client, pool
+}
+
+// startAuthServer wires the service against pool and also returns its signer,
+// so tests can mint tokens with permissions the service never issues itself.
+func startAuthServer(t *testing.T, pool *pgxpool.Pool, logoutRequiresJWT bool) (authv1connect.AuthServiceClient, *auth.Signer) {
 	// 1. Initialize Repositories
 	txManager := database.NewPostgresTransactionManager(pool, 5*time.Second)
-	userRepo := infradb.NewPostgresUserRepository(pool)
-	tokenRepo := infradb.NewPostgresTokenRepository(pool)
-	outboxRepo := infradb.NewPostgresOutboxRepository(pool)
+	userRepo := infradb.NewPostgresUserRepository(pool, database.DefaultQueryTimeout)
+	tokenRepo := infradb.NewPostgresTokenRepository(pool, database.DefaultQueryTimeout)
+	outboxRepo := infradb.NewPostgresOutboxRepository(pool, database.DefaultQueryTimeout)
 
 	// 2. Initialize Dependencies
 	// Generate ephemeral RSA keys for testing
@@ -49,15 +65,26 @@ func setupAuthApp(t *testing.T, pool *pgxpool.Pool) (authv1connect.AuthServiceCl
 		Bytes: pubBytes,
 	})
 
-	signer, err := auth.NewSigner(privPEM, pubPEM, "gavel-auth-service")
+	signer, err := auth.NewSigner(privPEM, pubPEM, "gavel-auth-service", nil)
 	require.NoError(t, err)
 
 	// 3. Initialize Service
-	authService := users.NewService(userRepo, tokenRepo, outboxRepo, signer, txManager)
+	authService := users.NewService(userRepo, tokenRepo, outboxRepo, signer, txManager, auth.DefaultPasswordPolicy(), logoutRequiresJWT, nil)
 
 	// 4. Initialize API Handler
-	authHandler := api.NewAuthServiceHandler(authService)
-	path, handler := authv1connect.NewAuthServiceHandler(authHandler)
+	authHandler := api.NewAuthServiceHandler(authService, nil)
+	publicRoutes := map[string]bool{
+		authv1connect.AuthServiceRegisterProcedure:   true,
+		authv1connect.AuthServiceLoginProcedure:      true,
+		authv1connect.AuthServiceRefreshProcedure:    true,
+		authv1connect.AuthServiceLogoutProcedure:     true,
+		authv1connect.AuthServiceGetProfileProcedure: true,
+	}
+	path, handler := authv1connect.NewAuthServiceHandler(
+		authHandler,
+		// One trusted proxy, so tests can pose as clients through X-Forwarded-For
+		connect.WithInterceptors(clientip.NewInterceptor(1), auth.NewAuthInterceptorWithPublicRoutes(signer, publicRoutes)),
+	)
 
 	// 5. Create Test Server
 	mux := http.NewServeMux()
@@ -71,7 +98,7 @@ func setupAuthApp(t *testing.T, pool *pgxpool.Pool) (authv1connect.AuthServiceCl
 		server.URL,
 	)
 
-	return client, pool
+	return client, signer
 }
 
 // verifyUserExists checks if a user exists in the database.
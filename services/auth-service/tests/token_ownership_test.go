@@ -0,0 +1,154 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	authv1 "github.com/floroz/gavel/pkg/proto/auth/v1"
+	"github.com/floroz/gavel/pkg/proto/auth/v1/authv1connect"
+	"github.com/floroz/gavel/pkg/testhelpers"
+)
+
+type session struct {
+	userID       string
+	accessToken  string
+	refreshToken string
+}
+
+// registerAndLogin creates a user and returns its first session.
+func registerAndLogin(t *testing.T, client authv1connect.AuthServiceClient, email string) session {
+	t.Helper()
+	ctx := context.Background()
+
+	reg, err := client.Register(ctx, connect.NewRequest(&authv1.RegisterRequest{
+		Email:       email,
+		Password:    "securepass",
+		FullName:    "Token Owner",
+		PhoneNumber: "+15550001111",
+		CountryCode: "US",
+	}))
+	require.NoError(t, err)
+
+	login, err := client.Login(ctx, connect.NewRequest(&authv1.LoginRequest{
+		Email:    email,
+		Password: "securepass",
+	}))
+	require.NoError(t, err)
+
+	return session{
+		userID:       reg.Msg.UserId,
+		accessToken:  login.Msg.AccessToken,
+		refreshToken: login.Msg.RefreshToken,
+	}
+}
+
+func logoutRequest(refreshToken, accessToken string) *connect.Request[authv1.LogoutRequest] {
+	req := connect.NewRequest(&authv1.LogoutRequest{RefreshToken: refreshToken})
+	if accessToken != "" {
+		req.Header().Set("Authorization", "Bearer "+accessToken)
+	}
+	return req
+}
+
+func tokenSubject(t *testing.T, accessToken string) string {
+	t.Helper()
+	claims := jwt.MapClaims{}
+	_, _, err := jwt.NewParser().ParseUnverified(accessToken, claims)
+	require.NoError(t, err)
+	sub, err := claims.GetSubject()
+	require.NoError(t, err)
+	return sub
+}
+
+func TestAuth_RefreshTokenOwnership(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+
+	client, pool := setupAuthApp(t, testDB.Pool)
+	ctx := context.Background()
+
+	n := 0
+	newSession := func(t *testing.T) session {
+		n++
+		return registerAndLogin(t, client, fmt.Sprintf("owner%d@example.com", n))
+	}
+
+	t.Run("Refresh_IssuesTokensForTokenOwner", func(t *testing.T) {
+		alice := newSession(t)
+		bob := newSession(t)
+
+		// Bob's access token on the request must not change who the refresh is for
+		req := connect.NewRequest(&authv1.RefreshRequest{RefreshToken: alice.refreshToken})
+		req.Header().Set("Authorization", "Bearer "+bob.accessToken)
+		res, err := client.Refresh(ctx, req)
+		require.NoError(t, err)
+
+		assert.Equal(t, alice.userID, tokenSubject(t, res.Msg.AccessToken))
+	})
+
+	t.Run("Refresh_ReusedTokenRevokesAllSessions", func(t *testing.T) {
+		alice := newSession(t)
+
+		rotated, err := client.Refresh(ctx, connect.NewRequest(&authv1.RefreshRequest{RefreshToken: alice.refreshToken}))
+		require.NoError(t, err)
+
+		// Replaying the rotated-out token is treated as theft
+		_, err = client.Refresh(ctx, connect.NewRequest(&authv1.RefreshRequest{RefreshToken: alice.refreshToken}))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+
+		_, err = client.Refresh(ctx, connect.NewRequest(&authv1.RefreshRequest{RefreshToken: rotated.Msg.RefreshToken}))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	})
+
+	t.Run("Logout_OtherUsersTokenIsRejected", func(t *testing.T) {
+		alice := newSession(t)
+		bob := newSession(t)
+
+		_, err := client.Logout(ctx, logoutRequest(alice.refreshToken, bob.accessToken))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+		// Alice's session survives
+		_, err = client.Refresh(ctx, connect.NewRequest(&authv1.RefreshRequest{RefreshToken: alice.refreshToken}))
+		require.NoError(t, err)
+	})
+
+	t.Run("Logout_OwnerRevokesToken", func(t *testing.T) {
+		alice := newSession(t)
+
+		_, err := client.Logout(ctx, logoutRequest(alice.refreshToken, alice.accessToken))
+		require.NoError(t, err)
+
+		_, err = client.Refresh(ctx, connect.NewRequest(&authv1.RefreshRequest{RefreshToken: alice.refreshToken}))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	})
+
+	t.Run("Logout_UnknownTokenIsNoOp", func(t *testing.T) {
+		_, err := client.Logout(ctx, logoutRequest("not-a-real-token", ""))
+		require.NoError(t, err)
+	})
+
+	t.Run("Logout_RequiresAccessTokenWhenConfigured", func(t *testing.T) {
+		// Sessions must come from the strict app, which signs with its own keys
+		strictClient, _ := setupAuthAppWithOptions(t, pool, true)
+		alice := registerAndLogin(t, strictClient, "strict@example.com")
+
+		_, err := strictClient.Logout(ctx, logoutRequest(alice.refreshToken, ""))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+
+		_, err = strictClient.Logout(ctx, logoutRequest(alice.refreshToken, alice.accessToken))
+		require.NoError(t, err)
+	})
+}
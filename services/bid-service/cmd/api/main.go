@@ -1,41 +1,71 @@
 package main
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	"log/slog"
 	"net/http"
 	"os"
+	"strconv"
+	"syscall"
 	"time"
+	_ "time/tzdata" // embed zone data: the alpine runtime image ships without it
 
 	"connectrpc.com/connect"
-	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/joho/godotenv"
-	"github.com/rabbitmq/amqp091-go"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/redis/go-redis/v9"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 
 	"github.com/floroz/gavel/pkg/auth"
+	"github.com/floroz/gavel/pkg/clients"
+	authservice "github.com/floroz/gavel/pkg/clients/auth"
+	"github.com/floroz/gavel/pkg/clock"
 	pkgdb "github.com/floroz/gavel/pkg/database"
+	"github.com/floroz/gavel/pkg/dial"
 	pkgevents "github.com/floroz/gavel/pkg/events"
+	"github.com/floroz/gavel/pkg/limits"
+	"github.com/floroz/gavel/pkg/logging"
+	"github.com/floroz/gavel/pkg/maintenance"
+	"github.com/floroz/gavel/pkg/pagination"
 	"github.com/floroz/gavel/pkg/proto/bids/v1/bidsv1connect"
+	"github.com/floroz/gavel/pkg/recovery"
+	"github.com/floroz/gavel/pkg/tracing"
 	"github.com/floroz/gavel/services/bid-service/internal/adapters/api"
+	"github.com/floroz/gavel/services/bid-service/internal/adapters/authclient"
+	"github.com/floroz/gavel/services/bid-service/internal/adapters/cache"
 	"github.com/floroz/gavel/services/bid-service/internal/adapters/database"
 	"github.com/floroz/gavel/services/bid-service/internal/domain/bids"
 	"github.com/floroz/gavel/services/bid-service/internal/domain/items"
 )
 
 func main() {
-	// Initialize structured logger
-	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
-	slog.SetDefault(logger)
-
 	// Load environment variables (local overrides .env)
 	_ = godotenv.Load(".env.local")
 	_ = godotenv.Load()
 
+	// Initialize structured logger (LOG_LEVEL, LOG_FORMAT)
+	logger := logging.NewFromEnv()
+	slog.SetDefault(logger)
+
 	ctx := context.Background()
 
+	// Tracing is a no-op unless an OTLP endpoint is configured
+	shutdownTracing, err := tracing.Setup(ctx, "bid-service")
+	if err != nil {
+		logger.Error("Failed to set up tracing", "error", err)
+		os.Exit(1)
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			logger.Error("Failed to flush traces", "error", err)
+		}
+	}()
+
 	// 1. Load JWT Public Key for token validation
 	publicKeyPath := os.Getenv("JWT_PUBLIC_KEY_PATH")
 	if publicKeyPath == "" {
@@ -56,13 +86,36 @@ func main() {
 	}
 
 	// Create signer with only public key (for validation only)
-	signer, err := auth.NewSignerFromPublicKey(publicKeyPEM, issuer)
+	signer, err := auth.NewSignerFromPublicKey(publicKeyPEM, issuer, nil)
 	if err != nil {
 		logger.Error("Failed to create signer", "error", err)
 		os.Exit(1)
 	}
+	if err := signer.SelfTest(); err != nil {
+		logger.Error("JWT key check failed", "error", err)
+		os.Exit(1)
+	}
 	logger.Info("JWT public key loaded", "path", publicKeyPath)
 
+	// Secret the public bidder pseudonyms are derived with
+	bidderLabelKey, err := readSecretKey("BIDDER_LABEL_KEY_PATH", bids.MinBidderLabelKeyLen)
+	if err != nil {
+		logger.Error("Failed to load bidder label key", "error", err)
+		os.Exit(1)
+	}
+
+	// Secret the page tokens are signed with; every replica must share it
+	pageTokenKey, err := readSecretKey("PAGE_TOKEN_KEY_PATH", pagination.MinKeyLen)
+	if err != nil {
+		logger.Error("Failed to load page token key", "error", err)
+		os.Exit(1)
+	}
+	pageTokens, err := pagination.NewCodec(pageTokenKey)
+	if err != nil {
+		logger.Error("Invalid page token key", "error", err)
+		os.Exit(1)
+	}
+
 	// 2. Initialize Postgres Connection Pool
 	dbURL := os.Getenv("BID_DB_URL")
 
@@ -71,13 +124,7 @@ func main() {
 		os.Exit(1)
 	}
 
-	dbConfig, err := pgxpool.ParseConfig(dbURL)
-	if err != nil {
-		logger.Error("Unable to parse database config", "error", err)
-		os.Exit(1)
-	}
-
-	pool, err := pgxpool.NewWithConfig(ctx, dbConfig)
+	pool, err := pkgdb.NewPool(ctx, dbURL)
 	if err != nil {
 		logger.Error("Unable to create connection pool", "error", err)
 		os.Exit(1)
@@ -90,14 +137,20 @@ func main() {
 	}
 	logger.Info("Postgres Connected")
 
+	if migrateErr := pkgdb.MigrateFromEnv(ctx, dbURL, logger); migrateErr != nil {
+		logger.Error("Unable to run database migrations", "error", migrateErr)
+		os.Exit(1)
+	}
+
 	// 2. Check RabbitMQ (Optional for API, but good for health)
-	rabbitURL := os.Getenv("RABBITMQ_URL")
-	if rabbitURL == "" {
-		logger.Error("RABBITMQ_URL is not set")
+	// RABBITMQ_URL, optionally RABBITMQ_USERNAME/RABBITMQ_PASSWORD and RABBITMQ_TLS*
+	rabbitCfg, err := dial.RabbitMQFromEnv()
+	if err != nil {
+		logger.Error("Invalid RabbitMQ configuration", "error", err)
 		os.Exit(1)
 	}
 
-	amqpConn, err := amqp091.Dial(rabbitURL)
+	amqpConn, err := rabbitCfg.Dial()
 	if err != nil {
 		logger.Error("Failed to connect to RabbitMQ", "error", err)
 		os.Exit(1)
@@ -112,54 +165,158 @@ func main() {
 	}
 	defer rabbitPublisher.Close()
 
-	// 3. Check Redis (Optional for API, but good for health)
-	redisURL := os.Getenv("REDIS_URL")
-	if redisURL != "" {
-		rdb := redis.NewClient(&redis.Options{Addr: redisURL})
-		if err := rdb.Ping(ctx).Err(); err != nil {
-			logger.Warn("Redis connection failed (API might still work)", "error", err)
+	// 3. Check Redis (Optional: used for caching, API works without it)
+	// REDIS_URL, optionally REDIS_USERNAME/REDIS_PASSWORD and REDIS_TLS*
+	redisOpts, err := dial.RedisOptionsFromEnv()
+	if err != nil {
+		logger.Error("Invalid Redis configuration", "error", err)
+		os.Exit(1)
+	}
+	var rdb *redis.Client
+	if redisOpts != nil {
+		client := redis.NewClient(redisOpts)
+		if err := client.Ping(ctx).Err(); err != nil {
+			logger.Warn("Redis connection failed, caching disabled", "error", err)
+			_ = client.Close()
 		} else {
 			logger.Info("Redis Connected")
+			rdb = client
+			defer rdb.Close()
 		}
 	}
 
 	// 4. Initialize Repositories (Infrastructure Layer)
 	txManager := pkgdb.NewPostgresTransactionManager(pool, 3*time.Second)
-	bidRepo := database.NewPostgresBidRepository(pool)
-	itemRepo := database.NewPostgresItemRepository(pool)
-	outboxRepo := database.NewPostgresOutboxRepository(pool)
+	bidRepo := database.NewPostgresBidRepository(pool, pkgdb.DefaultQueryTimeout)
+	itemRepo := cache.NewCachedItemRepository(
+		database.NewPostgresItemRepository(pool, pkgdb.DefaultQueryTimeout),
+		rdb,
+		cache.DefaultItemTTL,
+		logger,
+	)
+	outboxRepo := database.NewPostgresOutboxRepository(pool, pkgdb.DefaultQueryTimeout)
+
+	// Outbox relay (OUTBOX_BATCH_SIZE, OUTBOX_POLL_INTERVAL, OUTBOX_PUBLISH_NOW), started in step 7
+	relayCfg, err := pkgevents.RelayConfigFromEnv(pkgevents.RelayConfig{BatchSize: 10, Interval: 1 * time.Second})
+	if err != nil {
+		logger.Error("Invalid outbox relay configuration", "error", err)
+		os.Exit(1)
+	}
+	outboxRelay := pkgevents.NewOutboxRelay(
+		outboxRepo,
+		rabbitPublisher,
+		txManager,
+		relayCfg.BatchSize,
+		relayCfg.Interval,
+		pkgevents.AuctionEventsExchange,
+		nil, // no transient event types, nothing expires
+		logger,
+	)
+	// With OUTBOX_PUBLISH_NOW bid events are published on commit; the relay catches any that fail
+	var immediatePublisher bids.ImmediatePublisher
+	if relayCfg.PublishNow {
+		immediatePublisher = outboxRelay
+	}
 
 	// 5. Initialize Service (Domain Layer)
-	auctionService := bids.NewAuctionService(txManager, bidRepo, itemRepo, outboxRepo)
-	itemService := items.NewService(itemRepo)
+	var priceCache bids.PriceCache
+	if rdb != nil {
+		priceCache = cache.NewRedisPriceCache(rdb, cache.DefaultPriceTTL)
+	}
+	// MAX_BID_AMOUNT (cents) caps accepted bids; unset uses the domain default
+	var maxBidAmount int64
+	if v := os.Getenv("MAX_BID_AMOUNT"); v != "" {
+		maxBidAmount, err = strconv.ParseInt(v, 10, 64)
+		if err != nil || maxBidAmount <= 0 {
+			logger.Error("Invalid MAX_BID_AMOUNT", "value", v)
+			os.Exit(1)
+		}
+	}
+	antiSniping, err := bids.AntiSnipingFromEnv()
+	if err != nil {
+		logger.Error("Invalid anti-sniping config", "error", err)
+		os.Exit(1)
+	}
+	// One clock for the services and the handler, so they agree on whether an auction is over
+	clk := clock.Real{}
+	auctionService := bids.NewAuctionService(txManager, bidRepo, itemRepo, outboxRepo, priceCache, nil, maxBidAmount, clk, antiSniping, immediatePublisher)
+	// MAX_ITEM_IMAGES caps the images per item; unset uses the domain default
+	var maxItemImages int
+	if v := os.Getenv("MAX_ITEM_IMAGES"); v != "" {
+		maxItemImages, err = strconv.Atoi(v)
+		if err != nil || maxItemImages <= 0 {
+			logger.Error("Invalid MAX_ITEM_IMAGES", "value", v)
+			os.Exit(1)
+		}
+	}
+	// MAX_ACTIVE_LISTINGS caps each seller's live listings; unset uses the domain default
+	var maxActiveListings int
+	if v := os.Getenv("MAX_ACTIVE_LISTINGS"); v != "" {
+		maxActiveListings, err = strconv.Atoi(v)
+		if err != nil || maxActiveListings <= 0 {
+			logger.Error("Invalid MAX_ACTIVE_LISTINGS", "value", v)
+			os.Exit(1)
+		}
+	}
+	itemService := items.NewService(txManager, itemRepo, outboxRepo, maxItemImages, maxActiveListings, clk)
+
+	// 6. Bidder names for GetItemBids (Optional: AUTH_SERVICE_URL, bids are served without names if unset)
+	var bidders bids.BidderDirectory
+	if authURL := os.Getenv("AUTH_SERVICE_URL"); authURL != "" {
+		var tokens clients.TokenSource
+		if path := os.Getenv(auth.EnvServiceTokenPath); path != "" {
+			tokens = auth.NewFileTokenSource(path)
+		}
+		authClient := authservice.NewClient(&http.Client{Timeout: 2 * time.Second}, authURL, tokens)
+		bidders = authclient.NewBidderDirectory(authClient)
+	} else {
+		logger.Warn("AUTH_SERVICE_URL is not set, bidder names disabled")
+	}
 
 	// 7. Initialize API Handler (ConnectRPC) with auth interceptor
-	bidHandler := api.NewBidServiceHandler(auctionService, itemService, bidRepo)
+	bidHandler := api.NewBidServiceHandler(auctionService, itemService, bidRepo, bidders, bidderLabelKey, pageTokens, clk)
 
 	// Configure public routes (no auth required)
 	publicRoutes := map[string]bool{
-		"/bids.v1.BidService/GetItem":     true,
-		"/bids.v1.BidService/ListItems":   true,
-		"/bids.v1.BidService/GetItemBids": true,
+		"/bids.v1.BidService/GetItem":         true,
+		"/bids.v1.BidService/ListItems":       true,
+		"/bids.v1.BidService/ListEndingSoon":  true,
+		"/bids.v1.BidService/GetItemBids":     true,
+		"/bids.v1.BidService/RecordItemView":  true,
+		"/bids.v1.BidService/GetCurrentPrice": true,
+		"/bids.v1.BidService/GetWinningBid":   true,
+		"/bids.v1.BidService/ListCategories":  true,
 	}
 
+	// MAX_REQUEST_BYTES caps request messages; oversized ones get CodeResourceExhausted
+	maxRequestBytes, err := limits.MaxRequestBytesFromEnv()
+	if err != nil {
+		logger.Error("Invalid request size limit", "error", err)
+		os.Exit(1)
+	}
+	// MAINTENANCE_MODE rejects writes with CodeUnavailable; SIGUSR1 toggles it at runtime
+	maintenanceMode, err := maintenance.FromEnv()
+	if err != nil {
+		logger.Error("Invalid maintenance mode", "error", err)
+		os.Exit(1)
+	}
+	maintenanceMode.ToggleOnSignal(ctx, logger, syscall.SIGUSR1)
+	if maintenanceMode.Enabled() {
+		logger.Warn("Starting in maintenance mode, writes are rejected")
+	}
 	authInterceptor := auth.NewAuthInterceptorWithPublicRoutes(signer, publicRoutes)
 	path, handler := bidsv1connect.NewBidServiceHandler(
 		bidHandler,
-		connect.WithInterceptors(authInterceptor),
+		connect.WithInterceptors(
+			recovery.NewInterceptor(logger),
+			tracing.NewInterceptor(),
+			maintenance.NewInterceptor(maintenanceMode),
+			authInterceptor,
+		),
+		connect.WithReadMaxBytes(maxRequestBytes),
 	)
 
 	// 7. Start Outbox Relay
-	outboxRelay := pkgevents.NewOutboxRelay(
-		outboxRepo,
-		rabbitPublisher,
-		txManager,
-		10,               // batch size
-		1*time.Second,    // interval
-		"auction.events", // exchange
-		logger,
-	)
-
 	// Run relay in background
 	go func() {
 		logger.Info("Starting Outbox Relay...")
@@ -168,12 +325,21 @@ func main() {
 		}
 	}()
 
+	// Metrics are served on /metrics; the pool gauges show PlaceBid's lock contention
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		pkgdb.NewPoolCollector(pool),
+	)
+
 	mux := http.NewServeMux()
-	mux.Handle(path, handler)
+	mux.Handle(path, limits.MaxBytesHandler(handler, maxRequestBytes))
 	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 		_, _ = w.Write([]byte("OK"))
 	})
+	mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
 
 	// 7. Start Server
 	addr := ":8080"
@@ -190,3 +356,21 @@ func main() {
 		os.Exit(1)
 	}
 }
+
+// readSecretKey reads the key file named by the env variable, ignoring surrounding
+// whitespace, and checks it is at least minLen bytes
+func readSecretKey(env string, minLen int) ([]byte, error) {
+	path := os.Getenv(env)
+	if path == "" {
+		return nil, fmt.Errorf("%s is not set", env)
+	}
+	key, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	key = bytes.TrimSpace(key)
+	if len(key) < minLen {
+		return nil, fmt.Errorf("key in %s is %d bytes, at least %d are required", path, len(key), minLen)
+	}
+	return key, nil
+}
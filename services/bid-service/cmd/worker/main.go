@@ -2,27 +2,61 @@ package main
 
 import (
 	"context"
+	"errors"
 	"log/slog"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
-	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/joho/godotenv"
-	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/redis/go-redis/v9"
+	"golang.org/x/sync/errgroup"
 
+	pkgdb "github.com/floroz/gavel/pkg/database"
+	"github.com/floroz/gavel/pkg/dial"
+	pkgevents "github.com/floroz/gavel/pkg/events"
+	"github.com/floroz/gavel/pkg/lock"
+	"github.com/floroz/gavel/pkg/logging"
+	"github.com/floroz/gavel/pkg/shutdown"
+	"github.com/floroz/gavel/services/bid-service/internal/adapters/cache"
+	"github.com/floroz/gavel/services/bid-service/internal/adapters/database"
 	"github.com/floroz/gavel/services/bid-service/internal/adapters/events"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/items"
 )
 
-func main() {
-	// Initialize structured logger
-	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
-	slog.SetDefault(logger)
+// activationInterval is how often scheduled auctions are checked for a passed start time.
+// PlaceBid also opens a started auction on its first bid, so this only bounds how long
+// a started auction can be missing from listings.
+const activationInterval = 15 * time.Second
+
+// reconcileInterval is how often every item's denormalized bid totals are checked
+// against the bids table. Drift only comes from bugs or manual edits, so this is rare.
+const reconcileInterval = 6 * time.Hour
+
+// reconcileBatchSize is how many item IDs the reconciliation sweep reads at a time
+const reconcileBatchSize = 500
 
+// sweepLeaseTTL and sweepLeaseRetry control the Redis leases that keep each sweep running
+// on one worker replica at a time. A crashed leader's lease lapses after the TTL.
+const (
+	sweepLeaseTTL   = 30 * time.Second
+	sweepLeaseRetry = 10 * time.Second
+)
+
+func main() {
 	// Load environment variables (local overrides .env)
 	_ = godotenv.Load(".env.local")
 	_ = godotenv.Load()
 
+	// Initialize structured logger (LOG_LEVEL, LOG_FORMAT)
+	logger := logging.NewFromEnv()
+	slog.SetDefault(logger)
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -35,19 +69,20 @@ func main() {
 		cancel()
 	}()
 
+	shutdownTimeout, err := shutdown.TimeoutFromEnv()
+	if err != nil {
+		logger.Error("Invalid shutdown timeout", "error", err)
+		os.Exit(1)
+	}
+
 	// 1. Initialize Postgres Connection Pool
 	dbURL := os.Getenv("BID_DB_URL")
 	if dbURL == "" {
 		logger.Error("BID_DB_URL is not set")
 		os.Exit(1)
 	}
-	dbConfig, err := pgxpool.ParseConfig(dbURL)
-	if err != nil {
-		logger.Error("Unable to parse database config", "error", err)
-		os.Exit(1)
-	}
 
-	pool, err := pgxpool.NewWithConfig(ctx, dbConfig)
+	pool, err := pkgdb.NewPool(ctx, dbURL)
 	if err != nil {
 		logger.Error("Unable to create connection pool", "error", err)
 		os.Exit(1)
@@ -60,13 +95,19 @@ func main() {
 	}
 	logger.Info("Postgres Connected")
 
+	if migrateErr := pkgdb.MigrateFromEnv(ctx, dbURL, logger); migrateErr != nil {
+		logger.Error("Unable to run database migrations", "error", migrateErr)
+		os.Exit(1)
+	}
+
 	// 2. Connect to RabbitMQ
-	rabbitURL := os.Getenv("RABBITMQ_URL")
-	if rabbitURL == "" {
-		logger.Error("RABBITMQ_URL is not set")
+	// RABBITMQ_URL, optionally RABBITMQ_USERNAME/RABBITMQ_PASSWORD and RABBITMQ_TLS*
+	rabbitCfg, err := dial.RabbitMQFromEnv()
+	if err != nil {
+		logger.Error("Invalid RabbitMQ configuration", "error", err)
 		os.Exit(1)
 	}
-	amqpConn, err := amqp.Dial(rabbitURL)
+	amqpConn, err := rabbitCfg.Dial()
 	if err != nil {
 		logger.Error("Failed to connect to RabbitMQ", "error", err)
 		os.Exit(1)
@@ -74,17 +115,122 @@ func main() {
 	defer amqpConn.Close()
 	logger.Info("RabbitMQ Connected")
 
-	// 3. Initialize Producer
-	producer, err := events.NewBidEventsProducer(pool, amqpConn, logger)
+	// 3. Initialize Producer (OUTBOX_BATCH_SIZE, OUTBOX_POLL_INTERVAL)
+	relayCfg, err := pkgevents.RelayConfigFromEnv(pkgevents.RelayConfig{BatchSize: 10, Interval: 500 * time.Millisecond})
+	if err != nil {
+		logger.Error("Invalid outbox relay configuration", "error", err)
+		os.Exit(1)
+	}
+	producer, err := events.NewBidEventsProducer(pool, amqpConn, relayCfg, logger)
 	if err != nil {
 		logger.Error("Failed to create producer", "error", err)
 		os.Exit(1)
 	}
 	defer producer.Close()
 
-	logger.Info("Starting Bid Events Producer...")
-	if runErr := producer.Run(ctx); runErr != nil {
-		logger.Error("Producer failed", "error", runErr)
+	// 4. Connect to Redis (Optional: leases the sweeps to one replica and invalidates the
+	// API's item cache; without it every replica sweeps)
+	// REDIS_URL, optionally REDIS_USERNAME/REDIS_PASSWORD and REDIS_TLS*
+	redisOpts, err := dial.RedisOptionsFromEnv()
+	if err != nil {
+		logger.Error("Invalid Redis configuration", "error", err)
+		os.Exit(1)
+	}
+	var rdb *redis.Client
+	if redisOpts != nil {
+		client := redis.NewClient(redisOpts)
+		if err := client.Ping(ctx).Err(); err != nil {
+			logger.Warn("Redis connection failed, every replica will sweep", "error", err)
+			_ = client.Close()
+		} else {
+			logger.Info("Redis Connected")
+			rdb = client
+			defer rdb.Close()
+		}
+	}
+
+	// 5. Open scheduled auctions as their start time passes
+	itemService := items.NewService(
+		pkgdb.NewPostgresTransactionManager(pool, 3*time.Second),
+		cache.NewCachedItemRepository(
+			database.NewPostgresItemRepository(pool, pkgdb.DefaultQueryTimeout),
+			rdb,
+			cache.DefaultItemTTL,
+			logger,
+		),
+		database.NewPostgresOutboxRepository(pool, pkgdb.DefaultQueryTimeout),
+		0, 0, nil,
+	)
+	// Metrics are served on METRICS_ADDR (default :9091) at /metrics
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		pkgdb.NewPoolCollector(pool),
+	)
+	metricsAddr := os.Getenv("METRICS_ADDR")
+	if metricsAddr == "" {
+		metricsAddr = ":9091"
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+	metricsServer := &http.Server{
+		Addr:              metricsAddr,
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	g, gCtx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		logger.Info("Metrics server starting", "addr", metricsAddr)
+		if err := metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+		return nil
+	})
+	g.Go(func() error {
+		<-gCtx.Done()
+		shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer shutdownCancel()
+		return metricsServer.Shutdown(shutdownCtx)
+	})
+
+	g.Go(func() error {
+		return runSweep(gCtx, rdb, "bid-worker:scheduled-activation", logger, func(ctx context.Context) {
+			runScheduledActivation(ctx, itemService, logger)
+		})
+	})
+
+	// 6. Correct drifted bid counts and highest bids
+	g.Go(func() error {
+		return runSweep(gCtx, rdb, "bid-worker:reconciliation", logger, func(ctx context.Context) {
+			runReconciliation(ctx, itemService, logger)
+		})
+	})
+
+	// 7. Anonymize the bids of deleted accounts
+	userDeletedConsumer := events.NewUserDeletedConsumer(amqpConn, database.NewPostgresBidRepository(pool, pkgdb.DefaultQueryTimeout), logger)
+	g.Go(func() error {
+		// A failing consumer is logged but does not take the relay down with it
+		if runErr := userDeletedConsumer.Run(gCtx); runErr != nil {
+			logger.Error("User deletion consumer failed", "error", runErr)
+		}
+		return nil
+	})
+
+	g.Go(func() error {
+		logger.Info("Starting Bid Events Producer...")
+		return producer.Run(gCtx)
+	})
+
+	// The relay finishes its current batch and the consumer its current message before
+	// returning; wait up to shutdownTimeout for both
+	if err := shutdown.Wait(ctx, shutdownTimeout, g.Wait); err != nil {
+		if errors.Is(err, shutdown.ErrTimeout) {
+			logger.Error("Worker did not stop in time", "timeout", shutdownTimeout)
+			os.Exit(1)
+		}
+		logger.Error("Producer failed", "error", err)
 		// Run returns nil on context cancel.
 		if ctx.Err() == nil {
 			os.Exit(1)
@@ -93,3 +239,66 @@ func main() {
 
 	logger.Info("Worker stopped")
 }
+
+// runSweep runs sweep until ctx is cancelled. With Redis it runs only while this replica
+// holds the lease on key, so one replica sweeps at a time; without Redis it always runs.
+func runSweep(ctx context.Context, rdb *redis.Client, key string, logger *slog.Logger, sweep func(ctx context.Context)) error {
+	if rdb == nil {
+		sweep(ctx)
+		return nil
+	}
+	lease := lock.NewLease(rdb, key, sweepLeaseTTL)
+	return lock.RunAsLeader(ctx, lease, sweepLeaseRetry, logger, func(ctx context.Context) error {
+		sweep(ctx)
+		return nil
+	})
+}
+
+// runScheduledActivation activates started auctions every activationInterval until ctx is cancelled
+func runScheduledActivation(ctx context.Context, itemService *items.Service, logger *slog.Logger) {
+	ticker := time.NewTicker(activationInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := itemService.ActivateScheduledItems(ctx)
+			if err != nil {
+				logger.Error("Failed to activate scheduled items", "error", err)
+				continue
+			}
+			if n > 0 {
+				logger.Info("Activated scheduled items", "count", n)
+			}
+		}
+	}
+}
+
+// runReconciliation sweeps every item's bid totals every reconcileInterval until ctx is cancelled
+func runReconciliation(ctx context.Context, itemService *items.Service, logger *slog.Logger) {
+	ticker := time.NewTicker(reconcileInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			drifted, err := itemService.ReconcileAllItems(ctx, reconcileBatchSize)
+			for _, rec := range drifted {
+				logger.Warn("Corrected drifted item bid totals",
+					"item_id", rec.ItemID,
+					"stored_bid_count", rec.Stored.Count,
+					"stored_highest_bid", rec.Stored.HighestBid,
+					"bid_count", rec.Actual.Count,
+					"highest_bid", rec.Actual.HighestBid,
+				)
+			}
+			if err != nil {
+				logger.Error("Item reconciliation failed", "error", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package api
+
+import (
+	"connectrpc.com/connect"
+
+	"github.com/floroz/gavel/services/bid-service/internal/domain"
+)
+
+// connectCodes maps each domain error code to the Connect code handlers answer with
+var connectCodes = map[domain.Code]connect.Code{
+	domain.CodeNotFound:          connect.CodeNotFound,
+	domain.CodeConflict:          connect.CodeAborted,
+	domain.CodeInvalidInput:      connect.CodeInvalidArgument,
+	domain.CodePermissionDenied:  connect.CodePermissionDenied,
+	domain.CodePrecondition:      connect.CodeFailedPrecondition,
+	domain.CodeResourceExhausted: connect.CodeResourceExhausted,
+	domain.CodeAlreadyExists:     connect.CodeAlreadyExists,
+}
+
+// connectError maps a service error to a Connect error by its domain code.
+// Anything without one (database failures, bugs) is internal.
+func connectError(err error) error {
+	code, ok := connectCodes[domain.CodeOf(err)]
+	if !ok {
+		code = connect.CodeInternal
+	}
+	return connect.NewError(code, err)
+}
@@ -0,0 +1,75 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/floroz/gavel/services/bid-service/internal/domain"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/bids"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/items"
+)
+
+func TestConnectError_MapsEveryDomainCode(t *testing.T) {
+	tests := []struct {
+		code domain.Code
+		want connect.Code
+	}{
+		{domain.CodeNotFound, connect.CodeNotFound},
+		{domain.CodeConflict, connect.CodeAborted},
+		{domain.CodeInvalidInput, connect.CodeInvalidArgument},
+		{domain.CodePermissionDenied, connect.CodePermissionDenied},
+		{domain.CodePrecondition, connect.CodeFailedPrecondition},
+		{domain.CodeResourceExhausted, connect.CodeResourceExhausted},
+		{domain.CodeAlreadyExists, connect.CodeAlreadyExists},
+		{domain.CodeUnknown, connect.CodeInternal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.code.String(), func(t *testing.T) {
+			err := connectError(domain.NewError(tt.code, "boom"))
+			assert.Equal(t, tt.want, connect.CodeOf(err))
+		})
+	}
+	assert.Len(t, connectCodes, len(tests)-1, "every domain code except unknown needs a mapping")
+}
+
+func TestConnectError_Sentinels(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want connect.Code
+	}{
+		{"item not found", items.ErrItemNotFound, connect.CodeNotFound},
+		{"bid not found", bids.ErrBidNotFound, connect.CodeNotFound},
+		{"wrapped item not found", fmt.Errorf("item not found: %w", items.ErrItemNotFound), connect.CodeNotFound},
+		{"not the owner", items.ErrUnauthorized, connect.CodePermissionDenied},
+		{"seller bidding", bids.ErrSellerCannotBid, connect.CodePermissionDenied},
+		{"bid too low", bids.ErrBidTooLow, connect.CodeFailedPrecondition},
+		{"duplicate bid", bids.ErrDuplicateBid, connect.CodeAlreadyExists},
+		{"auction ended", bids.ErrAuctionEnded, connect.CodeFailedPrecondition},
+		{"cannot cancel", items.ErrCannotCancel, connect.CodeFailedPrecondition},
+		{"cannot pause", items.ErrCannotPause, connect.CodeFailedPrecondition},
+		{"invalid bid amount", bids.ErrInvalidBidAmount, connect.CodeInvalidArgument},
+		{"off bid step", bids.ErrInvalidBidStep, connect.CodeInvalidArgument},
+		{"end time not later", bids.ErrEndAtNotLater, connect.CodeInvalidArgument},
+		{"invalid category", items.ErrInvalidCategory, connect.CodeInvalidArgument},
+		{"too many images", items.ErrTooManyImages, connect.CodeInvalidArgument},
+		{"listing limit", items.ErrListingLimitReached, connect.CodeResourceExhausted},
+		{"highest bid changed", items.ErrHighestBidChanged, connect.CodeAborted},
+		{"plain error", errors.New("connection refused"), connect.CodeInternal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := connectError(tt.err)
+			assert.Equal(t, tt.want, connect.CodeOf(err))
+
+			var connectErr *connect.Error
+			require.ErrorAs(t, err, &connectErr)
+			assert.ErrorIs(t, connectErr, tt.err, "the original error stays in the chain")
+		})
+	}
+}
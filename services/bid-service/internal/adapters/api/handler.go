@@ -3,30 +3,61 @@ package api
 import (
 	"context"
 	"errors"
+	"fmt"
+	"log/slog"
 	"time"
 
 	"connectrpc.com/connect"
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"github.com/floroz/gavel/pkg/auth"
+	"github.com/floroz/gavel/pkg/clock"
+	"github.com/floroz/gavel/pkg/flags"
+	"github.com/floroz/gavel/pkg/pagination"
 	bidsv1 "github.com/floroz/gavel/pkg/proto/bids/v1"
 	"github.com/floroz/gavel/pkg/proto/bids/v1/bidsv1connect"
+	"github.com/floroz/gavel/pkg/validation"
+	"github.com/floroz/gavel/services/bid-service/internal/domain"
 	"github.com/floroz/gavel/services/bid-service/internal/domain/bids"
 	"github.com/floroz/gavel/services/bid-service/internal/domain/items"
 )
 
+// Feature flags gating RPCs that are rolled out dark (see pkg/flags)
+const (
+	flagBuyNow = "BUY_NOW"
+)
+
+// errFeatureDisabled answers an RPC whose feature flag is off as if it did not exist yet
+func errFeatureDisabled(rpc string) error {
+	return connect.NewError(connect.CodeUnimplemented, fmt.Errorf("%s is not enabled", rpc))
+}
+
 type BidServiceHandler struct {
 	bidsv1connect.UnimplementedBidServiceHandler
 	auctionService *bids.AuctionService
 	itemService    *items.Service
 	bidRepo        bids.BidRepository
+	bidders        bids.BidderDirectory
+	bidderLabelKey []byte
+	pageTokens     *pagination.Codec
+	clock          clock.Clock
 }
 
-func NewBidServiceHandler(auctionService *bids.AuctionService, itemService *items.Service, bidRepo bids.BidRepository) *BidServiceHandler {
+// NewBidServiceHandler creates the handler.
+// bidders may be nil, in which case GetItemBids never includes bidder names.
+// bidderLabelKey is the secret the public bidder pseudonyms are derived with.
+// pageTokens signs the keyset page tokens, so clients cannot edit the position in them.
+// clk should be the clock the services use; nil uses the wall clock.
+func NewBidServiceHandler(auctionService *bids.AuctionService, itemService *items.Service, bidRepo bids.BidRepository, bidders bids.BidderDirectory, bidderLabelKey []byte, pageTokens *pagination.Codec, clk clock.Clock) *BidServiceHandler {
 	return &BidServiceHandler{
 		auctionService: auctionService,
 		itemService:    itemService,
 		bidRepo:        bidRepo,
+		bidders:        bidders,
+		bidderLabelKey: bidderLabelKey,
+		pageTokens:     pageTokens,
+		clock:          clock.OrReal(clk),
 	}
 }
 
@@ -53,26 +84,9 @@ func (h *BidServiceHandler) PlaceBid(
 	}
 
 	// 3. Execution
-	bid, err := h.auctionService.PlaceBid(ctx, cmd)
+	bid, item, err := h.auctionService.PlaceBid(ctx, cmd)
 	if err != nil {
-		if errors.Is(err, bids.ErrBidTooLow) || errors.Is(err, bids.ErrAuctionEnded) {
-			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
-		}
-		if errors.Is(err, bids.ErrInvalidBidAmount) {
-			return nil, connect.NewError(connect.CodeInvalidArgument, err)
-		}
-		if errors.Is(err, bids.ErrSellerCannotBid) {
-			return nil, connect.NewError(connect.CodePermissionDenied, err)
-		}
-		// Check for "item not found" wrapped error
-		// Since we wrap it with fmt.Errorf("item not found: %w", err), checking string or unwrapping is needed.
-		// A cleaner way is to have a typed error for ItemNotFound in the domain.
-		// For now, simple string check or if the service returned a sentinel.
-		// The service currently returns `fmt.Errorf("item not found: %w", err)`.
-		// Let's rely on the error string for this specific case as per current implementation,
-		// or better, let's assume standard error wrapping.
-		// Ideally, we should define ErrItemNotFound in domain/bids.
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, connectError(err)
 	}
 
 	// 4. Response Mapping
@@ -84,11 +98,105 @@ func (h *BidServiceHandler) PlaceBid(
 			Amount:    bid.Amount,
 			CreatedAt: bid.CreatedAt.Format(time.RFC3339),
 		},
+		Item: mapItemToProto(item, h.clock.Now()),
 	}
 
 	return connect.NewResponse(res), nil
 }
 
+// BuyNow buys an item outright at its buy-now price, ending the auction
+func (h *BidServiceHandler) BuyNow(
+	ctx context.Context,
+	req *connect.Request[bidsv1.BuyNowRequest],
+) (*connect.Response[bidsv1.BuyNowResponse], error) {
+	if !flags.Enabled(flagBuyNow) {
+		return nil, errFeatureDisabled("BuyNow")
+	}
+
+	userID, err := uuid.Parse(auth.MustGetUserID(ctx))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("invalid user_id in token"))
+	}
+
+	itemID, err := uuid.Parse(req.Msg.ItemId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid item_id"))
+	}
+
+	bid, item, err := h.auctionService.BuyNow(ctx, bids.BuyNowCommand{ItemID: itemID, UserID: userID})
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	res := &bidsv1.BuyNowResponse{
+		Bid: &bidsv1.Bid{
+			Id:        bid.ID.String(),
+			ItemId:    bid.ItemID.String(),
+			UserId:    bid.UserID.String(),
+			Amount:    bid.Amount,
+			CreatedAt: bid.CreatedAt.Format(time.RFC3339),
+		},
+		Item: mapItemToProto(item, h.clock.Now()),
+	}
+	return connect.NewResponse(res), nil
+}
+
+// GetBid retrieves a single bid
+// Only the bidder and the seller of the item can view it
+func (h *BidServiceHandler) GetBid(
+	ctx context.Context,
+	req *connect.Request[bidsv1.GetBidRequest],
+) (*connect.Response[bidsv1.GetBidResponse], error) {
+	// Get user ID from context (auth required)
+	userID, err := uuid.Parse(auth.MustGetUserID(ctx))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("invalid user_id in token"))
+	}
+
+	bidID, err := uuid.Parse(req.Msg.BidId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid bid_id"))
+	}
+
+	bid, err := h.auctionService.GetBid(ctx, bidID, userID)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	res := &bidsv1.GetBidResponse{
+		Bid: &bidsv1.Bid{
+			Id:        bid.ID.String(),
+			ItemId:    bid.ItemID.String(),
+			UserId:    bid.UserID.String(),
+			Amount:    bid.Amount,
+			CreatedAt: bid.CreatedAt.Format(time.RFC3339),
+		},
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+// Request parsing errors reported against CreateItem fields
+var (
+	errInvalidEndAtFormat   = errors.New("invalid end_at format")
+	errInvalidStartAtFormat = errors.New("invalid start_at format")
+)
+
+// createItemFields maps CreateItem validation failures to the request field at fault
+var createItemFields = []validation.Field{
+	{Err: items.ErrInvalidStartPrice, Field: "start_price"},
+	{Err: items.ErrInvalidBuyNow, Field: "buy_now_price"},
+	{Err: items.ErrNegativeBidStep, Field: "bid_step"},
+	{Err: items.ErrInvalidEndTime, Field: "end_at"},
+	{Err: errInvalidEndAtFormat, Field: "end_at"},
+	{Err: items.ErrInvalidStartTime, Field: "start_at"},
+	{Err: errInvalidStartAtFormat, Field: "start_at"},
+	{Err: items.ErrInvalidTimezone, Field: "end_at_timezone"},
+	{Err: items.ErrTooManyImages, Field: "images"},
+	{Err: items.ErrInvalidImageURL, Field: "images"},
+	{Err: items.ErrInvalidCategory, Field: "category"},
+}
+
 // CreateItem creates a new auction item
 func (h *BidServiceHandler) CreateItem(
 	ctx context.Context,
@@ -100,35 +208,56 @@ func (h *BidServiceHandler) CreateItem(
 		return nil, connect.NewError(connect.CodeInternal, errors.New("invalid user_id in token"))
 	}
 
-	// Parse end time
-	endAt, err := time.Parse(time.RFC3339, req.Msg.EndAt)
+	// Parse end time, resolving local wall-clock times in the seller's zone
+	endAt, err := items.ParseEndAt(req.Msg.EndAt, req.Msg.EndAtTimezone)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid end_at format"))
+		if errors.Is(err, items.ErrInvalidTimezone) {
+			return nil, validation.InvalidArgument(err, createItemFields...)
+		}
+		return nil, validation.InvalidArgument(errInvalidEndAtFormat, createItemFields...)
+	}
+
+	// Start time is optional and shares the end time's zone
+	var startAt time.Time
+	if req.Msg.StartAt != "" {
+		startAt, err = items.ParseEndAt(req.Msg.StartAt, req.Msg.EndAtTimezone)
+		if err != nil {
+			return nil, validation.InvalidArgument(errInvalidStartAtFormat, createItemFields...)
+		}
 	}
 
 	// Create command
 	cmd := items.CreateItemCommand{
-		Title:       req.Msg.Title,
-		Description: req.Msg.Description,
-		StartPrice:  req.Msg.StartPrice,
-		EndAt:       endAt,
-		Images:      req.Msg.Images,
-		Category:    req.Msg.Category,
-		SellerID:    userID,
+		Title:         req.Msg.Title,
+		Description:   req.Msg.Description,
+		StartPrice:    req.Msg.StartPrice,
+		BuyNowPrice:   req.Msg.BuyNowPrice,
+		BidStep:       req.Msg.BidStep,
+		StartAt:       startAt,
+		EndAt:         endAt,
+		EndAtTimezone: req.Msg.EndAtTimezone,
+		Images:        req.Msg.Images,
+		Category:      req.Msg.Category,
+		SellerID:      userID,
+		ValidateOnly:  req.Msg.ValidateOnly,
 	}
 
 	// Execute
 	item, err := h.itemService.CreateItem(ctx, cmd)
 	if err != nil {
-		if errors.Is(err, items.ErrInvalidStartPrice) || errors.Is(err, items.ErrInvalidEndTime) {
-			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		if domain.CodeOf(err) == domain.CodeInvalidInput {
+			return nil, validation.InvalidArgument(err, createItemFields...)
 		}
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, connectError(err)
 	}
 
 	// Map to proto
 	res := &bidsv1.CreateItemResponse{
-		Item: mapItemToProto(item),
+		Item: mapItemToProto(item, h.clock.Now()),
+	}
+	if req.Msg.ValidateOnly {
+		// Nothing was saved, so there is no id to look the item up by
+		res.Item.Id = ""
 	}
 
 	return connect.NewResponse(res), nil
@@ -144,20 +273,21 @@ func (h *BidServiceHandler) GetItem(
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid id"))
 	}
+	if err := validateItemReadMask(req.Msg.ReadMask); err != nil {
+		return nil, validation.InvalidArgument(err, readMaskField)
+	}
 
 	// Execute
 	item, err := h.itemService.GetItem(ctx, itemID)
 	if err != nil {
-		if errors.Is(err, items.ErrItemNotFound) {
-			return nil, connect.NewError(connect.CodeNotFound, err)
-		}
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, connectError(err)
 	}
 
 	// Map to proto
 	res := &bidsv1.GetItemResponse{
-		Item: mapItemToProto(item),
+		Item: mapItemToProto(item, h.clock.Now()),
 	}
+	applyItemReadMask(res.Item, req.Msg.ReadMask)
 
 	return connect.NewResponse(res), nil
 }
@@ -167,31 +297,98 @@ func (h *BidServiceHandler) ListItems(
 	ctx context.Context,
 	req *connect.Request[bidsv1.ListItemsRequest],
 ) (*connect.Response[bidsv1.ListItemsResponse], error) {
-	// Use page_size for limit (default 20)
-	// Note: page_token is not implemented yet - would require cursor-based pagination
+	after, err := h.decodeItemPageToken(req.Msg.PageToken)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := validateItemReadMask(req.Msg.ReadMask); err != nil {
+		return nil, validation.InvalidArgument(err, readMaskField)
+	}
+
 	limit := int(req.Msg.PageSize)
 	if limit <= 0 {
 		limit = 20
 	}
 
-	// Execute (using offset 0 for now - proper pagination would decode page_token)
+	// Fetch one extra row to learn whether another page exists
 	itemList, err := h.itemService.ListItems(ctx, items.ListItemsQuery{
-		Limit:  limit,
-		Offset: 0,
+		Limit: limit + 1,
+		After: after,
 	})
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	// Map to proto
-	protoItems := make([]*bidsv1.Item, len(itemList))
+	res := &bidsv1.ListItemsResponse{}
+	if len(itemList) > limit {
+		itemList = itemList[:limit]
+		res.NextPageToken, err = h.encodeItemPageToken(items.CursorAfter(itemList[limit-1]))
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+	now := h.clock.Now()
+	res.Items = make([]*bidsv1.Item, len(itemList))
 	for i, item := range itemList {
-		protoItems[i] = mapItemToProto(item)
+		res.Items[i] = mapItemToProto(item, now)
+		applyItemReadMask(res.Items[i], req.Msg.ReadMask)
 	}
 
-	res := &bidsv1.ListItemsResponse{
-		Items: protoItems,
-		// TODO: Implement next_page_token for cursor-based pagination
+	return connect.NewResponse(res), nil
+}
+
+// Window bounds for ListEndingSoon
+const (
+	defaultEndingSoonWindow = time.Hour
+	maxEndingSoonWindow     = 7 * 24 * time.Hour
+	maxEndingSoonPageSize   = 100
+)
+
+// ListEndingSoon retrieves active items closing within the requested window, soonest first
+func (h *BidServiceHandler) ListEndingSoon(
+	ctx context.Context,
+	req *connect.Request[bidsv1.ListEndingSoonRequest],
+) (*connect.Response[bidsv1.ListEndingSoonResponse], error) {
+	within := defaultEndingSoonWindow
+	if req.Msg.WithinSeconds < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, items.ErrInvalidWindow)
+	}
+	if req.Msg.WithinSeconds > 0 {
+		within = min(time.Duration(req.Msg.WithinSeconds)*time.Second, maxEndingSoonWindow)
+	}
+
+	offset, err := decodeOffsetPageToken(req.Msg.PageToken)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	limit := int(req.Msg.PageSize)
+	if limit <= 0 {
+		limit = 20
+	}
+	if limit > maxEndingSoonPageSize {
+		limit = maxEndingSoonPageSize
+	}
+
+	// Fetch one extra row to learn whether another page exists
+	itemList, err := h.itemService.ListItemsEndingSoon(ctx, items.ListItemsEndingSoonQuery{
+		Within: within,
+		Limit:  limit + 1,
+		Offset: offset,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	res := &bidsv1.ListEndingSoonResponse{}
+	if len(itemList) > limit {
+		itemList = itemList[:limit]
+		res.NextPageToken = encodeOffsetPageToken(offset + limit)
+	}
+	now := h.clock.Now()
+	res.Items = make([]*bidsv1.Item, len(itemList))
+	for i, item := range itemList {
+		res.Items[i] = mapItemToProto(item, now)
 	}
 
 	return connect.NewResponse(res), nil
@@ -225,9 +422,10 @@ func (h *BidServiceHandler) ListSellerItems(
 	}
 
 	// Map to proto
+	now := h.clock.Now()
 	protoItems := make([]*bidsv1.Item, len(itemList))
 	for i, item := range itemList {
-		protoItems[i] = mapItemToProto(item)
+		protoItems[i] = mapItemToProto(item, now)
 	}
 
 	res := &bidsv1.ListSellerItemsResponse{
@@ -238,6 +436,153 @@ func (h *BidServiceHandler) ListSellerItems(
 	return connect.NewResponse(res), nil
 }
 
+// maxUserBidsPageSize caps ListUserBids pages
+const maxUserBidsPageSize = 200
+
+// ListUserBids retrieves the bids a user placed. Callers see their own bids;
+// admins may name any user.
+func (h *BidServiceHandler) ListUserBids(
+	ctx context.Context,
+	req *connect.Request[bidsv1.ListUserBidsRequest],
+) (*connect.Response[bidsv1.ListUserBidsResponse], error) {
+	userID, err := uuid.Parse(auth.MustGetUserID(ctx))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("invalid user_id in token"))
+	}
+	if req.Msg.UserId != "" {
+		requested, err := uuid.Parse(req.Msg.UserId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid user_id"))
+		}
+		if requested != userID {
+			if err := auth.RequirePermission(ctx, auth.PermissionAdmin); err != nil {
+				return nil, err
+			}
+			userID = requested
+		}
+	}
+
+	offset, err := decodeOffsetPageToken(req.Msg.PageToken)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	limit := int(req.Msg.PageSize)
+	if limit <= 0 {
+		limit = 20
+	}
+	if limit > maxUserBidsPageSize {
+		limit = maxUserBidsPageSize
+	}
+
+	// Fetch one extra row to learn whether another page exists
+	bidList, err := h.bidRepo.ListBidsByUser(ctx, userID, limit+1, offset)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	res := &bidsv1.ListUserBidsResponse{}
+	if len(bidList) > limit {
+		bidList = bidList[:limit]
+		res.NextPageToken = encodeOffsetPageToken(offset + limit)
+	}
+	res.Bids = make([]*bidsv1.Bid, len(bidList))
+	for i, bid := range bidList {
+		res.Bids[i] = &bidsv1.Bid{
+			Id:        bid.ID.String(),
+			ItemId:    bid.ItemID.String(),
+			UserId:    bid.UserID.String(),
+			Amount:    bid.Amount,
+			CreatedAt: bid.CreatedAt.Format(time.RFC3339),
+		}
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+// maxWonAuctionsPageSize caps ListWonAuctions pages
+const maxWonAuctionsPageSize = 100
+
+// ListWonAuctions retrieves the finished auctions the authenticated user won
+func (h *BidServiceHandler) ListWonAuctions(
+	ctx context.Context,
+	req *connect.Request[bidsv1.ListWonAuctionsRequest],
+) (*connect.Response[bidsv1.ListWonAuctionsResponse], error) {
+	userID, err := uuid.Parse(auth.MustGetUserID(ctx))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("invalid user_id in token"))
+	}
+
+	offset, err := decodeOffsetPageToken(req.Msg.PageToken)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	limit := int(req.Msg.PageSize)
+	if limit <= 0 {
+		limit = 20
+	}
+	if limit > maxWonAuctionsPageSize {
+		limit = maxWonAuctionsPageSize
+	}
+
+	// Fetch one extra row to learn whether another page exists
+	won, err := h.bidRepo.ListWonItemsByUser(ctx, userID, limit+1, offset)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	res := &bidsv1.ListWonAuctionsResponse{}
+	if len(won) > limit {
+		won = won[:limit]
+		res.NextPageToken = encodeOffsetPageToken(offset + limit)
+	}
+	now := h.clock.Now()
+	res.Auctions = make([]*bidsv1.WonAuction, len(won))
+	for i, w := range won {
+		res.Auctions[i] = &bidsv1.WonAuction{
+			Item: mapItemToProto(w.Item, now),
+			WinningBid: &bidsv1.Bid{
+				Id:        w.WinningBid.ID.String(),
+				ItemId:    w.WinningBid.ItemID.String(),
+				UserId:    w.WinningBid.UserID.String(),
+				Amount:    w.WinningBid.Amount,
+				CreatedAt: w.WinningBid.CreatedAt.Format(time.RFC3339),
+			},
+		}
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+// GetSellerDashboard returns listing aggregates for the authenticated seller
+func (h *BidServiceHandler) GetSellerDashboard(
+	ctx context.Context,
+	req *connect.Request[bidsv1.GetSellerDashboardRequest],
+) (*connect.Response[bidsv1.GetSellerDashboardResponse], error) {
+	// Get user ID from context (auth required)
+	userID, err := uuid.Parse(auth.MustGetUserID(ctx))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("invalid user_id in token"))
+	}
+
+	dashboard, err := h.itemService.GetSellerDashboard(ctx, userID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	res := &bidsv1.GetSellerDashboardResponse{
+		SellerId:          dashboard.SellerID.String(),
+		ActiveListings:    dashboard.ActiveListings,
+		TotalBidsReceived: dashboard.TotalBidsReceived,
+	}
+	if dashboard.HighestValuedItem != nil {
+		res.HighestValuedItem = mapItemToProto(dashboard.HighestValuedItem, h.clock.Now())
+	}
+
+	return connect.NewResponse(res), nil
+}
+
 // UpdateItem updates an item's editable fields
 func (h *BidServiceHandler) UpdateItem(
 	ctx context.Context,
@@ -255,13 +600,14 @@ func (h *BidServiceHandler) UpdateItem(
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid id"))
 	}
 
+	if err := rejectImmutableFields(req.Msg); err != nil {
+		return nil, err
+	}
+
 	// First get the existing item to preserve fields that aren't being updated
 	existingItem, err := h.itemService.GetItem(ctx, itemID)
 	if err != nil {
-		if errors.Is(err, items.ErrItemNotFound) {
-			return nil, connect.NewError(connect.CodeNotFound, err)
-		}
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, connectError(err)
 	}
 
 	// Create command with optional fields
@@ -286,34 +632,56 @@ func (h *BidServiceHandler) UpdateItem(
 	}
 
 	cmd := items.UpdateItemCommand{
-		ItemID:      itemID,
-		UserID:      userID,
-		Title:       title,
-		Description: description,
-		Images:      images,
-		Category:    category,
+		ItemID:        itemID,
+		UserID:        userID,
+		Title:         title,
+		Description:   description,
+		Images:        images,
+		Category:      category,
+		EndAtTimezone: req.Msg.EndAtTimezone,
 	}
 
 	// Execute
 	item, err := h.itemService.UpdateItem(ctx, cmd)
 	if err != nil {
-		if errors.Is(err, items.ErrItemNotFound) {
-			return nil, connect.NewError(connect.CodeNotFound, err)
-		}
-		if errors.Is(err, items.ErrUnauthorized) {
-			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		if domain.CodeOf(err) == domain.CodeInvalidInput {
+			return nil, validation.InvalidArgument(err, createItemFields...)
 		}
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, connectError(err)
 	}
 
 	// Map to proto
 	res := &bidsv1.UpdateItemResponse{
-		Item: mapItemToProto(item),
+		Item: mapItemToProto(item, h.clock.Now()),
 	}
 
 	return connect.NewResponse(res), nil
 }
 
+// rejectImmutableFields fails an UpdateItem request that sets anything outside
+// items.EditableFields. Fields this server's proto doesn't define, as sent by a client
+// built against a newer one, are rejected too rather than silently dropped.
+func rejectImmutableFields(msg *bidsv1.UpdateItemRequest) error {
+	var errs []error
+	var fields []validation.Field
+	msg.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		name := string(fd.Name())
+		if name != "id" && !items.EditableFields[name] {
+			fieldErr := fmt.Errorf("%w: %s", items.ErrImmutableField, name)
+			errs = append(errs, fieldErr)
+			fields = append(fields, validation.Field{Err: fieldErr, Field: name})
+		}
+		return true
+	})
+	if len(msg.ProtoReflect().GetUnknown()) > 0 {
+		errs = append(errs, fmt.Errorf("%w: unrecognized field", items.ErrImmutableField))
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return validation.InvalidArgument(errors.Join(errs...), fields...)
+}
+
 // CancelItem cancels an auction item
 func (h *BidServiceHandler) CancelItem(
 	ctx context.Context,
@@ -340,26 +708,141 @@ func (h *BidServiceHandler) CancelItem(
 	// Execute
 	item, err := h.itemService.CancelItem(ctx, cmd)
 	if err != nil {
-		if errors.Is(err, items.ErrItemNotFound) {
-			return nil, connect.NewError(connect.CodeNotFound, err)
-		}
-		if errors.Is(err, items.ErrUnauthorized) {
-			return nil, connect.NewError(connect.CodePermissionDenied, err)
-		}
-		if errors.Is(err, items.ErrCannotCancel) {
-			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
-		}
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, connectError(err)
 	}
 
 	// Map to proto and return
 	res := &bidsv1.CancelItemResponse{
-		Item: mapItemToProto(item),
+		Item: mapItemToProto(item, h.clock.Now()),
 	}
 	return connect.NewResponse(res), nil
 }
 
-// GetItemBids retrieves all bids for an item
+// BulkCancelItems cancels every cancellable item of the caller and reports the items it skipped
+func (h *BidServiceHandler) BulkCancelItems(
+	ctx context.Context,
+	req *connect.Request[bidsv1.BulkCancelItemsRequest],
+) (*connect.Response[bidsv1.BulkCancelItemsResponse], error) {
+	userID, err := uuid.Parse(auth.MustGetUserID(ctx))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("invalid user_id in token"))
+	}
+
+	result, err := h.itemService.BulkCancelItems(ctx, userID)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	res := &bidsv1.BulkCancelItemsResponse{
+		CancelledCount:   int32(len(result.Cancelled)),
+		SkippedCount:     int32(len(result.Skipped)),
+		CancelledItemIds: make([]string, 0, len(result.Cancelled)),
+		SkippedItemIds:   make([]string, 0, len(result.Skipped)),
+	}
+	for _, item := range result.Cancelled {
+		res.CancelledItemIds = append(res.CancelledItemIds, item.ID.String())
+	}
+	for _, item := range result.Skipped {
+		res.SkippedItemIds = append(res.SkippedItemIds, item.ID.String())
+	}
+	return connect.NewResponse(res), nil
+}
+
+// PauseItem suspends bidding on an item (seller or admin)
+func (h *BidServiceHandler) PauseItem(
+	ctx context.Context,
+	req *connect.Request[bidsv1.PauseItemRequest],
+) (*connect.Response[bidsv1.PauseItemResponse], error) {
+	userID, err := uuid.Parse(auth.MustGetUserID(ctx))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("invalid user_id in token"))
+	}
+
+	itemID, err := uuid.Parse(req.Msg.ItemId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid item_id"))
+	}
+
+	item, err := h.itemService.PauseItem(ctx, items.PauseItemCommand{
+		ItemID:  itemID,
+		UserID:  userID,
+		AsAdmin: auth.HasPermission(ctx, auth.PermissionAdmin),
+	})
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse(&bidsv1.PauseItemResponse{Item: mapItemToProto(item, h.clock.Now())}), nil
+}
+
+// ResumeItem reopens a paused item for bids (seller or admin)
+func (h *BidServiceHandler) ResumeItem(
+	ctx context.Context,
+	req *connect.Request[bidsv1.ResumeItemRequest],
+) (*connect.Response[bidsv1.ResumeItemResponse], error) {
+	userID, err := uuid.Parse(auth.MustGetUserID(ctx))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("invalid user_id in token"))
+	}
+
+	itemID, err := uuid.Parse(req.Msg.ItemId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid item_id"))
+	}
+
+	item, err := h.itemService.ResumeItem(ctx, items.ResumeItemCommand{
+		ItemID:      itemID,
+		UserID:      userID,
+		AsAdmin:     auth.HasPermission(ctx, auth.PermissionAdmin),
+		ExtendEndAt: req.Msg.ExtendEndAt,
+	})
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse(&bidsv1.ResumeItemResponse{Item: mapItemToProto(item, h.clock.Now())}), nil
+}
+
+// ExtendAuction moves an auction's end time later (seller only)
+func (h *BidServiceHandler) ExtendAuction(
+	ctx context.Context,
+	req *connect.Request[bidsv1.ExtendAuctionRequest],
+) (*connect.Response[bidsv1.ExtendAuctionResponse], error) {
+	userID, err := uuid.Parse(auth.MustGetUserID(ctx))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("invalid user_id in token"))
+	}
+
+	itemID, err := uuid.Parse(req.Msg.ItemId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid item_id"))
+	}
+
+	endAt, err := time.Parse(time.RFC3339, req.Msg.NewEndAt)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid new_end_at: must be RFC 3339"))
+	}
+
+	item, err := h.auctionService.ExtendAuction(ctx, bids.ExtendAuctionCommand{
+		ItemID: itemID,
+		UserID: userID,
+		EndAt:  endAt.UTC(),
+	})
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse(&bidsv1.ExtendAuctionResponse{Item: mapItemToProto(item, h.clock.Now())}), nil
+}
+
+// Page size bounds for GetItemBids
+const (
+	defaultItemBidsPageSize = 50
+	maxItemBidsPageSize     = 100
+)
+
+// GetItemBids retrieves a page of an item's bids, newest first unless order_by says otherwise.
+// With since it instead returns the bids placed after that time, oldest first, for polling.
 func (h *BidServiceHandler) GetItemBids(
 	ctx context.Context,
 	req *connect.Request[bidsv1.GetItemBidsRequest],
@@ -370,58 +853,472 @@ func (h *BidServiceHandler) GetItemBids(
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid item_id"))
 	}
 
-	// Execute
-	bidList, err := h.bidRepo.GetBidsByItemID(ctx, itemID)
+	order, err := mapBidOrderFromProto(req.Msg.OrderBy)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	pageSize := int(req.Msg.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultItemBidsPageSize
+	}
+	pageSize = min(pageSize, maxItemBidsPageSize)
+
+	var bidList []*bids.Bid
+	var nextPageToken, serverTime, sinceBidID string
+	if req.Msg.Since != "" {
+		if req.Msg.PageToken != "" || order != bids.BidOrderTime {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("since cannot be combined with page_token or amount ordering"))
+		}
+		since, err := time.Parse(time.RFC3339Nano, req.Msg.Since)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid since: must be an RFC 3339 timestamp"))
+		}
+
+		after := bids.CursorAtTime(since)
+		if req.Msg.SinceBidId != "" {
+			if after.ID, err = uuid.Parse(req.Msg.SinceBidId); err != nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid since_bid_id"))
+			}
+		}
+
+		var upTo time.Time
+		bidList, upTo, err = h.bidRepo.GetBidsSince(ctx, itemID, after, pageSize+1)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		// More bids than fit: resume right after the last one returned rather than skipping
+		// to upTo, which may be shared with the bids left out
+		if len(bidList) > pageSize {
+			bidList = bidList[:pageSize]
+			last := bidList[len(bidList)-1]
+			upTo, sinceBidID = last.CreatedAt, last.ID.String()
+		}
+		// A since ahead of the bound (a client clock running fast) is handed back unchanged
+		if upTo.Before(since) {
+			upTo, sinceBidID = since, req.Msg.SinceBidId
+		}
+		serverTime = upTo.UTC().Format(time.RFC3339Nano)
+	} else {
+		query := bids.ItemBidsQuery{
+			ItemID:  itemID,
+			OrderBy: order,
+			Limit:   pageSize + 1, // one extra row tells us whether another page exists
+		}
+		if req.Msg.PageToken != "" {
+			query.After, err = h.decodeBidPageToken(req.Msg.PageToken, order)
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, err)
+			}
+		}
+
+		bidList, err = h.bidRepo.GetBidsByItemID(ctx, query)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+
+		if len(bidList) > pageSize {
+			bidList = bidList[:pageSize]
+			nextPageToken, err = h.encodeBidPageToken(order, bids.CursorAfter(bidList[len(bidList)-1]))
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInternal, err)
+			}
+		}
+	}
+
+	// Bidder identities are only disclosed to the seller and admins; everyone else sees
+	// pseudonyms, apart from their own bids
+	callerID, seesBidders := h.bidListingViewer(ctx, itemID)
+
+	var names map[uuid.UUID]string
+	if req.Msg.IncludeBidderNames {
+		if callerID == uuid.Nil {
+			return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("include_bidder_names requires authentication"))
+		}
+		if !seesBidders {
+			return nil, connect.NewError(connect.CodePermissionDenied, errors.New("only the seller can see bidder names"))
+		}
+		names = h.bidderNames(ctx, bidList)
 	}
 
 	// Map to proto
 	protoBids := make([]*bidsv1.Bid, len(bidList))
 	for i, bid := range bidList {
 		protoBids[i] = &bidsv1.Bid{
-			Id:        bid.ID.String(),
-			ItemId:    bid.ItemID.String(),
-			UserId:    bid.UserID.String(),
-			Amount:    bid.Amount,
-			CreatedAt: bid.CreatedAt.Format(time.RFC3339),
+			Id:                bid.ID.String(),
+			ItemId:            bid.ItemID.String(),
+			Amount:            bid.Amount,
+			CreatedAt:         bid.CreatedAt.Format(time.RFC3339),
+			BidderDisplayName: names[bid.UserID],
+			BidderLabel:       bids.BidderLabel(h.bidderLabelKey, bid.ItemID, bid.UserID),
+		}
+		if seesBidders || bid.UserID == callerID {
+			protoBids[i].UserId = bid.UserID.String()
 		}
 	}
 
 	res := &bidsv1.GetItemBidsResponse{
-		Bids: protoBids,
+		Bids:          protoBids,
+		NextPageToken: nextPageToken,
+		ServerTime:    serverTime,
+		SinceBidId:    sinceBidID,
 	}
 
 	return connect.NewResponse(res), nil
 }
 
-// mapItemToProto converts a domain Item to a proto Item
-func mapItemToProto(item *items.Item) *bidsv1.Item {
-	// Map status
-	var protoStatus bidsv1.ItemStatus
-	switch item.Status {
-	case items.ItemStatusActive:
-		protoStatus = bidsv1.ItemStatus_ITEM_STATUS_ACTIVE
-	case items.ItemStatusEnded:
-		protoStatus = bidsv1.ItemStatus_ITEM_STATUS_ENDED
-	case items.ItemStatusCancelled:
-		protoStatus = bidsv1.ItemStatus_ITEM_STATUS_CANCELLED
-	default:
-		protoStatus = bidsv1.ItemStatus_ITEM_STATUS_UNSPECIFIED
+// bidListingViewer identifies the caller of a public bid listing (uuid.Nil if anonymous)
+// and reports whether they may see real bidder ids: admins and the item's seller.
+func (h *BidServiceHandler) bidListingViewer(ctx context.Context, itemID uuid.UUID) (uuid.UUID, bool) {
+	sub, ok := auth.GetUserID(ctx)
+	if !ok {
+		return uuid.Nil, false
+	}
+	callerID, err := uuid.Parse(sub)
+	if err != nil {
+		return uuid.Nil, false
+	}
+	if auth.HasPermission(ctx, auth.PermissionAdmin) {
+		return callerID, true
+	}
+	item, err := h.itemService.GetItem(ctx, itemID)
+	if err != nil {
+		return callerID, false
+	}
+	return callerID, item.SellerID == callerID
+}
+
+// bidderNamesTimeout bounds the auth service lookup so a slow dependency cannot stall GetItemBids
+const bidderNamesTimeout = 500 * time.Millisecond
+
+// bidderNames looks up display names for the bidders on a page. Names are decoration:
+// on any failure the bids are returned without them.
+func (h *BidServiceHandler) bidderNames(ctx context.Context, bidList []*bids.Bid) map[uuid.UUID]string {
+	if h.bidders == nil || len(bidList) == 0 {
+		return nil
+	}
+
+	seen := make(map[uuid.UUID]bool, len(bidList))
+	var userIDs []uuid.UUID
+	for _, bid := range bidList {
+		if !seen[bid.UserID] {
+			seen[bid.UserID] = true
+			userIDs = append(userIDs, bid.UserID)
+		}
+	}
+
+	lookupCtx, cancel := context.WithTimeout(ctx, bidderNamesTimeout)
+	defer cancel()
+	names, err := h.bidders.DisplayNames(lookupCtx, userIDs)
+	if err != nil {
+		slog.WarnContext(ctx, "Bidder names unavailable, returning bids without them", "error", err)
+		return nil
+	}
+	return names
+}
+
+// RecordItemView increments an item's view counter
+// Public route: the viewer is identified only if a valid token is supplied
+func (h *BidServiceHandler) RecordItemView(
+	ctx context.Context,
+	req *connect.Request[bidsv1.RecordItemViewRequest],
+) (*connect.Response[bidsv1.RecordItemViewResponse], error) {
+	itemID, err := uuid.Parse(req.Msg.ItemId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid item_id"))
+	}
+
+	cmd := items.RecordItemViewCommand{ItemID: itemID}
+	if userIDStr, ok := auth.GetUserID(ctx); ok {
+		if viewerID, parseErr := uuid.Parse(userIDStr); parseErr == nil {
+			cmd.ViewerID = viewerID
+		}
+	}
+
+	if err := h.itemService.RecordItemView(ctx, cmd); err != nil {
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse(&bidsv1.RecordItemViewResponse{}), nil
+}
+
+// GetCurrentPrice returns the current highest bid for an item from the fast-read path
+func (h *BidServiceHandler) GetCurrentPrice(
+	ctx context.Context,
+	req *connect.Request[bidsv1.GetCurrentPriceRequest],
+) (*connect.Response[bidsv1.GetCurrentPriceResponse], error) {
+	itemID, err := uuid.Parse(req.Msg.ItemId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid item_id"))
+	}
+
+	price, err := h.auctionService.GetCurrentPrice(ctx, itemID)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse(&bidsv1.GetCurrentPriceResponse{
+		ItemId:            itemID.String(),
+		CurrentHighestBid: price,
+	}), nil
+}
+
+// GetWinningBid returns the item's highest bid for "you are winning" displays. The bidder
+// is identified the same way as in GetItemBids.
+func (h *BidServiceHandler) GetWinningBid(
+	ctx context.Context,
+	req *connect.Request[bidsv1.GetWinningBidRequest],
+) (*connect.Response[bidsv1.GetWinningBidResponse], error) {
+	itemID, err := uuid.Parse(req.Msg.ItemId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid item_id"))
+	}
+
+	bid, err := h.auctionService.GetWinningBid(ctx, itemID)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	res := &bidsv1.GetWinningBidResponse{}
+	if bid == nil {
+		return connect.NewResponse(res), nil
+	}
+
+	callerID, seesBidders := h.bidListingViewer(ctx, itemID)
+	isCaller := callerID != uuid.Nil && bid.UserID == callerID
+	res.IsCallerWinning = isCaller
+	res.Bid = &bidsv1.Bid{
+		Id:          bid.ID.String(),
+		ItemId:      bid.ItemID.String(),
+		Amount:      bid.Amount,
+		CreatedAt:   bid.CreatedAt.Format(time.RFC3339),
+		BidderLabel: bids.BidderLabel(h.bidderLabelKey, bid.ItemID, bid.UserID),
+	}
+	if seesBidders || isCaller {
+		res.Bid.UserId = bid.UserID.String()
+	}
+	return connect.NewResponse(res), nil
+}
+
+// GetBidPosition tells the caller whether they are leading on an item, and if not,
+// where their best bid ranks and what it would take to lead
+func (h *BidServiceHandler) GetBidPosition(
+	ctx context.Context,
+	req *connect.Request[bidsv1.GetBidPositionRequest],
+) (*connect.Response[bidsv1.GetBidPositionResponse], error) {
+	userID, err := uuid.Parse(auth.MustGetUserID(ctx))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("invalid user_id in token"))
 	}
 
+	itemID, err := uuid.Parse(req.Msg.ItemId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid item_id"))
+	}
+
+	position, err := h.auctionService.GetBidPosition(ctx, itemID, userID)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	res := &bidsv1.GetBidPositionResponse{
+		IsBidding:    position.IsBidding(),
+		Rank:         int32(position.Rank),
+		BidderCount:  int32(position.Bidders),
+		AmountToLead: position.AmountToLead,
+	}
+	if bid := position.BestBid; bid != nil {
+		res.BestBid = &bidsv1.Bid{
+			Id:          bid.ID.String(),
+			ItemId:      bid.ItemID.String(),
+			UserId:      bid.UserID.String(),
+			Amount:      bid.Amount,
+			CreatedAt:   bid.CreatedAt.Format(time.RFC3339),
+			BidderLabel: bids.BidderLabel(h.bidderLabelKey, bid.ItemID, bid.UserID),
+		}
+	}
+	return connect.NewResponse(res), nil
+}
+
+// ListCategories returns the categories sellers can file items under
+func (h *BidServiceHandler) ListCategories(
+	ctx context.Context,
+	_ *connect.Request[bidsv1.ListCategoriesRequest],
+) (*connect.Response[bidsv1.ListCategoriesResponse], error) {
+	categories, err := h.itemService.ListCategories(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	res := &bidsv1.ListCategoriesResponse{
+		Categories: make([]*bidsv1.Category, len(categories)),
+	}
+	for i, c := range categories {
+		res.Categories[i] = &bidsv1.Category{Slug: c.Slug, Name: c.Name}
+	}
+	return connect.NewResponse(res), nil
+}
+
+const maxAdminItemsPageSize = 100
+
+// AdminListItems lists items in one status for moderators
+func (h *BidServiceHandler) AdminListItems(
+	ctx context.Context,
+	req *connect.Request[bidsv1.AdminListItemsRequest],
+) (*connect.Response[bidsv1.AdminListItemsResponse], error) {
+	if err := auth.RequirePermission(ctx, auth.PermissionAdmin); err != nil {
+		return nil, err
+	}
+
+	status, err := mapItemStatusFromProto(req.Msg.Status)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	offset, err := decodeOffsetPageToken(req.Msg.PageToken)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	limit := int(req.Msg.PageSize)
+	if limit <= 0 {
+		limit = 20
+	}
+	if limit > maxAdminItemsPageSize {
+		limit = maxAdminItemsPageSize
+	}
+
+	// Fetch one extra row to learn whether another page exists
+	itemList, err := h.itemService.ListItemsByStatus(ctx, items.ListItemsByStatusQuery{
+		Status: status,
+		Limit:  limit + 1,
+		Offset: offset,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	res := &bidsv1.AdminListItemsResponse{}
+	if len(itemList) > limit {
+		itemList = itemList[:limit]
+		res.NextPageToken = encodeOffsetPageToken(offset + limit)
+	}
+	now := h.clock.Now()
+	res.Items = make([]*bidsv1.Item, len(itemList))
+	for i, item := range itemList {
+		res.Items[i] = mapItemToProto(item, now)
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+// AdminReconcileItem corrects an item's denormalized bid totals from its bids
+func (h *BidServiceHandler) AdminReconcileItem(
+	ctx context.Context,
+	req *connect.Request[bidsv1.AdminReconcileItemRequest],
+) (*connect.Response[bidsv1.AdminReconcileItemResponse], error) {
+	if err := auth.RequirePermission(ctx, auth.PermissionAdmin); err != nil {
+		return nil, err
+	}
+
+	itemID, err := uuid.Parse(req.Msg.ItemId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid item_id"))
+	}
+
+	rec, err := h.itemService.ReconcileItem(ctx, itemID)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	if rec.Drifted() {
+		slog.WarnContext(ctx, "Corrected drifted item bid totals",
+			"item_id", itemID,
+			"stored_bid_count", rec.Stored.Count,
+			"stored_highest_bid", rec.Stored.HighestBid,
+			"bid_count", rec.Actual.Count,
+			"highest_bid", rec.Actual.HighestBid,
+		)
+	}
+
+	return connect.NewResponse(&bidsv1.AdminReconcileItemResponse{
+		StoredBidCount:   rec.Stored.Count,
+		StoredHighestBid: rec.Stored.HighestBid,
+		BidCount:         rec.Actual.Count,
+		HighestBid:       rec.Actual.HighestBid,
+		Corrected:        rec.Drifted(),
+	}), nil
+}
+
+// AdminGetUserBidTotals returns a user's bid count, total and latest bid straight from the
+// bids table, for user-stats to rebuild its aggregates from (admin only)
+func (h *BidServiceHandler) AdminGetUserBidTotals(
+	ctx context.Context,
+	req *connect.Request[bidsv1.AdminGetUserBidTotalsRequest],
+) (*connect.Response[bidsv1.AdminGetUserBidTotalsResponse], error) {
+	if err := auth.RequirePermission(ctx, auth.PermissionAdmin); err != nil {
+		return nil, err
+	}
+
+	userID, err := uuid.Parse(req.Msg.UserId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid user_id"))
+	}
+
+	totals, err := h.bidRepo.GetUserBidTotals(ctx, userID)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	res := &bidsv1.AdminGetUserBidTotalsResponse{
+		BidCount:    totals.Count,
+		TotalAmount: totals.TotalAmount,
+		AsOf:        totals.AsOf.Format(time.RFC3339Nano),
+	}
+	if !totals.LastBidAt.IsZero() {
+		res.LastBidAt = totals.LastBidAt.Format(time.RFC3339Nano)
+	}
+	return connect.NewResponse(res), nil
+}
+
+// mapItemToProto converts a domain Item to a proto Item; now drives the time-derived fields
+func mapItemToProto(item *items.Item, now time.Time) *bidsv1.Item {
+
 	return &bidsv1.Item{
 		Id:                item.ID.String(),
 		Title:             item.Title,
 		Description:       item.Description,
 		StartPrice:        item.StartPrice,
 		CurrentHighestBid: item.CurrentHighestBid,
-		EndAt:             item.EndAt.Format(time.RFC3339),
+		BuyNowPrice:       item.BuyNowPrice,
+		BidStep:           item.BidStep,
+		StartAt:           item.StartAt.Format(time.RFC3339),
+		EndAt:             item.LocalEndAt().Format(time.RFC3339),
+		EndAtTimezone:     item.EndAtTimezone,
 		CreatedAt:         item.CreatedAt.Format(time.RFC3339),
 		UpdatedAt:         item.UpdatedAt.Format(time.RFC3339),
 		Images:            item.Images,
 		Category:          item.Category,
 		SellerId:          item.SellerID.String(),
-		Status:            protoStatus,
+		Status:            mapItemStatusToProto(item.Status),
+		Views:             item.Views,
+		BidCount:          item.BidCount,
+		SecondsRemaining:  item.SecondsRemaining(now),
+		AuctionState:      mapItemStatusToProto(item.AuctionState(now)),
+	}
+}
+
+func mapItemStatusToProto(status items.ItemStatus) bidsv1.ItemStatus {
+	switch status {
+	case items.ItemStatusScheduled:
+		return bidsv1.ItemStatus_ITEM_STATUS_SCHEDULED
+	case items.ItemStatusActive:
+		return bidsv1.ItemStatus_ITEM_STATUS_ACTIVE
+	case items.ItemStatusPaused:
+		return bidsv1.ItemStatus_ITEM_STATUS_PAUSED
+	case items.ItemStatusEnded:
+		return bidsv1.ItemStatus_ITEM_STATUS_ENDED
+	case items.ItemStatusCancelled:
+		return bidsv1.ItemStatus_ITEM_STATUS_CANCELLED
+	default:
+		return bidsv1.ItemStatus_ITEM_STATUS_UNSPECIFIED
 	}
 }
@@ -0,0 +1,114 @@
+package api
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/floroz/gavel/pkg/pagination"
+	bidsv1 "github.com/floroz/gavel/pkg/proto/bids/v1"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/bids"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/items"
+)
+
+var errInvalidPageToken = errors.New("invalid page_token")
+
+// bidPageToken is the signed next_page_token of GetItemBids.
+// The order is recorded so a token cannot be replayed against a different ordering.
+type bidPageToken struct {
+	Order     bids.BidOrder `json:"o"`
+	Amount    int64         `json:"a"`
+	CreatedAt time.Time     `json:"t"`
+	ID        uuid.UUID     `json:"i"`
+}
+
+func (h *BidServiceHandler) encodeBidPageToken(order bids.BidOrder, cursor *bids.BidCursor) (string, error) {
+	return pagination.EncodeCursor(h.pageTokens, bidPageToken{
+		Order:     order,
+		Amount:    cursor.Amount,
+		CreatedAt: cursor.CreatedAt,
+		ID:        cursor.ID,
+	})
+}
+
+func (h *BidServiceHandler) decodeBidPageToken(token string, order bids.BidOrder) (*bids.BidCursor, error) {
+	t, err := pagination.DecodeCursor[bidPageToken](h.pageTokens, token)
+	if err != nil || t == nil {
+		return nil, errInvalidPageToken
+	}
+	if t.Order != order {
+		return nil, fmt.Errorf("%w: issued for a different order_by", errInvalidPageToken)
+	}
+	return &bids.BidCursor{Amount: t.Amount, CreatedAt: t.CreatedAt, ID: t.ID}, nil
+}
+
+func (h *BidServiceHandler) encodeItemPageToken(cursor *items.ItemCursor) (string, error) {
+	return pagination.EncodeCursor(h.pageTokens, pagination.Cursor{Time: cursor.CreatedAt, ID: cursor.ID})
+}
+
+// decodeItemPageToken returns the ListItems position in token, or nil for the first page
+func (h *BidServiceHandler) decodeItemPageToken(token string) (*items.ItemCursor, error) {
+	t, err := pagination.DecodeCursor[pagination.Cursor](h.pageTokens, token)
+	if err != nil {
+		return nil, errInvalidPageToken
+	}
+	if t == nil {
+		return nil, nil
+	}
+	if t.ID == uuid.Nil {
+		return nil, errInvalidPageToken
+	}
+	return &items.ItemCursor{CreatedAt: t.Time, ID: t.ID}, nil
+}
+
+// encodeOffsetPageToken is the opaque next_page_token of offset-paged listings
+func encodeOffsetPageToken(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(offset)))
+}
+
+func decodeOffsetPageToken(token string) (int, error) {
+	if token == "" {
+		return 0, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, errInvalidPageToken
+	}
+	offset, err := strconv.Atoi(string(data))
+	if err != nil || offset < 0 {
+		return 0, errInvalidPageToken
+	}
+	return offset, nil
+}
+
+func mapBidOrderFromProto(order bidsv1.BidOrderBy) (bids.BidOrder, error) {
+	switch order {
+	case bidsv1.BidOrderBy_BID_ORDER_BY_UNSPECIFIED, bidsv1.BidOrderBy_BID_ORDER_BY_TIME:
+		return bids.BidOrderTime, nil
+	case bidsv1.BidOrderBy_BID_ORDER_BY_AMOUNT:
+		return bids.BidOrderAmount, nil
+	default:
+		return 0, fmt.Errorf("unsupported order_by %v", order)
+	}
+}
+
+func mapItemStatusFromProto(status bidsv1.ItemStatus) (items.ItemStatus, error) {
+	switch status {
+	case bidsv1.ItemStatus_ITEM_STATUS_SCHEDULED:
+		return items.ItemStatusScheduled, nil
+	case bidsv1.ItemStatus_ITEM_STATUS_ACTIVE:
+		return items.ItemStatusActive, nil
+	case bidsv1.ItemStatus_ITEM_STATUS_PAUSED:
+		return items.ItemStatusPaused, nil
+	case bidsv1.ItemStatus_ITEM_STATUS_ENDED:
+		return items.ItemStatusEnded, nil
+	case bidsv1.ItemStatus_ITEM_STATUS_CANCELLED:
+		return items.ItemStatusCancelled, nil
+	default:
+		return "", fmt.Errorf("unsupported status %v", status)
+	}
+}
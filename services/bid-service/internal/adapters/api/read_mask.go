@@ -0,0 +1,44 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	bidsv1 "github.com/floroz/gavel/pkg/proto/bids/v1"
+	"github.com/floroz/gavel/pkg/validation"
+)
+
+var errInvalidReadMask = errors.New("invalid read_mask")
+
+// readMaskField reports a rejected read_mask against the request field
+var readMaskField = validation.Field{Err: errInvalidReadMask, Field: "read_mask"}
+
+// validateItemReadMask rejects a read_mask naming anything but a top-level Item field
+func validateItemReadMask(mask *fieldmaskpb.FieldMask) error {
+	if mask != nil && !mask.IsValid(&bidsv1.Item{}) {
+		return fmt.Errorf("%w: paths must name item fields", errInvalidReadMask)
+	}
+	return nil
+}
+
+// applyItemReadMask clears every field of item the mask does not name.
+// An empty or missing mask leaves the item whole.
+func applyItemReadMask(item *bidsv1.Item, mask *fieldmaskpb.FieldMask) {
+	if len(mask.GetPaths()) == 0 {
+		return
+	}
+	keep := make(map[protoreflect.Name]bool, len(mask.GetPaths()))
+	for _, path := range mask.GetPaths() {
+		keep[protoreflect.Name(path)] = true
+	}
+	msg := item.ProtoReflect()
+	fields := msg.Descriptor().Fields()
+	for i := range fields.Len() {
+		if fd := fields.Get(i); !keep[fd.Name()] {
+			msg.Clear(fd)
+		}
+	}
+}
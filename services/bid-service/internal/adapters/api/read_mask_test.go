@@ -0,0 +1,52 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	bidsv1 "github.com/floroz/gavel/pkg/proto/bids/v1"
+)
+
+func TestApplyItemReadMask(t *testing.T) {
+	full := &bidsv1.Item{
+		Id:                "item-1",
+		Title:             "Lamp",
+		Description:       "Brass",
+		StartPrice:        1000,
+		CurrentHighestBid: 1500,
+		Images:            []string{"a.jpg"},
+		Status:            bidsv1.ItemStatus_ITEM_STATUS_ACTIVE,
+		SecondsRemaining:  60,
+	}
+
+	tests := []struct {
+		name string
+		mask *fieldmaskpb.FieldMask
+		want *bidsv1.Item
+	}{
+		{"nil mask keeps everything", nil, full},
+		{"empty mask keeps everything", &fieldmaskpb.FieldMask{}, full},
+		{
+			"subset",
+			&fieldmaskpb.FieldMask{Paths: []string{"title", "current_highest_bid", "status"}},
+			&bidsv1.Item{Title: "Lamp", CurrentHighestBid: 1500, Status: bidsv1.ItemStatus_ITEM_STATUS_ACTIVE},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item := proto.Clone(full).(*bidsv1.Item)
+			applyItemReadMask(item, tt.mask)
+			assert.True(t, proto.Equal(tt.want, item), "got %v", item)
+		})
+	}
+}
+
+func TestValidateItemReadMask(t *testing.T) {
+	assert.NoError(t, validateItemReadMask(nil))
+	assert.NoError(t, validateItemReadMask(&fieldmaskpb.FieldMask{Paths: []string{"id", "bid_step"}}))
+	assert.ErrorIs(t, validateItemReadMask(&fieldmaskpb.FieldMask{Paths: []string{"reserve_price"}}), errInvalidReadMask)
+	assert.ErrorIs(t, validateItemReadMask(&fieldmaskpb.FieldMask{Paths: []string{"title.length"}}), errInvalidReadMask)
+}
@@ -0,0 +1,48 @@
+package authclient
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	authv1 "github.com/floroz/gavel/pkg/proto/auth/v1"
+	"github.com/floroz/gavel/pkg/proto/auth/v1/authv1connect"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/bids"
+)
+
+// BidderDirectory implements bids.BidderDirectory with the auth service's GetDisplayNames RPC
+type BidderDirectory struct {
+	client authv1connect.AuthServiceClient
+}
+
+var _ bids.BidderDirectory = (*BidderDirectory)(nil)
+
+// NewBidderDirectory creates a directory backed by client
+func NewBidderDirectory(client authv1connect.AuthServiceClient) *BidderDirectory {
+	return &BidderDirectory{client: client}
+}
+
+// DisplayNames resolves userIDs in a single call; a page of bids never exceeds the RPC's limit
+func (d *BidderDirectory) DisplayNames(ctx context.Context, userIDs []uuid.UUID) (map[uuid.UUID]string, error) {
+	req := &authv1.GetDisplayNamesRequest{UserIds: make([]string, len(userIDs))}
+	for i, id := range userIDs {
+		req.UserIds[i] = id.String()
+	}
+
+	res, err := d.client.GetDisplayNames(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get display names: %w", err)
+	}
+
+	names := make(map[uuid.UUID]string, len(res.Msg.DisplayNames))
+	for raw, name := range res.Msg.DisplayNames {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, fmt.Errorf("auth service returned invalid user_id %q", raw)
+		}
+		names[id] = name
+	}
+	return names, nil
+}
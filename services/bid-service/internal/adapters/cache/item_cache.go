@@ -0,0 +1,289 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/redis/go-redis/v9"
+
+	pkgdb "github.com/floroz/gavel/pkg/database"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/items"
+)
+
+// DefaultItemTTL is how long an item stays cached when no TTL is configured
+const DefaultItemTTL = 30 * time.Second
+
+// CachedItemRepository decorates an items.Repository with a Redis read-through cache
+// for GetItemByID. It wraps every method explicitly rather than embedding the repository,
+// so a new method cannot slip through without a decision on invalidation: every write
+// that changes an item deletes its cache entry.
+//
+// Writes made inside a transaction invalidate once it commits (see pkgdb.AfterCommit). A
+// read that loaded the old row just before the commit may still re-cache it afterwards;
+// the short TTL bounds that staleness.
+type CachedItemRepository struct {
+	repo   items.Repository
+	rdb    *redis.Client
+	ttl    time.Duration
+	logger *slog.Logger
+}
+
+var _ items.Repository = (*CachedItemRepository)(nil)
+
+// NewCachedItemRepository wraps repo with a Redis cache.
+// If rdb is nil, repo is returned unchanged (caching disabled).
+func NewCachedItemRepository(repo items.Repository, rdb *redis.Client, ttl time.Duration, logger *slog.Logger) items.Repository {
+	if rdb == nil {
+		return repo
+	}
+	if ttl <= 0 {
+		ttl = DefaultItemTTL
+	}
+	return &CachedItemRepository{
+		repo:   repo,
+		rdb:    rdb,
+		ttl:    ttl,
+		logger: logger,
+	}
+}
+
+// ItemKey returns the Redis key used to cache an item
+func ItemKey(itemID uuid.UUID) string {
+	return fmt.Sprintf("item:%s", itemID)
+}
+
+// GetItemByID returns the cached item if present, otherwise loads and caches it.
+// Redis failures fall back to the underlying repository.
+func (r *CachedItemRepository) GetItemByID(ctx context.Context, itemID uuid.UUID) (*items.Item, error) {
+	key := ItemKey(itemID)
+
+	data, err := r.rdb.Get(ctx, key).Bytes()
+	if err == nil {
+		var item items.Item
+		if jsonErr := json.Unmarshal(data, &item); jsonErr == nil {
+			return &item, nil
+		}
+		r.logger.Warn("Discarding malformed cached item", "item_id", itemID)
+	} else if !errors.Is(err, redis.Nil) {
+		r.logger.Warn("Item cache read failed", "item_id", itemID, "error", err)
+	}
+
+	item, err := r.repo.GetItemByID(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	if data, jsonErr := json.Marshal(item); jsonErr == nil {
+		if setErr := r.rdb.Set(ctx, key, data, r.ttl).Err(); setErr != nil {
+			r.logger.Warn("Item cache write failed", "item_id", itemID, "error", setErr)
+		}
+	}
+
+	return item, nil
+}
+
+// UpdateItem updates the item and invalidates its cache entry
+func (r *CachedItemRepository) UpdateItem(ctx context.Context, tx pgx.Tx, item *items.Item) error {
+	if err := r.repo.UpdateItem(ctx, tx, item); err != nil {
+		return err
+	}
+	r.invalidateOnCommit(ctx, tx, item.ID)
+	return nil
+}
+
+// UpdateStatus updates the item status and invalidates its cache entry
+func (r *CachedItemRepository) UpdateStatus(ctx context.Context, tx pgx.Tx, itemID uuid.UUID, status items.ItemStatus) error {
+	if err := r.repo.UpdateStatus(ctx, tx, itemID, status); err != nil {
+		return err
+	}
+	r.invalidateOnCommit(ctx, tx, itemID)
+	return nil
+}
+
+// PauseItem pauses the item and invalidates its cache entry
+func (r *CachedItemRepository) PauseItem(ctx context.Context, itemID uuid.UUID) error {
+	if err := r.repo.PauseItem(ctx, itemID); err != nil {
+		return err
+	}
+	r.invalidate(ctx, itemID)
+	return nil
+}
+
+// ResumeItem resumes the item and invalidates its cache entry
+func (r *CachedItemRepository) ResumeItem(ctx context.Context, itemID uuid.UUID, extendEndAt bool) (time.Time, error) {
+	endAt, err := r.repo.ResumeItem(ctx, itemID, extendEndAt)
+	if err != nil {
+		return time.Time{}, err
+	}
+	r.invalidate(ctx, itemID)
+	return endAt, nil
+}
+
+// UpdateHighestBid updates the highest bid and invalidates the cache entry
+func (r *CachedItemRepository) UpdateHighestBid(ctx context.Context, tx pgx.Tx, itemID uuid.UUID, amount int64) error {
+	if err := r.repo.UpdateHighestBid(ctx, tx, itemID, amount); err != nil {
+		return err
+	}
+	r.invalidateOnCommit(ctx, tx, itemID)
+	return nil
+}
+
+// UpdateEndAt sets the end time and invalidates the cache entry
+func (r *CachedItemRepository) UpdateEndAt(ctx context.Context, tx pgx.Tx, itemID uuid.UUID, endAt time.Time) error {
+	if err := r.repo.UpdateEndAt(ctx, tx, itemID, endAt); err != nil {
+		return err
+	}
+	r.invalidateOnCommit(ctx, tx, itemID)
+	return nil
+}
+
+// ExtendEndAt extends the item and invalidates the cache entry
+func (r *CachedItemRepository) ExtendEndAt(ctx context.Context, tx pgx.Tx, itemID uuid.UUID, endAt time.Time, by time.Duration) error {
+	if err := r.repo.ExtendEndAt(ctx, tx, itemID, endAt, by); err != nil {
+		return err
+	}
+	r.invalidateOnCommit(ctx, tx, itemID)
+	return nil
+}
+
+// MarkExpiredItemsEnded ends a batch of expired items and invalidates their cache entries
+func (r *CachedItemRepository) MarkExpiredItemsEnded(ctx context.Context, tx pgx.Tx, before time.Time, limit int) ([]*items.Item, error) {
+	ended, err := r.repo.MarkExpiredItemsEnded(ctx, tx, before, limit)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range ended {
+		r.invalidateOnCommit(ctx, tx, item.ID)
+	}
+	return ended, nil
+}
+
+// ReconcileItem reconciles the item's bid totals and, if they changed, invalidates the
+// cache entry and the cached price. The price cache only ever raises its value, so a
+// drifted price lowered here would otherwise be served until it expired.
+func (r *CachedItemRepository) ReconcileItem(ctx context.Context, itemID uuid.UUID) (*items.Reconciliation, error) {
+	rec, err := r.repo.ReconcileItem(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+	if rec.Drifted() {
+		if err := r.rdb.Del(ctx, ItemKey(itemID), PriceKey(itemID)).Err(); err != nil {
+			r.logger.Warn("Item cache invalidation failed", "item_id", itemID, "error", err)
+		}
+	}
+	return rec, nil
+}
+
+// ActivateItem activates the item and invalidates its cache entry
+func (r *CachedItemRepository) ActivateItem(ctx context.Context, tx pgx.Tx, itemID uuid.UUID) error {
+	if err := r.repo.ActivateItem(ctx, tx, itemID); err != nil {
+		return err
+	}
+	r.invalidateOnCommit(ctx, tx, itemID)
+	return nil
+}
+
+// MarkEnded ends the item and invalidates its cache entry
+func (r *CachedItemRepository) MarkEnded(ctx context.Context, tx pgx.Tx, itemID uuid.UUID) error {
+	if err := r.repo.MarkEnded(ctx, tx, itemID); err != nil {
+		return err
+	}
+	r.invalidateOnCommit(ctx, tx, itemID)
+	return nil
+}
+
+// ActivateScheduledItems activates the due scheduled items and invalidates their cache entries
+func (r *CachedItemRepository) ActivateScheduledItems(ctx context.Context) ([]uuid.UUID, error) {
+	ids, err := r.repo.ActivateScheduledItems(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range ids {
+		r.invalidate(ctx, id)
+	}
+	return ids, nil
+}
+
+// IncrementViews counts a view and invalidates the cache entry, which carries the count
+func (r *CachedItemRepository) IncrementViews(ctx context.Context, itemID uuid.UUID) error {
+	if err := r.repo.IncrementViews(ctx, itemID); err != nil {
+		return err
+	}
+	r.invalidate(ctx, itemID)
+	return nil
+}
+
+// CreateItem creates the item. A new item has no cache entry: misses are never cached.
+func (r *CachedItemRepository) CreateItem(ctx context.Context, tx pgx.Tx, item *items.Item) error {
+	return r.repo.CreateItem(ctx, tx, item)
+}
+
+// The remaining methods only read and go straight to the repository
+
+func (r *CachedItemRepository) GetItemByIDForUpdate(ctx context.Context, tx pgx.Tx, itemID uuid.UUID) (*items.Item, error) {
+	return r.repo.GetItemByIDForUpdate(ctx, tx, itemID)
+}
+
+func (r *CachedItemRepository) CountActiveItemsBySeller(ctx context.Context, tx pgx.Tx, sellerID uuid.UUID) (int, error) {
+	return r.repo.CountActiveItemsBySeller(ctx, tx, sellerID)
+}
+
+func (r *CachedItemRepository) ListActiveItems(ctx context.Context, limit int, after *items.ItemCursor) ([]*items.Item, error) {
+	return r.repo.ListActiveItems(ctx, limit, after)
+}
+
+func (r *CachedItemRepository) ListItemsEndingSoon(ctx context.Context, within time.Duration, limit, offset int) ([]*items.Item, error) {
+	return r.repo.ListItemsEndingSoon(ctx, within, limit, offset)
+}
+
+func (r *CachedItemRepository) ListItemsByStatus(ctx context.Context, status items.ItemStatus, limit, offset int) ([]*items.Item, error) {
+	return r.repo.ListItemsByStatus(ctx, status, limit, offset)
+}
+
+func (r *CachedItemRepository) ListItemsBySellerID(ctx context.Context, sellerID uuid.UUID, limit, offset int) ([]*items.Item, error) {
+	return r.repo.ListItemsBySellerID(ctx, sellerID, limit, offset)
+}
+
+func (r *CachedItemRepository) ListItemsBySellerForUpdate(ctx context.Context, tx pgx.Tx, sellerID uuid.UUID) ([]*items.Item, error) {
+	return r.repo.ListItemsBySellerForUpdate(ctx, tx, sellerID)
+}
+
+func (r *CachedItemRepository) CountBidsByItemID(ctx context.Context, itemID uuid.UUID) (int64, error) {
+	return r.repo.CountBidsByItemID(ctx, itemID)
+}
+
+func (r *CachedItemRepository) ListItemIDs(ctx context.Context, after uuid.UUID, limit int) ([]uuid.UUID, error) {
+	return r.repo.ListItemIDs(ctx, after, limit)
+}
+
+func (r *CachedItemRepository) GetSellerDashboard(ctx context.Context, sellerID uuid.UUID) (*items.SellerDashboard, error) {
+	return r.repo.GetSellerDashboard(ctx, sellerID)
+}
+
+func (r *CachedItemRepository) ListCategories(ctx context.Context) ([]*items.Category, error) {
+	return r.repo.ListCategories(ctx)
+}
+
+func (r *CachedItemRepository) CategoryExists(ctx context.Context, slug string) (bool, error) {
+	return r.repo.CategoryExists(ctx, slug)
+}
+
+// invalidateOnCommit deletes the item's cache entry once tx commits. The context may be
+// done by then, so the delete runs without its cancellation.
+func (r *CachedItemRepository) invalidateOnCommit(ctx context.Context, tx pgx.Tx, itemID uuid.UUID) {
+	pkgdb.AfterCommit(tx, func() {
+		r.invalidate(context.WithoutCancel(ctx), itemID)
+	})
+}
+
+func (r *CachedItemRepository) invalidate(ctx context.Context, itemID uuid.UUID) {
+	if err := r.rdb.Del(ctx, ItemKey(itemID)).Err(); err != nil {
+		r.logger.Warn("Item cache invalidation failed", "item_id", itemID, "error", err)
+	}
+}
@@ -0,0 +1,174 @@
+//go:build integration
+
+package cache_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/floroz/gavel/pkg/testhelpers"
+	"github.com/floroz/gavel/services/bid-service/internal/adapters/cache"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/items"
+)
+
+func TestCachedItemRepository_Integration(t *testing.T) {
+	rdb := testhelpers.NewTestRedis(t)
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	ctx := context.Background()
+
+	newItem := func() *items.Item {
+		return &items.Item{
+			ID:         uuid.New(),
+			Title:      "Cached Item",
+			StartPrice: 1000,
+			EndAt:      time.Now().Add(time.Hour).UTC().Truncate(time.Second),
+			Images:     []string{},
+			SellerID:   uuid.New(),
+			Status:     items.ItemStatusActive,
+		}
+	}
+
+	t.Run("cache hit avoids the database", func(t *testing.T) {
+		item := newItem()
+		inner := &countingRepository{items: map[uuid.UUID]*items.Item{item.ID: item}}
+		repo := cache.NewCachedItemRepository(inner, rdb, time.Minute, logger)
+
+		first, err := repo.GetItemByID(ctx, item.ID)
+		require.NoError(t, err)
+		second, err := repo.GetItemByID(ctx, item.ID)
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, inner.reads)
+		assert.Equal(t, first.Title, second.Title)
+		assert.True(t, first.EndAt.Equal(second.EndAt))
+	})
+
+	t.Run("update invalidates the cached value", func(t *testing.T) {
+		item := newItem()
+		inner := &countingRepository{items: map[uuid.UUID]*items.Item{item.ID: item}}
+		repo := cache.NewCachedItemRepository(inner, rdb, time.Minute, logger)
+
+		_, err := repo.GetItemByID(ctx, item.ID)
+		require.NoError(t, err)
+
+		updated := *item
+		updated.Title = "Renamed Item"
+		require.NoError(t, repo.UpdateItem(ctx, nil, &updated))
+
+		exists, err := rdb.Exists(ctx, cache.ItemKey(item.ID)).Result()
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), exists)
+
+		got, err := repo.GetItemByID(ctx, item.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Renamed Item", got.Title)
+		assert.Equal(t, 2, inner.reads)
+	})
+
+	t.Run("cancel invalidates the cached value", func(t *testing.T) {
+		item := newItem()
+		inner := &countingRepository{items: map[uuid.UUID]*items.Item{item.ID: item}}
+		repo := cache.NewCachedItemRepository(inner, rdb, time.Minute, logger)
+
+		_, err := repo.GetItemByID(ctx, item.ID)
+		require.NoError(t, err)
+		require.NoError(t, repo.UpdateStatus(ctx, nil, item.ID, items.ItemStatusCancelled))
+
+		got, err := repo.GetItemByID(ctx, item.ID)
+		require.NoError(t, err)
+		assert.Equal(t, items.ItemStatusCancelled, got.Status)
+	})
+
+	t.Run("status and view changes invalidate the cached value", func(t *testing.T) {
+		changes := map[string]func(repo items.Repository, id uuid.UUID) error{
+			"activate": func(repo items.Repository, id uuid.UUID) error { return repo.ActivateItem(ctx, nil, id) },
+			"end":      func(repo items.Repository, id uuid.UUID) error { return repo.MarkEnded(ctx, nil, id) },
+			"view":     func(repo items.Repository, id uuid.UUID) error { return repo.IncrementViews(ctx, id) },
+			"activate due": func(repo items.Repository, _ uuid.UUID) error {
+				_, err := repo.ActivateScheduledItems(ctx)
+				return err
+			},
+		}
+		for name, change := range changes {
+			t.Run(name, func(t *testing.T) {
+				item := newItem()
+				item.Status = items.ItemStatusScheduled
+				inner := &countingRepository{items: map[uuid.UUID]*items.Item{item.ID: item}}
+				repo := cache.NewCachedItemRepository(inner, rdb, time.Minute, logger)
+
+				_, err := repo.GetItemByID(ctx, item.ID)
+				require.NoError(t, err)
+				require.NoError(t, change(repo, item.ID))
+
+				exists, err := rdb.Exists(ctx, cache.ItemKey(item.ID)).Result()
+				require.NoError(t, err)
+				assert.Equal(t, int64(0), exists)
+			})
+		}
+	})
+
+	t.Run("reconciling drifted totals drops the cached item and price", func(t *testing.T) {
+		item := newItem()
+		item.CurrentHighestBid = 9000
+		inner := &countingRepository{items: map[uuid.UUID]*items.Item{item.ID: item}, actualHighestBid: 2000}
+		repo := cache.NewCachedItemRepository(inner, rdb, time.Minute, logger)
+		prices := cache.NewRedisPriceCache(rdb, time.Minute)
+
+		_, err := repo.GetItemByID(ctx, item.ID)
+		require.NoError(t, err)
+		require.NoError(t, prices.SetCurrentPrice(ctx, item.ID, 9000))
+
+		rec, err := repo.ReconcileItem(ctx, item.ID)
+		require.NoError(t, err)
+		require.True(t, rec.Drifted())
+
+		exists, err := rdb.Exists(ctx, cache.ItemKey(item.ID), cache.PriceKey(item.ID)).Result()
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), exists)
+
+		// A valid bid below the drifted price can be cached again
+		require.NoError(t, prices.SetCurrentPrice(ctx, item.ID, 2500))
+		price, found, err := prices.GetCurrentPrice(ctx, item.ID)
+		require.NoError(t, err)
+		require.True(t, found)
+		assert.Equal(t, int64(2500), price)
+	})
+
+	t.Run("reconciling consistent totals keeps the cache", func(t *testing.T) {
+		item := newItem()
+		item.CurrentHighestBid = 2000
+		inner := &countingRepository{items: map[uuid.UUID]*items.Item{item.ID: item}, actualHighestBid: 2000}
+		repo := cache.NewCachedItemRepository(inner, rdb, time.Minute, logger)
+		prices := cache.NewRedisPriceCache(rdb, time.Minute)
+
+		_, err := repo.GetItemByID(ctx, item.ID)
+		require.NoError(t, err)
+		require.NoError(t, prices.SetCurrentPrice(ctx, item.ID, 2000))
+
+		_, err = repo.ReconcileItem(ctx, item.ID)
+		require.NoError(t, err)
+
+		exists, err := rdb.Exists(ctx, cache.ItemKey(item.ID), cache.PriceKey(item.ID)).Result()
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), exists)
+	})
+
+	t.Run("not found is not cached", func(t *testing.T) {
+		inner := &countingRepository{items: map[uuid.UUID]*items.Item{}}
+		repo := cache.NewCachedItemRepository(inner, rdb, time.Minute, logger)
+
+		missingID := uuid.New()
+		_, err := repo.GetItemByID(ctx, missingID)
+		require.ErrorIs(t, err, items.ErrItemNotFound)
+		_, err = repo.GetItemByID(ctx, missingID)
+		require.ErrorIs(t, err, items.ErrItemNotFound)
+		assert.Equal(t, 2, inner.reads)
+	})
+}
@@ -0,0 +1,89 @@
+package cache_test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/floroz/gavel/services/bid-service/internal/adapters/cache"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/items"
+)
+
+// countingRepository is an in-memory items.Repository that counts reads
+type countingRepository struct {
+	items.Repository
+	items map[uuid.UUID]*items.Item
+	reads int
+
+	actualHighestBid int64 // what ReconcileItem finds the highest bid to be
+}
+
+func (r *countingRepository) GetItemByID(_ context.Context, itemID uuid.UUID) (*items.Item, error) {
+	r.reads++
+	item, ok := r.items[itemID]
+	if !ok {
+		return nil, items.ErrItemNotFound
+	}
+	copied := *item
+	return &copied, nil
+}
+
+func (r *countingRepository) UpdateItem(_ context.Context, _ pgx.Tx, item *items.Item) error {
+	copied := *item
+	r.items[item.ID] = &copied
+	return nil
+}
+
+func (r *countingRepository) UpdateStatus(_ context.Context, _ pgx.Tx, itemID uuid.UUID, status items.ItemStatus) error {
+	r.items[itemID].Status = status
+	return nil
+}
+
+func (r *countingRepository) ActivateItem(_ context.Context, _ pgx.Tx, itemID uuid.UUID) error {
+	r.items[itemID].Status = items.ItemStatusActive
+	return nil
+}
+
+func (r *countingRepository) MarkEnded(_ context.Context, _ pgx.Tx, itemID uuid.UUID) error {
+	r.items[itemID].Status = items.ItemStatusEnded
+	return nil
+}
+
+func (r *countingRepository) ActivateScheduledItems(context.Context) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	for id, item := range r.items {
+		if item.Status == items.ItemStatusScheduled {
+			item.Status = items.ItemStatusActive
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// ReconcileItem corrects the item's highest bid to actualHighestBid
+func (r *countingRepository) ReconcileItem(_ context.Context, itemID uuid.UUID) (*items.Reconciliation, error) {
+	item := r.items[itemID]
+	rec := &items.Reconciliation{
+		ItemID: itemID,
+		Stored: items.BidTotals{Count: item.BidCount, HighestBid: item.CurrentHighestBid},
+		Actual: items.BidTotals{Count: item.BidCount, HighestBid: r.actualHighestBid},
+	}
+	item.CurrentHighestBid = r.actualHighestBid
+	return rec, nil
+}
+
+func (r *countingRepository) IncrementViews(_ context.Context, itemID uuid.UUID) error {
+	r.items[itemID].Views++
+	return nil
+}
+
+func TestNewCachedItemRepository_NilRedisIsPassthrough(t *testing.T) {
+	inner := &countingRepository{items: map[uuid.UUID]*items.Item{}}
+	repo := cache.NewCachedItemRepository(inner, nil, time.Minute, slog.Default())
+	assert.Same(t, inner, repo)
+}
@@ -0,0 +1,66 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// DefaultPriceTTL bounds how long a cached price can outlive a missed update
+const DefaultPriceTTL = 5 * time.Minute
+
+// setIfHigher only moves the cached price up, so concurrent or out-of-order
+// writes after commit can never replace a newer price with an older one.
+var setIfHigher = redis.NewScript(`
+local current = redis.call('GET', KEYS[1])
+if current and tonumber(current) >= tonumber(ARGV[1]) then
+	redis.call('PEXPIRE', KEYS[1], ARGV[2])
+	return 0
+end
+redis.call('SET', KEYS[1], ARGV[1], 'PX', ARGV[2])
+return 1
+`)
+
+// RedisPriceCache implements bids.PriceCache using Redis
+type RedisPriceCache struct {
+	rdb *redis.Client
+	ttl time.Duration
+}
+
+// NewRedisPriceCache creates a new Redis price cache
+func NewRedisPriceCache(rdb *redis.Client, ttl time.Duration) *RedisPriceCache {
+	if ttl <= 0 {
+		ttl = DefaultPriceTTL
+	}
+	return &RedisPriceCache{rdb: rdb, ttl: ttl}
+}
+
+// PriceKey returns the Redis key used to cache an item's current price
+func PriceKey(itemID uuid.UUID) string {
+	return fmt.Sprintf("item:%s:price", itemID)
+}
+
+// SetCurrentPrice stores amount unless a higher price is already cached
+func (c *RedisPriceCache) SetCurrentPrice(ctx context.Context, itemID uuid.UUID, amount int64) error {
+	err := setIfHigher.Run(ctx, c.rdb, []string{PriceKey(itemID)}, amount, c.ttl.Milliseconds()).Err()
+	if err != nil {
+		return fmt.Errorf("failed to cache price: %w", err)
+	}
+	return nil
+}
+
+// GetCurrentPrice returns the cached price and whether it was found
+func (c *RedisPriceCache) GetCurrentPrice(ctx context.Context, itemID uuid.UUID) (int64, bool, error) {
+	price, err := c.rdb.Get(ctx, PriceKey(itemID)).Int64()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return 0, false, nil
+		}
+		return 0, false, fmt.Errorf("failed to read cached price: %w", err)
+	}
+	return price, true, nil
+}
@@ -2,46 +2,132 @@ package database
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 
+	pkgdb "github.com/floroz/gavel/pkg/database"
 	"github.com/floroz/gavel/services/bid-service/internal/domain/bids"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/items"
 )
 
+// ItemBidsChannel is the Postgres NOTIFY channel SaveBid announces new bids on
+const ItemBidsChannel = "item_bids"
+
+// ItemBidNotification is the JSON payload sent on ItemBidsChannel
+type ItemBidNotification struct {
+	ItemID uuid.UUID `json:"item_id"`
+	Amount int64     `json:"amount"`
+}
+
 // PostgresBidRepository implements bids.BidRepository using pgx
 type PostgresBidRepository struct {
-	pool *pgxpool.Pool // Keep pool for read-only operations
+	pool         *pgxpool.Pool // Keep pool for read-only operations
+	queryTimeout time.Duration
 }
 
 // NewPostgresBidRepository creates a new PostgreSQL bid repository
-func NewPostgresBidRepository(pool *pgxpool.Pool) *PostgresBidRepository {
-	return &PostgresBidRepository{pool: pool}
+// queryTimeout: per-operation deadline applied to every query (0 = no timeout)
+func NewPostgresBidRepository(pool *pgxpool.Pool, queryTimeout time.Duration) *PostgresBidRepository {
+	return &PostgresBidRepository{pool: pool, queryTimeout: queryTimeout}
 }
 
 // SaveBid saves a bid using the provided database connection (pool or transaction)
+// and increments the item's bid_count in the same statement.
+// created_at comes from the database clock and is written back to bid.CreatedAt. It is
+// clock_timestamp(), not NOW(): NOW() is when the transaction began, before it waited on
+// the item lock, so a bid could be stamped earlier than the bid it outbid.
 func (r *PostgresBidRepository) SaveBid(ctx context.Context, tx pgx.Tx, bid *bids.Bid) error {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
 	query := `
-		INSERT INTO bids (id, item_id, user_id, amount, created_at)
-		VALUES ($1, $2, $3, $4, $5)
+		WITH inserted AS (
+			INSERT INTO bids (id, item_id, user_id, amount, created_at)
+			VALUES ($1, $2, $3, $4, clock_timestamp())
+			RETURNING item_id, created_at
+		), counted AS (
+			UPDATE items SET bid_count = items.bid_count + 1
+			FROM inserted
+			WHERE items.id = inserted.item_id
+		)
+		SELECT created_at FROM inserted
 	`
-	_, err := tx.Exec(ctx, query,
+	err := tx.QueryRow(ctx, query,
 		bid.ID,
 		bid.ItemID,
 		bid.UserID,
 		bid.Amount,
-		bid.CreatedAt,
-	)
+	).Scan(&bid.CreatedAt)
 	if err != nil {
 		return fmt.Errorf("failed to insert bid: %w", err)
 	}
+
+	// Postgres delivers the notification only if the transaction commits
+	payload, err := json.Marshal(ItemBidNotification{ItemID: bid.ItemID, Amount: bid.Amount})
+	if err != nil {
+		return fmt.Errorf("failed to marshal bid notification: %w", err)
+	}
+	if _, err := tx.Exec(ctx, "SELECT pg_notify($1, $2)", ItemBidsChannel, string(payload)); err != nil {
+		return fmt.Errorf("failed to notify bid: %w", err)
+	}
+	return nil
+}
+
+// SaveBids bulk-inserts bids with COPY. No bid notifications are sent.
+func (r *PostgresBidRepository) SaveBids(ctx context.Context, tx pgx.Tx, bidList []*bids.Bid) error {
+	if len(bidList) == 0 {
+		return nil
+	}
+
+	// Bulk loads are expected to run longer than a single query, so no per-query timeout here
+	copied, err := tx.CopyFrom(ctx,
+		pgx.Identifier{"bids"},
+		[]string{"id", "item_id", "user_id", "amount", "created_at"},
+		pgx.CopyFromSlice(len(bidList), func(i int) ([]any, error) {
+			b := bidList[i]
+			return []any{b.ID, b.ItemID, b.UserID, b.Amount, b.CreatedAt}, nil
+		}),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to copy bids: %w", err)
+	}
+	if copied != int64(len(bidList)) {
+		return fmt.Errorf("copied %d of %d bids", copied, len(bidList))
+	}
+
+	perItem := make(map[uuid.UUID]int64)
+	for _, b := range bidList {
+		perItem[b.ItemID]++
+	}
+	itemIDs := make([]uuid.UUID, 0, len(perItem))
+	counts := make([]int64, 0, len(perItem))
+	for id, n := range perItem {
+		itemIDs = append(itemIDs, id)
+		counts = append(counts, n)
+	}
+	query := `
+		UPDATE items SET bid_count = items.bid_count + c.n
+		FROM unnest($1::uuid[], $2::bigint[]) AS c(item_id, n)
+		WHERE items.id = c.item_id
+	`
+	if _, err := tx.Exec(ctx, query, itemIDs, counts); err != nil {
+		return fmt.Errorf("failed to update bid counts: %w", err)
+	}
 	return nil
 }
 
 // GetBidByID retrieves a bid by its ID
 func (r *PostgresBidRepository) GetBidByID(ctx context.Context, bidID uuid.UUID) (*bids.Bid, error) {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
 	query := `
 		SELECT id, item_id, user_id, amount, created_at
 		FROM bids
@@ -56,23 +142,90 @@ func (r *PostgresBidRepository) GetBidByID(ctx context.Context, bidID uuid.UUID)
 		&bid.CreatedAt,
 	)
 	if err != nil {
-		if err == pgx.ErrNoRows {
-			return nil, fmt.Errorf("bid not found")
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, bids.ErrBidNotFound
 		}
 		return nil, fmt.Errorf("failed to get bid: %w", err)
 	}
 	return &bid, nil
 }
 
-// GetBidsByItemID retrieves all bids for an item
-func (r *PostgresBidRepository) GetBidsByItemID(ctx context.Context, itemID uuid.UUID) ([]*bids.Bid, error) {
+// GetHighestBid returns the item's current winning bid, or nil if it has none
+func (r *PostgresBidRepository) GetHighestBid(ctx context.Context, tx pgx.Tx, itemID uuid.UUID) (*bids.Bid, error) {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
 	query := `
 		SELECT id, item_id, user_id, amount, created_at
 		FROM bids
 		WHERE item_id = $1
-		ORDER BY created_at DESC
+		ORDER BY amount DESC, created_at ASC
+		LIMIT 1
 	`
-	rows, err := r.pool.Query(ctx, query, itemID)
+	var bid bids.Bid
+	err := tx.QueryRow(ctx, query, itemID).Scan(
+		&bid.ID,
+		&bid.ItemID,
+		&bid.UserID,
+		&bid.Amount,
+		&bid.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get highest bid: %w", err)
+	}
+	return &bid, nil
+}
+
+// GetBidsByItemID retrieves a page of an item's bids using keyset pagination.
+// A NULL cursor starts at the first page and a NULL limit returns every remaining row.
+func (r *PostgresBidRepository) GetBidsByItemID(ctx context.Context, q bids.ItemBidsQuery) ([]*bids.Bid, error) {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	var limit *int
+	if q.Limit > 0 {
+		limit = &q.Limit
+	}
+
+	var query string
+	var args []any
+	switch q.OrderBy {
+	case bids.BidOrderTime:
+		query = `
+			SELECT id, item_id, user_id, amount, created_at
+			FROM bids
+			WHERE item_id = $1
+			  AND ($2::timestamptz IS NULL OR (created_at, id) < ($2, $3::uuid))
+			ORDER BY created_at DESC, id DESC
+			LIMIT $4
+		`
+		args = []any{q.ItemID, nil, nil, limit}
+		if q.After != nil {
+			args[1], args[2] = q.After.CreatedAt, q.After.ID
+		}
+	case bids.BidOrderAmount:
+		query = `
+			SELECT id, item_id, user_id, amount, created_at
+			FROM bids
+			WHERE item_id = $1
+			  AND ($2::bigint IS NULL
+			       OR amount < $2
+			       OR (amount = $2 AND (created_at, id) > ($3::timestamptz, $4::uuid)))
+			ORDER BY amount DESC, created_at ASC, id ASC
+			LIMIT $5
+		`
+		args = []any{q.ItemID, nil, nil, nil, limit}
+		if q.After != nil {
+			args[1], args[2], args[3] = q.After.Amount, q.After.CreatedAt, q.After.ID
+		}
+	default:
+		return nil, fmt.Errorf("unsupported bid order %d", q.OrderBy)
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query bids: %w", err)
 	}
@@ -99,3 +252,220 @@ func (r *PostgresBidRepository) GetBidsByItemID(ctx context.Context, itemID uuid
 
 	return result, nil
 }
+
+// bidVisibilityLag holds the upper bound of GetBidsSince and GetUserBidTotals back from the
+// database clock. A bid is
+// stamped when it is saved but only becomes visible when its transaction commits a few
+// statements later, so bids stamped inside this window may still be in flight.
+const bidVisibilityLag = 2 * time.Second
+
+// GetBidsSince returns the item's bids after the cursor and up to now() less
+// bidVisibilityLag, in (created_at, id) order. The bound is read first, so consecutive polls
+// tile without gaps or overlap regardless of the client's clock, and the id tiebreak lets a
+// full page resume partway through bids sharing a timestamp.
+func (r *PostgresBidRepository) GetBidsSince(ctx context.Context, itemID uuid.UUID, after bids.BidCursor, limit int) ([]*bids.Bid, time.Time, error) {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	var serverTime time.Time
+	if err := r.pool.QueryRow(ctx, `SELECT now() - $1::interval`, bidVisibilityLag).Scan(&serverTime); err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to read database time: %w", err)
+	}
+
+	query := `
+		SELECT id, item_id, user_id, amount, created_at
+		FROM bids
+		WHERE item_id = $1 AND (created_at, id) > ($2, $3) AND created_at <= $4
+		ORDER BY created_at ASC, id ASC
+		LIMIT $5
+	`
+	rows, err := r.pool.Query(ctx, query, itemID, after.CreatedAt, after.ID, serverTime, limit)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to query bids: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*bids.Bid
+	for rows.Next() {
+		var bid bids.Bid
+		if err := rows.Scan(&bid.ID, &bid.ItemID, &bid.UserID, &bid.Amount, &bid.CreatedAt); err != nil {
+			return nil, time.Time{}, fmt.Errorf("failed to scan bid: %w", err)
+		}
+		result = append(result, &bid)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, time.Time{}, fmt.Errorf("error iterating bids: %w", err)
+	}
+
+	return result, serverTime, nil
+}
+
+// GetBidPosition ranks userID's best bid among each bidder's best bid on the item.
+// The count row is always returned, so a user without bids still gets the bidder total.
+func (r *PostgresBidRepository) GetBidPosition(ctx context.Context, itemID, userID uuid.UUID) (*bids.BidPosition, error) {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	query := `
+		WITH best AS (
+			SELECT DISTINCT ON (user_id) id, item_id, user_id, amount, created_at
+			FROM bids
+			WHERE item_id = $1
+			ORDER BY user_id, amount DESC, created_at ASC
+		), ranked AS (
+			SELECT *, RANK() OVER (ORDER BY amount DESC, created_at ASC) AS rank
+			FROM best
+		)
+		SELECT r.id, r.item_id, r.user_id, r.amount, r.created_at, COALESCE(r.rank, 0), c.bidders
+		FROM (SELECT COUNT(*) AS bidders FROM best) c
+		LEFT JOIN ranked r ON r.user_id = $2
+	`
+	var (
+		bidID, bidItemID, bidUserID *uuid.UUID
+		amount                      *int64
+		createdAt                   *time.Time
+		position                    bids.BidPosition
+	)
+	err := r.pool.QueryRow(ctx, query, itemID, userID).Scan(
+		&bidID, &bidItemID, &bidUserID, &amount, &createdAt, &position.Rank, &position.Bidders,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bid position: %w", err)
+	}
+	if bidID != nil {
+		position.BestBid = &bids.Bid{
+			ID:        *bidID,
+			ItemID:    *bidItemID,
+			UserID:    *bidUserID,
+			Amount:    *amount,
+			CreatedAt: *createdAt,
+		}
+	}
+	return &position, nil
+}
+
+// ListBidsByUser retrieves a page of the bids userID placed, newest first.
+// Served by idx_bids_user_id.
+func (r *PostgresBidRepository) ListBidsByUser(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*bids.Bid, error) {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	query := `
+		SELECT id, item_id, user_id, amount, created_at
+		FROM bids
+		WHERE user_id = $1
+		ORDER BY created_at DESC, id DESC
+		LIMIT $2 OFFSET $3
+	`
+	rows, err := r.pool.Query(ctx, query, userID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list user bids: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*bids.Bid
+	for rows.Next() {
+		var bid bids.Bid
+		if err := rows.Scan(&bid.ID, &bid.ItemID, &bid.UserID, &bid.Amount, &bid.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan bid: %w", err)
+		}
+		result = append(result, &bid)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return result, nil
+}
+
+// AnonymizeBidder moves userID's bids to the shared tombstone id in one statement
+func (r *PostgresBidRepository) AnonymizeBidder(ctx context.Context, userID uuid.UUID) (int64, error) {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	tag, err := r.pool.Exec(ctx, `UPDATE bids SET user_id = $2 WHERE user_id = $1`, userID, bids.DeletedBidderID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to anonymize bidder: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+// GetUserBidTotals aggregates the user's bids stamped up to AsOf, which trails the database
+// clock by bidVisibilityLag. A bid still in flight when the statement runs is stamped after
+// AsOf, so it is left to the user-stats event stream rather than dropped by both.
+func (r *PostgresBidRepository) GetUserBidTotals(ctx context.Context, userID uuid.UUID) (*bids.UserBidTotals, error) {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	// SUM over bigint is numeric, so capping it cannot overflow
+	query := `
+		WITH bound AS (SELECT now() - $3::interval AS as_of)
+		SELECT COUNT(b.id), LEAST(COALESCE(SUM(b.amount), 0), $2)::bigint, MAX(b.created_at), bound.as_of
+		FROM bound
+		LEFT JOIN bids b ON b.user_id = $1 AND b.created_at <= bound.as_of
+		GROUP BY bound.as_of
+	`
+	var totals bids.UserBidTotals
+	var lastBidAt *time.Time
+	err := r.pool.QueryRow(ctx, query, userID, int64(math.MaxInt64), bidVisibilityLag).Scan(
+		&totals.Count, &totals.TotalAmount, &lastBidAt, &totals.AsOf,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user bid totals: %w", err)
+	}
+	if lastBidAt != nil {
+		totals.LastBidAt = *lastBidAt
+	}
+	return &totals, nil
+}
+
+// ListWonItemsByUser retrieves the finished auctions whose highest bid belongs to userID,
+// most recently ended first. An auction past its end time counts as finished even while
+// its status still reads active. Ties on amount go to the earlier bid, as in GetHighestBid.
+func (r *PostgresBidRepository) ListWonItemsByUser(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*bids.WonItem, error) {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	query := `
+		WITH candidates AS (
+			SELECT DISTINCT item_id FROM bids WHERE user_id = $1
+		), winners AS (
+			SELECT DISTINCT ON (b.item_id) b.id, b.item_id, b.user_id, b.amount, b.created_at
+			FROM bids b
+			JOIN candidates c ON c.item_id = b.item_id
+			ORDER BY b.item_id, b.amount DESC, b.created_at ASC, b.id ASC
+		)
+		SELECT` + itemColumns + `, w.id, w.user_id, w.amount, w.created_at
+		FROM winners w
+		JOIN items i ON i.id = w.item_id
+		LEFT JOIN item_views v ON v.item_id = i.id
+		WHERE w.user_id = $1
+		  AND (i.status = $2 OR (i.status = $3 AND i.end_at <= NOW()))
+		ORDER BY i.end_at DESC, i.id DESC
+		LIMIT $4 OFFSET $5
+	`
+	rows, err := r.pool.Query(ctx, query, userID, items.ItemStatusEnded, items.ItemStatusActive, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query won items: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*bids.WonItem
+	for rows.Next() {
+		var item items.Item
+		var bid bids.Bid
+		targets := append(itemScanTargets(&item), &bid.ID, &bid.UserID, &bid.Amount, &bid.CreatedAt)
+		if err := rows.Scan(targets...); err != nil {
+			return nil, fmt.Errorf("failed to scan won item: %w", err)
+		}
+		bid.ItemID = item.ID
+		result = append(result, &bids.WonItem{Item: &item, WinningBid: &bid})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating won items: %w", err)
+	}
+
+	return result, nil
+}
@@ -2,7 +2,9 @@ package database
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -12,29 +14,88 @@ import (
 	"github.com/floroz/gavel/services/bid-service/internal/domain/items"
 )
 
+// itemColumns lists every item column along with the view count, in itemScanTargets order.
+// View counts live in a separate table so incrementing them never contends with the bid lock.
+const itemColumns = `
+	i.id, i.title, i.description, i.start_price, i.current_highest_bid, i.buy_now_price, i.bid_step, i.start_at, i.end_at, i.end_at_timezone,
+	i.created_at, i.updated_at, i.images, i.category, i.seller_id, i.status, i.bid_count, COALESCE(v.views, 0),
+	i.extension_count, i.extended_seconds
+`
+
+// itemSelect selects all item columns along with the view count
+const itemSelect = `
+	SELECT` + itemColumns + `
+	FROM items i
+	LEFT JOIN item_views v ON v.item_id = i.id
+`
+
+// itemScanTargets returns the scan destinations for itemColumns
+func itemScanTargets(item *items.Item) []any {
+	return []any{
+		&item.ID,
+		&item.Title,
+		&item.Description,
+		&item.StartPrice,
+		&item.CurrentHighestBid,
+		&item.BuyNowPrice,
+		&item.BidStep,
+		&item.StartAt,
+		&item.EndAt,
+		&item.EndAtTimezone,
+		&item.CreatedAt,
+		&item.UpdatedAt,
+		&item.Images,
+		&item.Category,
+		&item.SellerID,
+		&item.Status,
+		&item.BidCount,
+		&item.Views,
+		&item.ExtensionCount,
+		&item.ExtendedSeconds,
+	}
+}
+
+// scanItem scans a single row produced by itemSelect
+func scanItem(row pgx.Row) (*items.Item, error) {
+	var item items.Item
+	if err := row.Scan(itemScanTargets(&item)...); err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
 // PostgresItemRepository implements bids.ItemRepository using pgx
 type PostgresItemRepository struct {
-	pool *pgxpool.Pool // Keep pool for non-transactional reads
+	pool         *pgxpool.Pool // Keep pool for non-transactional reads
+	queryTimeout time.Duration
 }
 
 // NewPostgresItemRepository creates a new PostgreSQL item repository
-func NewPostgresItemRepository(pool *pgxpool.Pool) *PostgresItemRepository {
-	return &PostgresItemRepository{pool: pool}
+// queryTimeout: per-operation deadline applied to every query (0 = no timeout)
+func NewPostgresItemRepository(pool *pgxpool.Pool, queryTimeout time.Duration) *PostgresItemRepository {
+	return &PostgresItemRepository{pool: pool, queryTimeout: queryTimeout}
 }
 
-// CreateItem creates a new auction item
-func (r *PostgresItemRepository) CreateItem(ctx context.Context, item *items.Item) error {
+// CreateItem creates a new auction item (transactional)
+func (r *PostgresItemRepository) CreateItem(ctx context.Context, tx pgx.Tx, item *items.Item) error {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
 	query := `
-		INSERT INTO items (id, title, description, start_price, current_highest_bid, end_at, created_at, updated_at, images, category, seller_id, status)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		INSERT INTO items (id, title, description, start_price, current_highest_bid, buy_now_price, bid_step, start_at, end_at, end_at_timezone, created_at, updated_at, images, category, seller_id, status)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
 	`
-	_, err := r.pool.Exec(ctx, query,
+	_, err := tx.Exec(ctx, query,
 		item.ID,
 		item.Title,
 		item.Description,
 		item.StartPrice,
 		item.CurrentHighestBid,
+		item.BuyNowPrice,
+		item.BidStep,
+		item.StartAt,
 		item.EndAt,
+		item.EndAtTimezone,
 		item.CreatedAt,
 		item.UpdatedAt,
 		item.Images,
@@ -50,105 +111,306 @@ func (r *PostgresItemRepository) CreateItem(ctx context.Context, item *items.Ite
 
 // GetItemByID retrieves an item by its ID (non-transactional read)
 func (r *PostgresItemRepository) GetItemByID(ctx context.Context, itemID uuid.UUID) (*items.Item, error) {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
 	return r.getItemByID(ctx, r.pool, itemID, false)
 }
 
 // GetItemByIDForUpdate retrieves an item by its ID and locks it for update (transactional)
 // This prevents race conditions when multiple users bid on the same item
 func (r *PostgresItemRepository) GetItemByIDForUpdate(ctx context.Context, tx pgx.Tx, itemID uuid.UUID) (*items.Item, error) {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
 	return r.getItemByID(ctx, tx, itemID, true)
 }
 
 // getItemByID is the internal implementation that works with any DBTX
 func (r *PostgresItemRepository) getItemByID(ctx context.Context, db pkgdb.DBTX, itemID uuid.UUID, forUpdate bool) (*items.Item, error) {
-	query := `
-		SELECT id, title, description, start_price, current_highest_bid, end_at, created_at, updated_at, images, category, seller_id, status
-		FROM items
-		WHERE id = $1
+	query := itemSelect + `
+		WHERE i.id = $1
 	`
 	if forUpdate {
-		query += " FOR UPDATE"
+		// NO KEY UPDATE still serializes bidders but does not block
+		// foreign-key inserts such as view counter upserts
+		query += " FOR NO KEY UPDATE OF i"
 	}
 
-	var item items.Item
-	err := db.QueryRow(ctx, query, itemID).Scan(
-		&item.ID,
-		&item.Title,
-		&item.Description,
-		&item.StartPrice,
-		&item.CurrentHighestBid,
-		&item.EndAt,
-		&item.CreatedAt,
-		&item.UpdatedAt,
-		&item.Images,
-		&item.Category,
-		&item.SellerID,
-		&item.Status,
-	)
+	item, err := scanItem(db.QueryRow(ctx, query, itemID))
 	if err != nil {
-		if err == pgx.ErrNoRows {
-			return nil, fmt.Errorf("item not found")
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, items.ErrItemNotFound
 		}
 		return nil, fmt.Errorf("failed to get item: %w", err)
 	}
-	return &item, nil
+	return item, nil
 }
 
-// UpdateItem updates an item's editable fields
-func (r *PostgresItemRepository) UpdateItem(ctx context.Context, item *items.Item) error {
+// UpdateItem updates an item's editable fields (transactional)
+func (r *PostgresItemRepository) UpdateItem(ctx context.Context, tx pgx.Tx, item *items.Item) error {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	// updated_at is set by the items_set_updated_at trigger and read back
 	query := `
 		UPDATE items
-		SET title = $1, description = $2, images = $3, category = $4, updated_at = $5
+		SET title = $1, description = $2, images = $3, category = $4, end_at_timezone = $5
 		WHERE id = $6
+		RETURNING updated_at
 	`
-	result, err := r.pool.Exec(ctx, query,
+	err := tx.QueryRow(ctx, query,
 		item.Title,
 		item.Description,
 		item.Images,
 		item.Category,
-		item.UpdatedAt,
+		item.EndAtTimezone,
 		item.ID,
-	)
+	).Scan(&item.UpdatedAt)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return items.ErrItemNotFound
+		}
 		return fmt.Errorf("failed to update item: %w", err)
 	}
 
-	if result.RowsAffected() == 0 {
-		return fmt.Errorf("item not found")
-	}
-
 	return nil
 }
 
-// UpdateStatus updates an item's status
-func (r *PostgresItemRepository) UpdateStatus(ctx context.Context, itemID uuid.UUID, status items.ItemStatus) error {
+// UpdateStatus updates an item's status (transactional)
+func (r *PostgresItemRepository) UpdateStatus(ctx context.Context, tx pgx.Tx, itemID uuid.UUID, status items.ItemStatus) error {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
 	query := `
 		UPDATE items
-		SET status = $1, updated_at = NOW()
+		SET status = $1
 		WHERE id = $2
 	`
-	result, err := r.pool.Exec(ctx, query, status, itemID)
+	result, err := tx.Exec(ctx, query, status, itemID)
 	if err != nil {
 		return fmt.Errorf("failed to update status: %w", err)
 	}
 
 	if result.RowsAffected() == 0 {
-		return fmt.Errorf("item not found")
+		return items.ErrItemNotFound
+	}
+
+	return nil
+}
+
+// PauseItem moves an active item to paused, stamping paused_at
+func (r *PostgresItemRepository) PauseItem(ctx context.Context, itemID uuid.UUID) error {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	query := `
+		UPDATE items
+		SET status = $1, paused_at = NOW()
+		WHERE id = $2 AND status = $3
+	`
+	result, err := r.pool.Exec(ctx, query, items.ItemStatusPaused, itemID, items.ItemStatusActive)
+	if err != nil {
+		return fmt.Errorf("failed to pause item: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return items.ErrCannotPause
+	}
+	return nil
+}
+
+// ResumeItem moves a paused item back to active, optionally adding the time spent
+// paused to end_at, and clears paused_at
+func (r *PostgresItemRepository) ResumeItem(ctx context.Context, itemID uuid.UUID, extendEndAt bool) (time.Time, error) {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	query := `
+		UPDATE items
+		SET status = $1,
+		    end_at = CASE WHEN $2::boolean THEN end_at + (NOW() - paused_at) ELSE end_at END,
+		    paused_at = NULL
+		WHERE id = $3 AND status = $4
+		RETURNING end_at
+	`
+	var endAt time.Time
+	err := r.pool.QueryRow(ctx, query, items.ItemStatusActive, extendEndAt, itemID, items.ItemStatusPaused).Scan(&endAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return time.Time{}, items.ErrNotPaused
+		}
+		return time.Time{}, fmt.Errorf("failed to resume item: %w", err)
+	}
+	return endAt, nil
+}
+
+// ActivateScheduledItems moves every scheduled item whose start time has passed to active
+func (r *PostgresItemRepository) ActivateScheduledItems(ctx context.Context) ([]uuid.UUID, error) {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	query := `
+		UPDATE items
+		SET status = $1
+		WHERE status = $2 AND start_at <= NOW()
+		RETURNING id
+	`
+	rows, err := r.pool.Query(ctx, query, items.ItemStatusActive, items.ItemStatusScheduled)
+	if err != nil {
+		return nil, fmt.Errorf("failed to activate scheduled items: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan item id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
 	}
 
+	return ids, nil
+}
+
+// ActivateItem moves a single scheduled item to active within a transaction
+func (r *PostgresItemRepository) ActivateItem(ctx context.Context, tx pgx.Tx, itemID uuid.UUID) error {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	query := `
+		UPDATE items
+		SET status = $1
+		WHERE id = $2 AND status = $3
+	`
+	if _, err := tx.Exec(ctx, query, items.ItemStatusActive, itemID, items.ItemStatusScheduled); err != nil {
+		return fmt.Errorf("failed to activate item: %w", err)
+	}
 	return nil
 }
 
-// ListActiveItems retrieves active items with pagination
-func (r *PostgresItemRepository) ListActiveItems(ctx context.Context, limit, offset int) ([]*items.Item, error) {
+// MarkEnded moves an active item to ended within a transaction
+// Returns items.ErrItemNotActive if the item is not active
+func (r *PostgresItemRepository) MarkEnded(ctx context.Context, tx pgx.Tx, itemID uuid.UUID) error {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
 	query := `
-		SELECT id, title, description, start_price, current_highest_bid, end_at, created_at, updated_at, images, category, seller_id, status
+		UPDATE items
+		SET status = $1
+		WHERE id = $2 AND status = $3
+	`
+	result, err := tx.Exec(ctx, query, items.ItemStatusEnded, itemID, items.ItemStatusActive)
+	if err != nil {
+		return fmt.Errorf("failed to mark item ended: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return items.ErrItemNotActive
+	}
+	return nil
+}
+
+// MarkExpiredItemsEnded ends up to limit active items whose end time is at or before before,
+// earliest first, and returns them as they are after the update.
+// Rows already locked by another transaction are skipped so concurrent close workers
+// each take a different batch, the same way the outbox relay claims events.
+func (r *PostgresItemRepository) MarkExpiredItemsEnded(ctx context.Context, tx pgx.Tx, before time.Time, limit int) ([]*items.Item, error) {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	query := `
+		WITH expired AS (
+			SELECT id
+			FROM items
+			WHERE status = $1 AND end_at <= $2
+			ORDER BY end_at ASC
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		), ended AS (
+			UPDATE items
+			SET status = $4
+			WHERE id IN (SELECT id FROM expired)
+			RETURNING *
+		)
+		SELECT` + itemColumns + `
+		FROM ended i
+		LEFT JOIN item_views v ON v.item_id = i.id
+		ORDER BY i.end_at ASC
+	`
+	rows, err := tx.Query(ctx, query, items.ItemStatusActive, before, limit, items.ItemStatusEnded)
+	if err != nil {
+		return nil, fmt.Errorf("failed to mark expired items ended: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*items.Item
+	for rows.Next() {
+		item, err := scanItem(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan item: %w", err)
+		}
+		result = append(result, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return result, nil
+}
+
+// CountActiveItemsBySeller counts the seller's scheduled, active and paused items.
+// A transaction-scoped advisory lock on the seller serializes the count with other
+// creates for the same seller; locking the seller's rows would not stop a concurrent
+// insert. Served by idx_items_seller_id.
+func (r *PostgresItemRepository) CountActiveItemsBySeller(ctx context.Context, tx pgx.Tx, sellerID uuid.UUID) (int, error) {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	lock := `SELECT pg_advisory_xact_lock(hashtextextended('items.seller:' || $1::text, 0))`
+	if _, err := tx.Exec(ctx, lock, sellerID); err != nil {
+		return 0, fmt.Errorf("failed to lock seller listings: %w", err)
+	}
+
+	query := `
+		SELECT COUNT(*)
 		FROM items
-		WHERE status = $1 AND end_at > NOW()
-		ORDER BY created_at DESC
-		LIMIT $2 OFFSET $3
+		WHERE seller_id = $1 AND status IN ($2, $3, $4)
+	`
+	var count int
+	err := tx.QueryRow(ctx, query, sellerID,
+		items.ItemStatusScheduled, items.ItemStatusActive, items.ItemStatusPaused,
+	).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count seller items: %w", err)
+	}
+	return count, nil
+}
+
+// ListActiveItems retrieves a page of active items using keyset pagination, newest first.
+// The id tiebreak keeps the order total, so items sharing a created_at are never repeated
+// or skipped across pages. Served by idx_items_active_created_at.
+func (r *PostgresItemRepository) ListActiveItems(ctx context.Context, limit int, after *items.ItemCursor) ([]*items.Item, error) {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	var afterCreatedAt *time.Time
+	var afterID *uuid.UUID
+	if after != nil {
+		afterCreatedAt, afterID = &after.CreatedAt, &after.ID
+	}
+
+	query := itemSelect + `
+		WHERE i.status = $1 AND i.end_at > NOW()
+			AND ($2::timestamptz IS NULL OR (i.created_at, i.id) < ($2, $3))
+		ORDER BY i.created_at DESC, i.id DESC
+		LIMIT $4
 	`
-	rows, err := r.pool.Query(ctx, query, items.ItemStatusActive, limit, offset)
+	rows, err := r.pool.Query(ctx, query, items.ItemStatusActive, afterCreatedAt, afterID, limit)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list active items: %w", err)
 	}
@@ -156,25 +418,76 @@ func (r *PostgresItemRepository) ListActiveItems(ctx context.Context, limit, off
 
 	var result []*items.Item
 	for rows.Next() {
-		var item items.Item
-		err := rows.Scan(
-			&item.ID,
-			&item.Title,
-			&item.Description,
-			&item.StartPrice,
-			&item.CurrentHighestBid,
-			&item.EndAt,
-			&item.CreatedAt,
-			&item.UpdatedAt,
-			&item.Images,
-			&item.Category,
-			&item.SellerID,
-			&item.Status,
-		)
+		item, err := scanItem(rows)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan item: %w", err)
 		}
-		result = append(result, &item)
+		result = append(result, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return result, nil
+}
+
+// ListItemsEndingSoon retrieves active items ending within the window, soonest first.
+// The range scan is served by idx_items_status_end_at.
+func (r *PostgresItemRepository) ListItemsEndingSoon(ctx context.Context, within time.Duration, limit, offset int) ([]*items.Item, error) {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	query := itemSelect + `
+		WHERE i.status = $1 AND i.end_at > NOW() AND i.end_at <= NOW() + make_interval(secs => $2)
+		ORDER BY i.end_at ASC, i.id ASC
+		LIMIT $3 OFFSET $4
+	`
+	rows, err := r.pool.Query(ctx, query, items.ItemStatusActive, within.Seconds(), limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list items ending soon: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*items.Item
+	for rows.Next() {
+		item, err := scanItem(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan item: %w", err)
+		}
+		result = append(result, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return result, nil
+}
+
+// ListItemsByStatus retrieves items in the given status, newest first
+func (r *PostgresItemRepository) ListItemsByStatus(ctx context.Context, status items.ItemStatus, limit, offset int) ([]*items.Item, error) {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	query := itemSelect + `
+		WHERE i.status = $1
+		ORDER BY i.created_at DESC, i.id DESC
+		LIMIT $2 OFFSET $3
+	`
+	rows, err := r.pool.Query(ctx, query, status, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list items by status: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*items.Item
+	for rows.Next() {
+		item, err := scanItem(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan item: %w", err)
+		}
+		result = append(result, item)
 	}
 
 	if err := rows.Err(); err != nil {
@@ -186,11 +499,12 @@ func (r *PostgresItemRepository) ListActiveItems(ctx context.Context, limit, off
 
 // ListItemsBySellerID retrieves all items for a specific seller
 func (r *PostgresItemRepository) ListItemsBySellerID(ctx context.Context, sellerID uuid.UUID, limit, offset int) ([]*items.Item, error) {
-	query := `
-		SELECT id, title, description, start_price, current_highest_bid, end_at, created_at, updated_at, images, category, seller_id, status
-		FROM items
-		WHERE seller_id = $1
-		ORDER BY created_at DESC
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	query := itemSelect + `
+		WHERE i.seller_id = $1
+		ORDER BY i.created_at DESC
 		LIMIT $2 OFFSET $3
 	`
 	rows, err := r.pool.Query(ctx, query, sellerID, limit, offset)
@@ -201,25 +515,11 @@ func (r *PostgresItemRepository) ListItemsBySellerID(ctx context.Context, seller
 
 	var result []*items.Item
 	for rows.Next() {
-		var item items.Item
-		err := rows.Scan(
-			&item.ID,
-			&item.Title,
-			&item.Description,
-			&item.StartPrice,
-			&item.CurrentHighestBid,
-			&item.EndAt,
-			&item.CreatedAt,
-			&item.UpdatedAt,
-			&item.Images,
-			&item.Category,
-			&item.SellerID,
-			&item.Status,
-		)
+		item, err := scanItem(rows)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan item: %w", err)
 		}
-		result = append(result, &item)
+		result = append(result, item)
 	}
 
 	if err := rows.Err(); err != nil {
@@ -229,8 +529,45 @@ func (r *PostgresItemRepository) ListItemsBySellerID(ctx context.Context, seller
 	return result, nil
 }
 
-// CountBidsByItemID returns the number of bids for a specific item
+// ListItemsBySellerForUpdate locks the seller's scheduled and active items (transactional).
+// Locking in id order keeps two bulk cancels for the same seller from deadlocking.
+func (r *PostgresItemRepository) ListItemsBySellerForUpdate(ctx context.Context, tx pgx.Tx, sellerID uuid.UUID) ([]*items.Item, error) {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	query := itemSelect + `
+		WHERE i.seller_id = $1 AND i.status IN ($2, $3)
+		ORDER BY i.id
+		FOR NO KEY UPDATE OF i
+	`
+	rows, err := tx.Query(ctx, query, sellerID, items.ItemStatusScheduled, items.ItemStatusActive)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock seller items: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*items.Item
+	for rows.Next() {
+		item, err := scanItem(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan item: %w", err)
+		}
+		result = append(result, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return result, nil
+}
+
+// CountBidsByItemID counts the bids table directly. Unlike Item.BidCount it cannot
+// lag behind a cached item, at the cost of a scan over the item's bids.
 func (r *PostgresItemRepository) CountBidsByItemID(ctx context.Context, itemID uuid.UUID) (int64, error) {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
 	query := `SELECT COUNT(*) FROM bids WHERE item_id = $1`
 	var count int64
 	err := r.pool.QueryRow(ctx, query, itemID).Scan(&count)
@@ -240,12 +577,130 @@ func (r *PostgresItemRepository) CountBidsByItemID(ctx context.Context, itemID u
 	return count, nil
 }
 
+// ReconcileItem recomputes bid_count and current_highest_bid from the bids table.
+// The item row is locked first so bids placed meanwhile (which take the same lock)
+// are either fully counted or wait until the correction commits.
+func (r *PostgresItemRepository) ReconcileItem(ctx context.Context, itemID uuid.UUID) (*items.Reconciliation, error) {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin reconciliation: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	rec := &items.Reconciliation{ItemID: itemID}
+	err = tx.QueryRow(ctx, `
+		SELECT bid_count, current_highest_bid FROM items WHERE id = $1 FOR NO KEY UPDATE
+	`, itemID).Scan(&rec.Stored.Count, &rec.Stored.HighestBid)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, items.ErrItemNotFound
+		}
+		return nil, fmt.Errorf("failed to lock item: %w", err)
+	}
+
+	// Read committed: this statement sees every bid committed before the lock was granted
+	err = tx.QueryRow(ctx, `
+		SELECT COUNT(*), COALESCE(MAX(amount), 0) FROM bids WHERE item_id = $1
+	`, itemID).Scan(&rec.Actual.Count, &rec.Actual.HighestBid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to total bids: %w", err)
+	}
+
+	if !rec.Drifted() {
+		return rec, nil
+	}
+	_, err = tx.Exec(ctx, `
+		UPDATE items SET bid_count = $1, current_highest_bid = $2 WHERE id = $3
+	`, rec.Actual.Count, rec.Actual.HighestBid, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to correct item totals: %w", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit reconciliation: %w", err)
+	}
+	return rec, nil
+}
+
+// ListItemIDs returns item IDs after the given one in ascending order
+func (r *PostgresItemRepository) ListItemIDs(ctx context.Context, after uuid.UUID, limit int) ([]uuid.UUID, error) {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT id FROM items WHERE id > $1 ORDER BY id LIMIT $2
+	`, after, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list item ids: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan item id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return ids, nil
+}
+
+// GetSellerDashboard computes a seller's listing aggregates in two queries:
+// one for the counts and one for the highest-valued item
+func (r *PostgresItemRepository) GetSellerDashboard(ctx context.Context, sellerID uuid.UUID) (*items.SellerDashboard, error) {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	dashboard := &items.SellerDashboard{SellerID: sellerID}
+
+	countsQuery := `
+		SELECT
+			(SELECT COUNT(*) FROM items
+				WHERE seller_id = $1 AND status = $2 AND end_at > NOW()),
+			(SELECT COALESCE(SUM(bid_count), 0) FROM items
+				WHERE seller_id = $1)
+	`
+	err := r.pool.QueryRow(ctx, countsQuery, sellerID, items.ItemStatusActive).Scan(
+		&dashboard.ActiveListings,
+		&dashboard.TotalBidsReceived,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count seller aggregates: %w", err)
+	}
+
+	highestQuery := itemSelect + `
+		WHERE i.seller_id = $1 AND i.status <> $2 AND i.current_highest_bid > 0
+		ORDER BY i.current_highest_bid DESC, i.created_at DESC
+		LIMIT 1
+	`
+	item, err := scanItem(r.pool.QueryRow(ctx, highestQuery, sellerID, items.ItemStatusCancelled))
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("failed to get highest valued item: %w", err)
+	}
+	dashboard.HighestValuedItem = item
+
+	return dashboard, nil
+}
+
 // UpdateHighestBid updates the current highest bid for an item within a transaction
+// Returns items.ErrHighestBidChanged if the item is missing or already has an equal or higher bid
 func (r *PostgresItemRepository) UpdateHighestBid(ctx context.Context, tx pgx.Tx, itemID uuid.UUID, amount int64) error {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	// Guarded update: the highest bid can never decrease, even without a row lock
 	query := `
 		UPDATE items
-		SET current_highest_bid = $1, updated_at = NOW()
-		WHERE id = $2
+		SET current_highest_bid = $1
+		WHERE id = $2 AND current_highest_bid < $1
 	`
 	result, err := tx.Exec(ctx, query, amount, itemID)
 	if err != nil {
@@ -253,8 +708,110 @@ func (r *PostgresItemRepository) UpdateHighestBid(ctx context.Context, tx pgx.Tx
 	}
 
 	if result.RowsAffected() == 0 {
-		return fmt.Errorf("item not found")
+		return items.ErrHighestBidChanged
+	}
+
+	return nil
+}
+
+// UpdateEndAt sets the item's end time within a transaction
+func (r *PostgresItemRepository) UpdateEndAt(ctx context.Context, tx pgx.Tx, itemID uuid.UUID, endAt time.Time) error {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	query := `
+		UPDATE items
+		SET end_at = $1
+		WHERE id = $2
+	`
+	result, err := tx.Exec(ctx, query, endAt, itemID)
+	if err != nil {
+		return fmt.Errorf("failed to update end time: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return items.ErrItemNotFound
+	}
+	return nil
+}
+
+// ExtendEndAt sets the item's end time and adds the extension to its running totals
+func (r *PostgresItemRepository) ExtendEndAt(ctx context.Context, tx pgx.Tx, itemID uuid.UUID, endAt time.Time, by time.Duration) error {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	query := `
+		UPDATE items
+		SET end_at = $1,
+		    extension_count = extension_count + 1,
+		    extended_seconds = extended_seconds + $2
+		WHERE id = $3
+	`
+	result, err := tx.Exec(ctx, query, endAt.UTC(), int64(by.Seconds()), itemID)
+	if err != nil {
+		return fmt.Errorf("failed to extend item: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return items.ErrItemNotFound
 	}
+	return nil
+}
+
+// IncrementViews atomically increments the view counter for an item
+func (r *PostgresItemRepository) IncrementViews(ctx context.Context, itemID uuid.UUID) error {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
 
+	query := `
+		INSERT INTO item_views (item_id, views)
+		VALUES ($1, 1)
+		ON CONFLICT (item_id) DO UPDATE SET views = item_views.views + 1
+	`
+	_, err := r.pool.Exec(ctx, query, itemID)
+	if err != nil {
+		if pkgdb.IsForeignKeyViolation(err) {
+			return items.ErrItemNotFound
+		}
+		return fmt.Errorf("failed to increment views: %w", err)
+	}
 	return nil
 }
+
+// ListCategories returns every managed category in display order
+func (r *PostgresItemRepository) ListCategories(ctx context.Context) ([]*items.Category, error) {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	rows, err := r.pool.Query(ctx, `SELECT slug, name FROM categories ORDER BY sort_order, name`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+	defer rows.Close()
+
+	var categories []*items.Category
+	for rows.Next() {
+		var c items.Category
+		if err := rows.Scan(&c.Slug, &c.Name); err != nil {
+			return nil, fmt.Errorf("failed to scan category: %w", err)
+		}
+		categories = append(categories, &c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return categories, nil
+}
+
+// CategoryExists checks slug against the categories table
+func (r *PostgresItemRepository) CategoryExists(ctx context.Context, slug string) (bool, error) {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	var exists bool
+	err := r.pool.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM categories WHERE slug = $1)`, slug).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("failed to look up category: %w", err)
+	}
+	return exists, nil
+}
@@ -2,6 +2,7 @@ package database
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -10,20 +11,30 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	pkgevents "github.com/floroz/gavel/pkg/events"
+
+	pkgdb "github.com/floroz/gavel/pkg/database"
 )
 
 // PostgresOutboxRepository implements bids.OutboxRepository using pgx
 type PostgresOutboxRepository struct {
-	pool *pgxpool.Pool
+	pool         *pgxpool.Pool
+	queryTimeout time.Duration
 }
 
+// PublishNow on the api's relay claims single events through this repository
+var _ pkgevents.PendingEventLocker = (*PostgresOutboxRepository)(nil)
+
 // NewPostgresOutboxRepository creates a new PostgreSQL outbox repository
-func NewPostgresOutboxRepository(pool *pgxpool.Pool) *PostgresOutboxRepository {
-	return &PostgresOutboxRepository{pool: pool}
+// queryTimeout: per-operation deadline applied to every query (0 = no timeout)
+func NewPostgresOutboxRepository(pool *pgxpool.Pool, queryTimeout time.Duration) *PostgresOutboxRepository {
+	return &PostgresOutboxRepository{pool: pool, queryTimeout: queryTimeout}
 }
 
 // SaveEvent saves an outbox event within a transaction
 func (r *PostgresOutboxRepository) SaveEvent(ctx context.Context, tx pgx.Tx, event *pkgevents.OutboxEvent) error {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
 	query := `
 		INSERT INTO outbox_events (id, event_type, payload, status, created_at)
 		VALUES ($1, $2, $3, $4::outbox_status, $5)
@@ -44,6 +55,9 @@ func (r *PostgresOutboxRepository) SaveEvent(ctx context.Context, tx pgx.Tx, eve
 // GetPendingEvents retrieves pending events for processing
 // Uses SELECT FOR UPDATE SKIP LOCKED to prevent multiple workers from processing the same event
 func (r *PostgresOutboxRepository) GetPendingEvents(ctx context.Context, tx pgx.Tx, limit int) ([]*pkgevents.OutboxEvent, error) {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
 	query := `
 		SELECT id, event_type, payload, status, created_at, processed_at
 		FROM outbox_events
@@ -77,8 +91,42 @@ func (r *PostgresOutboxRepository) GetPendingEvents(ctx context.Context, tx pgx.
 	return events, nil
 }
 
+// LockPendingEvent locks a single event for OutboxRelay.PublishNow. It returns nil
+// when the event is no longer pending or the relay's batch already holds it.
+func (r *PostgresOutboxRepository) LockPendingEvent(ctx context.Context, tx pgx.Tx, id uuid.UUID) (*pkgevents.OutboxEvent, error) {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	query := `
+		SELECT id, event_type, payload, status, created_at, processed_at
+		FROM outbox_events
+		WHERE id = $1 AND status = $2::outbox_status
+		FOR UPDATE SKIP LOCKED
+	`
+
+	var event pkgevents.OutboxEvent
+	err := tx.QueryRow(ctx, query, id, pkgevents.OutboxStatusPending).Scan(
+		&event.ID,
+		&event.EventType,
+		&event.Payload,
+		&event.Status,
+		&event.CreatedAt,
+		&event.ProcessedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to lock event: %w", err)
+	}
+	return &event, nil
+}
+
 // UpdateEventStatus updates the status of an event
 func (r *PostgresOutboxRepository) UpdateEventStatus(ctx context.Context, tx pgx.Tx, eventID uuid.UUID, status pkgevents.OutboxStatus) error {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
 	query := `
 		UPDATE outbox_events
 		SET status = $1::outbox_status, processed_at = $2
@@ -99,6 +147,27 @@ func (r *PostgresOutboxRepository) UpdateEventStatus(ctx context.Context, tx pgx
 	if result.RowsAffected() == 0 {
 		return fmt.Errorf("event not found")
 	}
-
 	return nil
 }
+
+// GetLastPublishedAt returns the created_at of the newest published event
+// and false if nothing has been published yet. It reads committed statuses only, so
+// a rolled back batch never counts. Served by idx_outbox_events_published_created_at.
+func (r *PostgresOutboxRepository) GetLastPublishedAt(ctx context.Context) (time.Time, bool, error) {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	query := `
+		SELECT MAX(created_at)
+		FROM outbox_events
+		WHERE status = $1::outbox_status
+	`
+	var lastPublishedAt *time.Time
+	if err := r.pool.QueryRow(ctx, query, pkgevents.OutboxStatusPublished).Scan(&lastPublishedAt); err != nil {
+		return time.Time{}, false, fmt.Errorf("failed to get last published time: %w", err)
+	}
+	if lastPublishedAt == nil {
+		return time.Time{}, false, nil
+	}
+	return *lastPublishedAt, true, nil
+}
@@ -1,3 +1,5 @@
+//go:build integration
+
 package database_test
 
 import (
@@ -9,23 +11,20 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	pkgdb "github.com/floroz/gavel/pkg/database"
 	"github.com/floroz/gavel/pkg/events"
 	"github.com/floroz/gavel/pkg/testhelpers"
 	"github.com/floroz/gavel/services/bid-service/internal/adapters/database"
 )
 
 func TestOutboxRepository_Integration(t *testing.T) {
-	if testing.Short() {
-		t.Skip("skipping integration test in short mode")
-	}
-
 	// 1. Setup Database
 	// Path to migrations relative to this file
 	migrationsPath := "../../../migrations"
 	td := testhelpers.NewTestDatabase(t, migrationsPath)
 	defer td.Close()
 
-	repo := database.NewPostgresOutboxRepository(td.Pool)
+	repo := database.NewPostgresOutboxRepository(td.Pool, pkgdb.DefaultQueryTimeout)
 	ctx := context.Background()
 
 	t.Run("SaveEvent_Success", func(t *testing.T) {
@@ -92,3 +91,138 @@ func TestOutboxRepository_Integration(t *testing.T) {
 		assert.NotNil(t, processedAt)
 	})
 }
+
+func TestOutboxRepository_GetLastPublishedAt(t *testing.T) {
+	td := testhelpers.NewTestDatabase(t, "../../../migrations")
+	defer td.Close()
+
+	repo := database.NewPostgresOutboxRepository(td.Pool, pkgdb.DefaultQueryTimeout)
+	ctx := context.Background()
+
+	saveEvent := func(t *testing.T, createdAt time.Time) *events.OutboxEvent {
+		t.Helper()
+		event := &events.OutboxEvent{
+			ID:        uuid.New(),
+			EventType: "bid.placed",
+			Payload:   []byte(`{}`),
+			Status:    events.OutboxStatusPending,
+			CreatedAt: createdAt,
+		}
+		tx, err := td.Pool.Begin(ctx)
+		require.NoError(t, err)
+		require.NoError(t, repo.SaveEvent(ctx, tx, event))
+		require.NoError(t, tx.Commit(ctx))
+		return event
+	}
+
+	setStatus := func(t *testing.T, event *events.OutboxEvent, status events.OutboxStatus, commit bool) {
+		t.Helper()
+		tx, err := td.Pool.Begin(ctx)
+		require.NoError(t, err)
+		defer tx.Rollback(ctx)
+		require.NoError(t, repo.UpdateEventStatus(ctx, tx, event.ID, status))
+		if commit {
+			require.NoError(t, tx.Commit(ctx))
+		}
+	}
+
+	base := time.Now().UTC().Truncate(time.Microsecond).Add(-time.Hour)
+	first := saveEvent(t, base)
+	second := saveEvent(t, base.Add(time.Minute))
+	third := saveEvent(t, base.Add(2*time.Minute))
+
+	t.Run("nothing published yet", func(t *testing.T) {
+		_, found, err := repo.GetLastPublishedAt(ctx)
+		require.NoError(t, err)
+		assert.False(t, found)
+	})
+
+	t.Run("advances as events publish", func(t *testing.T) {
+		setStatus(t, first, events.OutboxStatusPublished, true)
+		last, found, err := repo.GetLastPublishedAt(ctx)
+		require.NoError(t, err)
+		require.True(t, found)
+		assert.True(t, first.CreatedAt.Equal(last), "got %v", last)
+
+		setStatus(t, third, events.OutboxStatusPublished, true)
+		last, _, err = repo.GetLastPublishedAt(ctx)
+		require.NoError(t, err)
+		assert.True(t, third.CreatedAt.Equal(last), "got %v", last)
+	})
+
+	t.Run("never moves backwards", func(t *testing.T) {
+		setStatus(t, second, events.OutboxStatusPublished, true)
+		last, _, err := repo.GetLastPublishedAt(ctx)
+		require.NoError(t, err)
+		assert.True(t, third.CreatedAt.Equal(last), "got %v", last)
+	})
+
+	t.Run("ignores failed and rolled back publishes", func(t *testing.T) {
+		failed := saveEvent(t, base.Add(3*time.Minute))
+		setStatus(t, failed, events.OutboxStatusFailed, true)
+
+		rolledBack := saveEvent(t, base.Add(4*time.Minute))
+		setStatus(t, rolledBack, events.OutboxStatusPublished, false)
+
+		last, _, err := repo.GetLastPublishedAt(ctx)
+		require.NoError(t, err)
+		assert.True(t, third.CreatedAt.Equal(last), "got %v", last)
+	})
+}
+
+func TestOutboxRepository_LockPendingEvent(t *testing.T) {
+	td := testhelpers.NewTestDatabase(t, "../../../migrations")
+	defer td.Close()
+
+	repo := database.NewPostgresOutboxRepository(td.Pool, pkgdb.DefaultQueryTimeout)
+	ctx := context.Background()
+
+	event := &events.OutboxEvent{
+		ID:        uuid.New(),
+		EventType: "bid.placed",
+		Payload:   []byte(`{}`),
+		Status:    events.OutboxStatusPending,
+		CreatedAt: time.Now().UTC(),
+	}
+	tx, err := td.Pool.Begin(ctx)
+	require.NoError(t, err)
+	require.NoError(t, repo.SaveEvent(ctx, tx, event))
+	require.NoError(t, tx.Commit(ctx))
+
+	t.Run("claims a pending event", func(t *testing.T) {
+		tx, err := td.Pool.Begin(ctx)
+		require.NoError(t, err)
+		defer tx.Rollback(ctx)
+
+		locked, err := repo.LockPendingEvent(ctx, tx, event.ID)
+		require.NoError(t, err)
+		require.NotNil(t, locked)
+		assert.Equal(t, event.ID, locked.ID)
+		assert.Equal(t, "bid.placed", locked.EventType)
+
+		t.Run("skips it while another transaction holds it", func(t *testing.T) {
+			other, err := td.Pool.Begin(ctx)
+			require.NoError(t, err)
+			defer other.Rollback(ctx)
+
+			locked, err := repo.LockPendingEvent(ctx, other, event.ID)
+			require.NoError(t, err)
+			assert.Nil(t, locked)
+		})
+	})
+
+	t.Run("skips published events", func(t *testing.T) {
+		tx, err := td.Pool.Begin(ctx)
+		require.NoError(t, err)
+		require.NoError(t, repo.UpdateEventStatus(ctx, tx, event.ID, events.OutboxStatusPublished))
+		require.NoError(t, tx.Commit(ctx))
+
+		tx, err = td.Pool.Begin(ctx)
+		require.NoError(t, err)
+		defer tx.Rollback(ctx)
+
+		locked, err := repo.LockPendingEvent(ctx, tx, event.ID)
+		require.NoError(t, err)
+		assert.Nil(t, locked)
+	})
+}
@@ -21,22 +21,24 @@ type BidEventsProducer struct {
 }
 
 // NewBidEventsProducer creates a new producer
-func NewBidEventsProducer(pool *pgxpool.Pool, conn *amqp.Connection, logger *slog.Logger) (*BidEventsProducer, error) {
+// relayCfg sets the outbox batch size and polling interval
+func NewBidEventsProducer(pool *pgxpool.Pool, conn *amqp.Connection, relayCfg pkgevents.RelayConfig, logger *slog.Logger) (*BidEventsProducer, error) {
 	publisher, err := pkgevents.NewRabbitMQPublisher(conn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create publisher: %w", err)
 	}
 
 	txManager := pkgdb.NewPostgresTransactionManager(pool, 3*time.Second)
-	outboxRepo := database.NewPostgresOutboxRepository(pool)
+	outboxRepo := database.NewPostgresOutboxRepository(pool, pkgdb.DefaultQueryTimeout)
 
 	relay := pkgevents.NewOutboxRelay(
 		outboxRepo,
 		publisher,
 		txManager,
-		10,                   // Batch size
-		500*time.Millisecond, // Polling interval
-		"auction.events",     // exchange
+		relayCfg.BatchSize,
+		relayCfg.Interval,
+		pkgevents.AuctionEventsExchange,
+		nil, // no transient event types, nothing expires
 		logger,
 	)
 
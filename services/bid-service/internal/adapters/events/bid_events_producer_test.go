@@ -1,3 +1,5 @@
+//go:build integration
+
 package events_test
 
 import (
@@ -20,10 +22,6 @@ import (
 )
 
 func TestBidEventsProducerIntegration(t *testing.T) {
-	if testing.Short() {
-		t.Skip("Skipping integration test in short mode")
-	}
-
 	ctx := context.Background()
 	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
 
@@ -53,7 +51,7 @@ func TestBidEventsProducerIntegration(t *testing.T) {
 	defer conn.Close()
 
 	// 4. Setup Producer
-	producer, err := events.NewBidEventsProducer(dbPool, conn, logger)
+	producer, err := events.NewBidEventsProducer(dbPool, conn, pkgevents.RelayConfig{BatchSize: 10, Interval: 500 * time.Millisecond}, logger)
 	require.NoError(t, err)
 	defer producer.Close()
 
@@ -1,3 +1,5 @@
+//go:build integration
+
 package events_test
 
 import (
@@ -22,10 +24,6 @@ import (
 
 // TestRelayIntegrationWithRabbitMQ runs a full integration test with a real RabbitMQ container
 func TestRelayIntegrationWithRabbitMQ(t *testing.T) {
-	if testing.Short() {
-		t.Skip("Skipping integration test in short mode")
-	}
-
 	ctx := context.Background()
 
 	// 1. Start RabbitMQ Container
@@ -59,7 +57,7 @@ func TestRelayIntegrationWithRabbitMQ(t *testing.T) {
 	defer rabbitPublisher.Close()
 
 	txManager := pkgdb.NewPostgresTransactionManager(dbPool, time.Second)
-	outboxRepo := database.NewPostgresOutboxRepository(dbPool)
+	outboxRepo := database.NewPostgresOutboxRepository(dbPool, pkgdb.DefaultQueryTimeout)
 	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
 
 	relay := pkgevents.NewOutboxRelay(
@@ -69,6 +67,7 @@ func TestRelayIntegrationWithRabbitMQ(t *testing.T) {
 		10,
 		50*time.Millisecond,
 		"auction.events",
+		nil,
 		logger,
 	)
 
@@ -0,0 +1,118 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"google.golang.org/protobuf/proto"
+
+	pkgevents "github.com/floroz/gavel/pkg/events"
+	pb "github.com/floroz/gavel/pkg/proto"
+)
+
+// UserDeletedQueue is the queue the bid service reads account deletions from
+const UserDeletedQueue = "bid_service_user_deletions"
+
+// BidderAnonymizer detaches a deleted user from the bids they placed
+type BidderAnonymizer interface {
+	AnonymizeBidder(ctx context.Context, userID uuid.UUID) (int64, error)
+}
+
+// UserDeletedConsumer anonymizes the bids of every deleted account
+type UserDeletedConsumer struct {
+	conn       *amqp.Connection
+	anonymizer BidderAnonymizer
+	logger     *slog.Logger
+}
+
+// NewUserDeletedConsumer creates a new user.deleted consumer
+func NewUserDeletedConsumer(conn *amqp.Connection, anonymizer BidderAnonymizer, logger *slog.Logger) *UserDeletedConsumer {
+	return &UserDeletedConsumer{
+		conn:       conn,
+		anonymizer: anonymizer,
+		logger:     logger,
+	}
+}
+
+// Run starts the consumer loop; it returns nil once ctx is cancelled
+func (c *UserDeletedConsumer) Run(ctx context.Context) error {
+	ch, err := c.conn.Channel()
+	if err != nil {
+		return fmt.Errorf("failed to open channel: %w", err)
+	}
+	defer ch.Close()
+
+	if err := c.setupRabbitMQ(ch); err != nil {
+		return fmt.Errorf("failed to setup rabbitmq: %w", err)
+	}
+
+	msgs, err := ch.Consume(UserDeletedQueue, "", false, false, false, false, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start consuming: %w", err)
+	}
+
+	// Shutdown only stops the loop between messages: the one being handled still
+	// anonymizes and acks, within the worker's SHUTDOWN_TIMEOUT
+	inFlight := context.WithoutCancel(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case d, ok := <-msgs:
+			if !ok {
+				return fmt.Errorf("channel closed")
+			}
+			c.handleDelivery(inFlight, d)
+		}
+	}
+}
+
+// handleDelivery anonymizes the user's bids and acks, drops malformed messages,
+// and requeues on database errors. Anonymizing is idempotent, so redelivery is safe.
+func (c *UserDeletedConsumer) handleDelivery(ctx context.Context, d amqp.Delivery) {
+	var event pb.UserDeleted
+	if err := proto.Unmarshal(d.Body, &event); err != nil {
+		c.logger.Error("Failed to unmarshal user.deleted event", "error", err)
+		c.nack(d, false)
+		return
+	}
+	userID, err := uuid.Parse(event.UserId)
+	if err != nil {
+		c.logger.Error("Invalid user_id in user.deleted event", "error", err)
+		c.nack(d, false)
+		return
+	}
+
+	n, err := c.anonymizer.AnonymizeBidder(ctx, userID)
+	if err != nil {
+		c.logger.Error("Failed to anonymize bids", "user_id", userID, "error", err)
+		c.nack(d, true)
+		return
+	}
+
+	if err := d.Ack(false); err != nil {
+		c.logger.Error("Failed to Ack message", "error", err)
+	}
+	c.logger.Info("Anonymized bids of deleted user", "user_id", userID, "bids", n)
+}
+
+func (c *UserDeletedConsumer) nack(d amqp.Delivery, requeue bool) {
+	if err := d.Nack(false, requeue); err != nil {
+		c.logger.Error("Failed to Nack message", "requeue", requeue, "error", err)
+	}
+}
+
+func (c *UserDeletedConsumer) setupRabbitMQ(ch *amqp.Channel) error {
+	if err := ch.ExchangeDeclare(pkgevents.AuctionEventsExchange, "topic", true, false, false, false, nil); err != nil {
+		return err
+	}
+	q, err := ch.QueueDeclare(UserDeletedQueue, true, false, false, false, nil)
+	if err != nil {
+		return err
+	}
+	return ch.QueueBind(q.Name, "user.deleted", pkgevents.AuctionEventsExchange, false, nil)
+}
@@ -0,0 +1,98 @@
+package bids
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/floroz/gavel/services/bid-service/internal/domain/items"
+)
+
+// Environment variables read by AntiSnipingFromEnv
+const (
+	EnvAntiSnipingWindow        = "ANTI_SNIPING_WINDOW"         // Go duration; unset or 0 disables extensions
+	EnvAntiSnipingExtension     = "ANTI_SNIPING_EXTENSION"      // Go duration, defaults to the window
+	EnvAntiSnipingMaxExtensions = "ANTI_SNIPING_MAX_EXTENSIONS" // 0 for no limit
+	EnvAntiSnipingMaxTotal      = "ANTI_SNIPING_MAX_TOTAL"      // Go duration, 0 for no limit
+)
+
+// Default caps on how far anti-sniping may stretch one auction
+const (
+	DefaultMaxExtensions = 10
+	DefaultMaxExtension  = 30 * time.Minute
+)
+
+// AntiSniping extends an auction when a bid arrives in its final Window, so other bidders
+// get a chance to respond. Each extension leaves Extension on the clock after the bid.
+// MaxExtensions and MaxTotal cap the extensions per item; once either is reached, bids
+// are still accepted but no longer move the end time. The zero value disables extensions.
+type AntiSniping struct {
+	Window        time.Duration
+	Extension     time.Duration
+	MaxExtensions int           // 0 means no limit on the number of extensions
+	MaxTotal      time.Duration // 0 means no limit on the time added
+}
+
+// Enabled reports whether bids can extend auctions at all
+func (a AntiSniping) Enabled() bool {
+	return a.Window > 0 && a.Extension > 0
+}
+
+// extend returns the new end time and how much it adds when a bid at now should extend item,
+// or false when the bid is outside the window or the item has used up its extensions.
+// The last extension is shortened to fit within MaxTotal.
+func (a AntiSniping) extend(item *items.Item, now time.Time) (time.Time, time.Duration, bool) {
+	if !a.Enabled() || item.EndAt.Sub(now) > a.Window {
+		return time.Time{}, 0, false
+	}
+	if a.MaxExtensions > 0 && item.ExtensionCount >= a.MaxExtensions {
+		return time.Time{}, 0, false
+	}
+
+	by := now.Add(a.Extension).Sub(item.EndAt).Truncate(time.Second)
+	if a.MaxTotal > 0 {
+		by = min(by, a.MaxTotal-time.Duration(item.ExtendedSeconds)*time.Second)
+	}
+	if by <= 0 {
+		return time.Time{}, 0, false
+	}
+	return item.EndAt.Add(by), by, true
+}
+
+// AntiSnipingFromEnv reads the ANTI_SNIPING_* variables. Extensions are off unless
+// ANTI_SNIPING_WINDOW is set; the caps default to DefaultMaxExtensions and DefaultMaxExtension.
+func AntiSnipingFromEnv() (AntiSniping, error) {
+	cfg := AntiSniping{MaxExtensions: DefaultMaxExtensions, MaxTotal: DefaultMaxExtension}
+
+	var err error
+	if cfg.Window, err = durationFromEnv(EnvAntiSnipingWindow, 0); err != nil {
+		return AntiSniping{}, err
+	}
+	if cfg.Extension, err = durationFromEnv(EnvAntiSnipingExtension, cfg.Window); err != nil {
+		return AntiSniping{}, err
+	}
+	if cfg.MaxTotal, err = durationFromEnv(EnvAntiSnipingMaxTotal, cfg.MaxTotal); err != nil {
+		return AntiSniping{}, err
+	}
+	if v := os.Getenv(EnvAntiSnipingMaxExtensions); v != "" {
+		n, convErr := strconv.Atoi(v)
+		if convErr != nil || n < 0 {
+			return AntiSniping{}, fmt.Errorf("invalid %s %q: must be a non-negative integer", EnvAntiSnipingMaxExtensions, v)
+		}
+		cfg.MaxExtensions = n
+	}
+	return cfg, nil
+}
+
+func durationFromEnv(key string, fallback time.Duration) (time.Duration, error) {
+	v := os.Getenv(key)
+	if v == "" {
+		return fallback, nil
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("invalid %s %q: must be a non-negative duration", key, v)
+	}
+	return d, nil
+}
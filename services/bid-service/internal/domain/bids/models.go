@@ -1,9 +1,14 @@
 package bids
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/floroz/gavel/services/bid-service/internal/domain/items"
 )
 
 // Bid represents an auction bid
@@ -15,11 +20,101 @@ type Bid struct {
 	CreatedAt time.Time `db:"created_at"`
 }
 
+// WonItem is a finished auction together with the bid that won it
+type WonItem struct {
+	Item       *items.Item
+	WinningBid *Bid
+}
+
+// UserBidTotals are a user's bid figures as of AsOf, which user-stats rebuilds its
+// per-user aggregates from
+type UserBidTotals struct {
+	Count       int64
+	TotalAmount int64     // saturates at math.MaxInt64
+	LastBidAt   time.Time // zero if the user has no bids
+	AsOf        time.Time // the totals cover bids stamped up to this time; trails the database clock
+}
+
+// BidPosition is where one bidder's best bid on an item ranks among every bidder's best bid
+type BidPosition struct {
+	BestBid      *Bid  // nil if the bidder has not bid on the item
+	Rank         int   // 1 is leading, 0 if not bidding
+	Bidders      int   // distinct bidders on the item
+	AmountToLead int64 // smallest bid that would take the lead, 0 while leading
+}
+
+// IsBidding reports whether the bidder has a bid on the item
+func (p *BidPosition) IsBidding() bool {
+	return p.BestBid != nil
+}
+
+// DeletedBidderID replaces the user id on every bid of a deleted account. All deleted
+// bidders share it, so the bids keep item totals and auction history intact without
+// linking back to anyone, including to each other.
+var DeletedBidderID = uuid.Max
+
+// deletedBidderLabel is shown in place of a pseudonym for DeletedBidderID
+const deletedBidderLabel = "Deleted bidder"
+
+// MinBidderLabelKeyLen is the shortest key BidderLabel may be given
+const MinBidderLabelKeyLen = 16
+
+// BidderLabel is the pseudonym shown for userID on itemID's public bid listings, e.g. "Bidder #3f9a2c".
+// It is stable for a bidder within one item but differs across items, so a bidder's
+// activity cannot be followed from one auction to another. The label is an HMAC under the
+// server's secret key: user and item ids are not secret, so a plain hash could be reversed
+// by hashing every known bidder against the item.
+func BidderLabel(key []byte, itemID, userID uuid.UUID) string {
+	if userID == DeletedBidderID {
+		return deletedBidderLabel
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write(itemID[:])
+	mac.Write(userID[:])
+	return "Bidder #" + hex.EncodeToString(mac.Sum(nil)[:3])
+}
+
+// BidOrder selects how an item's bids are sorted
+type BidOrder int
+
+const (
+	BidOrderTime   BidOrder = iota // newest first
+	BidOrderAmount                 // highest first, earlier bid wins ties
+)
+
+// BidCursor marks the last bid of a page; the next page starts right after it.
+// It carries every column either ordering sorts by.
+type BidCursor struct {
+	Amount    int64
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+// CursorAfter returns the cursor positioned at bid
+func CursorAfter(bid *Bid) *BidCursor {
+	return &BidCursor{Amount: bid.Amount, CreatedAt: bid.CreatedAt, ID: bid.ID}
+}
+
+// CursorAtTime returns a cursor positioned after every bid created at or before t
+func CursorAtTime(t time.Time) BidCursor {
+	return BidCursor{CreatedAt: t, ID: uuid.Max}
+}
+
+// ItemBidsQuery selects one page of an item's bids
+type ItemBidsQuery struct {
+	ItemID  uuid.UUID
+	OrderBy BidOrder
+	Limit   int        // 0 returns every remaining bid
+	After   *BidCursor // nil starts from the first page
+}
+
 // EventType defines the type of event
 type EventType string
 
 const (
-	EventTypeBidPlaced EventType = "bid.placed"
+	EventTypeBidPlaced       EventType = "bid.placed"
+	EventTypeAuctionEnded    EventType = "auction.ended"
+	EventTypeAuctionExtended EventType = "auction.extended"
 )
 
 func (e EventType) String() string {
@@ -28,7 +123,7 @@ func (e EventType) String() string {
 
 func (e EventType) IsValid() bool {
 	switch e {
-	case EventTypeBidPlaced:
+	case EventTypeBidPlaced, EventTypeAuctionEnded, EventTypeAuctionExtended:
 		return true
 	default:
 		return false
@@ -3,6 +3,7 @@ package bids
 import (
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -49,3 +50,21 @@ func TestEventType_IsValid(t *testing.T) {
 		})
 	}
 }
+
+func TestBidderLabel(t *testing.T) {
+	key := []byte("0123456789abcdef0123456789abcdef")
+	item, otherItem := uuid.New(), uuid.New()
+	alice, bob := uuid.New(), uuid.New()
+
+	label := BidderLabel(key, item, alice)
+	assert.Regexp(t, `^Bidder #[0-9a-f]{6}$`, label)
+	assert.Equal(t, label, BidderLabel(key, item, alice), "label must be stable for the same bidder and item")
+	assert.NotEqual(t, label, BidderLabel(key, item, bob), "bidders on one item must get different labels")
+	assert.NotEqual(t, label, BidderLabel(key, otherItem, alice), "labels must not link a bidder across items")
+	assert.NotContains(t, label, alice.String())
+
+	assert.NotEqual(t, label, BidderLabel([]byte("fedcba9876543210fedcba9876543210"), item, alice),
+		"labels must depend on the server key")
+
+	assert.Equal(t, "Deleted bidder", BidderLabel(key, item, DeletedBidderID))
+}
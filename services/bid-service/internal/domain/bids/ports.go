@@ -2,6 +2,7 @@ package bids
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -12,20 +13,63 @@ import (
 
 // BidRepository defines the interface for bid persistence
 type BidRepository interface {
-	// SaveBid saves a bid within a transaction
+	// SaveBid saves a bid within a transaction and sets bid.CreatedAt to the stored timestamp
 	SaveBid(ctx context.Context, tx pgx.Tx, bid *Bid) error
 
-	// GetBidByID retrieves a bid by its ID
+	// SaveBids bulk-inserts bids for imports and data migrations. It skips everything
+	// SaveBid's live path does besides the insert, including the item's highest bid,
+	// which the caller must recompute once the load is done.
+	SaveBids(ctx context.Context, tx pgx.Tx, bids []*Bid) error
+
+	// GetBidByID retrieves a bid by its ID, returning ErrBidNotFound if it does not exist
 	GetBidByID(ctx context.Context, bidID uuid.UUID) (*Bid, error)
 
-	// GetBidsByItemID retrieves all bids for an item
-	GetBidsByItemID(ctx context.Context, itemID uuid.UUID) ([]*Bid, error)
+	// GetHighestBid returns the item's current winning bid within a transaction,
+	// or nil if the item has no bids. Ties go to the earlier bid.
+	GetHighestBid(ctx context.Context, tx pgx.Tx, itemID uuid.UUID) (*Bid, error)
+
+	// GetBidsByItemID retrieves a page of an item's bids in the query's order
+	GetBidsByItemID(ctx context.Context, query ItemBidsQuery) ([]*Bid, error)
+
+	// GetBidsSince returns up to limit of the item's bids after the cursor in (created_at, id)
+	// order, oldest first, and the database time the read was bounded by. Bids are only
+	// returned up to that time, so resuming from it, or from the last bid of a full page,
+	// yields each later bid exactly once. Only CreatedAt and ID of the cursor are used.
+	GetBidsSince(ctx context.Context, itemID uuid.UUID, after BidCursor, limit int) ([]*Bid, time.Time, error)
+
+	// GetBidPosition ranks userID's best bid on the item against every other bidder's best
+	// and fills in BestBid, Rank and Bidders, ordering equal amounts by who bid first.
+	// BestBid is nil and Rank 0 if userID has not bid on the item.
+	GetBidPosition(ctx context.Context, itemID, userID uuid.UUID) (*BidPosition, error)
+
+	// ListBidsByUser retrieves a page of the bids userID placed, newest first
+	ListBidsByUser(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*Bid, error)
+
+	// ListWonItemsByUser retrieves a page of the finished auctions userID won, most recently ended first
+	ListWonItemsByUser(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*WonItem, error)
+
+	// GetUserBidTotals counts and sums every bid userID placed
+	GetUserBidTotals(ctx context.Context, userID uuid.UUID) (*UserBidTotals, error)
+
+	// AnonymizeBidder reassigns every bid userID placed to DeletedBidderID and returns
+	// how many bids changed. Running it again for the same user is a no-op.
+	AnonymizeBidder(ctx context.Context, userID uuid.UUID) (int64, error)
+}
+
+// BidderDirectory resolves bidder user ids to display names owned by the auth service.
+// Ids it does not know are absent from the result.
+type BidderDirectory interface {
+	DisplayNames(ctx context.Context, userIDs []uuid.UUID) (map[uuid.UUID]string, error)
 }
 
 // OutboxRepository defines the interface for outbox event persistence
 type OutboxRepository interface {
 	events.OutboxRepository
 	SaveEvent(ctx context.Context, tx pgx.Tx, event *events.OutboxEvent) error
+
+	// GetLastPublishedAt returns the created_at of the newest event the relay has published,
+	// and false if none has been published yet. Use it as a replay cursor or to measure lag.
+	GetLastPublishedAt(ctx context.Context) (time.Time, bool, error)
 }
 
 // ItemRepository defines the interface for item persistence
@@ -39,7 +83,48 @@ type ItemRepository interface {
 	GetItemByIDForUpdate(ctx context.Context, tx pgx.Tx, itemID uuid.UUID) (*items.Item, error)
 
 	// UpdateHighestBid updates the current highest bid for an item within a transaction
+	// Only applies if amount is strictly greater than the stored bid, otherwise returns ErrHighestBidChanged
 	UpdateHighestBid(ctx context.Context, tx pgx.Tx, itemID uuid.UUID, amount int64) error
+
+	// UpdateEndAt sets the item's end time within a transaction, without counting it as an
+	// anti-sniping extension
+	UpdateEndAt(ctx context.Context, tx pgx.Tx, itemID uuid.UUID, endAt time.Time) error
+
+	// ExtendEndAt moves the item's end time to endAt within a transaction, recording the
+	// extension of by against the item's anti-sniping totals
+	ExtendEndAt(ctx context.Context, tx pgx.Tx, itemID uuid.UUID, endAt time.Time, by time.Duration) error
+
+	// ActivateItem moves a scheduled item to active within a transaction.
+	// It is a no-op for items that are not scheduled.
+	ActivateItem(ctx context.Context, tx pgx.Tx, itemID uuid.UUID) error
+
+	// MarkEnded moves an active item to ended within a transaction
+	// Returns items.ErrItemNotActive if the item is not active
+	MarkEnded(ctx context.Context, tx pgx.Tx, itemID uuid.UUID) error
+}
+
+// SpendingLimiter is a deployment-specific policy on how much a user may commit across
+// open auctions, consulted before a bid or buy-now is accepted. Implementations reject
+// with ErrSpendingLimitExceeded; any other error fails the bid as an internal error.
+type SpendingLimiter interface {
+	CheckCanCommit(ctx context.Context, userID uuid.UUID, amount int64) error
+}
+
+// PriceCache is a fast-read store for the current highest bid of an item.
+// It may lag behind the database, so Postgres remains the source of truth.
+type PriceCache interface {
+	// SetCurrentPrice stores amount unless a higher price is already cached
+	SetCurrentPrice(ctx context.Context, itemID uuid.UUID, amount int64) error
+
+	// GetCurrentPrice returns the cached price and whether it was found
+	GetCurrentPrice(ctx context.Context, itemID uuid.UUID) (int64, bool, error)
+}
+
+// ImmediatePublisher publishes outbox events right after the transaction that saved
+// them commits, ahead of the relay's next poll. Events it fails to publish stay
+// pending, so the relay still delivers them.
+type ImmediatePublisher interface {
+	PublishNow(ctx context.Context, ids ...uuid.UUID) error
 }
 
 // EventPublisher defines the interface for publishing events to a message broker
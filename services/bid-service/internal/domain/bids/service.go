@@ -2,16 +2,22 @@ package bids
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/floroz/gavel/pkg/clock"
 	"github.com/floroz/gavel/pkg/database"
 	"github.com/floroz/gavel/pkg/events"
 	pb "github.com/floroz/gavel/pkg/proto"
+	"github.com/floroz/gavel/services/bid-service/internal/domain"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/items"
 )
 
 type PlaceBidCommand struct {
@@ -20,63 +26,290 @@ type PlaceBidCommand struct {
 	Amount int64
 }
 
+// BuyNowCommand buys ItemID outright for UserID at the item's buy-now price
+type BuyNowCommand struct {
+	ItemID uuid.UUID
+	UserID uuid.UUID
+}
+
+// ExtendAuctionCommand moves ItemID's end time to EndAt on behalf of UserID, who must be the seller
+type ExtendAuctionCommand struct {
+	ItemID uuid.UUID
+	UserID uuid.UUID
+	EndAt  time.Time
+}
+
 // Validation errors
 var (
-	ErrBidTooLow        = fmt.Errorf("bid amount must be higher than current highest bid")
-	ErrAuctionEnded     = fmt.Errorf("auction has ended")
-	ErrInvalidBidAmount = fmt.Errorf("bid amount must be positive")
-	ErrSellerCannotBid  = fmt.Errorf("seller cannot bid on their own item")
+	ErrBidTooLow          = domain.NewError(domain.CodePrecondition, "bid amount must be higher than current highest bid")
+	ErrDuplicateBid       = domain.NewError(domain.CodeAlreadyExists, "bid repeats the user's own standing highest bid")
+	ErrBidBelowStartPrice = domain.NewError(domain.CodePrecondition, "first bid must be at least the start price")
+	ErrAuctionEnded       = domain.NewError(domain.CodePrecondition, "auction has ended")
+	ErrAuctionNotStarted  = domain.NewError(domain.CodePrecondition, "auction has not started yet")
+	ErrAuctionPaused      = domain.NewError(domain.CodePrecondition, "auction is paused")
+	ErrBuyNowUnavailable  = domain.NewError(domain.CodePrecondition, "item has no buy-now price")
+	ErrBuyNowPriceReached = domain.NewError(domain.CodePrecondition, "bidding has already reached the buy-now price")
+	ErrInvalidBidAmount   = domain.NewError(domain.CodeInvalidInput, "bid amount must be positive")
+	ErrBidAmountTooHigh   = domain.NewError(domain.CodeInvalidInput, "bid amount exceeds the maximum allowed")
+	ErrInvalidBidStep     = domain.NewError(domain.CodeInvalidInput, "bid amount is not a whole number of bid steps above the start price")
+	ErrSellerCannotBid    = domain.NewError(domain.CodePermissionDenied, "seller cannot bid on their own item")
+	ErrBidNotFound        = domain.NewError(domain.CodeNotFound, "bid not found")
+	ErrBidAccessDenied    = domain.NewError(domain.CodePermissionDenied, "only the bidder or the item seller can view this bid")
+	ErrCannotExtend       = domain.NewError(domain.CodePrecondition, "only scheduled, active or paused auctions can be extended")
+	ErrEndAtNotLater      = domain.NewError(domain.CodeInvalidInput, "new end time must be later than the current one")
+	ErrAuctionTooLong     = domain.NewError(domain.CodeInvalidInput, "auction would run longer than the maximum duration")
+
+	// ErrSpendingLimitExceeded is returned by a SpendingLimiter to reject a bid
+	ErrSpendingLimitExceeded = domain.NewError(domain.CodePrecondition, "bid exceeds the user's spending limit")
 )
 
-// validateBidAmount checks if the bid amount is higher than the current highest bid
-func validateBidAmount(bidAmount, currentHighest int64) error {
+// DefaultMaxBidAmount is the largest accepted bid ($1bn in cents) when none is configured.
+// It keeps absurd amounts out of prices and downstream aggregates such as user stats.
+const DefaultMaxBidAmount int64 = 100_000_000_000
+
+// validateBidAmount checks if the bid amount is higher than the current highest bid.
+// When there are no bids yet, the first bid must be at least the start price.
+func validateBidAmount(bidAmount, currentHighest, startPrice, maxAmount int64) error {
 	if bidAmount <= 0 {
 		return ErrInvalidBidAmount
 	}
+	if bidAmount > maxAmount {
+		return ErrBidAmountTooHigh
+	}
+	if currentHighest == 0 && bidAmount < startPrice {
+		return ErrBidBelowStartPrice
+	}
 	if bidAmount <= currentHighest {
 		return ErrBidTooLow
 	}
 	return nil
 }
 
-// validateAuctionNotEnded checks if the auction has not ended
-func validateAuctionNotEnded(endAt time.Time) error {
-	if time.Now().After(endAt) {
+// validateBidStep checks that the bid sits a whole number of steps above the start price.
+// A step of 0 accepts any amount.
+func validateBidStep(bidAmount, startPrice, step int64) error {
+	if step > 0 && (bidAmount-startPrice)%step != 0 {
+		return ErrInvalidBidStep
+	}
+	return nil
+}
+
+// validateAuctionNotEnded checks if the auction has not ended as of now
+func validateAuctionNotEnded(endAt, now time.Time) error {
+	if now.After(endAt) {
 		return ErrAuctionEnded
 	}
 	return nil
 }
 
+// NoSpendingLimit is the default SpendingLimiter: it accepts every bid
+type NoSpendingLimit struct{}
+
+func (NoSpendingLimit) CheckCanCommit(context.Context, uuid.UUID, int64) error { return nil }
+
+// Retry policy for transient database errors in PlaceBid
+const (
+	defaultMaxAttempts  = 3
+	defaultRetryBackoff = 50 * time.Millisecond
+)
+
 // AuctionService implements the core business logic
 type AuctionService struct {
-	txManager  database.TransactionManager
-	bidRepo    BidRepository
-	itemRepo   ItemRepository
-	outboxRepo OutboxRepository
+	txManager    database.TransactionManager
+	bidRepo      BidRepository
+	itemRepo     ItemRepository
+	outboxRepo   OutboxRepository
+	priceCache   PriceCache // optional, nil disables the fast-read path
+	limiter      SpendingLimiter
+	maxBidAmount int64
+	maxAttempts  int
+	retryBackoff time.Duration
+	clock        clock.Clock
+	antiSniping  AntiSniping
+	immediate    ImmediatePublisher // optional, nil leaves every event to the relay
 }
 
 // NewAuctionService creates a new auction service
+// limiter: optional, nil means NoSpendingLimit
+// clk: optional, nil means the wall clock
+// antiSniping: the zero value never extends auctions
+// immediate: optional, publishes bid events on commit instead of on the relay's next poll
 func NewAuctionService(
 	txManager database.TransactionManager,
 	bidRepo BidRepository,
 	itemRepo ItemRepository,
 	outboxRepo OutboxRepository,
+	priceCache PriceCache,
+	limiter SpendingLimiter,
+	maxBidAmount int64,
+	clk clock.Clock,
+	antiSniping AntiSniping,
+	immediate ImmediatePublisher,
 ) *AuctionService {
+	if maxBidAmount <= 0 {
+		maxBidAmount = DefaultMaxBidAmount
+	}
+	if limiter == nil {
+		limiter = NoSpendingLimit{}
+	}
 	return &AuctionService{
-		txManager:  txManager,
-		bidRepo:    bidRepo,
-		itemRepo:   itemRepo,
-		outboxRepo: outboxRepo,
+		txManager:    txManager,
+		bidRepo:      bidRepo,
+		itemRepo:     itemRepo,
+		outboxRepo:   outboxRepo,
+		priceCache:   priceCache,
+		limiter:      limiter,
+		maxBidAmount: maxBidAmount,
+		maxAttempts:  defaultMaxAttempts,
+		retryBackoff: defaultRetryBackoff,
+		clock:        clock.OrReal(clk),
+		antiSniping:  antiSniping,
+		immediate:    immediate,
+	}
+}
+
+// PlaceBid places a bid, retrying the whole transaction a bounded number of times
+// when it fails on a transient database error (lock timeout, serialization failure).
+// Business errors such as ErrBidTooLow are returned immediately.
+// Along with the bid it returns the item as the bid left it: the new highest bid and,
+// if anti-sniping applied, the extended end time.
+func (s *AuctionService) PlaceBid(ctx context.Context, cmd PlaceBidCommand) (*Bid, *items.Item, error) {
+	backoff := s.retryBackoff
+	for attempt := 1; ; attempt++ {
+		bid, item, err := s.placeBid(ctx, cmd)
+		if err == nil {
+			s.cachePrice(ctx, bid.ItemID, bid.Amount)
+			return bid, item, nil
+		}
+		if attempt >= s.maxAttempts || !database.IsRetryable(err) {
+			return nil, nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, nil, err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
 	}
 }
 
-// PlaceBid implements the transactional outbox pattern
+// GetCurrentPrice returns the current highest bid for an item.
+// It reads from the price cache when available and falls back to the database on a miss,
+// repopulating the cache. A cache error is treated as a miss.
+func (s *AuctionService) GetCurrentPrice(ctx context.Context, itemID uuid.UUID) (int64, error) {
+	if s.priceCache != nil {
+		if price, found, err := s.priceCache.GetCurrentPrice(ctx, itemID); err == nil && found {
+			return price, nil
+		}
+	}
+
+	item, err := s.itemRepo.GetItemByID(ctx, itemID)
+	if err != nil {
+		return 0, itemLookupError(err)
+	}
+
+	s.cachePrice(ctx, itemID, item.CurrentHighestBid)
+	return item.CurrentHighestBid, nil
+}
+
+// GetBid returns a bid if the requester placed it or is the seller of the item it was placed on.
+func (s *AuctionService) GetBid(ctx context.Context, bidID, requesterID uuid.UUID) (*Bid, error) {
+	bid, err := s.bidRepo.GetBidByID(ctx, bidID)
+	if err != nil {
+		return nil, err
+	}
+	if bid.UserID == requesterID {
+		return bid, nil
+	}
+
+	item, err := s.itemRepo.GetItemByID(ctx, bid.ItemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get item for bid: %w", err)
+	}
+	if item.SellerID != requesterID {
+		return nil, ErrBidAccessDenied
+	}
+	return bid, nil
+}
+
+// GetWinningBid returns the item's current highest bid, or nil while it has no bids
+func (s *AuctionService) GetWinningBid(ctx context.Context, itemID uuid.UUID) (*Bid, error) {
+	if _, err := s.itemRepo.GetItemByID(ctx, itemID); err != nil {
+		return nil, itemLookupError(err)
+	}
+
+	tx, err := s.txManager.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	bid, err := s.bidRepo.GetHighestBid(ctx, tx, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get winning bid: %w", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return bid, nil
+}
+
+// GetBidPosition returns how userID's best bid on the item ranks and what it would take to lead.
+// A user without bids on the item gets a position with a nil BestBid.
+func (s *AuctionService) GetBidPosition(ctx context.Context, itemID, userID uuid.UUID) (*BidPosition, error) {
+	item, err := s.itemRepo.GetItemByID(ctx, itemID)
+	if err != nil {
+		return nil, itemLookupError(err)
+	}
+
+	position, err := s.bidRepo.GetBidPosition(ctx, itemID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bid position: %w", err)
+	}
+	if position.Rank != 1 {
+		position.AmountToLead = minimumBid(item.CurrentHighestBid, item.StartPrice)
+	}
+	return position, nil
+}
+
+// minimumBid is the smallest amount validateBidAmount accepts
+func minimumBid(currentHighest, startPrice int64) int64 {
+	if currentHighest == 0 {
+		return startPrice
+	}
+	return currentHighest + 1
+}
+
+// cachePrice writes the price to the cache after the database is up to date.
+// Failures are ignored: the cache is best effort and readers fall back to the database.
+func (s *AuctionService) cachePrice(ctx context.Context, itemID uuid.UUID, amount int64) {
+	if s.priceCache == nil {
+		return
+	}
+	_ = s.priceCache.SetCurrentPrice(ctx, itemID, amount)
+}
+
+// publishNow hands committed events to the immediate publisher. Failures are only logged:
+// the events are still pending in the outbox and the relay publishes them.
+func (s *AuctionService) publishNow(ctx context.Context, ids ...uuid.UUID) {
+	if s.immediate == nil {
+		return
+	}
+	if err := s.immediate.PublishNow(ctx, ids...); err != nil {
+		slog.WarnContext(ctx, "Immediate publish failed, leaving events to the outbox relay", "outbox_ids", ids, "error", err)
+	}
+}
+
+// placeBid implements the transactional outbox pattern
 // It saves the bid and the event in the same database transaction
-func (s *AuctionService) PlaceBid(ctx context.Context, cmd PlaceBidCommand) (*Bid, error) {
+func (s *AuctionService) placeBid(ctx context.Context, cmd PlaceBidCommand) (*Bid, *items.Item, error) {
 	// Start transaction
 	tx, err := s.txManager.BeginTx(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+		return nil, nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
 	defer func() {
 		_ = tx.Rollback(ctx) // Rollback if commit is not called
@@ -86,74 +319,347 @@ func (s *AuctionService) PlaceBid(ctx context.Context, cmd PlaceBidCommand) (*Bi
 	// This ensures that only one transaction can modify this item at a time
 	item, err := s.itemRepo.GetItemByIDForUpdate(ctx, tx, cmd.ItemID)
 	if err != nil {
-		return nil, fmt.Errorf("item not found: %w", err)
+		return nil, nil, fmt.Errorf("item not found: %w", err)
 	}
 
 	// Validate seller cannot bid on own item
 	if item.SellerID == cmd.UserID {
-		return nil, ErrSellerCannotBid
+		return nil, nil, ErrSellerCannotBid
 	}
 
-	if valErr := validateBidAmount(cmd.Amount, item.CurrentHighestBid); valErr != nil {
-		return nil, valErr
+	if openErr := s.openIfScheduled(ctx, tx, item); openErr != nil {
+		return nil, nil, openErr
+	}
+	// A bought-now or cancelled item keeps its end time, so the status is what closes it
+	switch item.Status {
+	case items.ItemStatusActive:
+	case items.ItemStatusPaused:
+		return nil, nil, ErrAuctionPaused
+	case items.ItemStatusEnded:
+		return nil, nil, ErrAuctionEnded
+	default:
+		return nil, nil, items.ErrItemNotActive
 	}
 
-	if valErr := validateAuctionNotEnded(item.EndAt); valErr != nil {
-		return nil, valErr
+	if valErr := validateBidAmount(cmd.Amount, item.CurrentHighestBid, item.StartPrice, s.maxBidAmount); valErr != nil {
+		if errors.Is(valErr, ErrBidTooLow) && cmd.Amount == item.CurrentHighestBid {
+			return nil, nil, s.checkDuplicateBid(ctx, tx, cmd, valErr)
+		}
+		return nil, nil, valErr
+	}
+	if valErr := validateBidStep(cmd.Amount, item.StartPrice, item.BidStep); valErr != nil {
+		return nil, nil, valErr
+	}
+
+	now := s.clock.Now()
+	if valErr := validateAuctionNotEnded(item.EndAt, now); valErr != nil {
+		return nil, nil, valErr
+	}
+
+	if limitErr := s.checkSpendingLimit(ctx, cmd.UserID, cmd.Amount); limitErr != nil {
+		return nil, nil, limitErr
+	}
+
+	// The item row is locked, so the current winner cannot change under us
+	previous, err := s.bidRepo.GetHighestBid(ctx, tx, cmd.ItemID)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	// Create the bid
+	// Create the bid; CreatedAt is assigned by SaveBid so the event carries the stored timestamp
 	bid := &Bid{
-		ID:        uuid.New(),
-		ItemID:    cmd.ItemID,
-		UserID:    cmd.UserID,
-		Amount:    cmd.Amount,
-		CreatedAt: time.Now(),
+		ID:     uuid.New(),
+		ItemID: cmd.ItemID,
+		UserID: cmd.UserID,
+		Amount: cmd.Amount,
 	}
 
+	// The returned item is a copy of the locked row with this bid's writes applied to it
+	updated := *item
+
 	// Step 1: Save the bid
 	if saveErr := s.bidRepo.SaveBid(ctx, tx, bid); saveErr != nil {
-		return nil, fmt.Errorf("failed to save bid: %w", saveErr)
+		return nil, nil, fmt.Errorf("failed to save bid: %w", saveErr)
 	}
 
 	// Step 2: Update the item's highest bid
 	if updateErr := s.itemRepo.UpdateHighestBid(ctx, tx, cmd.ItemID, cmd.Amount); updateErr != nil {
-		return nil, fmt.Errorf("failed to update highest bid: %w", updateErr)
+		if errors.Is(updateErr, items.ErrHighestBidChanged) {
+			// The stored bid moved since we read it: re-validate against the current value
+			current, getErr := s.itemRepo.GetItemByIDForUpdate(ctx, tx, cmd.ItemID)
+			if getErr != nil {
+				return nil, nil, fmt.Errorf("item not found: %w", getErr)
+			}
+			if valErr := validateBidAmount(cmd.Amount, current.CurrentHighestBid, current.StartPrice, s.maxBidAmount); valErr != nil {
+				return nil, nil, valErr
+			}
+		}
+		return nil, nil, fmt.Errorf("failed to update highest bid: %w", updateErr)
+	}
+
+	// A bid in the closing window pushes the end back, until the item's extensions run out
+	endAt, extendedBy, extended := s.antiSniping.extend(item, now)
+	if extended {
+		if extendErr := s.itemRepo.ExtendEndAt(ctx, tx, cmd.ItemID, endAt, extendedBy); extendErr != nil {
+			return nil, nil, fmt.Errorf("failed to extend auction: %w", extendErr)
+		}
+	}
+
+	// Step 3: Save the event to the outbox (in the same transaction)
+	eventID, saveErr := s.saveEvent(ctx, tx, EventTypeBidPlaced, bidPlacedEvent(bid, item, previous))
+	if saveErr != nil {
+		return nil, nil, saveErr
+	}
+
+	updated.CurrentHighestBid = bid.Amount
+	updated.BidCount++
+	if extended {
+		updated.EndAt = endAt.UTC()
+		updated.ExtensionCount++
+		updated.ExtendedSeconds += int64(extendedBy / time.Second)
+	}
+
+	// Commit the transaction
+	// If this succeeds, both the bid and the event are guaranteed to be saved
+	if commitErr := tx.Commit(ctx); commitErr != nil {
+		return nil, nil, fmt.Errorf("failed to commit transaction: %w", commitErr)
+	}
+	s.publishNow(ctx, eventID)
+
+	return bid, &updated, nil
+}
+
+// checkDuplicateBid tells a repeated submission apart from an outbid: a bid matching the
+// standing highest bid is ErrDuplicateBid when that bid is the same user's, so a
+// double-submitted bid reports that it already stands. Anything else keeps tooLow.
+func (s *AuctionService) checkDuplicateBid(ctx context.Context, tx pgx.Tx, cmd PlaceBidCommand, tooLow error) error {
+	highest, err := s.bidRepo.GetHighestBid(ctx, tx, cmd.ItemID)
+	if err != nil {
+		return err
+	}
+	if highest != nil && highest.UserID == cmd.UserID && highest.Amount == cmd.Amount {
+		return ErrDuplicateBid
+	}
+	return tooLow
+}
+
+// BuyNow buys an item at its buy-now price. Within the item lock it records the purchase
+// as the winning bid, ends the auction and emits bid.placed and auction.ended.
+// It is rejected once a standing bid has reached the buy-now price.
+func (s *AuctionService) BuyNow(ctx context.Context, cmd BuyNowCommand) (*Bid, *items.Item, error) {
+	tx, err := s.txManager.BeginTx(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx) // Rollback if commit is not called
+	}()
+
+	item, err := s.itemRepo.GetItemByIDForUpdate(ctx, tx, cmd.ItemID)
+	if err != nil {
+		return nil, nil, itemLookupError(err)
+	}
+
+	if item.SellerID == cmd.UserID {
+		return nil, nil, ErrSellerCannotBid
+	}
+	if !item.HasBuyNow() {
+		return nil, nil, ErrBuyNowUnavailable
+	}
+	if openErr := s.openIfScheduled(ctx, tx, item); openErr != nil {
+		return nil, nil, openErr
+	}
+	if item.Status == items.ItemStatusPaused {
+		return nil, nil, ErrAuctionPaused
+	}
+	if item.Status != items.ItemStatusActive {
+		return nil, nil, items.ErrItemNotActive
+	}
+	if valErr := validateAuctionNotEnded(item.EndAt, s.clock.Now()); valErr != nil {
+		return nil, nil, valErr
+	}
+	if item.CurrentHighestBid >= item.BuyNowPrice {
+		return nil, nil, ErrBuyNowPriceReached
+	}
+	if limitErr := s.checkSpendingLimit(ctx, cmd.UserID, item.BuyNowPrice); limitErr != nil {
+		return nil, nil, limitErr
 	}
 
-	// Step 3: Create the event (protobuf message)
+	previous, err := s.bidRepo.GetHighestBid(ctx, tx, cmd.ItemID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bid := &Bid{
+		ID:     uuid.New(),
+		ItemID: cmd.ItemID,
+		UserID: cmd.UserID,
+		Amount: item.BuyNowPrice,
+	}
+	if saveErr := s.bidRepo.SaveBid(ctx, tx, bid); saveErr != nil {
+		return nil, nil, fmt.Errorf("failed to save bid: %w", saveErr)
+	}
+	if updateErr := s.itemRepo.UpdateHighestBid(ctx, tx, cmd.ItemID, bid.Amount); updateErr != nil {
+		return nil, nil, fmt.Errorf("failed to update highest bid: %w", updateErr)
+	}
+	if endErr := s.itemRepo.MarkEnded(ctx, tx, cmd.ItemID); endErr != nil {
+		return nil, nil, fmt.Errorf("failed to end auction: %w", endErr)
+	}
+
+	// The outbid bidder still hears about it through bid.placed
+	placedID, saveErr := s.saveEvent(ctx, tx, EventTypeBidPlaced, bidPlacedEvent(bid, item, previous))
+	if saveErr != nil {
+		return nil, nil, saveErr
+	}
+	ended := &pb.AuctionEnded{
+		ItemId:       item.ID.String(),
+		SellerId:     item.SellerID.String(),
+		WinnerId:     bid.UserID.String(),
+		WinningBidId: bid.ID.String(),
+		Amount:       bid.Amount,
+		Timestamp:    timestamppb.New(bid.CreatedAt),
+		BuyNow:       true,
+	}
+	endedID, saveErr := s.saveEvent(ctx, tx, EventTypeAuctionEnded, ended)
+	if saveErr != nil {
+		return nil, nil, saveErr
+	}
+
+	if commitErr := tx.Commit(ctx); commitErr != nil {
+		return nil, nil, fmt.Errorf("failed to commit transaction: %w", commitErr)
+	}
+
+	s.publishNow(ctx, placedID, endedID)
+	s.cachePrice(ctx, bid.ItemID, bid.Amount)
+	item.CurrentHighestBid = bid.Amount
+	item.Status = items.ItemStatusEnded
+	return bid, item, nil
+}
+
+// ExtendAuction lets the seller push a live auction's end time later, up to
+// items.MaxAuctionDuration after it started, and emits auction.extended.
+// Unlike anti-sniping extensions it does not count against the item's extension cap.
+func (s *AuctionService) ExtendAuction(ctx context.Context, cmd ExtendAuctionCommand) (*items.Item, error) {
+	tx, err := s.txManager.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx) // Rollback if commit is not called
+	}()
+
+	item, err := s.itemRepo.GetItemByIDForUpdate(ctx, tx, cmd.ItemID)
+	if err != nil {
+		return nil, itemLookupError(err)
+	}
+
+	if !item.IsOwnedBy(cmd.UserID) {
+		return nil, items.ErrUnauthorized
+	}
+	switch item.Status {
+	case items.ItemStatusScheduled, items.ItemStatusActive, items.ItemStatusPaused:
+	default:
+		return nil, ErrCannotExtend
+	}
+	now := s.clock.Now()
+	if valErr := validateAuctionNotEnded(item.EndAt, now); valErr != nil {
+		return nil, valErr
+	}
+	if !cmd.EndAt.After(item.EndAt) {
+		return nil, ErrEndAtNotLater
+	}
+	if cmd.EndAt.Sub(item.StartAt) > items.MaxAuctionDuration {
+		return nil, ErrAuctionTooLong
+	}
+
+	if updateErr := s.itemRepo.UpdateEndAt(ctx, tx, cmd.ItemID, cmd.EndAt); updateErr != nil {
+		return nil, fmt.Errorf("failed to extend auction: %w", updateErr)
+	}
+	extended := &pb.AuctionExtended{
+		ItemId:        item.ID.String(),
+		SellerId:      item.SellerID.String(),
+		PreviousEndAt: timestamppb.New(item.EndAt),
+		NewEndAt:      timestamppb.New(cmd.EndAt),
+		Timestamp:     timestamppb.New(now),
+	}
+	if _, saveErr := s.saveEvent(ctx, tx, EventTypeAuctionExtended, extended); saveErr != nil {
+		return nil, saveErr
+	}
+
+	if commitErr := tx.Commit(ctx); commitErr != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", commitErr)
+	}
+
+	item.EndAt = cmd.EndAt
+	return item, nil
+}
+
+// checkSpendingLimit asks the limiter whether userID may commit amount. It runs last,
+// once the bid is otherwise valid, and while the item row is still locked.
+func (s *AuctionService) checkSpendingLimit(ctx context.Context, userID uuid.UUID, amount int64) error {
+	if err := s.limiter.CheckCanCommit(ctx, userID, amount); err != nil {
+		return fmt.Errorf("spending limit check failed: %w", err)
+	}
+	return nil
+}
+
+// openIfScheduled opens a scheduled auction whose start time has passed, for when
+// the worker has not activated it yet. Items that are not scheduled are left alone.
+func (s *AuctionService) openIfScheduled(ctx context.Context, tx pgx.Tx, item *items.Item) error {
+	if item.Status != items.ItemStatusScheduled {
+		return nil
+	}
+	if !item.HasStarted(s.clock.Now()) {
+		return ErrAuctionNotStarted
+	}
+	if err := s.itemRepo.ActivateItem(ctx, tx, item.ID); err != nil {
+		return fmt.Errorf("failed to activate item: %w", err)
+	}
+	item.Status = items.ItemStatusActive
+	return nil
+}
+
+// bidPlacedEvent builds the bid.placed payload; item is the state before the bid
+func bidPlacedEvent(bid *Bid, item *items.Item, previous *Bid) *pb.BidPlaced {
 	event := &pb.BidPlaced{
-		BidId:     bid.ID.String(),
-		ItemId:    bid.ItemID.String(),
-		UserId:    bid.UserID.String(),
-		Amount:    bid.Amount,
-		Timestamp: timestamppb.New(bid.CreatedAt),
+		BidId:          bid.ID.String(),
+		ItemId:         bid.ItemID.String(),
+		UserId:         bid.UserID.String(),
+		Amount:         bid.Amount,
+		Timestamp:      timestamppb.New(bid.CreatedAt),
+		PreviousAmount: item.CurrentHighestBid,
+	}
+	if previous != nil {
+		event.PreviousHighestBidderId = previous.UserID.String()
 	}
+	return event
+}
 
-	// Marshal the protobuf message
-	payload, marshalErr := proto.Marshal(event)
-	if marshalErr != nil {
-		return nil, fmt.Errorf("failed to marshal event: %w", marshalErr)
+// saveEvent marshals msg and writes it to the outbox in tx, returning the event ID
+func (s *AuctionService) saveEvent(ctx context.Context, tx pgx.Tx, eventType EventType, msg proto.Message) (uuid.UUID, error) {
+	payload, err := proto.Marshal(msg)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to marshal event: %w", err)
 	}
 
-	// Step 4: Save the event to the outbox (in the same transaction)
 	outboxEvent := &events.OutboxEvent{
 		ID:        uuid.New(),
-		EventType: "bid.placed",
+		EventType: eventType.String(),
 		Payload:   payload,
 		Status:    events.OutboxStatusPending,
-		CreatedAt: time.Now(),
+		CreatedAt: s.clock.Now(),
 	}
-
-	if saveErr := s.outboxRepo.SaveEvent(ctx, tx, outboxEvent); saveErr != nil {
-		return nil, fmt.Errorf("failed to save outbox event: %w", saveErr)
+	if err := s.outboxRepo.SaveEvent(ctx, tx, outboxEvent); err != nil {
+		return uuid.Nil, fmt.Errorf("failed to save outbox event: %w", err)
 	}
+	return outboxEvent.ID, nil
+}
 
-	// Commit the transaction
-	// If this succeeds, both the bid and the event are guaranteed to be saved
-	if commitErr := tx.Commit(ctx); commitErr != nil {
-		return nil, fmt.Errorf("failed to commit transaction: %w", commitErr)
+// itemLookupError reports a missing item as items.ErrItemNotFound and anything else, such
+// as a timeout or a dropped connection, as an internal failure
+func itemLookupError(err error) error {
+	if errors.Is(err, items.ErrItemNotFound) || errors.Is(err, pgx.ErrNoRows) {
+		return items.ErrItemNotFound
 	}
-
-	return bid, nil
+	return fmt.Errorf("failed to get item: %w", err)
 }
@@ -1,10 +1,22 @@
 package bids
 
 import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"math"
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/floroz/gavel/pkg/clock/clocktest"
+	"github.com/floroz/gavel/pkg/events"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/items"
 )
 
 func TestValidateBidAmount(t *testing.T) {
@@ -12,37 +24,109 @@ func TestValidateBidAmount(t *testing.T) {
 		name           string
 		bidAmount      int64
 		currentHighest int64
+		startPrice     int64
 		wantErr        error
 	}{
 		{
 			name:           "Valid bid",
 			bidAmount:      150,
 			currentHighest: 100,
+			startPrice:     50,
 			wantErr:        nil,
 		},
 		{
 			name:           "Bid too low",
 			bidAmount:      90,
 			currentHighest: 100,
+			startPrice:     50,
 			wantErr:        ErrBidTooLow,
 		},
 		{
 			name:           "Bid equal to current",
 			bidAmount:      100,
 			currentHighest: 100,
+			startPrice:     50,
 			wantErr:        ErrBidTooLow,
 		},
+		{
+			name:           "First bid below start price",
+			bidAmount:      1,
+			currentHighest: 0,
+			startPrice:     10000,
+			wantErr:        ErrBidBelowStartPrice,
+		},
+		{
+			name:           "First bid at start price",
+			bidAmount:      10000,
+			currentHighest: 0,
+			startPrice:     10000,
+			wantErr:        nil,
+		},
+		{
+			name:           "First bid above start price",
+			bidAmount:      12000,
+			currentHighest: 0,
+			startPrice:     10000,
+			wantErr:        nil,
+		},
+		{
+			name:           "Bid at max amount",
+			bidAmount:      DefaultMaxBidAmount,
+			currentHighest: 100,
+			startPrice:     50,
+			wantErr:        nil,
+		},
+		{
+			name:           "Bid above max amount",
+			bidAmount:      DefaultMaxBidAmount + 1,
+			currentHighest: 100,
+			startPrice:     50,
+			wantErr:        ErrBidAmountTooHigh,
+		},
+		{
+			name:           "MaxInt64 bid",
+			bidAmount:      math.MaxInt64,
+			currentHighest: 100,
+			startPrice:     50,
+			wantErr:        ErrBidAmountTooHigh,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateBidAmount(tt.bidAmount, tt.currentHighest, tt.startPrice, DefaultMaxBidAmount)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestValidateBidStep(t *testing.T) {
+	tests := []struct {
+		name       string
+		bidAmount  int64
+		startPrice int64
+		step       int64
+		wantErr    error
+	}{
+		{name: "No step accepts any amount", bidAmount: 1234, startPrice: 1000, step: 0},
+		{name: "Start price is on step", bidAmount: 1000, startPrice: 1000, step: 250},
+		{name: "Whole steps above start", bidAmount: 1750, startPrice: 1000, step: 250},
+		{name: "Whole currency units", bidAmount: 2500, startPrice: 500, step: 100},
+		{name: "Off step", bidAmount: 1260, startPrice: 1000, step: 250, wantErr: ErrInvalidBidStep},
+		{name: "Fractional cents", bidAmount: 2550, startPrice: 500, step: 100, wantErr: ErrInvalidBidStep},
+		{name: "Steps count from an off-grid start price", bidAmount: 1199, startPrice: 999, step: 100},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := validateBidAmount(tt.bidAmount, tt.currentHighest)
+			err := validateBidStep(tt.bidAmount, tt.startPrice, tt.step)
 			assert.Equal(t, tt.wantErr, err)
 		})
 	}
 }
 
 func TestValidateAuctionNotEnded(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
 	tests := []struct {
 		name    string
 		endAt   time.Time
@@ -50,20 +134,682 @@ func TestValidateAuctionNotEnded(t *testing.T) {
 	}{
 		{
 			name:    "Auction active",
-			endAt:   time.Now().Add(1 * time.Hour),
+			endAt:   now.Add(1 * time.Hour),
+			wantErr: nil,
+		},
+		{
+			name:    "Auction ending this instant",
+			endAt:   now,
 			wantErr: nil,
 		},
 		{
 			name:    "Auction ended",
-			endAt:   time.Now().Add(-1 * time.Hour),
+			endAt:   now.Add(-1 * time.Hour),
 			wantErr: ErrAuctionEnded,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := validateAuctionNotEnded(tt.endAt)
+			err := validateAuctionNotEnded(tt.endAt, now)
 			assert.Equal(t, tt.wantErr, err)
 		})
 	}
 }
+
+// fakeItemRepository serves items from memory and counts reads
+type fakeItemRepository struct {
+	items map[uuid.UUID]*items.Item
+	reads int
+	err   error // returned by every read when set
+}
+
+func (r *fakeItemRepository) GetItemByID(_ context.Context, itemID uuid.UUID) (*items.Item, error) {
+	r.reads++
+	if r.err != nil {
+		return nil, r.err
+	}
+	item, ok := r.items[itemID]
+	if !ok {
+		return nil, items.ErrItemNotFound
+	}
+	return item, nil
+}
+
+func (r *fakeItemRepository) GetItemByIDForUpdate(ctx context.Context, _ pgx.Tx, itemID uuid.UUID) (*items.Item, error) {
+	return r.GetItemByID(ctx, itemID)
+}
+
+func (r *fakeItemRepository) UpdateHighestBid(_ context.Context, _ pgx.Tx, _ uuid.UUID, _ int64) error {
+	return nil
+}
+
+func (r *fakeItemRepository) ActivateItem(_ context.Context, _ pgx.Tx, _ uuid.UUID) error {
+	return nil
+}
+
+func (r *fakeItemRepository) MarkEnded(_ context.Context, _ pgx.Tx, _ uuid.UUID) error {
+	return nil
+}
+
+func (r *fakeItemRepository) UpdateEndAt(_ context.Context, _ pgx.Tx, itemID uuid.UUID, endAt time.Time) error {
+	r.items[itemID].EndAt = endAt
+	return nil
+}
+
+func (r *fakeItemRepository) ExtendEndAt(_ context.Context, _ pgx.Tx, itemID uuid.UUID, endAt time.Time, by time.Duration) error {
+	item := r.items[itemID]
+	item.EndAt = endAt
+	item.ExtensionCount++
+	item.ExtendedSeconds += int64(by / time.Second)
+	return nil
+}
+
+// fakePriceCache is an in-memory PriceCache
+type fakePriceCache struct {
+	prices map[uuid.UUID]int64
+}
+
+func (c *fakePriceCache) SetCurrentPrice(_ context.Context, itemID uuid.UUID, amount int64) error {
+	if current, ok := c.prices[itemID]; !ok || amount > current {
+		c.prices[itemID] = amount
+	}
+	return nil
+}
+
+func (c *fakePriceCache) GetCurrentPrice(_ context.Context, itemID uuid.UUID) (int64, bool, error) {
+	price, ok := c.prices[itemID]
+	return price, ok, nil
+}
+
+func TestAuctionService_GetCurrentPrice(t *testing.T) {
+	itemID := uuid.New()
+	newRepo := func() *fakeItemRepository {
+		return &fakeItemRepository{items: map[uuid.UUID]*items.Item{
+			itemID: {ID: itemID, CurrentHighestBid: 2500},
+		}}
+	}
+
+	t.Run("cache hit skips the database", func(t *testing.T) {
+		repo := newRepo()
+		priceCache := &fakePriceCache{prices: map[uuid.UUID]int64{itemID: 3000}}
+		service := NewAuctionService(nil, nil, repo, nil, priceCache, nil, DefaultMaxBidAmount, nil, AntiSniping{}, nil)
+
+		price, err := service.GetCurrentPrice(context.Background(), itemID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3000), price)
+		assert.Equal(t, 0, repo.reads)
+	})
+
+	t.Run("cache miss falls back to the database and populates the cache", func(t *testing.T) {
+		repo := newRepo()
+		priceCache := &fakePriceCache{prices: map[uuid.UUID]int64{}}
+		service := NewAuctionService(nil, nil, repo, nil, priceCache, nil, DefaultMaxBidAmount, nil, AntiSniping{}, nil)
+
+		price, err := service.GetCurrentPrice(context.Background(), itemID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2500), price)
+		assert.Equal(t, 1, repo.reads)
+		assert.Equal(t, int64(2500), priceCache.prices[itemID])
+	})
+
+	t.Run("works without a cache", func(t *testing.T) {
+		repo := newRepo()
+		service := NewAuctionService(nil, nil, repo, nil, nil, nil, DefaultMaxBidAmount, nil, AntiSniping{}, nil)
+
+		price, err := service.GetCurrentPrice(context.Background(), itemID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2500), price)
+	})
+
+	t.Run("unknown item", func(t *testing.T) {
+		service := NewAuctionService(nil, nil, newRepo(), nil, nil, nil, DefaultMaxBidAmount, nil, AntiSniping{}, nil)
+
+		_, err := service.GetCurrentPrice(context.Background(), uuid.New())
+		assert.ErrorIs(t, err, items.ErrItemNotFound)
+	})
+
+	t.Run("a database failure is not reported as not found", func(t *testing.T) {
+		repo := newRepo()
+		repo.err = context.DeadlineExceeded
+		service := NewAuctionService(nil, nil, repo, nil, nil, nil, DefaultMaxBidAmount, nil, AntiSniping{}, nil)
+
+		_, err := service.GetCurrentPrice(context.Background(), itemID)
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, items.ErrItemNotFound)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+// fakeTx stands in for a pgx transaction; only Commit and Rollback are called directly
+type fakeTx struct {
+	pgx.Tx
+	committed bool
+}
+
+func (tx *fakeTx) Commit(context.Context) error   { tx.committed = true; return nil }
+func (tx *fakeTx) Rollback(context.Context) error { return nil }
+
+type fakeTxManager struct {
+	tx *fakeTx
+}
+
+func (m *fakeTxManager) BeginTx(context.Context) (pgx.Tx, error) {
+	m.tx = &fakeTx{}
+	return m.tx, nil
+}
+
+// fakeBidRepository records saved bids on an item whose standing bid is highest, nil for none
+type fakeBidRepository struct {
+	BidRepository
+	highest *Bid
+	saved   []*Bid
+}
+
+func (r *fakeBidRepository) GetHighestBid(context.Context, pgx.Tx, uuid.UUID) (*Bid, error) {
+	return r.highest, nil
+}
+
+func (r *fakeBidRepository) SaveBid(_ context.Context, _ pgx.Tx, bid *Bid) error {
+	bid.CreatedAt = time.Now()
+	r.saved = append(r.saved, bid)
+	return nil
+}
+
+type fakeOutboxRepository struct {
+	OutboxRepository
+}
+
+func (fakeOutboxRepository) SaveEvent(context.Context, pgx.Tx, *events.OutboxEvent) error {
+	return nil
+}
+
+// recordingOutboxRepository keeps the events saved to it
+type recordingOutboxRepository struct {
+	OutboxRepository
+	saved []*events.OutboxEvent
+}
+
+func (r *recordingOutboxRepository) SaveEvent(_ context.Context, _ pgx.Tx, event *events.OutboxEvent) error {
+	r.saved = append(r.saved, event)
+	return nil
+}
+
+// fakeImmediatePublisher records the events handed to it after commit
+type fakeImmediatePublisher struct {
+	err       error
+	published []uuid.UUID
+}
+
+func (p *fakeImmediatePublisher) PublishNow(_ context.Context, ids ...uuid.UUID) error {
+	p.published = append(p.published, ids...)
+	return p.err
+}
+
+// fakeSpendingLimiter allows commitments up to limit and records what it was asked
+type fakeSpendingLimiter struct {
+	limit   int64
+	err     error
+	checked []int64
+}
+
+func (l *fakeSpendingLimiter) CheckCanCommit(_ context.Context, _ uuid.UUID, amount int64) error {
+	l.checked = append(l.checked, amount)
+	if l.err != nil {
+		return l.err
+	}
+	if amount > l.limit {
+		return ErrSpendingLimitExceeded
+	}
+	return nil
+}
+
+func TestAuctionService_SpendingLimiter(t *testing.T) {
+	itemID := uuid.New()
+	newService := func(limiter SpendingLimiter) (*AuctionService, *fakeBidRepository, *fakeTxManager) {
+		itemRepo := &fakeItemRepository{items: map[uuid.UUID]*items.Item{
+			itemID: {
+				ID:          itemID,
+				SellerID:    uuid.New(),
+				StartPrice:  1000,
+				BuyNowPrice: 9000,
+				Status:      items.ItemStatusActive,
+				EndAt:       time.Now().Add(time.Hour),
+			},
+		}}
+		bidRepo := &fakeBidRepository{}
+		txManager := &fakeTxManager{}
+		return NewAuctionService(txManager, bidRepo, itemRepo, fakeOutboxRepository{}, nil, limiter, DefaultMaxBidAmount, nil, AntiSniping{}, nil), bidRepo, txManager
+	}
+	placeBid := PlaceBidCommand{ItemID: itemID, UserID: uuid.New(), Amount: 2000}
+
+	t.Run("allowed bid is placed", func(t *testing.T) {
+		limiter := &fakeSpendingLimiter{limit: 5000}
+		service, bidRepo, txManager := newService(limiter)
+
+		bid, _, err := service.PlaceBid(context.Background(), placeBid)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2000), bid.Amount)
+		assert.Equal(t, []int64{2000}, limiter.checked)
+		assert.Len(t, bidRepo.saved, 1)
+		assert.True(t, txManager.tx.committed)
+	})
+
+	t.Run("denied bid is not saved", func(t *testing.T) {
+		limiter := &fakeSpendingLimiter{limit: 1500}
+		service, bidRepo, txManager := newService(limiter)
+
+		_, _, err := service.PlaceBid(context.Background(), placeBid)
+		assert.ErrorIs(t, err, ErrSpendingLimitExceeded)
+		assert.Empty(t, bidRepo.saved)
+		assert.False(t, txManager.tx.committed)
+	})
+
+	t.Run("buy now is checked at the buy-now price", func(t *testing.T) {
+		limiter := &fakeSpendingLimiter{limit: 5000}
+		service, bidRepo, _ := newService(limiter)
+
+		_, _, err := service.BuyNow(context.Background(), BuyNowCommand{ItemID: itemID, UserID: uuid.New()})
+		assert.ErrorIs(t, err, ErrSpendingLimitExceeded)
+		assert.Equal(t, []int64{9000}, limiter.checked)
+		assert.Empty(t, bidRepo.saved)
+	})
+
+	t.Run("limiter failures are not reported as limit errors", func(t *testing.T) {
+		service, _, _ := newService(&fakeSpendingLimiter{err: errors.New("ledger unavailable")})
+
+		_, _, err := service.PlaceBid(context.Background(), placeBid)
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, ErrSpendingLimitExceeded)
+	})
+
+	t.Run("nil limiter accepts every bid", func(t *testing.T) {
+		service, bidRepo, _ := newService(nil)
+
+		_, _, err := service.PlaceBid(context.Background(), placeBid)
+		require.NoError(t, err)
+		assert.Len(t, bidRepo.saved, 1)
+	})
+}
+
+func TestAuctionService_UsesInjectedClock(t *testing.T) {
+	itemID := uuid.New()
+	endAt := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	newService := func(clk *clocktest.Fake) (*AuctionService, *fakeBidRepository) {
+		itemRepo := &fakeItemRepository{items: map[uuid.UUID]*items.Item{
+			itemID: {
+				ID:         itemID,
+				SellerID:   uuid.New(),
+				StartPrice: 1000,
+				Status:     items.ItemStatusActive,
+				EndAt:      endAt,
+			},
+		}}
+		bidRepo := &fakeBidRepository{}
+		return NewAuctionService(&fakeTxManager{}, bidRepo, itemRepo, fakeOutboxRepository{}, nil, nil, DefaultMaxBidAmount, clk, AntiSniping{}, nil), bidRepo
+	}
+	placeBid := PlaceBidCommand{ItemID: itemID, UserID: uuid.New(), Amount: 2000}
+
+	// The item's end time is in the past by wall time, so only the fake clock can keep it open
+	t.Run("open while the clock is before end_at", func(t *testing.T) {
+		service, bidRepo := newService(clocktest.NewFake(endAt.Add(-time.Minute)))
+
+		_, _, err := service.PlaceBid(context.Background(), placeBid)
+		require.NoError(t, err)
+		assert.Len(t, bidRepo.saved, 1)
+	})
+
+	t.Run("ended once the clock passes end_at", func(t *testing.T) {
+		clk := clocktest.NewFake(endAt.Add(-time.Minute))
+		service, bidRepo := newService(clk)
+		clk.Advance(2 * time.Minute)
+
+		_, _, err := service.PlaceBid(context.Background(), placeBid)
+		assert.ErrorIs(t, err, ErrAuctionEnded)
+		assert.Empty(t, bidRepo.saved)
+	})
+}
+
+func TestAntiSniping_Extend(t *testing.T) {
+	endAt := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	policy := AntiSniping{Window: 2 * time.Minute, Extension: 2 * time.Minute, MaxExtensions: 3, MaxTotal: 5 * time.Minute}
+
+	tests := []struct {
+		name   string
+		item   items.Item
+		now    time.Time
+		wantBy time.Duration
+		wantOK bool
+	}{
+		{"outside the window", items.Item{EndAt: endAt}, endAt.Add(-5 * time.Minute), 0, false},
+		{"inside the window", items.Item{EndAt: endAt}, endAt.Add(-30 * time.Second), 90 * time.Second, true},
+		{"count cap reached", items.Item{EndAt: endAt, ExtensionCount: 3, ExtendedSeconds: 180}, endAt.Add(-30 * time.Second), 0, false},
+		{"clamped to the remaining total", items.Item{EndAt: endAt, ExtensionCount: 2, ExtendedSeconds: 270}, endAt.Add(-30 * time.Second), 30 * time.Second, true},
+		{"total cap reached", items.Item{EndAt: endAt, ExtensionCount: 2, ExtendedSeconds: 300}, endAt.Add(-30 * time.Second), 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newEnd, by, ok := policy.extend(&tt.item, tt.now)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantBy, by)
+			if ok {
+				assert.Equal(t, endAt.Add(tt.wantBy), newEnd)
+			}
+		})
+	}
+
+	t.Run("the zero value never extends", func(t *testing.T) {
+		_, _, ok := AntiSniping{}.extend(&items.Item{EndAt: endAt}, endAt.Add(-time.Second))
+		assert.False(t, ok)
+	})
+}
+
+func TestAuctionService_AntiSnipingCap(t *testing.T) {
+	itemID := uuid.New()
+	endAt := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	item := &items.Item{
+		ID:         itemID,
+		SellerID:   uuid.New(),
+		StartPrice: 1000,
+		Status:     items.ItemStatusActive,
+		EndAt:      endAt,
+	}
+	itemRepo := &fakeItemRepository{items: map[uuid.UUID]*items.Item{itemID: item}}
+	bidRepo := &fakeBidRepository{}
+	clk := clocktest.NewFake(endAt.Add(-30 * time.Second))
+	policy := AntiSniping{Window: time.Minute, Extension: time.Minute, MaxExtensions: 2}
+	service := NewAuctionService(&fakeTxManager{}, bidRepo, itemRepo, fakeOutboxRepository{}, nil, nil, DefaultMaxBidAmount, clk, policy, nil)
+
+	// Every bid lands 30 seconds before the current end, inside the window
+	for i := range 4 {
+		clk.Set(item.EndAt.Add(-30 * time.Second))
+		_, _, err := service.PlaceBid(context.Background(), PlaceBidCommand{ItemID: itemID, UserID: uuid.New(), Amount: int64(2000 + i*100)})
+		require.NoError(t, err, "bid %d must be accepted", i)
+	}
+
+	assert.Len(t, bidRepo.saved, 4, "bids past the cap are still accepted")
+	assert.Equal(t, 2, item.ExtensionCount)
+	assert.Equal(t, int64(60), item.ExtendedSeconds)
+	assert.Equal(t, endAt.Add(time.Minute), item.EndAt, "only the first two bids extend the auction")
+}
+
+func TestAuctionService_PlaceBidReturnsUpdatedItem(t *testing.T) {
+	endAt := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	newService := func(now time.Time) (*AuctionService, *items.Item) {
+		item := &items.Item{
+			ID:                uuid.New(),
+			SellerID:          uuid.New(),
+			StartPrice:        1000,
+			CurrentHighestBid: 1500,
+			BidCount:          2,
+			Status:            items.ItemStatusActive,
+			EndAt:             endAt,
+		}
+		itemRepo := &fakeItemRepository{items: map[uuid.UUID]*items.Item{item.ID: item}}
+		policy := AntiSniping{Window: 2 * time.Minute, Extension: 2 * time.Minute, MaxExtensions: 3}
+		service := NewAuctionService(&fakeTxManager{}, &fakeBidRepository{}, itemRepo, fakeOutboxRepository{}, nil, nil, DefaultMaxBidAmount, clocktest.NewFake(now), policy, nil)
+		return service, item
+	}
+
+	t.Run("outside the closing window", func(t *testing.T) {
+		now := endAt.Add(-time.Hour)
+		service, item := newService(now)
+
+		_, updated, err := service.PlaceBid(context.Background(), PlaceBidCommand{ItemID: item.ID, UserID: uuid.New(), Amount: 2000})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2000), updated.CurrentHighestBid)
+		assert.Equal(t, int64(3), updated.BidCount)
+		assert.Equal(t, endAt, updated.EndAt)
+		assert.Equal(t, items.ItemStatusActive, updated.AuctionState(now))
+	})
+
+	t.Run("inside the closing window", func(t *testing.T) {
+		now := endAt.Add(-30 * time.Second)
+		service, item := newService(now)
+
+		_, updated, err := service.PlaceBid(context.Background(), PlaceBidCommand{ItemID: item.ID, UserID: uuid.New(), Amount: 2000})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2000), updated.CurrentHighestBid)
+		assert.Equal(t, endAt.Add(90*time.Second), updated.EndAt, "the returned end time includes the extension")
+		assert.Equal(t, 1, updated.ExtensionCount)
+		assert.Equal(t, int64(90), updated.ExtendedSeconds)
+		assert.Equal(t, int64(120), updated.SecondsRemaining(now), "a closing bid leaves a full window to answer it")
+	})
+}
+
+func TestAuctionService_RejectsDuplicateBid(t *testing.T) {
+	bidderID := uuid.New()
+	newService := func(highest *Bid) (*AuctionService, *fakeBidRepository, *items.Item) {
+		item := &items.Item{
+			ID:                uuid.New(),
+			SellerID:          uuid.New(),
+			StartPrice:        1000,
+			CurrentHighestBid: highest.Amount,
+			BidCount:          1,
+			Status:            items.ItemStatusActive,
+			EndAt:             time.Now().Add(time.Hour),
+		}
+		highest.ItemID = item.ID
+		bidRepo := &fakeBidRepository{highest: highest}
+		itemRepo := &fakeItemRepository{items: map[uuid.UUID]*items.Item{item.ID: item}}
+		service := NewAuctionService(&fakeTxManager{}, bidRepo, itemRepo, fakeOutboxRepository{}, nil, nil, DefaultMaxBidAmount, nil, AntiSniping{}, nil)
+		return service, bidRepo, item
+	}
+
+	t.Run("resubmitting the standing bid", func(t *testing.T) {
+		service, bidRepo, item := newService(&Bid{ID: uuid.New(), UserID: bidderID, Amount: 1500})
+
+		_, _, err := service.PlaceBid(context.Background(), PlaceBidCommand{ItemID: item.ID, UserID: bidderID, Amount: 1500})
+		assert.ErrorIs(t, err, ErrDuplicateBid)
+		assert.Empty(t, bidRepo.saved)
+	})
+
+	t.Run("matching someone else's bid is still too low", func(t *testing.T) {
+		service, bidRepo, item := newService(&Bid{ID: uuid.New(), UserID: uuid.New(), Amount: 1500})
+
+		_, _, err := service.PlaceBid(context.Background(), PlaceBidCommand{ItemID: item.ID, UserID: bidderID, Amount: 1500})
+		assert.ErrorIs(t, err, ErrBidTooLow)
+		assert.Empty(t, bidRepo.saved)
+	})
+
+	t.Run("bidding below one's own standing bid is too low", func(t *testing.T) {
+		service, _, item := newService(&Bid{ID: uuid.New(), UserID: bidderID, Amount: 1500})
+
+		_, _, err := service.PlaceBid(context.Background(), PlaceBidCommand{ItemID: item.ID, UserID: bidderID, Amount: 1200})
+		assert.ErrorIs(t, err, ErrBidTooLow)
+	})
+
+	t.Run("raising one's own standing bid is allowed", func(t *testing.T) {
+		service, bidRepo, item := newService(&Bid{ID: uuid.New(), UserID: bidderID, Amount: 1500})
+
+		_, _, err := service.PlaceBid(context.Background(), PlaceBidCommand{ItemID: item.ID, UserID: bidderID, Amount: 2000})
+		require.NoError(t, err)
+		assert.Len(t, bidRepo.saved, 1)
+	})
+}
+
+func TestAuctionService_PublishesBidEventsOnCommit(t *testing.T) {
+	newService := func(publisher ImmediatePublisher) (*AuctionService, *recordingOutboxRepository, *items.Item) {
+		item := &items.Item{
+			ID:          uuid.New(),
+			SellerID:    uuid.New(),
+			StartPrice:  1000,
+			BuyNowPrice: 9000,
+			Status:      items.ItemStatusActive,
+			EndAt:       time.Now().Add(time.Hour),
+		}
+		itemRepo := &fakeItemRepository{items: map[uuid.UUID]*items.Item{item.ID: item}}
+		outboxRepo := &recordingOutboxRepository{}
+		service := NewAuctionService(&fakeTxManager{}, &fakeBidRepository{}, itemRepo, outboxRepo, nil, nil, DefaultMaxBidAmount, nil, AntiSniping{}, publisher)
+		return service, outboxRepo, item
+	}
+
+	t.Run("a placed bid is published at once", func(t *testing.T) {
+		publisher := &fakeImmediatePublisher{}
+		service, outboxRepo, item := newService(publisher)
+
+		_, _, err := service.PlaceBid(context.Background(), PlaceBidCommand{ItemID: item.ID, UserID: uuid.New(), Amount: 2000})
+		require.NoError(t, err)
+		require.Len(t, outboxRepo.saved, 1)
+		assert.Equal(t, []uuid.UUID{outboxRepo.saved[0].ID}, publisher.published)
+	})
+
+	t.Run("buy now publishes both of its events", func(t *testing.T) {
+		publisher := &fakeImmediatePublisher{}
+		service, outboxRepo, item := newService(publisher)
+
+		_, _, err := service.BuyNow(context.Background(), BuyNowCommand{ItemID: item.ID, UserID: uuid.New()})
+		require.NoError(t, err)
+		require.Len(t, outboxRepo.saved, 2)
+		assert.Equal(t, []uuid.UUID{outboxRepo.saved[0].ID, outboxRepo.saved[1].ID}, publisher.published)
+	})
+
+	t.Run("a failed publish does not fail the bid", func(t *testing.T) {
+		var logs bytes.Buffer
+		defaultLogger := slog.Default()
+		slog.SetDefault(slog.New(slog.NewTextHandler(&logs, nil)))
+		t.Cleanup(func() { slog.SetDefault(defaultLogger) })
+
+		publisher := &fakeImmediatePublisher{err: errors.New("broker unavailable")}
+		service, outboxRepo, item := newService(publisher)
+
+		bid, _, err := service.PlaceBid(context.Background(), PlaceBidCommand{ItemID: item.ID, UserID: uuid.New(), Amount: 2000})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2000), bid.Amount)
+		assert.Equal(t, events.OutboxStatusPending, outboxRepo.saved[0].Status, "the event is left for the relay")
+
+		assert.Contains(t, logs.String(), "level=WARN")
+		assert.Contains(t, logs.String(), outboxRepo.saved[0].ID.String(), "the warning names the outbox events")
+		assert.Contains(t, logs.String(), "broker unavailable")
+	})
+
+	t.Run("nothing is published before the commit", func(t *testing.T) {
+		publisher := &fakeImmediatePublisher{}
+		service, _, item := newService(publisher)
+
+		_, _, err := service.PlaceBid(context.Background(), PlaceBidCommand{ItemID: item.ID, UserID: item.SellerID, Amount: 2000})
+		require.ErrorIs(t, err, ErrSellerCannotBid)
+		assert.Empty(t, publisher.published)
+	})
+}
+
+func TestAuctionService_BuyNow_LookupErrors(t *testing.T) {
+	t.Run("unknown item", func(t *testing.T) {
+		repo := &fakeItemRepository{items: map[uuid.UUID]*items.Item{}}
+		service := NewAuctionService(&fakeTxManager{}, nil, repo, fakeOutboxRepository{}, nil, nil, DefaultMaxBidAmount, nil, AntiSniping{}, nil)
+
+		_, _, err := service.BuyNow(context.Background(), BuyNowCommand{ItemID: uuid.New(), UserID: uuid.New()})
+		assert.ErrorIs(t, err, items.ErrItemNotFound)
+	})
+
+	t.Run("a database failure is not reported as not found", func(t *testing.T) {
+		repo := &fakeItemRepository{err: errors.New("connection reset")}
+		service := NewAuctionService(&fakeTxManager{}, nil, repo, fakeOutboxRepository{}, nil, nil, DefaultMaxBidAmount, nil, AntiSniping{}, nil)
+
+		_, _, err := service.BuyNow(context.Background(), BuyNowCommand{ItemID: uuid.New(), UserID: uuid.New()})
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, items.ErrItemNotFound)
+	})
+}
+
+func TestAuctionService_ExtendAuction(t *testing.T) {
+	sellerID := uuid.New()
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	startAt := now.Add(-24 * time.Hour)
+	endAt := now.Add(time.Hour)
+
+	newService := func(status items.ItemStatus) (*AuctionService, *items.Item) {
+		item := &items.Item{
+			ID:       uuid.New(),
+			SellerID: sellerID,
+			Status:   status,
+			StartAt:  startAt,
+			EndAt:    endAt,
+		}
+		itemRepo := &fakeItemRepository{items: map[uuid.UUID]*items.Item{item.ID: item}}
+		service := NewAuctionService(&fakeTxManager{}, nil, itemRepo, fakeOutboxRepository{}, nil, nil, DefaultMaxBidAmount, clocktest.NewFake(now), AntiSniping{}, nil)
+		return service, item
+	}
+
+	t.Run("seller extends a live auction", func(t *testing.T) {
+		service, item := newService(items.ItemStatusActive)
+		newEnd := endAt.Add(48 * time.Hour)
+
+		extended, err := service.ExtendAuction(context.Background(), ExtendAuctionCommand{ItemID: item.ID, UserID: sellerID, EndAt: newEnd})
+		require.NoError(t, err)
+		assert.Equal(t, newEnd, extended.EndAt)
+		assert.Equal(t, newEnd, item.EndAt, "the stored end time moves")
+		assert.Zero(t, item.ExtensionCount, "owner extensions are not anti-sniping extensions")
+	})
+
+	tests := []struct {
+		name    string
+		status  items.ItemStatus
+		userID  uuid.UUID
+		endAt   time.Time
+		wantErr error
+	}{
+		{"shortening", items.ItemStatusActive, sellerID, endAt.Add(-time.Minute), ErrEndAtNotLater},
+		{"same end time", items.ItemStatusActive, sellerID, endAt, ErrEndAtNotLater},
+		{"non-owner", items.ItemStatusActive, uuid.New(), endAt.Add(time.Hour), items.ErrUnauthorized},
+		{"past the maximum duration", items.ItemStatusActive, sellerID, startAt.Add(items.MaxAuctionDuration + time.Second), ErrAuctionTooLong},
+		{"ended item", items.ItemStatusEnded, sellerID, endAt.Add(time.Hour), ErrCannotExtend},
+		{"cancelled item", items.ItemStatusCancelled, sellerID, endAt.Add(time.Hour), ErrCannotExtend},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, item := newService(tt.status)
+
+			_, err := service.ExtendAuction(context.Background(), ExtendAuctionCommand{ItemID: item.ID, UserID: tt.userID, EndAt: tt.endAt})
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, endAt, item.EndAt, "a rejected extension leaves the end time alone")
+		})
+	}
+
+	t.Run("a database failure is not reported as not found", func(t *testing.T) {
+		service, item := newService(items.ItemStatusActive)
+		service.itemRepo.(*fakeItemRepository).err = errors.New("connection reset")
+
+		_, err := service.ExtendAuction(context.Background(), ExtendAuctionCommand{ItemID: item.ID, UserID: sellerID, EndAt: endAt.Add(time.Hour)})
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, items.ErrItemNotFound)
+	})
+}
+
+func TestAuctionService_GetWinningBid_LookupErrors(t *testing.T) {
+	t.Run("unknown item", func(t *testing.T) {
+		repo := &fakeItemRepository{items: map[uuid.UUID]*items.Item{}}
+		service := NewAuctionService(&fakeTxManager{}, nil, repo, nil, nil, nil, DefaultMaxBidAmount, nil, AntiSniping{}, nil)
+
+		_, err := service.GetWinningBid(context.Background(), uuid.New())
+		assert.ErrorIs(t, err, items.ErrItemNotFound)
+	})
+
+	t.Run("a database failure is not reported as not found", func(t *testing.T) {
+		repo := &fakeItemRepository{err: errors.New("connection reset")}
+		service := NewAuctionService(&fakeTxManager{}, nil, repo, nil, nil, nil, DefaultMaxBidAmount, nil, AntiSniping{}, nil)
+
+		_, err := service.GetWinningBid(context.Background(), uuid.New())
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, items.ErrItemNotFound)
+	})
+}
+
+func TestAuctionService_GetBidPosition_LookupErrors(t *testing.T) {
+	t.Run("unknown item", func(t *testing.T) {
+		repo := &fakeItemRepository{items: map[uuid.UUID]*items.Item{}}
+		service := NewAuctionService(nil, nil, repo, nil, nil, nil, DefaultMaxBidAmount, nil, AntiSniping{}, nil)
+
+		_, err := service.GetBidPosition(context.Background(), uuid.New(), uuid.New())
+		assert.ErrorIs(t, err, items.ErrItemNotFound)
+	})
+
+	t.Run("a database failure is not reported as not found", func(t *testing.T) {
+		repo := &fakeItemRepository{err: errors.New("connection reset")}
+		service := NewAuctionService(nil, nil, repo, nil, nil, nil, DefaultMaxBidAmount, nil, AntiSniping{}, nil)
+
+		_, err := service.GetBidPosition(context.Background(), uuid.New(), uuid.New())
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, items.ErrItemNotFound)
+	})
+}
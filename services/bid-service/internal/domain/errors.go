@@ -0,0 +1,79 @@
+// Package domain holds what the bid-service domain packages share: the error type their
+// sentinels are built from, and the codes that classify them.
+package domain
+
+import "errors"
+
+// Code classifies a domain error so adapters can map it to a transport status once,
+// instead of matching each sentinel
+type Code int
+
+const (
+	// CodeUnknown is the code of any error that is not a domain Error
+	CodeUnknown Code = iota
+	// CodeNotFound means the entity the request names does not exist
+	CodeNotFound
+	// CodeConflict means a concurrent change won; retrying may succeed
+	CodeConflict
+	// CodeInvalidInput means the request itself is malformed or out of range
+	CodeInvalidInput
+	// CodePermissionDenied means the caller may not act on the entity
+	CodePermissionDenied
+	// CodePrecondition means the request is valid but the entity's state rejects it
+	CodePrecondition
+	// CodeResourceExhausted means the caller has used up a quota
+	CodeResourceExhausted
+	// CodeAlreadyExists means the request would repeat something the caller already did
+	CodeAlreadyExists
+)
+
+func (c Code) String() string {
+	switch c {
+	case CodeNotFound:
+		return "not_found"
+	case CodeConflict:
+		return "conflict"
+	case CodeInvalidInput:
+		return "invalid_input"
+	case CodePermissionDenied:
+		return "permission_denied"
+	case CodePrecondition:
+		return "precondition"
+	case CodeResourceExhausted:
+		return "resource_exhausted"
+	case CodeAlreadyExists:
+		return "already_exists"
+	default:
+		return "unknown"
+	}
+}
+
+// Error is a domain error carrying a Code. Sentinels are created once with NewError and
+// compared with errors.Is as before; wrapping them with fmt.Errorf("...: %w") keeps the code.
+type Error struct {
+	code Code
+	msg  string
+}
+
+// NewError creates a domain error with the given code and message
+func NewError(code Code, msg string) *Error {
+	return &Error{code: code, msg: msg}
+}
+
+func (e *Error) Error() string {
+	return e.msg
+}
+
+// Code returns the error's classification
+func (e *Error) Code() Code {
+	return e.code
+}
+
+// CodeOf returns the code of the first domain Error in err's chain, or CodeUnknown
+func CodeOf(err error) Code {
+	var domainErr *Error
+	if errors.As(err, &domainErr) {
+		return domainErr.code
+	}
+	return CodeUnknown
+}
@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCodeOf(t *testing.T) {
+	errMissing := NewError(CodeNotFound, "thing not found")
+
+	tests := []struct {
+		name string
+		err  error
+		want Code
+	}{
+		{"domain error", errMissing, CodeNotFound},
+		{"wrapped domain error", fmt.Errorf("loading thing: %w", errMissing), CodeNotFound},
+		{"joined errors take the first code", errors.Join(NewError(CodeInvalidInput, "bad"), errMissing), CodeInvalidInput},
+		{"plain error", errors.New("connection reset"), CodeUnknown},
+		{"nil", nil, CodeUnknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, CodeOf(tt.err))
+		})
+	}
+}
+
+func TestError_KeepsSentinelIdentity(t *testing.T) {
+	errA := NewError(CodePrecondition, "same message")
+	errB := NewError(CodePrecondition, "same message")
+
+	wrapped := fmt.Errorf("context: %w", errA)
+	assert.ErrorIs(t, wrapped, errA)
+	assert.NotErrorIs(t, wrapped, errB, "sentinels with equal messages must stay distinct")
+	assert.Equal(t, "context: same message", wrapped.Error())
+}
@@ -0,0 +1,63 @@
+package items
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/floroz/gavel/pkg/proto"
+)
+
+// EventType defines the type of event an item write emits
+type EventType string
+
+const (
+	EventTypeItemCreated   EventType = "item.created"
+	EventTypeItemUpdated   EventType = "item.updated"
+	EventTypeItemCancelled EventType = "item.cancelled"
+)
+
+func (e EventType) String() string {
+	return string(e)
+}
+
+// itemCreatedEvent builds the item.created payload
+func itemCreatedEvent(item *Item, now time.Time) *pb.ItemCreated {
+	return &pb.ItemCreated{
+		ItemId:      item.ID.String(),
+		SellerId:    item.SellerID.String(),
+		Title:       item.Title,
+		Description: item.Description,
+		Category:    item.Category,
+		StartPrice:  item.StartPrice,
+		BuyNowPrice: item.BuyNowPrice,
+		StartAt:     timestamppb.New(item.StartAt),
+		EndAt:       timestamppb.New(item.EndAt),
+		Status:      string(item.Status),
+		Images:      item.Images,
+		Timestamp:   timestamppb.New(now),
+	}
+}
+
+// itemUpdatedEvent builds the item.updated payload from the item as saved
+func itemUpdatedEvent(item *Item, now time.Time) *pb.ItemUpdated {
+	return &pb.ItemUpdated{
+		ItemId:        item.ID.String(),
+		SellerId:      item.SellerID.String(),
+		Title:         item.Title,
+		Description:   item.Description,
+		Category:      item.Category,
+		Images:        item.Images,
+		EndAtTimezone: item.EndAtTimezone,
+		Timestamp:     timestamppb.New(now),
+	}
+}
+
+// itemCancelledEvent builds the item.cancelled payload
+func itemCancelledEvent(item *Item, now time.Time) *pb.ItemCancelled {
+	return &pb.ItemCancelled{
+		ItemId:    item.ID.String(),
+		SellerId:  item.SellerID.String(),
+		Timestamp: timestamppb.New(now),
+	}
+}
@@ -0,0 +1,76 @@
+package items
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/floroz/gavel/services/bid-service/internal/domain"
+)
+
+// DefaultMaxImages is how many images an item may carry when no limit is configured
+const DefaultMaxImages = 12
+
+// MaxImageURLLength bounds each image entry
+const MaxImageURLLength = 2048
+
+// Image validation errors
+var (
+	ErrTooManyImages   = domain.NewError(domain.CodeInvalidInput, "too many images")
+	ErrInvalidImageURL = domain.NewError(domain.CodeInvalidInput, "image must be an https URL or a relative path")
+)
+
+// relativeImagePath matches paths served from our own origin, e.g. "uploads/abc.jpg" or
+// "/uploads/abc.jpg". The path must start with a letter or digit after the optional
+// slash, which rules out "//host" URLs and schemes.
+var relativeImagePath = regexp.MustCompile(`^/?[A-Za-z0-9][A-Za-z0-9._~%/-]*$`)
+
+// normalizeImages drops duplicate entries, keeping the first occurrence, and checks the
+// remaining ones against the count limit and the allowed URL formats
+func normalizeImages(images []string, maxImages int) ([]string, error) {
+	if len(images) == 0 {
+		return images, nil
+	}
+
+	seen := make(map[string]bool, len(images))
+	unique := make([]string, 0, len(images))
+	for i, image := range images {
+		if err := validateImageURL(image); err != nil {
+			return nil, fmt.Errorf("%w (image %d)", err, i)
+		}
+		if seen[image] {
+			continue
+		}
+		seen[image] = true
+		unique = append(unique, image)
+	}
+
+	if len(unique) > maxImages {
+		return nil, fmt.Errorf("%w: %d given, at most %d allowed", ErrTooManyImages, len(unique), maxImages)
+	}
+	return unique, nil
+}
+
+func validateImageURL(image string) error {
+	if image == "" || len(image) > MaxImageURLLength {
+		return ErrInvalidImageURL
+	}
+
+	if relativeImagePath.MatchString(image) {
+		if slices.Contains(strings.Split(image, "/"), "..") {
+			return ErrInvalidImageURL
+		}
+		return nil
+	}
+
+	u, err := url.Parse(image)
+	if err != nil || u.Scheme != "https" || u.Host == "" || u.User != nil {
+		return ErrInvalidImageURL
+	}
+	if strings.ContainsAny(image, " \t\r\n") {
+		return ErrInvalidImageURL
+	}
+	return nil
+}
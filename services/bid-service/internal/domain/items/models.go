@@ -1,6 +1,8 @@
 package items
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -10,7 +12,9 @@ import (
 type ItemStatus string
 
 const (
+	ItemStatusScheduled ItemStatus = "scheduled" // waiting for StartAt, not open to bids yet
 	ItemStatusActive    ItemStatus = "active"
+	ItemStatusPaused    ItemStatus = "paused" // bidding suspended by the seller or an admin, see PauseItem
 	ItemStatusEnded     ItemStatus = "ended"
 	ItemStatusCancelled ItemStatus = "cancelled"
 )
@@ -18,7 +22,7 @@ const (
 // IsValid checks if the status is valid
 func (s ItemStatus) IsValid() bool {
 	switch s {
-	case ItemStatusActive, ItemStatusEnded, ItemStatusCancelled:
+	case ItemStatusScheduled, ItemStatusActive, ItemStatusPaused, ItemStatusEnded, ItemStatusCancelled:
 		return true
 	default:
 		return false
@@ -32,13 +36,115 @@ type Item struct {
 	Description       string
 	StartPrice        int64 // in cents/micros
 	CurrentHighestBid int64
-	EndAt             time.Time
+	BuyNowPrice       int64     // 0 when the item cannot be bought outright
+	BidStep           int64     // bids must sit a multiple of this above StartPrice; 0 allows any amount
+	StartAt           time.Time // when bidding opens, always stored in UTC
+	EndAt             time.Time // always stored in UTC
+	EndAtTimezone     string    // IANA zone the seller entered EndAt in, empty if none
 	CreatedAt         time.Time
 	UpdatedAt         time.Time
 	Images            []string
 	Category          string
 	SellerID          uuid.UUID
 	Status            ItemStatus
+	BidCount          int64 // read-only, maintained by the bid repository when bids are saved
+	Views             int64 // read-only, maintained by RecordItemView
+	ExtensionCount    int   // anti-sniping extensions applied so far
+	ExtendedSeconds   int64 // total time anti-sniping has added to EndAt
+}
+
+// MaxAuctionDuration is the longest an auction may run from its start to its end
+const MaxAuctionDuration = 30 * 24 * time.Hour
+
+// ItemCursor marks the last item of a page in the newest-first listing; the next page
+// starts right after it
+type ItemCursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+// CursorAfter returns the cursor positioned at item
+func CursorAfter(item *Item) *ItemCursor {
+	return &ItemCursor{CreatedAt: item.CreatedAt, ID: item.ID}
+}
+
+// Category is one entry of the managed category set items are filed under
+type Category struct {
+	Slug string // stored on items, e.g. "home-garden"
+	Name string // display name, e.g. "Home & Garden"
+}
+
+// SellerDashboard summarizes a seller's listings
+type SellerDashboard struct {
+	SellerID          uuid.UUID
+	ActiveListings    int64 // active items that have not ended yet
+	TotalBidsReceived int64 // bids across all of the seller's items
+	HighestValuedItem *Item // non-cancelled item with the highest bid, nil if no item has bids
+}
+
+// BidTotals are the bid figures denormalized onto an item row
+type BidTotals struct {
+	Count      int64
+	HighestBid int64
+}
+
+// Reconciliation compares an item's stored BidTotals with the ones recomputed from
+// its bids. After reconciling, the item row holds Actual.
+type Reconciliation struct {
+	ItemID uuid.UUID
+	Stored BidTotals
+	Actual BidTotals
+}
+
+// Drifted reports whether the stored totals were wrong and had to be corrected
+func (r *Reconciliation) Drifted() bool {
+	return r.Stored != r.Actual
+}
+
+// LocalEndAt returns EndAt in the seller's timezone, or UTC if none was given
+func (i *Item) LocalEndAt() time.Time {
+	if i.EndAtTimezone != "" {
+		if loc, err := time.LoadLocation(i.EndAtTimezone); err == nil {
+			return i.EndAt.In(loc)
+		}
+	}
+	return i.EndAt.UTC()
+}
+
+// endAtLocalLayout is a wall-clock time without an offset, interpreted in the seller's timezone
+const endAtLocalLayout = "2006-01-02T15:04:05"
+
+// LoadTimezone validates an IANA timezone name. An empty name means UTC.
+func LoadTimezone(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil || name == "Local" {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidTimezone, name)
+	}
+	return loc, nil
+}
+
+// ParseEndAt parses an auction end time and normalizes it to UTC.
+// With a timezone, value may be a local wall-clock time ("2006-01-02T15:04:05")
+// that is resolved in that zone, so "18:00" stays 18:00 local across DST changes.
+// An RFC 3339 value with an explicit offset is always taken as an absolute instant.
+func ParseEndAt(value, timezone string) (time.Time, error) {
+	loc, err := LoadTimezone(timezone)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t.UTC(), nil
+	}
+	if timezone != "" {
+		if t, err := time.ParseInLocation(endAtLocalLayout, value, loc); err == nil {
+			return t.UTC(), nil
+		}
+	}
+	return time.Time{}, errors.New("invalid end_at format")
 }
 
 // IsActive returns true if the item is in active status and has not ended
@@ -46,9 +152,51 @@ func (i *Item) IsActive() bool {
 	return i.Status == ItemStatusActive && time.Now().Before(i.EndAt)
 }
 
-// CanBeCancelled returns true if the item can be cancelled (active and no bids)
+// HasStarted returns true once the item's start time has passed as of now.
+// A scheduled item that has started is due to become active.
+func (i *Item) HasStarted(now time.Time) bool {
+	return !now.Before(i.StartAt)
+}
+
+// AuctionState returns the item's status as of now, without waiting for the worker to
+// apply it: an active or scheduled item past its end time is ended, and a scheduled item
+// past its start time is active
+func (i *Item) AuctionState(now time.Time) ItemStatus {
+	switch i.Status {
+	case ItemStatusActive, ItemStatusScheduled:
+		if !now.Before(i.EndAt) {
+			return ItemStatusEnded
+		}
+		if i.HasStarted(now) {
+			return ItemStatusActive
+		}
+		return ItemStatusScheduled
+	default:
+		return i.Status
+	}
+}
+
+// SecondsRemaining returns the whole seconds until EndAt as of now, or 0 once the
+// auction is over. A paused item's clock keeps running.
+func (i *Item) SecondsRemaining(now time.Time) int64 {
+	switch i.AuctionState(now) {
+	case ItemStatusEnded, ItemStatusCancelled:
+		return 0
+	}
+	if remaining := i.EndAt.Sub(now); remaining > 0 {
+		return int64(remaining / time.Second)
+	}
+	return 0
+}
+
+// CanBeCancelled returns true if the item can be cancelled (active or scheduled, and no bids)
 func (i *Item) CanBeCancelled(hasBids bool) bool {
-	return i.Status == ItemStatusActive && !hasBids
+	return (i.Status == ItemStatusActive || i.Status == ItemStatusScheduled) && !hasBids
+}
+
+// HasBuyNow returns true if the item can be bought outright at BuyNowPrice
+func (i *Item) HasBuyNow() bool {
+	return i.BuyNowPrice > 0
 }
 
 // IsOwnedBy returns true if the item is owned by the given user
@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestItemStatus_IsValid(t *testing.T) {
@@ -14,6 +15,11 @@ func TestItemStatus_IsValid(t *testing.T) {
 		status ItemStatus
 		want   bool
 	}{
+		{
+			name:   "scheduled status is valid",
+			status: ItemStatusScheduled,
+			want:   true,
+		},
 		{
 			name:   "active status is valid",
 			status: ItemStatusActive,
@@ -115,6 +121,14 @@ func TestItem_CanBeCancelled(t *testing.T) {
 			hasBids: true,
 			want:    false,
 		},
+		{
+			name: "scheduled item with no bids can be cancelled",
+			item: &Item{
+				Status: ItemStatusScheduled,
+			},
+			hasBids: false,
+			want:    true,
+		},
 		{
 			name: "ended item cannot be cancelled",
 			item: &Item{
@@ -141,6 +155,40 @@ func TestItem_CanBeCancelled(t *testing.T) {
 	}
 }
 
+func TestItem_HasStarted(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	assert.True(t, (&Item{StartAt: now.Add(-time.Minute)}).HasStarted(now))
+	assert.True(t, (&Item{StartAt: now}).HasStarted(now))
+	assert.False(t, (&Item{StartAt: now.Add(time.Hour)}).HasStarted(now))
+}
+
+func TestItem_AuctionState(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name          string
+		item          *Item
+		wantState     ItemStatus
+		wantRemaining int64
+	}{
+		{"active before end", &Item{Status: ItemStatusActive, EndAt: now.Add(90 * time.Second)}, ItemStatusActive, 90},
+		{"partial seconds round down", &Item{Status: ItemStatusActive, EndAt: now.Add(1500 * time.Millisecond)}, ItemStatusActive, 1},
+		{"active past end is ended", &Item{Status: ItemStatusActive, EndAt: now.Add(-time.Minute)}, ItemStatusEnded, 0},
+		{"active at end is ended", &Item{Status: ItemStatusActive, EndAt: now}, ItemStatusEnded, 0},
+		{"scheduled before start", &Item{Status: ItemStatusScheduled, StartAt: now.Add(time.Hour), EndAt: now.Add(2 * time.Hour)}, ItemStatusScheduled, 7200},
+		{"scheduled past start is active", &Item{Status: ItemStatusScheduled, StartAt: now.Add(-time.Minute), EndAt: now.Add(time.Hour)}, ItemStatusActive, 3600},
+		{"paused keeps counting", &Item{Status: ItemStatusPaused, EndAt: now.Add(time.Minute)}, ItemStatusPaused, 60},
+		{"paused past end", &Item{Status: ItemStatusPaused, EndAt: now.Add(-time.Minute)}, ItemStatusPaused, 0},
+		{"cancelled", &Item{Status: ItemStatusCancelled, EndAt: now.Add(time.Hour)}, ItemStatusCancelled, 0},
+		{"ended", &Item{Status: ItemStatusEnded, EndAt: now.Add(-time.Hour)}, ItemStatusEnded, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantState, tt.item.AuctionState(now))
+			assert.Equal(t, tt.wantRemaining, tt.item.SecondsRemaining(now))
+		})
+	}
+}
+
 func TestItem_IsOwnedBy(t *testing.T) {
 	sellerID := uuid.New()
 	otherUserID := uuid.New()
@@ -176,3 +224,50 @@ func TestItem_IsOwnedBy(t *testing.T) {
 		})
 	}
 }
+
+func TestParseEndAt(t *testing.T) {
+	t.Run("RFC 3339 without timezone is normalized to UTC", func(t *testing.T) {
+		got, err := ParseEndAt("2026-06-01T18:00:00+02:00", "")
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2026, 6, 1, 16, 0, 0, 0, time.UTC), got)
+		assert.Equal(t, time.UTC, got.Location())
+	})
+
+	t.Run("local wall-clock time respects the DST boundary", func(t *testing.T) {
+		// Europe/Rome leaves summer time (UTC+2) for winter time (UTC+1) on 2026-10-25
+		before, err := ParseEndAt("2026-10-24T18:00:00", "Europe/Rome")
+		require.NoError(t, err)
+		after, err := ParseEndAt("2026-10-25T18:00:00", "Europe/Rome")
+		require.NoError(t, err)
+
+		assert.Equal(t, time.Date(2026, 10, 24, 16, 0, 0, 0, time.UTC), before)
+		assert.Equal(t, time.Date(2026, 10, 25, 17, 0, 0, 0, time.UTC), after)
+		assert.Equal(t, 25*time.Hour, after.Sub(before))
+	})
+
+	t.Run("explicit offset wins over timezone", func(t *testing.T) {
+		got, err := ParseEndAt("2026-10-25T18:00:00Z", "Europe/Rome")
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2026, 10, 25, 18, 0, 0, 0, time.UTC), got)
+	})
+
+	t.Run("invalid timezone", func(t *testing.T) {
+		_, err := ParseEndAt("2026-10-25T18:00:00", "Mars/Olympus_Mons")
+		assert.ErrorIs(t, err, ErrInvalidTimezone)
+	})
+
+	t.Run("local time without timezone is rejected", func(t *testing.T) {
+		_, err := ParseEndAt("2026-10-25T18:00:00", "")
+		assert.Error(t, err)
+	})
+}
+
+func TestItem_LocalEndAt(t *testing.T) {
+	endAt := time.Date(2026, 10, 25, 17, 0, 0, 0, time.UTC)
+
+	local := (&Item{EndAt: endAt, EndAtTimezone: "Europe/Rome"}).LocalEndAt()
+	assert.Equal(t, "2026-10-25T18:00:00+01:00", local.Format(time.RFC3339))
+	assert.True(t, local.Equal(endAt))
+
+	assert.Equal(t, "2026-10-25T17:00:00Z", (&Item{EndAt: endAt}).LocalEndAt().Format(time.RFC3339))
+}
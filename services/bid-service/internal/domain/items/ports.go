@@ -2,15 +2,18 @@ package items
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+
+	"github.com/floroz/gavel/pkg/events"
 )
 
 // Repository defines the interface for item persistence
 type Repository interface {
-	// CreateItem creates a new auction item
-	CreateItem(ctx context.Context, item *Item) error
+	// CreateItem creates a new auction item within a transaction
+	CreateItem(ctx context.Context, tx pgx.Tx, item *Item) error
 
 	// GetItemByID retrieves an item by its ID
 	GetItemByID(ctx context.Context, itemID uuid.UUID) (*Item, error)
@@ -21,20 +24,101 @@ type Repository interface {
 	GetItemByIDForUpdate(ctx context.Context, tx pgx.Tx, itemID uuid.UUID) (*Item, error)
 
 	// UpdateItem updates an item's editable fields (title, description, images, category)
-	UpdateItem(ctx context.Context, item *Item) error
+	// within a transaction
+	UpdateItem(ctx context.Context, tx pgx.Tx, item *Item) error
 
-	// UpdateStatus updates an item's status
-	UpdateStatus(ctx context.Context, itemID uuid.UUID, status ItemStatus) error
+	// UpdateStatus updates an item's status within a transaction
+	UpdateStatus(ctx context.Context, tx pgx.Tx, itemID uuid.UUID, status ItemStatus) error
 
 	// UpdateHighestBid updates the current highest bid for an item within a transaction
+	// Only applies if amount is strictly greater than the stored bid, otherwise returns ErrHighestBidChanged
 	UpdateHighestBid(ctx context.Context, tx pgx.Tx, itemID uuid.UUID, amount int64) error
 
-	// ListActiveItems retrieves active items with pagination
-	ListActiveItems(ctx context.Context, limit, offset int) ([]*Item, error)
+	// UpdateEndAt sets the item's end time within a transaction, without counting it as an
+	// anti-sniping extension
+	UpdateEndAt(ctx context.Context, tx pgx.Tx, itemID uuid.UUID, endAt time.Time) error
+
+	// ExtendEndAt moves the item's end time to endAt within a transaction and records the
+	// extension of by against the item's extension count and total
+	ExtendEndAt(ctx context.Context, tx pgx.Tx, itemID uuid.UUID, endAt time.Time, by time.Duration) error
+
+	// ActivateItem moves a scheduled item to active within a transaction
+	// It is a no-op for items that are not scheduled
+	ActivateItem(ctx context.Context, tx pgx.Tx, itemID uuid.UUID) error
+
+	// MarkEnded moves an active item to ended within a transaction
+	// Returns ErrItemNotActive if the item is not active
+	MarkEnded(ctx context.Context, tx pgx.Tx, itemID uuid.UUID) error
+
+	// MarkExpiredItemsEnded ends a batch of at most limit active items whose end time is
+	// at or before before, within a transaction, and returns the ended items.
+	// Items locked by another transaction are skipped rather than waited on
+	MarkExpiredItemsEnded(ctx context.Context, tx pgx.Tx, before time.Time, limit int) ([]*Item, error)
+
+	// PauseItem moves an active item to paused and records when the pause began
+	// Returns ErrCannotPause if the item is not active
+	PauseItem(ctx context.Context, itemID uuid.UUID) error
+
+	// ResumeItem moves a paused item back to active and returns its end time, pushed
+	// back by the length of the pause if extendEndAt is set
+	// Returns ErrNotPaused if the item is not paused
+	ResumeItem(ctx context.Context, itemID uuid.UUID, extendEndAt bool) (time.Time, error)
+
+	// ActivateScheduledItems moves scheduled items whose start time has passed to active
+	// and returns their IDs
+	ActivateScheduledItems(ctx context.Context) ([]uuid.UUID, error)
+
+	// CountActiveItemsBySeller counts the seller's live listings: scheduled, active or paused.
+	// It first locks the seller for the rest of tx, so concurrent creates for the same
+	// seller count one at a time
+	CountActiveItemsBySeller(ctx context.Context, tx pgx.Tx, sellerID uuid.UUID) (int, error)
+
+	// ListActiveItems retrieves a page of active items, newest first with ties broken by id,
+	// starting right after the cursor
+	ListActiveItems(ctx context.Context, limit int, after *ItemCursor) ([]*Item, error)
+
+	// ListItemsEndingSoon retrieves active items whose end time falls within the window, soonest first
+	ListItemsEndingSoon(ctx context.Context, within time.Duration, limit, offset int) ([]*Item, error)
+
+	// ListItemsByStatus retrieves items in the given status, newest first
+	ListItemsByStatus(ctx context.Context, status ItemStatus, limit, offset int) ([]*Item, error)
 
 	// ListItemsBySellerID retrieves all items for a specific seller
 	ListItemsBySellerID(ctx context.Context, sellerID uuid.UUID, limit, offset int) ([]*Item, error)
 
-	// CountBidsByItemID returns the number of bids for a specific item
+	// ListItemsBySellerForUpdate retrieves the seller's scheduled and active items, the ones
+	// CancelItem may cancel, and locks them in id order. Must be called within a transaction
+	ListItemsBySellerForUpdate(ctx context.Context, tx pgx.Tx, sellerID uuid.UUID) ([]*Item, error)
+
+	// CountBidsByItemID counts an item's bids from the bids table.
+	// Hot paths should prefer Item.BidCount and use this to confirm a zero count.
 	CountBidsByItemID(ctx context.Context, itemID uuid.UUID) (int64, error)
+
+	// ReconcileItem recomputes an item's bid count and highest bid from its bids and
+	// overwrites the stored values if they differ
+	// Returns ErrItemNotFound if the item does not exist
+	ReconcileItem(ctx context.Context, itemID uuid.UUID) (*Reconciliation, error)
+
+	// ListItemIDs returns item IDs greater than after in ascending order, for sweeps
+	// over every item. Pass uuid.Nil to start from the beginning.
+	ListItemIDs(ctx context.Context, after uuid.UUID, limit int) ([]uuid.UUID, error)
+
+	// GetSellerDashboard computes aggregate listing and bid figures for a seller
+	// A seller with no items gets a zero-valued dashboard
+	GetSellerDashboard(ctx context.Context, sellerID uuid.UUID) (*SellerDashboard, error)
+
+	// IncrementViews atomically increments the view counter for an item
+	// Returns ErrItemNotFound if the item does not exist
+	IncrementViews(ctx context.Context, itemID uuid.UUID) error
+
+	// ListCategories returns the managed category set in display order
+	ListCategories(ctx context.Context) ([]*Category, error)
+
+	// CategoryExists reports whether slug is part of the managed category set
+	CategoryExists(ctx context.Context, slug string) (bool, error)
+}
+
+// OutboxRepository persists the events item writes emit, in the same transaction as the write
+type OutboxRepository interface {
+	SaveEvent(ctx context.Context, tx pgx.Tx, event *events.OutboxEvent) error
 }
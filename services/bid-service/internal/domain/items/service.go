@@ -2,42 +2,83 @@ package items
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/floroz/gavel/pkg/clock"
+	"github.com/floroz/gavel/pkg/database"
+	"github.com/floroz/gavel/pkg/events"
+	"github.com/floroz/gavel/pkg/sanitize"
+	"github.com/floroz/gavel/services/bid-service/internal/domain"
 )
 
 // Service errors
 var (
-	ErrInvalidStartPrice = fmt.Errorf("start price must be greater than 0")
-	ErrInvalidEndTime    = fmt.Errorf("end time must be in the future")
-	ErrItemNotFound      = fmt.Errorf("item not found")
-	ErrUnauthorized      = fmt.Errorf("unauthorized: only the owner can perform this action")
-	ErrCannotCancel      = fmt.Errorf("cannot cancel item: item has bids or is not active")
-	ErrItemNotActive     = fmt.Errorf("item is not active")
-	ErrSellerCannotBid   = fmt.Errorf("seller cannot bid on their own item")
+	ErrInvalidStartPrice = domain.NewError(domain.CodeInvalidInput, "start price must be greater than 0")
+	ErrInvalidEndTime    = domain.NewError(domain.CodeInvalidInput, "end time must be in the future")
+	ErrInvalidStartTime  = domain.NewError(domain.CodeInvalidInput, "start time must be before end time")
+	ErrInvalidBuyNow     = domain.NewError(domain.CodeInvalidInput, "buy-now price must be greater than the start price")
+	ErrNegativeBidStep   = domain.NewError(domain.CodeInvalidInput, "bid step cannot be negative")
+	ErrItemNotFound      = domain.NewError(domain.CodeNotFound, "item not found")
+	ErrUnauthorized      = domain.NewError(domain.CodePermissionDenied, "unauthorized: only the owner can perform this action")
+	ErrCannotCancel      = domain.NewError(domain.CodePrecondition, "cannot cancel item: item has bids or is not active")
+	ErrItemNotActive     = domain.NewError(domain.CodePrecondition, "item is not active")
+	ErrSellerCannotBid   = domain.NewError(domain.CodePermissionDenied, "seller cannot bid on their own item")
+	ErrHighestBidChanged = domain.NewError(domain.CodeConflict, "highest bid was not updated: stored bid is equal or higher")
+	ErrInvalidTimezone   = domain.NewError(domain.CodeInvalidInput, "invalid timezone")
+	ErrInvalidStatus     = domain.NewError(domain.CodeInvalidInput, "invalid item status")
+	ErrInvalidWindow     = domain.NewError(domain.CodeInvalidInput, "ending-soon window must be positive")
+	ErrInvalidCategory   = domain.NewError(domain.CodeInvalidInput, "unknown category")
+	ErrImmutableField    = domain.NewError(domain.CodeInvalidInput, "field cannot be changed once the item is listed")
+	ErrCannotPause       = domain.NewError(domain.CodePrecondition, "only active auctions can be paused")
+	ErrNotPaused         = domain.NewError(domain.CodePrecondition, "item is not paused")
+
+	// ErrListingLimitReached is returned when a seller already has the most live listings allowed
+	ErrListingLimitReached = domain.NewError(domain.CodeResourceExhausted, "seller has reached the maximum number of live listings")
 )
 
+// EditableFields are the only item fields UpdateItem changes, named as in the API. The
+// start price, start and end times, seller and status are fixed once an item is listed,
+// since bidders commit against them; UpdateItemCommand has no room for them.
+var EditableFields = map[string]bool{
+	"title":           true,
+	"description":     true,
+	"images":          true,
+	"category":        true,
+	"end_at_timezone": true,
+}
+
 // CreateItemCommand represents the command to create a new item
 type CreateItemCommand struct {
-	Title       string
-	Description string
-	StartPrice  int64
-	EndAt       time.Time
-	Images      []string
-	Category    string
-	SellerID    uuid.UUID
+	Title         string
+	Description   string
+	StartPrice    int64
+	BuyNowPrice   int64     // optional, 0 disables buy-now
+	BidStep       int64     // optional, 0 allows any bid amount above the minimum
+	StartAt       time.Time // optional, zero or past starts the auction immediately
+	EndAt         time.Time
+	EndAtTimezone string // optional IANA zone the seller entered EndAt in
+	Images        []string
+	Category      string
+	SellerID      uuid.UUID
+	ValidateOnly  bool // run every check and return the item without saving it
 }
 
-// UpdateItemCommand represents the command to update an item
+// UpdateItemCommand represents the command to update an item; it carries EditableFields only
 type UpdateItemCommand struct {
-	ItemID      uuid.UUID
-	UserID      uuid.UUID
-	Title       string
-	Description string
-	Images      []string
-	Category    string
+	ItemID        uuid.UUID
+	UserID        uuid.UUID
+	Title         string
+	Description   string
+	Images        []string
+	Category      string
+	EndAtTimezone *string // nil leaves the zone unchanged; only affects how EndAt is displayed
 }
 
 // CancelItemCommand represents the command to cancel an item
@@ -46,10 +87,40 @@ type CancelItemCommand struct {
 	UserID uuid.UUID
 }
 
+// BulkCancelItemsResult splits the seller's scheduled and active items by whether
+// BulkCancelItems cancelled them
+type BulkCancelItemsResult struct {
+	Cancelled []*Item
+	Skipped   []*Item // already bid on
+}
+
+// PauseItemCommand suspends bidding on an item. AsAdmin lets moderators pause any item,
+// otherwise UserID must be the seller.
+type PauseItemCommand struct {
+	ItemID  uuid.UUID
+	UserID  uuid.UUID
+	AsAdmin bool
+}
+
+// ResumeItemCommand reopens a paused item for bids
+type ResumeItemCommand struct {
+	ItemID      uuid.UUID
+	UserID      uuid.UUID
+	AsAdmin     bool
+	ExtendEndAt bool // push end_at back by however long the item was paused
+}
+
+// RecordItemViewCommand represents a single view of an item
+// ViewerID is uuid.Nil for anonymous viewers
+type RecordItemViewCommand struct {
+	ItemID   uuid.UUID
+	ViewerID uuid.UUID
+}
+
 // ListItemsQuery represents pagination parameters for listing items
 type ListItemsQuery struct {
-	Limit  int
-	Offset int
+	Limit int
+	After *ItemCursor // nil starts from the first page
 }
 
 // ListSellerItemsQuery represents pagination parameters for listing seller's items
@@ -59,14 +130,58 @@ type ListSellerItemsQuery struct {
 	Offset   int
 }
 
+// ListItemsEndingSoonQuery represents pagination parameters for the closing-soon feed
+type ListItemsEndingSoonQuery struct {
+	Within time.Duration
+	Limit  int
+	Offset int
+}
+
+// ListItemsByStatusQuery represents pagination parameters for listing items in one status
+type ListItemsByStatusQuery struct {
+	Status ItemStatus
+	Limit  int
+	Offset int
+}
+
 // Service implements the core business logic for items
 type Service struct {
-	repo Repository
+	txManager   database.TransactionManager
+	repo        Repository
+	outboxRepo  OutboxRepository
+	maxImages   int
+	maxListings int
+	clock       clock.Clock
 }
 
-// NewService creates a new item service
-func NewService(repo Repository) *Service {
-	return &Service{repo: repo}
+// DefaultMaxActiveListings is how many live listings a seller may have when no limit is configured
+const DefaultMaxActiveListings = 100
+
+// NewService creates a new item service. Creates, updates and cancellations are written in a
+// transaction from txManager together with their outbox event.
+// A maxImages of zero or less uses DefaultMaxImages, a maxListings of zero or less uses
+// DefaultMaxActiveListings, and a nil clk uses the wall clock.
+func NewService(
+	txManager database.TransactionManager,
+	repo Repository,
+	outboxRepo OutboxRepository,
+	maxImages, maxListings int,
+	clk clock.Clock,
+) *Service {
+	if maxImages <= 0 {
+		maxImages = DefaultMaxImages
+	}
+	if maxListings <= 0 {
+		maxListings = DefaultMaxActiveListings
+	}
+	return &Service{
+		txManager:   txManager,
+		repo:        repo,
+		outboxRepo:  outboxRepo,
+		maxImages:   maxImages,
+		maxListings: maxListings,
+		clock:       clock.OrReal(clk),
+	}
 }
 
 // CreateItem creates a new auction item
@@ -76,30 +191,97 @@ func (s *Service) CreateItem(ctx context.Context, cmd CreateItemCommand) (*Item,
 		return nil, ErrInvalidStartPrice
 	}
 
+	// Validate buy-now price; buying at or below the opening price would skip the auction entirely
+	if cmd.BuyNowPrice != 0 && cmd.BuyNowPrice <= cmd.StartPrice {
+		return nil, ErrInvalidBuyNow
+	}
+
+	if cmd.BidStep < 0 {
+		return nil, ErrNegativeBidStep
+	}
+
+	now := s.clock.Now()
+
 	// Validate end time
-	if !cmd.EndAt.After(time.Now()) {
+	if !cmd.EndAt.After(now) {
 		return nil, ErrInvalidEndTime
 	}
 
+	// Validate timezone
+	if _, err := LoadTimezone(cmd.EndAtTimezone); err != nil {
+		return nil, err
+	}
+
+	images, err := normalizeImages(cmd.Images, s.maxImages)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.validateCategory(ctx, cmd.Category); err != nil {
+		return nil, err
+	}
+
+	// A future start schedules the auction; anything else opens it now
+	startAt, status := now, ItemStatusActive
+	if cmd.StartAt.After(now) {
+		startAt, status = cmd.StartAt, ItemStatusScheduled
+	}
+	if !startAt.Before(cmd.EndAt) {
+		return nil, ErrInvalidStartTime
+	}
+
 	// Create item
 	item := &Item{
 		ID:                uuid.New(),
-		Title:             cmd.Title,
-		Description:       cmd.Description,
+		Title:             sanitize.Line(cmd.Title),
+		Description:       sanitize.Text(cmd.Description),
 		StartPrice:        cmd.StartPrice,
 		CurrentHighestBid: 0,
-		EndAt:             cmd.EndAt,
-		CreatedAt:         time.Now(),
-		UpdatedAt:         time.Now(),
-		Images:            cmd.Images,
+		BuyNowPrice:       cmd.BuyNowPrice,
+		BidStep:           cmd.BidStep,
+		StartAt:           startAt.UTC(),
+		EndAt:             cmd.EndAt.UTC(),
+		EndAtTimezone:     cmd.EndAtTimezone,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+		Images:            images,
 		Category:          cmd.Category,
 		SellerID:          cmd.SellerID,
-		Status:            ItemStatusActive,
+		Status:            status,
+	}
+
+	tx, err := s.txManager.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx) // Rollback if commit is not called
+	}()
+
+	// Counted in the transaction that inserts the item, under a lock on the seller, so
+	// concurrent creates cannot all pass the check
+	live, err := s.repo.CountActiveItemsBySeller(ctx, tx, cmd.SellerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count seller listings: %w", err)
+	}
+	if live >= s.maxListings {
+		return nil, ErrListingLimitReached
 	}
 
-	if err := s.repo.CreateItem(ctx, item); err != nil {
+	if cmd.ValidateOnly {
+		return item, nil
+	}
+
+	if err := s.repo.CreateItem(ctx, tx, item); err != nil {
 		return nil, fmt.Errorf("failed to create item: %w", err)
 	}
+	if err := s.saveEvent(ctx, tx, EventTypeItemCreated, itemCreatedEvent(item, now)); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
 
 	return item, nil
 }
@@ -115,7 +297,7 @@ func (s *Service) GetItem(ctx context.Context, itemID uuid.UUID) (*Item, error)
 
 // ListItems retrieves active items with pagination
 func (s *Service) ListItems(ctx context.Context, query ListItemsQuery) ([]*Item, error) {
-	items, err := s.repo.ListActiveItems(ctx, query.Limit, query.Offset)
+	items, err := s.repo.ListActiveItems(ctx, query.Limit, query.After)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list items: %w", err)
 	}
@@ -131,10 +313,131 @@ func (s *Service) ListSellerItems(ctx context.Context, query ListSellerItemsQuer
 	return items, nil
 }
 
-// UpdateItem updates an item's editable fields
+// ListItemsEndingSoon retrieves active items that end within the query window
+func (s *Service) ListItemsEndingSoon(ctx context.Context, query ListItemsEndingSoonQuery) ([]*Item, error) {
+	if query.Within <= 0 {
+		return nil, ErrInvalidWindow
+	}
+	items, err := s.repo.ListItemsEndingSoon(ctx, query.Within, query.Limit, query.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list items ending soon: %w", err)
+	}
+	return items, nil
+}
+
+// ListItemsByStatus retrieves items in any status, for moderation
+func (s *Service) ListItemsByStatus(ctx context.Context, query ListItemsByStatusQuery) ([]*Item, error) {
+	if !query.Status.IsValid() {
+		return nil, ErrInvalidStatus
+	}
+	items, err := s.repo.ListItemsByStatus(ctx, query.Status, query.Limit, query.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list items by status: %w", err)
+	}
+	return items, nil
+}
+
+// ActivateScheduledItems opens every scheduled auction whose start time has passed
+// and returns how many were activated
+func (s *Service) ActivateScheduledItems(ctx context.Context) (int64, error) {
+	ids, err := s.repo.ActivateScheduledItems(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to activate scheduled items: %w", err)
+	}
+	return int64(len(ids)), nil
+}
+
+// ReconcileItem corrects an item's denormalized bid count and highest bid from its bids
+func (s *Service) ReconcileItem(ctx context.Context, itemID uuid.UUID) (*Reconciliation, error) {
+	rec, err := s.repo.ReconcileItem(ctx, itemID)
+	if err != nil {
+		if errors.Is(err, ErrItemNotFound) {
+			return nil, ErrItemNotFound
+		}
+		return nil, fmt.Errorf("failed to reconcile item: %w", err)
+	}
+	return rec, nil
+}
+
+// ReconcileAllItems reconciles every item, batchSize items at a time, and returns
+// the ones whose totals had drifted. Items deleted mid-sweep are skipped.
+func (s *Service) ReconcileAllItems(ctx context.Context, batchSize int) ([]*Reconciliation, error) {
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+
+	var drifted []*Reconciliation
+	after := uuid.Nil
+	for {
+		ids, err := s.repo.ListItemIDs(ctx, after, batchSize)
+		if err != nil {
+			return drifted, fmt.Errorf("failed to list items: %w", err)
+		}
+		for _, id := range ids {
+			rec, err := s.ReconcileItem(ctx, id)
+			if errors.Is(err, ErrItemNotFound) {
+				continue
+			}
+			if err != nil {
+				return drifted, err
+			}
+			if rec.Drifted() {
+				drifted = append(drifted, rec)
+			}
+		}
+		if len(ids) < batchSize {
+			return drifted, nil
+		}
+		after = ids[len(ids)-1]
+	}
+}
+
+// GetSellerDashboard returns aggregate figures across a seller's items
+func (s *Service) GetSellerDashboard(ctx context.Context, sellerID uuid.UUID) (*SellerDashboard, error) {
+	dashboard, err := s.repo.GetSellerDashboard(ctx, sellerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get seller dashboard: %w", err)
+	}
+	return dashboard, nil
+}
+
+// ListCategories returns the categories items can be filed under
+func (s *Service) ListCategories(ctx context.Context) ([]*Category, error) {
+	categories, err := s.repo.ListCategories(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+	return categories, nil
+}
+
+// validateCategory accepts an empty category, since it is optional, or a managed one
+func (s *Service) validateCategory(ctx context.Context, category string) error {
+	if category == "" {
+		return nil
+	}
+	exists, err := s.repo.CategoryExists(ctx, category)
+	if err != nil {
+		return fmt.Errorf("failed to check category: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("%w: %q", ErrInvalidCategory, category)
+	}
+	return nil
+}
+
+// UpdateItem updates an item's editable fields. The item is locked while it is checked
+// and written, so the update and its event commit together against the state they read.
 func (s *Service) UpdateItem(ctx context.Context, cmd UpdateItemCommand) (*Item, error) {
-	// Get the item
-	item, err := s.repo.GetItemByID(ctx, cmd.ItemID)
+	tx, err := s.txManager.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx) // Rollback if commit is not called
+	}()
+
+	// Get and lock the item
+	item, err := s.repo.GetItemByIDForUpdate(ctx, tx, cmd.ItemID)
 	if err != nil {
 		return nil, ErrItemNotFound
 	}
@@ -144,24 +447,62 @@ func (s *Service) UpdateItem(ctx context.Context, cmd UpdateItemCommand) (*Item,
 		return nil, ErrUnauthorized
 	}
 
+	if cmd.EndAtTimezone != nil {
+		if _, err := LoadTimezone(*cmd.EndAtTimezone); err != nil {
+			return nil, err
+		}
+		item.EndAtTimezone = *cmd.EndAtTimezone
+	}
+
+	// Unchanged images are kept as stored, even if they predate a lower limit
+	images := item.Images
+	if !slices.Equal(cmd.Images, item.Images) {
+		if images, err = normalizeImages(cmd.Images, s.maxImages); err != nil {
+			return nil, err
+		}
+	}
+
+	// Likewise a category from before the managed set only has to be valid once changed
+	if cmd.Category != item.Category {
+		if err := s.validateCategory(ctx, cmd.Category); err != nil {
+			return nil, err
+		}
+	}
+
 	// Update editable fields
-	item.Title = cmd.Title
-	item.Description = cmd.Description
-	item.Images = cmd.Images
+	item.Title = sanitize.Line(cmd.Title)
+	item.Description = sanitize.Text(cmd.Description)
+	item.Images = images
 	item.Category = cmd.Category
-	item.UpdatedAt = time.Now()
 
-	if err := s.repo.UpdateItem(ctx, item); err != nil {
+	if err := s.repo.UpdateItem(ctx, tx, item); err != nil {
 		return nil, fmt.Errorf("failed to update item: %w", err)
 	}
+	if err := s.saveEvent(ctx, tx, EventTypeItemUpdated, itemUpdatedEvent(item, s.clock.Now())); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
 
 	return item, nil
 }
 
-// CancelItem cancels an auction item
+// CancelItem cancels an auction item. The item stays locked from the bid check to the
+// commit, so a bid placed concurrently either lands first and blocks the cancellation
+// or waits and finds the item cancelled.
 func (s *Service) CancelItem(ctx context.Context, cmd CancelItemCommand) (*Item, error) {
-	// Get the item
-	item, err := s.repo.GetItemByID(ctx, cmd.ItemID)
+	tx, err := s.txManager.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx) // Rollback if commit is not called
+	}()
+
+	// Get and lock the item
+	item, err := s.repo.GetItemByIDForUpdate(ctx, tx, cmd.ItemID)
 	if err != nil {
 		return nil, ErrItemNotFound
 	}
@@ -171,29 +512,161 @@ func (s *Service) CancelItem(ctx context.Context, cmd CancelItemCommand) (*Item,
 		return nil, ErrUnauthorized
 	}
 
-	// Check if item has bids
-	bidCount, err := s.repo.CountBidsByItemID(ctx, cmd.ItemID)
+	hasBids, err := s.hasBids(ctx, item)
 	if err != nil {
-		return nil, fmt.Errorf("failed to check bids: %w", err)
+		return nil, err
 	}
 
-	hasBids := bidCount > 0
-
 	// Check if item can be cancelled
 	if !item.CanBeCancelled(hasBids) {
 		return nil, ErrCannotCancel
 	}
 
-	// Update status to cancelled
-	if err := s.repo.UpdateStatus(ctx, cmd.ItemID, ItemStatusCancelled); err != nil {
-		return nil, fmt.Errorf("failed to cancel item: %w", err)
+	if err := s.cancel(ctx, tx, item); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return item, nil
+}
+
+// BulkCancelItems cancels every scheduled or active item of the seller that has no bids,
+// in one transaction, by the same rules as CancelItem. Items with bids are skipped and
+// reported instead of failing the batch. All the items stay locked until the commit.
+func (s *Service) BulkCancelItems(ctx context.Context, sellerID uuid.UUID) (*BulkCancelItemsResult, error) {
+	tx, err := s.txManager.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx) // Rollback if commit is not called
+	}()
+
+	sellerItems, err := s.repo.ListItemsBySellerForUpdate(ctx, tx, sellerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list seller items: %w", err)
+	}
+
+	result := &BulkCancelItemsResult{}
+	for _, item := range sellerItems {
+		hasBids, err := s.hasBids(ctx, item)
+		if err != nil {
+			return nil, err
+		}
+		if !item.CanBeCancelled(hasBids) {
+			result.Skipped = append(result.Skipped, item)
+			continue
+		}
+		if err := s.cancel(ctx, tx, item); err != nil {
+			return nil, err
+		}
+		result.Cancelled = append(result.Cancelled, item)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return result, nil
+}
+
+// hasBids reports whether a locked item has bids. A non-zero counter settles it; zero
+// may be a counter that drifted from the bids table (see ReconcileItem), so confirm it there.
+func (s *Service) hasBids(ctx context.Context, item *Item) (bool, error) {
+	if item.BidCount > 0 {
+		return true, nil
 	}
+	bidCount, err := s.repo.CountBidsByItemID(ctx, item.ID)
+	if err != nil {
+		return false, fmt.Errorf("failed to check bids: %w", err)
+	}
+	return bidCount > 0, nil
+}
 
-	// Update item status and return
+// cancel marks a locked, cancellable item cancelled and records the event within tx
+func (s *Service) cancel(ctx context.Context, tx pgx.Tx, item *Item) error {
+	if err := s.repo.UpdateStatus(ctx, tx, item.ID, ItemStatusCancelled); err != nil {
+		return fmt.Errorf("failed to cancel item: %w", err)
+	}
+	if err := s.saveEvent(ctx, tx, EventTypeItemCancelled, itemCancelledEvent(item, s.clock.Now())); err != nil {
+		return err
+	}
 	item.Status = ItemStatusCancelled
+	return nil
+}
+
+// PauseItem suspends bidding without cancelling: bids and the highest bid are kept, and
+// the item drops out of the active listings until it is resumed. A paused item is not
+// ended by the worker, even past its end time.
+func (s *Service) PauseItem(ctx context.Context, cmd PauseItemCommand) (*Item, error) {
+	item, err := s.repo.GetItemByID(ctx, cmd.ItemID)
+	if err != nil {
+		return nil, ErrItemNotFound
+	}
+	if !cmd.AsAdmin && !item.IsOwnedBy(cmd.UserID) {
+		return nil, ErrUnauthorized
+	}
+
+	if err := s.repo.PauseItem(ctx, cmd.ItemID); err != nil {
+		if errors.Is(err, ErrCannotPause) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to pause item: %w", err)
+	}
+
+	item.Status = ItemStatusPaused
+	return item, nil
+}
+
+// ResumeItem reopens a paused item. If the original end time passed during the pause
+// and it is not extended, the item ends on the next worker run.
+func (s *Service) ResumeItem(ctx context.Context, cmd ResumeItemCommand) (*Item, error) {
+	item, err := s.repo.GetItemByID(ctx, cmd.ItemID)
+	if err != nil {
+		return nil, ErrItemNotFound
+	}
+	if !cmd.AsAdmin && !item.IsOwnedBy(cmd.UserID) {
+		return nil, ErrUnauthorized
+	}
+
+	endAt, err := s.repo.ResumeItem(ctx, cmd.ItemID, cmd.ExtendEndAt)
+	if err != nil {
+		if errors.Is(err, ErrNotPaused) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to resume item: %w", err)
+	}
+
+	item.Status = ItemStatusActive
+	item.EndAt = endAt.UTC()
 	return item, nil
 }
 
+// RecordItemView increments the item's view counter
+// Views by the seller of the item are not counted
+func (s *Service) RecordItemView(ctx context.Context, cmd RecordItemViewCommand) error {
+	if cmd.ViewerID != uuid.Nil {
+		item, err := s.repo.GetItemByID(ctx, cmd.ItemID)
+		if err != nil {
+			return ErrItemNotFound
+		}
+		if item.IsOwnedBy(cmd.ViewerID) {
+			return nil
+		}
+	}
+
+	if err := s.repo.IncrementViews(ctx, cmd.ItemID); err != nil {
+		if errors.Is(err, ErrItemNotFound) {
+			return ErrItemNotFound
+		}
+		return fmt.Errorf("failed to record item view: %w", err)
+	}
+	return nil
+}
+
 // ValidateSellerCannotBid checks if a user is trying to bid on their own item
 func (s *Service) ValidateSellerCannotBid(ctx context.Context, itemID, userID uuid.UUID) error {
 	item, err := s.repo.GetItemByID(ctx, itemID)
@@ -207,3 +680,23 @@ func (s *Service) ValidateSellerCannotBid(ctx context.Context, itemID, userID uu
 
 	return nil
 }
+
+// saveEvent marshals msg and writes it to the outbox within tx
+func (s *Service) saveEvent(ctx context.Context, tx pgx.Tx, eventType EventType, msg proto.Message) error {
+	payload, err := proto.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	outboxEvent := &events.OutboxEvent{
+		ID:        uuid.New(),
+		EventType: eventType.String(),
+		Payload:   payload,
+		Status:    events.OutboxStatusPending,
+		CreatedAt: s.clock.Now(),
+	}
+	if err := s.outboxRepo.SaveEvent(ctx, tx, outboxEvent); err != nil {
+		return fmt.Errorf("failed to save outbox event: %w", err)
+	}
+	return nil
+}
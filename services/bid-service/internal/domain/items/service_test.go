@@ -10,6 +10,12 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/floroz/gavel/pkg/clock/clocktest"
+	"github.com/floroz/gavel/pkg/events"
+	pb "github.com/floroz/gavel/pkg/proto"
 )
 
 // MockRepository is a mock implementation of Repository for testing
@@ -17,8 +23,8 @@ type MockRepository struct {
 	mock.Mock
 }
 
-func (m *MockRepository) CreateItem(ctx context.Context, item *Item) error {
-	args := m.Called(ctx, item)
+func (m *MockRepository) CreateItem(ctx context.Context, tx pgx.Tx, item *Item) error {
+	args := m.Called(ctx, tx, item)
 	return args.Error(0)
 }
 
@@ -38,13 +44,21 @@ func (m *MockRepository) GetItemByIDForUpdate(ctx context.Context, tx pgx.Tx, it
 	return args.Get(0).(*Item), args.Error(1)
 }
 
-func (m *MockRepository) UpdateItem(ctx context.Context, item *Item) error {
-	args := m.Called(ctx, item)
+func (m *MockRepository) ListItemsBySellerForUpdate(ctx context.Context, tx pgx.Tx, sellerID uuid.UUID) ([]*Item, error) {
+	args := m.Called(ctx, tx, sellerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Item), args.Error(1)
+}
+
+func (m *MockRepository) UpdateItem(ctx context.Context, tx pgx.Tx, item *Item) error {
+	args := m.Called(ctx, tx, item)
 	return args.Error(0)
 }
 
-func (m *MockRepository) UpdateStatus(ctx context.Context, itemID uuid.UUID, status ItemStatus) error {
-	args := m.Called(ctx, itemID, status)
+func (m *MockRepository) UpdateStatus(ctx context.Context, tx pgx.Tx, itemID uuid.UUID, status ItemStatus) error {
+	args := m.Called(ctx, tx, itemID, status)
 	return args.Error(0)
 }
 
@@ -53,8 +67,75 @@ func (m *MockRepository) UpdateHighestBid(ctx context.Context, tx pgx.Tx, itemID
 	return args.Error(0)
 }
 
-func (m *MockRepository) ListActiveItems(ctx context.Context, limit, offset int) ([]*Item, error) {
-	args := m.Called(ctx, limit, offset)
+func (m *MockRepository) ActivateItem(ctx context.Context, tx pgx.Tx, itemID uuid.UUID) error {
+	args := m.Called(ctx, tx, itemID)
+	return args.Error(0)
+}
+
+func (m *MockRepository) MarkEnded(ctx context.Context, tx pgx.Tx, itemID uuid.UUID) error {
+	args := m.Called(ctx, tx, itemID)
+	return args.Error(0)
+}
+
+func (m *MockRepository) MarkExpiredItemsEnded(ctx context.Context, tx pgx.Tx, before time.Time, limit int) ([]*Item, error) {
+	args := m.Called(ctx, tx, before, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Item), args.Error(1)
+}
+
+func (m *MockRepository) UpdateEndAt(ctx context.Context, tx pgx.Tx, itemID uuid.UUID, endAt time.Time) error {
+	args := m.Called(ctx, tx, itemID, endAt)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ExtendEndAt(ctx context.Context, tx pgx.Tx, itemID uuid.UUID, endAt time.Time, by time.Duration) error {
+	args := m.Called(ctx, tx, itemID, endAt, by)
+	return args.Error(0)
+}
+
+func (m *MockRepository) PauseItem(ctx context.Context, itemID uuid.UUID) error {
+	args := m.Called(ctx, itemID)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ResumeItem(ctx context.Context, itemID uuid.UUID, extendEndAt bool) (time.Time, error) {
+	args := m.Called(ctx, itemID, extendEndAt)
+	return args.Get(0).(time.Time), args.Error(1)
+}
+
+func (m *MockRepository) ActivateScheduledItems(ctx context.Context) ([]uuid.UUID, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
+func (m *MockRepository) CountActiveItemsBySeller(ctx context.Context, tx pgx.Tx, sellerID uuid.UUID) (int, error) {
+	args := m.Called(ctx, tx, sellerID)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockRepository) ListActiveItems(ctx context.Context, limit int, after *ItemCursor) ([]*Item, error) {
+	args := m.Called(ctx, limit, after)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Item), args.Error(1)
+}
+
+func (m *MockRepository) ListItemsEndingSoon(ctx context.Context, within time.Duration, limit, offset int) ([]*Item, error) {
+	args := m.Called(ctx, within, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Item), args.Error(1)
+}
+
+func (m *MockRepository) ListItemsByStatus(ctx context.Context, status ItemStatus, limit, offset int) ([]*Item, error) {
+	args := m.Called(ctx, status, limit, offset)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -74,6 +155,76 @@ func (m *MockRepository) CountBidsByItemID(ctx context.Context, itemID uuid.UUID
 	return args.Get(0).(int64), args.Error(1)
 }
 
+func (m *MockRepository) ReconcileItem(ctx context.Context, itemID uuid.UUID) (*Reconciliation, error) {
+	args := m.Called(ctx, itemID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Reconciliation), args.Error(1)
+}
+
+func (m *MockRepository) ListItemIDs(ctx context.Context, after uuid.UUID, limit int) ([]uuid.UUID, error) {
+	args := m.Called(ctx, after, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
+func (m *MockRepository) GetSellerDashboard(ctx context.Context, sellerID uuid.UUID) (*SellerDashboard, error) {
+	args := m.Called(ctx, sellerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*SellerDashboard), args.Error(1)
+}
+
+func (m *MockRepository) IncrementViews(ctx context.Context, itemID uuid.UUID) error {
+	args := m.Called(ctx, itemID)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ListCategories(ctx context.Context) ([]*Category, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Category), args.Error(1)
+}
+
+func (m *MockRepository) CategoryExists(ctx context.Context, slug string) (bool, error) {
+	args := m.Called(ctx, slug)
+	return args.Bool(0), args.Error(1)
+}
+
+// fakeTx stands in for a pgx transaction; only Commit and Rollback are called directly
+type fakeTx struct {
+	pgx.Tx
+	committed bool
+}
+
+func (tx *fakeTx) Commit(context.Context) error   { tx.committed = true; return nil }
+func (tx *fakeTx) Rollback(context.Context) error { return nil }
+
+type fakeTxManager struct {
+	tx *fakeTx
+}
+
+func (m *fakeTxManager) BeginTx(context.Context) (pgx.Tx, error) {
+	m.tx = &fakeTx{}
+	return m.tx, nil
+}
+
+// fakeOutbox records the events saved to it
+type fakeOutbox struct {
+	saved []*events.OutboxEvent
+}
+
+func (o *fakeOutbox) SaveEvent(_ context.Context, _ pgx.Tx, event *events.OutboxEvent) error {
+	o.saved = append(o.saved, event)
+	return nil
+}
+
 func TestService_CreateItem(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -94,7 +245,9 @@ func TestService_CreateItem(t *testing.T) {
 				SellerID:    uuid.New(),
 			},
 			setupMock: func(repo *MockRepository) {
-				repo.On("CreateItem", mock.Anything, mock.AnythingOfType("*items.Item")).Return(nil)
+				repo.On("CategoryExists", mock.Anything, "electronics").Return(true, nil)
+				repo.On("CountActiveItemsBySeller", mock.Anything, mock.Anything, mock.Anything).Return(0, nil)
+				repo.On("CreateItem", mock.Anything, mock.Anything, mock.AnythingOfType("*items.Item")).Return(nil)
 			},
 			wantErr: nil,
 			checkResult: func(t *testing.T, item *Item) {
@@ -105,6 +258,70 @@ func TestService_CreateItem(t *testing.T) {
 				assert.Equal(t, int64(0), item.CurrentHighestBid)
 			},
 		},
+		{
+			name: "schedules item with a future start time",
+			cmd: CreateItemCommand{
+				Title:      "Scheduled Item",
+				StartPrice: 1000,
+				StartAt:    time.Now().Add(2 * time.Hour),
+				EndAt:      time.Now().Add(24 * time.Hour),
+				SellerID:   uuid.New(),
+			},
+			setupMock: func(repo *MockRepository) {
+				repo.On("CountActiveItemsBySeller", mock.Anything, mock.Anything, mock.Anything).Return(0, nil)
+				repo.On("CreateItem", mock.Anything, mock.Anything, mock.AnythingOfType("*items.Item")).Return(nil)
+			},
+			checkResult: func(t *testing.T, item *Item) {
+				assert.Equal(t, ItemStatusScheduled, item.Status)
+				assert.False(t, item.HasStarted(time.Now()))
+			},
+		},
+		{
+			name: "past start time opens the item immediately",
+			cmd: CreateItemCommand{
+				Title:      "Test Item",
+				StartPrice: 1000,
+				StartAt:    time.Now().Add(-2 * time.Hour),
+				EndAt:      time.Now().Add(24 * time.Hour),
+				SellerID:   uuid.New(),
+			},
+			setupMock: func(repo *MockRepository) {
+				repo.On("CountActiveItemsBySeller", mock.Anything, mock.Anything, mock.Anything).Return(0, nil)
+				repo.On("CreateItem", mock.Anything, mock.Anything, mock.AnythingOfType("*items.Item")).Return(nil)
+			},
+			checkResult: func(t *testing.T, item *Item) {
+				assert.Equal(t, ItemStatusActive, item.Status)
+				assert.True(t, item.HasStarted(time.Now()))
+			},
+		},
+		{
+			name: "fails with start time after end time",
+			cmd: CreateItemCommand{
+				Title:      "Test Item",
+				StartPrice: 1000,
+				StartAt:    time.Now().Add(48 * time.Hour),
+				EndAt:      time.Now().Add(24 * time.Hour),
+				SellerID:   uuid.New(),
+			},
+			setupMock: func(repo *MockRepository) {
+				// No repo calls expected
+			},
+			wantErr: ErrInvalidStartTime,
+		},
+		{
+			name: "fails with buy-now price not above start price",
+			cmd: CreateItemCommand{
+				Title:       "Test Item",
+				StartPrice:  1000,
+				BuyNowPrice: 1000,
+				EndAt:       time.Now().Add(24 * time.Hour),
+				SellerID:    uuid.New(),
+			},
+			setupMock: func(repo *MockRepository) {
+				// No repo calls expected
+			},
+			wantErr: ErrInvalidBuyNow,
+		},
 		{
 			name: "fails with invalid start price (zero)",
 			cmd: CreateItemCommand{
@@ -144,6 +361,34 @@ func TestService_CreateItem(t *testing.T) {
 			},
 			wantErr: ErrInvalidEndTime,
 		},
+		{
+			name: "fails with invalid timezone",
+			cmd: CreateItemCommand{
+				Title:         "Test Item",
+				StartPrice:    1000,
+				EndAt:         time.Now().Add(24 * time.Hour),
+				EndAtTimezone: "Not/A_Zone",
+				SellerID:      uuid.New(),
+			},
+			setupMock: func(repo *MockRepository) {
+				// No repo calls expected
+			},
+			wantErr: ErrInvalidTimezone,
+		},
+		{
+			name: "fails with unknown category",
+			cmd: CreateItemCommand{
+				Title:      "Test Item",
+				StartPrice: 1000,
+				EndAt:      time.Now().Add(24 * time.Hour),
+				Category:   "electronic",
+				SellerID:   uuid.New(),
+			},
+			setupMock: func(repo *MockRepository) {
+				repo.On("CategoryExists", mock.Anything, "electronic").Return(false, nil)
+			},
+			wantErr: ErrInvalidCategory,
+		},
 	}
 
 	for _, tt := range tests {
@@ -151,7 +396,7 @@ func TestService_CreateItem(t *testing.T) {
 			repo := new(MockRepository)
 			tt.setupMock(repo)
 
-			service := NewService(repo)
+			service := NewService(&fakeTxManager{}, repo, &fakeOutbox{}, 0, 0, nil)
 			item, err := service.CreateItem(context.Background(), tt.cmd)
 
 			if tt.wantErr != nil {
@@ -170,6 +415,283 @@ func TestService_CreateItem(t *testing.T) {
 	}
 }
 
+func TestService_CreateItem_UsesInjectedClock(t *testing.T) {
+	clk := clocktest.NewFake(time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC))
+	repo := new(MockRepository)
+	service := NewService(&fakeTxManager{}, repo, &fakeOutbox{}, 0, 0, clk)
+
+	// In the future by wall time but already past by the service's clock
+	_, err := service.CreateItem(context.Background(), CreateItemCommand{
+		Title:      "Late Item",
+		StartPrice: 1000,
+		EndAt:      clk.Now().Add(-time.Minute),
+		SellerID:   uuid.New(),
+	})
+	assert.ErrorIs(t, err, ErrInvalidEndTime)
+
+	repo.On("CountActiveItemsBySeller", mock.Anything, mock.Anything, mock.Anything).Return(0, nil)
+	repo.On("CreateItem", mock.Anything, mock.Anything, mock.AnythingOfType("*items.Item")).Return(nil)
+	item, err := service.CreateItem(context.Background(), CreateItemCommand{
+		Title:      "On Time Item",
+		StartPrice: 1000,
+		EndAt:      clk.Now().Add(time.Hour),
+		SellerID:   uuid.New(),
+	})
+	require.NoError(t, err)
+	assert.True(t, clk.Now().Equal(item.StartAt))
+	assert.True(t, clk.Now().Equal(item.CreatedAt))
+}
+
+func TestService_SanitizesText(t *testing.T) {
+	const (
+		rawTitle       = "  Vintage\x00   camera\n\u202e "
+		rawDescription = "Mint   condition.\r\n\r\n\r\n\r\nShips\x1b[0m   worldwide.  "
+		cleanTitle     = "Vintage camera"
+		cleanDesc      = "Mint condition.\n\nShips[0m worldwide."
+	)
+
+	t.Run("create", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("CountActiveItemsBySeller", mock.Anything, mock.Anything, mock.Anything).Return(0, nil)
+		repo.On("CreateItem", mock.Anything, mock.Anything, mock.AnythingOfType("*items.Item")).Return(nil)
+		service := NewService(&fakeTxManager{}, repo, &fakeOutbox{}, 0, 0, nil)
+
+		item, err := service.CreateItem(context.Background(), CreateItemCommand{
+			Title:       rawTitle,
+			Description: rawDescription,
+			StartPrice:  1000,
+			EndAt:       time.Now().Add(24 * time.Hour),
+			SellerID:    uuid.New(),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, cleanTitle, item.Title)
+		assert.Equal(t, cleanDesc, item.Description)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		ownerID := uuid.New()
+		existing := &Item{ID: uuid.New(), SellerID: ownerID, Title: "Old", Status: ItemStatusActive}
+		repo := new(MockRepository)
+		repo.On("GetItemByIDForUpdate", mock.Anything, mock.Anything, existing.ID).Return(existing, nil)
+		repo.On("UpdateItem", mock.Anything, mock.Anything, mock.AnythingOfType("*items.Item")).Return(nil)
+		service := NewService(&fakeTxManager{}, repo, &fakeOutbox{}, 0, 0, nil)
+
+		item, err := service.UpdateItem(context.Background(), UpdateItemCommand{
+			ItemID:      existing.ID,
+			UserID:      ownerID,
+			Title:       rawTitle,
+			Description: rawDescription,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, cleanTitle, item.Title)
+		assert.Equal(t, cleanDesc, item.Description)
+	})
+}
+
+func TestService_CreateItem_ListingLimit(t *testing.T) {
+	sellerID := uuid.New()
+	cmd := CreateItemCommand{
+		Title:      "Capped Item",
+		StartPrice: 1000,
+		EndAt:      time.Now().Add(24 * time.Hour),
+		SellerID:   sellerID,
+	}
+
+	t.Run("allows listings below the cap", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("CountActiveItemsBySeller", mock.Anything, mock.Anything, sellerID).Return(2, nil)
+		repo.On("CreateItem", mock.Anything, mock.Anything, mock.AnythingOfType("*items.Item")).Return(nil)
+
+		_, err := NewService(&fakeTxManager{}, repo, &fakeOutbox{}, 0, 3, nil).CreateItem(context.Background(), cmd)
+		assert.NoError(t, err)
+	})
+
+	t.Run("rejects the listing past the cap", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("CountActiveItemsBySeller", mock.Anything, mock.Anything, sellerID).Return(3, nil)
+
+		_, err := NewService(&fakeTxManager{}, repo, &fakeOutbox{}, 0, 3, nil).CreateItem(context.Background(), cmd)
+		assert.ErrorIs(t, err, ErrListingLimitReached)
+		repo.AssertNotCalled(t, "CreateItem", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("defaults to a generous cap", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("CountActiveItemsBySeller", mock.Anything, mock.Anything, sellerID).Return(DefaultMaxActiveListings-1, nil)
+		repo.On("CreateItem", mock.Anything, mock.Anything, mock.AnythingOfType("*items.Item")).Return(nil)
+
+		_, err := NewService(&fakeTxManager{}, repo, &fakeOutbox{}, 0, 0, nil).CreateItem(context.Background(), cmd)
+		assert.NoError(t, err)
+	})
+}
+
+func TestService_CreateItem_ValidateOnly(t *testing.T) {
+	t.Run("valid listing is returned but not saved", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("CategoryExists", mock.Anything, "electronics").Return(true, nil)
+		repo.On("CountActiveItemsBySeller", mock.Anything, mock.Anything, mock.Anything).Return(0, nil)
+		service := NewService(&fakeTxManager{}, repo, &fakeOutbox{}, 0, 0, nil)
+
+		item, err := service.CreateItem(context.Background(), CreateItemCommand{
+			Title:        "Dry Run Item",
+			StartPrice:   1000,
+			EndAt:        time.Now().Add(24 * time.Hour),
+			Category:     "electronics",
+			SellerID:     uuid.New(),
+			ValidateOnly: true,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Dry Run Item", item.Title)
+		assert.Equal(t, ItemStatusActive, item.Status)
+		repo.AssertNotCalled(t, "CreateItem", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("invalid listing fails the same way", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("CategoryExists", mock.Anything, "nope").Return(false, nil)
+		service := NewService(&fakeTxManager{}, repo, &fakeOutbox{}, 0, 0, nil)
+
+		cmd := CreateItemCommand{
+			Title:      "Dry Run Item",
+			StartPrice: 1000,
+			EndAt:      time.Now().Add(24 * time.Hour),
+			Category:   "nope",
+			SellerID:   uuid.New(),
+		}
+		_, realErr := service.CreateItem(context.Background(), cmd)
+		cmd.ValidateOnly = true
+		_, dryErr := service.CreateItem(context.Background(), cmd)
+
+		require.ErrorIs(t, realErr, ErrInvalidCategory)
+		assert.Equal(t, realErr.Error(), dryErr.Error())
+		repo.AssertNotCalled(t, "CreateItem", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestService_WritesItemEvents(t *testing.T) {
+	clk := clocktest.NewFake(time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC))
+	itemID := uuid.New()
+	sellerID := uuid.New()
+
+	// decode checks exactly one event of eventType was saved in a committed transaction
+	// and unmarshals its payload into msg
+	decode := func(t *testing.T, txm *fakeTxManager, outbox *fakeOutbox, eventType EventType, msg proto.Message) {
+		t.Helper()
+		require.NotNil(t, txm.tx)
+		assert.True(t, txm.tx.committed)
+		require.Len(t, outbox.saved, 1)
+		assert.Equal(t, eventType.String(), outbox.saved[0].EventType)
+		assert.Equal(t, events.OutboxStatusPending, outbox.saved[0].Status)
+		require.NoError(t, proto.Unmarshal(outbox.saved[0].Payload, msg))
+	}
+
+	t.Run("create", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("CountActiveItemsBySeller", mock.Anything, mock.Anything, sellerID).Return(0, nil)
+		repo.On("CategoryExists", mock.Anything, "cameras").Return(true, nil)
+		repo.On("CreateItem", mock.Anything, mock.Anything, mock.AnythingOfType("*items.Item")).Return(nil)
+		txm, outbox := &fakeTxManager{}, &fakeOutbox{}
+
+		item, err := NewService(txm, repo, outbox, 0, 0, clk).CreateItem(context.Background(), CreateItemCommand{
+			Title:       "Camera",
+			Description: "Mint",
+			StartPrice:  1000,
+			BuyNowPrice: 5000,
+			EndAt:       clk.Now().Add(time.Hour),
+			Images:      []string{"https://example.com/a.jpg"},
+			Category:    "cameras",
+			SellerID:    sellerID,
+		})
+		require.NoError(t, err)
+
+		var event pb.ItemCreated
+		decode(t, txm, outbox, EventTypeItemCreated, &event)
+		assert.Equal(t, item.ID.String(), event.ItemId)
+		assert.Equal(t, sellerID.String(), event.SellerId)
+		assert.Equal(t, "Camera", event.Title)
+		assert.Equal(t, "Mint", event.Description)
+		assert.Equal(t, "cameras", event.Category)
+		assert.Equal(t, int64(1000), event.StartPrice)
+		assert.Equal(t, int64(5000), event.BuyNowPrice)
+		assert.True(t, item.StartAt.Equal(event.StartAt.AsTime()))
+		assert.True(t, item.EndAt.Equal(event.EndAt.AsTime()))
+		assert.Equal(t, string(ItemStatusActive), event.Status)
+		assert.Equal(t, []string{"https://example.com/a.jpg"}, event.Images)
+		assert.True(t, clk.Now().Equal(event.Timestamp.AsTime()))
+	})
+
+	t.Run("validate only writes no event", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("CountActiveItemsBySeller", mock.Anything, mock.Anything, sellerID).Return(0, nil)
+		txm, outbox := &fakeTxManager{}, &fakeOutbox{}
+
+		_, err := NewService(txm, repo, outbox, 0, 0, clk).CreateItem(context.Background(), CreateItemCommand{
+			Title:        "Camera",
+			StartPrice:   1000,
+			EndAt:        clk.Now().Add(time.Hour),
+			SellerID:     sellerID,
+			ValidateOnly: true,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, txm.tx, "the listing cap is checked in a transaction")
+		assert.False(t, txm.tx.committed)
+		assert.Empty(t, outbox.saved)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("GetItemByIDForUpdate", mock.Anything, mock.Anything, itemID).Return(&Item{ID: itemID, SellerID: sellerID, Title: "Old", Status: ItemStatusActive}, nil)
+		repo.On("UpdateItem", mock.Anything, mock.Anything, mock.AnythingOfType("*items.Item")).Return(nil)
+		txm, outbox := &fakeTxManager{}, &fakeOutbox{}
+		zone := "Europe/Rome"
+
+		_, err := NewService(txm, repo, outbox, 0, 0, clk).UpdateItem(context.Background(), UpdateItemCommand{
+			ItemID:        itemID,
+			UserID:        sellerID,
+			Title:         "New",
+			Description:   "Updated",
+			EndAtTimezone: &zone,
+		})
+		require.NoError(t, err)
+
+		var event pb.ItemUpdated
+		decode(t, txm, outbox, EventTypeItemUpdated, &event)
+		assert.Equal(t, itemID.String(), event.ItemId)
+		assert.Equal(t, sellerID.String(), event.SellerId)
+		assert.Equal(t, "New", event.Title)
+		assert.Equal(t, "Updated", event.Description)
+		assert.Equal(t, zone, event.EndAtTimezone)
+		assert.True(t, clk.Now().Equal(event.Timestamp.AsTime()))
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("GetItemByIDForUpdate", mock.Anything, mock.Anything, itemID).Return(&Item{ID: itemID, SellerID: sellerID, Status: ItemStatusActive}, nil)
+		repo.On("CountBidsByItemID", mock.Anything, itemID).Return(int64(0), nil)
+		repo.On("UpdateStatus", mock.Anything, mock.Anything, itemID, ItemStatusCancelled).Return(nil)
+		txm, outbox := &fakeTxManager{}, &fakeOutbox{}
+
+		_, err := NewService(txm, repo, outbox, 0, 0, clk).CancelItem(context.Background(), CancelItemCommand{ItemID: itemID, UserID: sellerID})
+		require.NoError(t, err)
+
+		var event pb.ItemCancelled
+		decode(t, txm, outbox, EventTypeItemCancelled, &event)
+		assert.Equal(t, itemID.String(), event.ItemId)
+		assert.Equal(t, sellerID.String(), event.SellerId)
+		assert.True(t, clk.Now().Equal(event.Timestamp.AsTime()))
+	})
+
+	t.Run("rejected cancel writes no event", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("GetItemByIDForUpdate", mock.Anything, mock.Anything, itemID).Return(&Item{ID: itemID, SellerID: sellerID, Status: ItemStatusActive, BidCount: 1}, nil)
+		txm, outbox := &fakeTxManager{}, &fakeOutbox{}
+
+		_, err := NewService(txm, repo, outbox, 0, 0, clk).CancelItem(context.Background(), CancelItemCommand{ItemID: itemID, UserID: sellerID})
+		assert.ErrorIs(t, err, ErrCannotCancel)
+		assert.Empty(t, outbox.saved)
+	})
+}
+
 func TestService_UpdateItem(t *testing.T) {
 	itemID := uuid.New()
 	ownerID := uuid.New()
@@ -189,18 +711,52 @@ func TestService_UpdateItem(t *testing.T) {
 				Title:       "Updated Title",
 				Description: "Updated Description",
 				Images:      []string{"new_image.jpg"},
-				Category:    "new_category",
+				Category:    "collectibles",
 			},
 			setupMock: func(repo *MockRepository) {
-				repo.On("GetItemByID", mock.Anything, itemID).Return(&Item{
+				repo.On("GetItemByIDForUpdate", mock.Anything, mock.Anything, itemID).Return(&Item{
 					ID:       itemID,
 					SellerID: ownerID,
 					Title:    "Old Title",
 				}, nil)
-				repo.On("UpdateItem", mock.Anything, mock.AnythingOfType("*items.Item")).Return(nil)
+				repo.On("CategoryExists", mock.Anything, "collectibles").Return(true, nil)
+				repo.On("UpdateItem", mock.Anything, mock.Anything, mock.AnythingOfType("*items.Item")).Return(nil)
 			},
 			wantErr: nil,
 		},
+		{
+			name: "keeps a legacy category without checking it",
+			cmd: UpdateItemCommand{
+				ItemID:   itemID,
+				UserID:   ownerID,
+				Title:    "Updated Title",
+				Category: "old_category",
+			},
+			setupMock: func(repo *MockRepository) {
+				repo.On("GetItemByIDForUpdate", mock.Anything, mock.Anything, itemID).Return(&Item{
+					ID:       itemID,
+					SellerID: ownerID,
+					Category: "old_category",
+				}, nil)
+				repo.On("UpdateItem", mock.Anything, mock.Anything, mock.AnythingOfType("*items.Item")).Return(nil)
+			},
+		},
+		{
+			name: "fails when changing to an unknown category",
+			cmd: UpdateItemCommand{
+				ItemID:   itemID,
+				UserID:   ownerID,
+				Category: "new_category",
+			},
+			setupMock: func(repo *MockRepository) {
+				repo.On("GetItemByIDForUpdate", mock.Anything, mock.Anything, itemID).Return(&Item{
+					ID:       itemID,
+					SellerID: ownerID,
+				}, nil)
+				repo.On("CategoryExists", mock.Anything, "new_category").Return(false, nil)
+			},
+			wantErr: ErrInvalidCategory,
+		},
 		{
 			name: "fails when item not found",
 			cmd: UpdateItemCommand{
@@ -208,7 +764,7 @@ func TestService_UpdateItem(t *testing.T) {
 				UserID: ownerID,
 			},
 			setupMock: func(repo *MockRepository) {
-				repo.On("GetItemByID", mock.Anything, itemID).Return(nil, errors.New("not found"))
+				repo.On("GetItemByIDForUpdate", mock.Anything, mock.Anything, itemID).Return(nil, errors.New("not found"))
 			},
 			wantErr: ErrItemNotFound,
 		},
@@ -219,7 +775,7 @@ func TestService_UpdateItem(t *testing.T) {
 				UserID: otherUserID,
 			},
 			setupMock: func(repo *MockRepository) {
-				repo.On("GetItemByID", mock.Anything, itemID).Return(&Item{
+				repo.On("GetItemByIDForUpdate", mock.Anything, mock.Anything, itemID).Return(&Item{
 					ID:       itemID,
 					SellerID: ownerID,
 				}, nil)
@@ -233,7 +789,7 @@ func TestService_UpdateItem(t *testing.T) {
 			repo := new(MockRepository)
 			tt.setupMock(repo)
 
-			service := NewService(repo)
+			service := NewService(&fakeTxManager{}, repo, &fakeOutbox{}, 0, 0, nil)
 			item, err := service.UpdateItem(context.Background(), tt.cmd)
 
 			if tt.wantErr != nil {
@@ -269,13 +825,13 @@ func TestService_CancelItem(t *testing.T) {
 				UserID: ownerID,
 			},
 			setupMock: func(repo *MockRepository) {
-				repo.On("GetItemByID", mock.Anything, itemID).Return(&Item{
+				repo.On("GetItemByIDForUpdate", mock.Anything, mock.Anything, itemID).Return(&Item{
 					ID:       itemID,
 					SellerID: ownerID,
 					Status:   ItemStatusActive,
 				}, nil)
 				repo.On("CountBidsByItemID", mock.Anything, itemID).Return(int64(0), nil)
-				repo.On("UpdateStatus", mock.Anything, itemID, ItemStatusCancelled).Return(nil)
+				repo.On("UpdateStatus", mock.Anything, mock.Anything, itemID, ItemStatusCancelled).Return(nil)
 			},
 			wantErr: nil,
 		},
@@ -286,7 +842,7 @@ func TestService_CancelItem(t *testing.T) {
 				UserID: ownerID,
 			},
 			setupMock: func(repo *MockRepository) {
-				repo.On("GetItemByID", mock.Anything, itemID).Return(nil, errors.New("not found"))
+				repo.On("GetItemByIDForUpdate", mock.Anything, mock.Anything, itemID).Return(nil, errors.New("not found"))
 			},
 			wantErr: ErrItemNotFound,
 		},
@@ -297,7 +853,7 @@ func TestService_CancelItem(t *testing.T) {
 				UserID: otherUserID,
 			},
 			setupMock: func(repo *MockRepository) {
-				repo.On("GetItemByID", mock.Anything, itemID).Return(&Item{
+				repo.On("GetItemByIDForUpdate", mock.Anything, mock.Anything, itemID).Return(&Item{
 					ID:       itemID,
 					SellerID: ownerID,
 					Status:   ItemStatusActive,
@@ -312,7 +868,7 @@ func TestService_CancelItem(t *testing.T) {
 				UserID: ownerID,
 			},
 			setupMock: func(repo *MockRepository) {
-				repo.On("GetItemByID", mock.Anything, itemID).Return(&Item{
+				repo.On("GetItemByIDForUpdate", mock.Anything, mock.Anything, itemID).Return(&Item{
 					ID:       itemID,
 					SellerID: ownerID,
 					Status:   ItemStatusActive,
@@ -321,6 +877,22 @@ func TestService_CancelItem(t *testing.T) {
 			},
 			wantErr: ErrCannotCancel,
 		},
+		{
+			name: "fails from the bid counter without counting bids",
+			cmd: CancelItemCommand{
+				ItemID: itemID,
+				UserID: ownerID,
+			},
+			setupMock: func(repo *MockRepository) {
+				repo.On("GetItemByIDForUpdate", mock.Anything, mock.Anything, itemID).Return(&Item{
+					ID:       itemID,
+					SellerID: ownerID,
+					Status:   ItemStatusActive,
+					BidCount: 3,
+				}, nil)
+			},
+			wantErr: ErrCannotCancel,
+		},
 		{
 			name: "fails when item is not active",
 			cmd: CancelItemCommand{
@@ -328,7 +900,7 @@ func TestService_CancelItem(t *testing.T) {
 				UserID: ownerID,
 			},
 			setupMock: func(repo *MockRepository) {
-				repo.On("GetItemByID", mock.Anything, itemID).Return(&Item{
+				repo.On("GetItemByIDForUpdate", mock.Anything, mock.Anything, itemID).Return(&Item{
 					ID:       itemID,
 					SellerID: ownerID,
 					Status:   ItemStatusEnded,
@@ -344,7 +916,7 @@ func TestService_CancelItem(t *testing.T) {
 			repo := new(MockRepository)
 			tt.setupMock(repo)
 
-			service := NewService(repo)
+			service := NewService(&fakeTxManager{}, repo, &fakeOutbox{}, 0, 0, nil)
 			item, err := service.CancelItem(context.Background(), tt.cmd)
 
 			if tt.wantErr != nil {
@@ -361,6 +933,88 @@ func TestService_CancelItem(t *testing.T) {
 	}
 }
 
+func TestService_BulkCancelItems(t *testing.T) {
+	sellerID := uuid.New()
+
+	t.Run("cancels items without bids and skips the rest", func(t *testing.T) {
+		noBids := &Item{ID: uuid.New(), SellerID: sellerID, Status: ItemStatusActive}
+		scheduled := &Item{ID: uuid.New(), SellerID: sellerID, Status: ItemStatusScheduled}
+		counted := &Item{ID: uuid.New(), SellerID: sellerID, Status: ItemStatusActive, BidCount: 2}
+		drifted := &Item{ID: uuid.New(), SellerID: sellerID, Status: ItemStatusActive} // counter says 0, bids table disagrees
+
+		repo := new(MockRepository)
+		repo.On("ListItemsBySellerForUpdate", mock.Anything, mock.Anything, sellerID).
+			Return([]*Item{noBids, scheduled, counted, drifted}, nil)
+		repo.On("CountBidsByItemID", mock.Anything, noBids.ID).Return(int64(0), nil)
+		repo.On("CountBidsByItemID", mock.Anything, scheduled.ID).Return(int64(0), nil)
+		repo.On("CountBidsByItemID", mock.Anything, drifted.ID).Return(int64(1), nil)
+		repo.On("UpdateStatus", mock.Anything, mock.Anything, noBids.ID, ItemStatusCancelled).Return(nil)
+		repo.On("UpdateStatus", mock.Anything, mock.Anything, scheduled.ID, ItemStatusCancelled).Return(nil)
+		txm, outbox := &fakeTxManager{}, &fakeOutbox{}
+
+		result, err := NewService(txm, repo, outbox, 0, 0, nil).BulkCancelItems(context.Background(), sellerID)
+		require.NoError(t, err)
+
+		assert.Equal(t, []*Item{noBids, scheduled}, result.Cancelled)
+		assert.Equal(t, []*Item{counted, drifted}, result.Skipped)
+		assert.Equal(t, ItemStatusCancelled, noBids.Status)
+		assert.Equal(t, ItemStatusActive, counted.Status)
+		assert.True(t, txm.tx.committed)
+		require.Len(t, outbox.saved, 2)
+		for _, event := range outbox.saved {
+			assert.Equal(t, string(EventTypeItemCancelled), event.EventType)
+		}
+		repo.AssertExpectations(t)
+		repo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, counted.ID, mock.Anything)
+	})
+
+	t.Run("a seller with nothing to cancel gets an empty result", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("ListItemsBySellerForUpdate", mock.Anything, mock.Anything, sellerID).Return([]*Item{}, nil)
+
+		result, err := NewService(&fakeTxManager{}, repo, &fakeOutbox{}, 0, 0, nil).BulkCancelItems(context.Background(), sellerID)
+		require.NoError(t, err)
+		assert.Empty(t, result.Cancelled)
+		assert.Empty(t, result.Skipped)
+	})
+
+	t.Run("a failed cancel aborts the batch", func(t *testing.T) {
+		item := &Item{ID: uuid.New(), SellerID: sellerID, Status: ItemStatusActive}
+		repo := new(MockRepository)
+		repo.On("ListItemsBySellerForUpdate", mock.Anything, mock.Anything, sellerID).Return([]*Item{item}, nil)
+		repo.On("CountBidsByItemID", mock.Anything, item.ID).Return(int64(0), nil)
+		repo.On("UpdateStatus", mock.Anything, mock.Anything, item.ID, ItemStatusCancelled).Return(errors.New("connection reset"))
+		txm := &fakeTxManager{}
+
+		result, err := NewService(txm, repo, &fakeOutbox{}, 0, 0, nil).BulkCancelItems(context.Background(), sellerID)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		assert.False(t, txm.tx.committed)
+	})
+}
+
+func TestService_ReconcileAllItems(t *testing.T) {
+	clean, drifted, deleted := uuid.New(), uuid.New(), uuid.New()
+	inSync := BidTotals{Count: 2, HighestBid: 1500}
+
+	repo := new(MockRepository)
+	repo.On("ListItemIDs", mock.Anything, uuid.Nil, 2).Return([]uuid.UUID{clean, drifted}, nil)
+	repo.On("ListItemIDs", mock.Anything, drifted, 2).Return([]uuid.UUID{deleted}, nil)
+	repo.On("ReconcileItem", mock.Anything, clean).Return(&Reconciliation{ItemID: clean, Stored: inSync, Actual: inSync}, nil)
+	repo.On("ReconcileItem", mock.Anything, drifted).Return(&Reconciliation{
+		ItemID: drifted,
+		Stored: BidTotals{Count: 2, HighestBid: 99999},
+		Actual: inSync,
+	}, nil)
+	repo.On("ReconcileItem", mock.Anything, deleted).Return(nil, ErrItemNotFound)
+
+	recs, err := NewService(&fakeTxManager{}, repo, &fakeOutbox{}, 0, 0, nil).ReconcileAllItems(context.Background(), 2)
+	require.NoError(t, err)
+	require.Len(t, recs, 1)
+	assert.Equal(t, drifted, recs[0].ItemID)
+	repo.AssertExpectations(t)
+}
+
 func TestService_ValidateSellerCannotBid(t *testing.T) {
 	itemID := uuid.New()
 	sellerID := uuid.New()
@@ -413,7 +1067,7 @@ func TestService_ValidateSellerCannotBid(t *testing.T) {
 			repo := new(MockRepository)
 			tt.setupMock(repo)
 
-			service := NewService(repo)
+			service := NewService(&fakeTxManager{}, repo, &fakeOutbox{}, 0, 0, nil)
 			err := service.ValidateSellerCannotBid(context.Background(), tt.itemID, tt.userID)
 
 			if tt.wantErr != nil {
@@ -426,3 +1080,207 @@ func TestService_ValidateSellerCannotBid(t *testing.T) {
 		})
 	}
 }
+
+func TestService_RecordItemView(t *testing.T) {
+	itemID := uuid.New()
+	sellerID := uuid.New()
+	viewerID := uuid.New()
+
+	tests := []struct {
+		name      string
+		cmd       RecordItemViewCommand
+		setupMock func(*MockRepository)
+		wantErr   error
+	}{
+		{
+			name: "anonymous view is counted",
+			cmd:  RecordItemViewCommand{ItemID: itemID},
+			setupMock: func(repo *MockRepository) {
+				repo.On("IncrementViews", mock.Anything, itemID).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "authenticated view is counted",
+			cmd:  RecordItemViewCommand{ItemID: itemID, ViewerID: viewerID},
+			setupMock: func(repo *MockRepository) {
+				repo.On("GetItemByID", mock.Anything, itemID).Return(&Item{ID: itemID, SellerID: sellerID}, nil)
+				repo.On("IncrementViews", mock.Anything, itemID).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "seller view is not counted",
+			cmd:  RecordItemViewCommand{ItemID: itemID, ViewerID: sellerID},
+			setupMock: func(repo *MockRepository) {
+				repo.On("GetItemByID", mock.Anything, itemID).Return(&Item{ID: itemID, SellerID: sellerID}, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "fails when item not found",
+			cmd:  RecordItemViewCommand{ItemID: itemID},
+			setupMock: func(repo *MockRepository) {
+				repo.On("IncrementViews", mock.Anything, itemID).Return(ErrItemNotFound)
+			},
+			wantErr: ErrItemNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := new(MockRepository)
+			tt.setupMock(repo)
+
+			service := NewService(&fakeTxManager{}, repo, &fakeOutbox{}, 0, 0, nil)
+			err := service.RecordItemView(context.Background(), tt.cmd)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_ListItemsByStatus(t *testing.T) {
+	t.Run("passes the status and paging through", func(t *testing.T) {
+		repo := new(MockRepository)
+		cancelled := []*Item{{ID: uuid.New(), Status: ItemStatusCancelled}}
+		repo.On("ListItemsByStatus", mock.Anything, ItemStatusCancelled, 10, 20).Return(cancelled, nil)
+
+		service := NewService(&fakeTxManager{}, repo, &fakeOutbox{}, 0, 0, nil)
+		got, err := service.ListItemsByStatus(context.Background(), ListItemsByStatusQuery{
+			Status: ItemStatusCancelled,
+			Limit:  10,
+			Offset: 20,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, cancelled, got)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("rejects an unknown status", func(t *testing.T) {
+		repo := new(MockRepository)
+
+		service := NewService(&fakeTxManager{}, repo, &fakeOutbox{}, 0, 0, nil)
+		_, err := service.ListItemsByStatus(context.Background(), ListItemsByStatusQuery{Status: ItemStatus("archived")})
+
+		assert.ErrorIs(t, err, ErrInvalidStatus)
+		repo.AssertNotCalled(t, "ListItemsByStatus")
+	})
+}
+
+func TestService_ListItemsEndingSoon(t *testing.T) {
+	t.Run("passes the window and paging through", func(t *testing.T) {
+		repo := new(MockRepository)
+		closing := []*Item{{ID: uuid.New(), Status: ItemStatusActive}}
+		repo.On("ListItemsEndingSoon", mock.Anything, time.Hour, 10, 0).Return(closing, nil)
+
+		service := NewService(&fakeTxManager{}, repo, &fakeOutbox{}, 0, 0, nil)
+		got, err := service.ListItemsEndingSoon(context.Background(), ListItemsEndingSoonQuery{
+			Within: time.Hour,
+			Limit:  10,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, closing, got)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("rejects a non-positive window", func(t *testing.T) {
+		repo := new(MockRepository)
+
+		service := NewService(&fakeTxManager{}, repo, &fakeOutbox{}, 0, 0, nil)
+		_, err := service.ListItemsEndingSoon(context.Background(), ListItemsEndingSoonQuery{Within: 0})
+
+		assert.ErrorIs(t, err, ErrInvalidWindow)
+		repo.AssertNotCalled(t, "ListItemsEndingSoon")
+	})
+}
+
+func TestService_ListCategories(t *testing.T) {
+	repo := new(MockRepository)
+	categories := []*Category{{Slug: "electronics", Name: "Electronics"}, {Slug: "art", Name: "Art"}}
+	repo.On("ListCategories", mock.Anything).Return(categories, nil)
+
+	got, err := NewService(&fakeTxManager{}, repo, &fakeOutbox{}, 0, 0, nil).ListCategories(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, categories, got)
+}
+
+func TestService_PauseItem(t *testing.T) {
+	itemID, ownerID := uuid.New(), uuid.New()
+	activeItem := func() *Item {
+		return &Item{ID: itemID, SellerID: ownerID, Status: ItemStatusActive}
+	}
+
+	t.Run("the seller can pause", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("GetItemByID", mock.Anything, itemID).Return(activeItem(), nil)
+		repo.On("PauseItem", mock.Anything, itemID).Return(nil)
+
+		item, err := NewService(&fakeTxManager{}, repo, &fakeOutbox{}, 0, 0, nil).PauseItem(context.Background(), PauseItemCommand{ItemID: itemID, UserID: ownerID})
+		require.NoError(t, err)
+		assert.Equal(t, ItemStatusPaused, item.Status)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("an admin can pause someone else's item", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("GetItemByID", mock.Anything, itemID).Return(activeItem(), nil)
+		repo.On("PauseItem", mock.Anything, itemID).Return(nil)
+
+		_, err := NewService(&fakeTxManager{}, repo, &fakeOutbox{}, 0, 0, nil).PauseItem(context.Background(), PauseItemCommand{ItemID: itemID, UserID: uuid.New(), AsAdmin: true})
+		require.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("other users cannot pause", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("GetItemByID", mock.Anything, itemID).Return(activeItem(), nil)
+
+		_, err := NewService(&fakeTxManager{}, repo, &fakeOutbox{}, 0, 0, nil).PauseItem(context.Background(), PauseItemCommand{ItemID: itemID, UserID: uuid.New()})
+		assert.ErrorIs(t, err, ErrUnauthorized)
+		repo.AssertNotCalled(t, "PauseItem", mock.Anything, mock.Anything)
+	})
+
+	t.Run("only active items can be paused", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("GetItemByID", mock.Anything, itemID).Return(activeItem(), nil)
+		repo.On("PauseItem", mock.Anything, itemID).Return(ErrCannotPause)
+
+		_, err := NewService(&fakeTxManager{}, repo, &fakeOutbox{}, 0, 0, nil).PauseItem(context.Background(), PauseItemCommand{ItemID: itemID, UserID: ownerID})
+		assert.ErrorIs(t, err, ErrCannotPause)
+	})
+}
+
+func TestService_ResumeItem(t *testing.T) {
+	itemID, ownerID := uuid.New(), uuid.New()
+	extendedEnd := time.Now().Add(2 * time.Hour).Truncate(time.Second)
+
+	t.Run("returns the item with its new end time", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("GetItemByID", mock.Anything, itemID).Return(&Item{ID: itemID, SellerID: ownerID, Status: ItemStatusPaused}, nil)
+		repo.On("ResumeItem", mock.Anything, itemID, true).Return(extendedEnd, nil)
+
+		item, err := NewService(&fakeTxManager{}, repo, &fakeOutbox{}, 0, 0, nil).ResumeItem(context.Background(), ResumeItemCommand{ItemID: itemID, UserID: ownerID, ExtendEndAt: true})
+		require.NoError(t, err)
+		assert.Equal(t, ItemStatusActive, item.Status)
+		assert.True(t, extendedEnd.Equal(item.EndAt))
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("fails when the item is not paused", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("GetItemByID", mock.Anything, itemID).Return(&Item{ID: itemID, SellerID: ownerID, Status: ItemStatusActive}, nil)
+		repo.On("ResumeItem", mock.Anything, itemID, false).Return(time.Time{}, ErrNotPaused)
+
+		_, err := NewService(&fakeTxManager{}, repo, &fakeOutbox{}, 0, 0, nil).ResumeItem(context.Background(), ResumeItemCommand{ItemID: itemID, UserID: ownerID})
+		assert.ErrorIs(t, err, ErrNotPaused)
+	})
+}
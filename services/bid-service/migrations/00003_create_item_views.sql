@@ -0,0 +1,10 @@
+-- +goose Up
+-- View counts are kept out of the items table so that incrementing them
+-- never waits on the row lock held while a bid is being placed.
+CREATE TABLE item_views (
+    item_id UUID PRIMARY KEY REFERENCES items(id) ON DELETE CASCADE,
+    views BIGINT NOT NULL DEFAULT 0
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS item_views;
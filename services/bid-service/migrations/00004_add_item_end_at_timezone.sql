@@ -0,0 +1,7 @@
+-- +goose Up
+-- end_at stays a UTC instant; the zone is kept only to echo the seller's
+-- original wall-clock time back on reads. Empty means no zone was given.
+ALTER TABLE items ADD COLUMN end_at_timezone TEXT NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE items DROP COLUMN IF EXISTS end_at_timezone;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- Serves the highest-bid lookup made while placing a bid and the GetItemBids orderings
+CREATE INDEX idx_bids_item_id_amount ON bids(item_id, amount DESC, created_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_bids_item_id_amount;
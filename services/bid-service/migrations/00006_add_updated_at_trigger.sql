@@ -0,0 +1,19 @@
+-- +goose Up
+-- updated_at is owned by the database so every write path agrees on it,
+-- regardless of which code issued the UPDATE or what its clock says
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION set_updated_at() RETURNS trigger AS $$
+BEGIN
+    NEW.updated_at = NOW();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER items_set_updated_at
+    BEFORE UPDATE ON items
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+-- +goose Down
+DROP TRIGGER IF EXISTS items_set_updated_at ON items;
+DROP FUNCTION IF EXISTS set_updated_at();
@@ -0,0 +1,18 @@
+-- +goose NO TRANSACTION
+-- ALTER TYPE ... ADD VALUE must commit before the new value can be used below
+-- +goose Up
+ALTER TYPE item_status ADD VALUE IF NOT EXISTS 'scheduled' BEFORE 'active';
+
+-- Existing auctions started when they were listed
+ALTER TABLE items ADD COLUMN start_at TIMESTAMP WITH TIME ZONE;
+UPDATE items SET start_at = COALESCE(created_at, NOW());
+ALTER TABLE items ALTER COLUMN start_at SET NOT NULL, ALTER COLUMN start_at SET DEFAULT NOW();
+
+CREATE INDEX idx_items_scheduled_start_at ON items(start_at) WHERE status = 'scheduled';
+
+-- +goose Down
+-- Postgres cannot drop an enum value, so 'scheduled' stays in the type.
+-- Scheduled items are opened so nothing is left in a status the old code ignores.
+DROP INDEX IF EXISTS idx_items_scheduled_start_at;
+UPDATE items SET status = 'active' WHERE status = 'scheduled';
+ALTER TABLE items DROP COLUMN IF EXISTS start_at;
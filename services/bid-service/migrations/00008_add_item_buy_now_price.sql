@@ -0,0 +1,6 @@
+-- +goose Up
+-- 0 means the item has no buy-now option
+ALTER TABLE items ADD COLUMN buy_now_price BIGINT NOT NULL DEFAULT 0 CHECK (buy_now_price >= 0);
+
+-- +goose Down
+ALTER TABLE items DROP COLUMN IF EXISTS buy_now_price;
@@ -0,0 +1,14 @@
+-- +goose Up
+-- Single-row high-water mark of the relay: the created_at of the newest event
+-- published so far. Backs lag monitoring and "replay since" operations.
+CREATE TABLE outbox_cursor (
+    id BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (id),
+    last_published_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+INSERT INTO outbox_cursor (id, last_published_at)
+SELECT TRUE, MAX(created_at) FROM outbox_events WHERE status = 'published'
+HAVING MAX(created_at) IS NOT NULL;
+
+-- +goose Down
+DROP TABLE IF EXISTS outbox_cursor;
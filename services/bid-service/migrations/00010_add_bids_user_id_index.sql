@@ -0,0 +1,6 @@
+-- +goose Up
+-- Serves per-bidder lookups such as the won auctions listing
+CREATE INDEX idx_bids_user_id ON bids(user_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_bids_user_id;
@@ -0,0 +1,12 @@
+-- +goose Up
+-- bid_count is maintained by the bid repository in the same transaction as each
+-- insert, so reads no longer need COUNT(*) over the bids table
+ALTER TABLE items ADD COLUMN bid_count BIGINT NOT NULL DEFAULT 0 CHECK (bid_count >= 0);
+
+UPDATE items i
+SET bid_count = b.n
+FROM (SELECT item_id, COUNT(*) AS n FROM bids GROUP BY item_id) b
+WHERE b.item_id = i.id;
+
+-- +goose Down
+ALTER TABLE items DROP COLUMN IF EXISTS bid_count;
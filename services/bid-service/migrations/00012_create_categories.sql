@@ -0,0 +1,26 @@
+-- +goose Up
+-- The managed set of item categories. items.category is not a foreign key because
+-- listings created before this table may carry free-form values; new and edited
+-- listings are checked against it by the item service.
+CREATE TABLE categories (
+    slug TEXT PRIMARY KEY,
+    name TEXT NOT NULL,
+    sort_order INT NOT NULL DEFAULT 0
+);
+
+INSERT INTO categories (slug, name, sort_order) VALUES
+    ('electronics', 'Electronics', 10),
+    ('collectibles', 'Collectibles', 20),
+    ('art', 'Art', 30),
+    ('jewelry', 'Jewelry & Watches', 40),
+    ('fashion', 'Fashion', 50),
+    ('home-garden', 'Home & Garden', 60),
+    ('sports', 'Sports & Outdoors', 70),
+    ('toys', 'Toys & Games', 80),
+    ('books', 'Books', 90),
+    ('music', 'Music & Instruments', 100),
+    ('vehicles', 'Vehicles', 110),
+    ('other', 'Other', 1000);
+
+-- +goose Down
+DROP TABLE IF EXISTS categories;
@@ -0,0 +1,13 @@
+-- +goose NO TRANSACTION
+-- ALTER TYPE ... ADD VALUE must commit before the new value can be used
+-- +goose Up
+ALTER TYPE item_status ADD VALUE IF NOT EXISTS 'paused' AFTER 'active';
+
+-- When the current pause began, so resuming can push end_at back by its length
+ALTER TABLE items ADD COLUMN paused_at TIMESTAMP WITH TIME ZONE;
+
+-- +goose Down
+-- Postgres cannot drop an enum value, so 'paused' stays in the type.
+-- Paused items are reopened so nothing is left in a status the old code ignores.
+UPDATE items SET status = 'active' WHERE status = 'paused';
+ALTER TABLE items DROP COLUMN IF EXISTS paused_at;
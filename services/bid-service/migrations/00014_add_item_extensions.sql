@@ -0,0 +1,9 @@
+-- +goose Up
+-- Anti-sniping extensions applied to each item, so PlaceBid can stop extending
+-- once the configured cap on count or total time is reached
+ALTER TABLE items ADD COLUMN extension_count INTEGER NOT NULL DEFAULT 0 CHECK (extension_count >= 0);
+ALTER TABLE items ADD COLUMN extended_seconds BIGINT NOT NULL DEFAULT 0 CHECK (extended_seconds >= 0);
+
+-- +goose Down
+ALTER TABLE items DROP COLUMN IF EXISTS extended_seconds;
+ALTER TABLE items DROP COLUMN IF EXISTS extension_count;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- Serves ListActiveItems' keyset pages: newest first, id breaking ties between equal timestamps
+CREATE INDEX idx_items_active_created_at ON items(created_at DESC, id DESC) WHERE status = 'active';
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_items_active_created_at;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- 0 means bids may be any amount above the minimum
+ALTER TABLE items ADD COLUMN bid_step BIGINT NOT NULL DEFAULT 0 CHECK (bid_step >= 0);
+
+-- +goose Down
+ALTER TABLE items DROP COLUMN IF EXISTS bid_step;
@@ -0,0 +1,16 @@
+-- +goose Up
+-- The newest published event is read straight from outbox_events, so publishing no longer
+-- serializes every relay and immediate publish on a single cursor row
+DROP TABLE IF EXISTS outbox_cursor;
+CREATE INDEX idx_outbox_events_published_created_at ON outbox_events(created_at DESC) WHERE status = 'published';
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_outbox_events_published_created_at;
+CREATE TABLE outbox_cursor (
+    id BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (id),
+    last_published_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+INSERT INTO outbox_cursor (id, last_published_at)
+SELECT TRUE, MAX(created_at) FROM outbox_events WHERE status = 'published'
+HAVING MAX(created_at) IS NOT NULL;
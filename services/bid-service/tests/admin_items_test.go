@@ -0,0 +1,133 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	bidsv1 "github.com/floroz/gavel/pkg/proto/bids/v1"
+	"github.com/floroz/gavel/pkg/testhelpers"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/items"
+)
+
+func TestAPI_AdminListItems(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+
+	client, pool, authConfig := setupBidApp(t, testDB.Pool)
+	ctx := context.Background()
+
+	// Three items per status, created a minute apart so the newest-first order is stable
+	seeded := map[items.ItemStatus][]uuid.UUID{}
+	base := time.Now().Add(-time.Hour)
+	n := 0
+	for _, status := range []items.ItemStatus{items.ItemStatusActive, items.ItemStatusEnded, items.ItemStatusCancelled} {
+		for range 3 {
+			n++
+			item := &items.Item{
+				ID:         uuid.New(),
+				Title:      string(status) + " item",
+				StartPrice: 1000,
+				EndAt:      time.Now().Add(24 * time.Hour),
+				CreatedAt:  base.Add(time.Duration(n) * time.Minute),
+				UpdatedAt:  base.Add(time.Duration(n) * time.Minute),
+				Images:     []string{},
+				Category:   "test",
+				SellerID:   uuid.New(),
+				Status:     status,
+			}
+			seedTestItem(t, pool, item)
+			seeded[status] = append(seeded[status], item.ID)
+		}
+	}
+
+	adminToken := authConfig.generateAdminToken(t, uuid.New())
+	list := func(token string, msg *bidsv1.AdminListItemsRequest) (*connect.Response[bidsv1.AdminListItemsResponse], error) {
+		req := connect.NewRequest(msg)
+		if token != "" {
+			req.Header().Set("Authorization", "Bearer "+token)
+		}
+		return client.AdminListItems(ctx, req)
+	}
+
+	t.Run("requires authentication", func(t *testing.T) {
+		_, err := list("", &bidsv1.AdminListItemsRequest{Status: bidsv1.ItemStatus_ITEM_STATUS_CANCELLED})
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	})
+
+	t.Run("non-admin is denied", func(t *testing.T) {
+		_, err := list(authConfig.generateTestToken(t, uuid.New()), &bidsv1.AdminListItemsRequest{
+			Status: bidsv1.ItemStatus_ITEM_STATUS_CANCELLED,
+		})
+		require.Error(t, err)
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	})
+
+	t.Run("status is required", func(t *testing.T) {
+		_, err := list(adminToken, &bidsv1.AdminListItemsRequest{})
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+
+	statuses := map[bidsv1.ItemStatus]items.ItemStatus{
+		bidsv1.ItemStatus_ITEM_STATUS_ACTIVE:    items.ItemStatusActive,
+		bidsv1.ItemStatus_ITEM_STATUS_ENDED:     items.ItemStatusEnded,
+		bidsv1.ItemStatus_ITEM_STATUS_CANCELLED: items.ItemStatusCancelled,
+	}
+	for protoStatus, status := range statuses {
+		t.Run("lists only "+string(status)+" items", func(t *testing.T) {
+			resp, err := list(adminToken, &bidsv1.AdminListItemsRequest{Status: protoStatus})
+			require.NoError(t, err)
+			require.Len(t, resp.Msg.Items, 3)
+			assert.Empty(t, resp.Msg.NextPageToken)
+
+			want := seeded[status]
+			for i, item := range resp.Msg.Items {
+				assert.Equal(t, protoStatus, item.Status)
+				// Newest first
+				assert.Equal(t, want[len(want)-1-i].String(), item.Id)
+			}
+		})
+	}
+
+	t.Run("pages through results", func(t *testing.T) {
+		var got []string
+		token := ""
+		for {
+			resp, err := list(adminToken, &bidsv1.AdminListItemsRequest{
+				Status:    bidsv1.ItemStatus_ITEM_STATUS_ENDED,
+				PageSize:  2,
+				PageToken: token,
+			})
+			require.NoError(t, err)
+			for _, item := range resp.Msg.Items {
+				got = append(got, item.Id)
+			}
+			if resp.Msg.NextPageToken == "" {
+				break
+			}
+			token = resp.Msg.NextPageToken
+		}
+		assert.Len(t, got, 3)
+		for _, id := range seeded[items.ItemStatusEnded] {
+			assert.Contains(t, got, id.String())
+		}
+	})
+
+	t.Run("rejects a malformed page token", func(t *testing.T) {
+		_, err := list(adminToken, &bidsv1.AdminListItemsRequest{
+			Status:    bidsv1.ItemStatus_ITEM_STATUS_ACTIVE,
+			PageToken: "not-a-token!",
+		})
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+}
@@ -0,0 +1,75 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/floroz/gavel/pkg/database"
+	bidsv1 "github.com/floroz/gavel/pkg/proto/bids/v1"
+	"github.com/floroz/gavel/pkg/testhelpers"
+	infradb "github.com/floroz/gavel/services/bid-service/internal/adapters/database"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/bids"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/items"
+)
+
+func TestBidRepository_AnonymizeBidder(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+
+	client, pool, authConfig := setupBidApp(t, testDB.Pool)
+	ctx := context.Background()
+	repo := infradb.NewPostgresBidRepository(pool, database.DefaultQueryTimeout)
+
+	item := &items.Item{
+		ID:         uuid.New(),
+		Title:      "Anonymized Item",
+		StartPrice: 1000,
+		EndAt:      time.Now().Add(24 * time.Hour),
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+		Images:     []string{},
+		Category:   "test",
+		SellerID:   uuid.New(),
+		Status:     items.ItemStatusActive,
+	}
+	seedTestItem(t, pool, item)
+
+	deleted, kept := uuid.New(), uuid.New()
+	for i, userID := range []uuid.UUID{deleted, kept, deleted} {
+		req := connect.NewRequest(&bidsv1.PlaceBidRequest{ItemId: item.ID.String(), Amount: int64(1100 + i*100)})
+		req.Header().Set("Authorization", "Bearer "+authConfig.generateTestToken(t, userID))
+		_, err := client.PlaceBid(ctx, req)
+		require.NoError(t, err)
+	}
+
+	n, err := repo.AnonymizeBidder(ctx, deleted)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), n)
+
+	remaining, err := repo.ListBidsByUser(ctx, deleted, 10, 0)
+	require.NoError(t, err)
+	assert.Empty(t, remaining)
+
+	tombstoned, err := repo.ListBidsByUser(ctx, bids.DeletedBidderID, 10, 0)
+	require.NoError(t, err)
+	assert.Len(t, tombstoned, 2)
+
+	untouched, err := repo.ListBidsByUser(ctx, kept, 10, 0)
+	require.NoError(t, err)
+	assert.Len(t, untouched, 1)
+
+	// Item totals are unaffected and a repeat is a no-op
+	stored := getTestItem(t, pool, item.ID)
+	assert.Equal(t, int64(3), stored.BidCount)
+	n, err = repo.AnonymizeBidder(ctx, deleted)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+}
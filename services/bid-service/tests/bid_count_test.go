@@ -0,0 +1,85 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/floroz/gavel/pkg/database"
+	"github.com/floroz/gavel/pkg/testhelpers"
+	infradb "github.com/floroz/gavel/services/bid-service/internal/adapters/database"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/bids"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/items"
+)
+
+func TestItem_BidCountStaysConsistent(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+	pool := testDB.Pool
+	ctx := context.Background()
+
+	itemRepo := infradb.NewPostgresItemRepository(pool, database.DefaultQueryTimeout)
+	auctionService := bids.NewAuctionService(
+		database.NewPostgresTransactionManager(pool, 5*time.Second),
+		infradb.NewPostgresBidRepository(pool, database.DefaultQueryTimeout),
+		itemRepo,
+		infradb.NewPostgresOutboxRepository(pool, database.DefaultQueryTimeout),
+		nil,
+		nil,
+		bids.DefaultMaxBidAmount,
+		nil,
+		bids.AntiSniping{},
+		nil,
+	)
+
+	itemID := uuid.New()
+	seedTestItem(t, pool, &items.Item{
+		ID:         itemID,
+		Title:      "Counted Item",
+		StartPrice: 1000,
+		EndAt:      time.Now().Add(1 * time.Hour),
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+		Images:     []string{},
+		Category:   "test",
+		SellerID:   uuid.New(),
+		Status:     items.ItemStatusActive,
+	})
+
+	// Amounts race each other, so some bids are rejected as too low; only accepted ones count
+	const bidders = 20
+	var accepted atomic.Int64
+	var wg sync.WaitGroup
+	for i := range bidders {
+		wg.Add(1)
+		go func(amount int64) {
+			defer wg.Done()
+			_, _, err := auctionService.PlaceBid(ctx, bids.PlaceBidCommand{
+				ItemID: itemID,
+				UserID: uuid.New(),
+				Amount: amount,
+			})
+			if err == nil {
+				accepted.Add(1)
+			}
+		}(int64(1100 + i*100))
+	}
+	wg.Wait()
+
+	counted, err := itemRepo.CountBidsByItemID(ctx, itemID)
+	require.NoError(t, err)
+	require.Positive(t, accepted.Load())
+	assert.Equal(t, accepted.Load(), counted)
+
+	item, err := itemRepo.GetItemByID(ctx, itemID)
+	require.NoError(t, err)
+	assert.Equal(t, counted, item.BidCount, "bid_count must match the bids table")
+}
@@ -0,0 +1,126 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/floroz/gavel/pkg/database"
+	bidsv1 "github.com/floroz/gavel/pkg/proto/bids/v1"
+	"github.com/floroz/gavel/pkg/testhelpers"
+	infradb "github.com/floroz/gavel/services/bid-service/internal/adapters/database"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/items"
+)
+
+func TestPlaceBid_NotifiesListeners(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+
+	client, pool, authConfig := setupBidApp(t, testDB.Pool)
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	listener := database.NewListener(pool, infradb.ItemBidsChannel, 100*time.Millisecond, logger)
+	notifications, unsubscribe := listener.Subscribe()
+	defer unsubscribe()
+
+	done := make(chan error, 1)
+	go func() { done <- listener.Run(ctx) }()
+
+	itemID := uuid.New()
+	seedTestItem(t, pool, &items.Item{
+		ID:         itemID,
+		Title:      "Streamed Item",
+		StartPrice: 1000,
+		EndAt:      time.Now().Add(1 * time.Hour),
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+		Images:     []string{},
+		Category:   "test",
+		SellerID:   uuid.New(),
+		Status:     items.ItemStatusActive,
+	})
+
+	// Each bid outbids the previous one by 100
+	token := authConfig.generateTestToken(t, uuid.New())
+	amount := int64(1000)
+	placeBid := func() (int64, error) {
+		amount += 100
+		req := connect.NewRequest(&bidsv1.PlaceBidRequest{ItemId: itemID.String(), Amount: amount})
+		req.Header().Set("Authorization", "Bearer "+token)
+		_, err := client.PlaceBid(ctx, req)
+		return amount, err
+	}
+
+	receive := func() infradb.ItemBidNotification {
+		t.Helper()
+		select {
+		case n := <-notifications:
+			assert.Equal(t, infradb.ItemBidsChannel, n.Channel)
+			var payload infradb.ItemBidNotification
+			require.NoError(t, json.Unmarshal([]byte(n.Payload), &payload))
+			return payload
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for bid notification")
+			return infradb.ItemBidNotification{}
+		}
+	}
+
+	// LISTEN is issued asynchronously, so keep bidding until the first notification arrives
+	require.Eventually(t, func() bool {
+		if _, err := placeBid(); err != nil {
+			return false
+		}
+		select {
+		case n := <-notifications:
+			var payload infradb.ItemBidNotification
+			return json.Unmarshal([]byte(n.Payload), &payload) == nil && payload.ItemID == itemID
+		case <-time.After(200 * time.Millisecond):
+			return false
+		}
+	}, 5*time.Second, 10*time.Millisecond)
+
+	t.Run("bid is announced with item and amount", func(t *testing.T) {
+		placed, err := placeBid()
+		require.NoError(t, err)
+
+		got := receive()
+		assert.Equal(t, itemID, got.ItemID)
+		assert.Equal(t, placed, got.Amount)
+	})
+
+	t.Run("listener reconnects after its connection is terminated", func(t *testing.T) {
+		_, err := pool.Exec(ctx, `
+			SELECT pg_terminate_backend(pid) FROM pg_stat_activity
+			WHERE query LIKE 'LISTEN%' AND pid <> pg_backend_pid()
+		`)
+		require.NoError(t, err)
+
+		require.Eventually(t, func() bool {
+			if _, err := placeBid(); err != nil {
+				return false
+			}
+			select {
+			case <-notifications:
+				return true
+			case <-time.After(200 * time.Millisecond):
+				return false
+			}
+		}, 10*time.Second, 10*time.Millisecond)
+	})
+
+	cancel()
+	require.NoError(t, <-done)
+}
@@ -0,0 +1,115 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	bidsv1 "github.com/floroz/gavel/pkg/proto/bids/v1"
+	"github.com/floroz/gavel/pkg/testhelpers"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/items"
+)
+
+func TestAPI_GetBidPosition(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+
+	client, pool, authConfig := setupBidApp(t, testDB.Pool)
+	ctx := context.Background()
+
+	item := &items.Item{
+		ID:         uuid.New(),
+		Title:      "Ranked Item",
+		StartPrice: 1000,
+		EndAt:      time.Now().Add(24 * time.Hour),
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+		Images:     []string{},
+		Category:   "test",
+		SellerID:   uuid.New(),
+		Status:     items.ItemStatusActive,
+	}
+	seedTestItem(t, pool, item)
+
+	leaderToken := authConfig.generateTestToken(t, uuid.New())
+	trailerToken := authConfig.generateTestToken(t, uuid.New())
+	outsiderToken := authConfig.generateTestToken(t, uuid.New())
+
+	getPosition := func(t *testing.T, token string) *bidsv1.GetBidPositionResponse {
+		t.Helper()
+		req := connect.NewRequest(&bidsv1.GetBidPositionRequest{ItemId: item.ID.String()})
+		req.Header().Set("Authorization", "Bearer "+token)
+		res, err := client.GetBidPosition(ctx, req)
+		require.NoError(t, err)
+		return res.Msg
+	}
+	placeBid := func(t *testing.T, token string, amount int64) {
+		t.Helper()
+		req := connect.NewRequest(&bidsv1.PlaceBidRequest{ItemId: item.ID.String(), Amount: amount})
+		req.Header().Set("Authorization", "Bearer "+token)
+		_, err := client.PlaceBid(ctx, req)
+		require.NoError(t, err)
+	}
+
+	t.Run("no bids yet", func(t *testing.T) {
+		res := getPosition(t, outsiderToken)
+		assert.False(t, res.IsBidding)
+		assert.Zero(t, res.BidderCount)
+		assert.Equal(t, int64(1000), res.AmountToLead, "the start price takes the lead")
+	})
+
+	// The leader is outbid once and comes back, so only their best bid counts
+	placeBid(t, leaderToken, 1500)
+	placeBid(t, trailerToken, 2000)
+	placeBid(t, leaderToken, 2500)
+
+	t.Run("leading", func(t *testing.T) {
+		res := getPosition(t, leaderToken)
+		assert.True(t, res.IsBidding)
+		assert.Equal(t, int32(1), res.Rank)
+		assert.Equal(t, int32(2), res.BidderCount)
+		assert.Zero(t, res.AmountToLead)
+		require.NotNil(t, res.BestBid)
+		assert.Equal(t, int64(2500), res.BestBid.Amount)
+	})
+
+	t.Run("trailing", func(t *testing.T) {
+		res := getPosition(t, trailerToken)
+		assert.True(t, res.IsBidding)
+		assert.Equal(t, int32(2), res.Rank)
+		assert.Equal(t, int32(2), res.BidderCount)
+		assert.Equal(t, int64(2501), res.AmountToLead)
+		require.NotNil(t, res.BestBid)
+		assert.Equal(t, int64(2000), res.BestBid.Amount)
+	})
+
+	t.Run("not participating", func(t *testing.T) {
+		res := getPosition(t, outsiderToken)
+		assert.False(t, res.IsBidding)
+		assert.Nil(t, res.BestBid)
+		assert.Zero(t, res.Rank)
+		assert.Equal(t, int32(2), res.BidderCount)
+		assert.Equal(t, int64(2501), res.AmountToLead)
+	})
+
+	t.Run("requires authentication", func(t *testing.T) {
+		_, err := client.GetBidPosition(ctx, connect.NewRequest(&bidsv1.GetBidPositionRequest{ItemId: item.ID.String()}))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	})
+
+	t.Run("unknown item", func(t *testing.T) {
+		req := connect.NewRequest(&bidsv1.GetBidPositionRequest{ItemId: uuid.NewString()})
+		req.Header().Set("Authorization", "Bearer "+outsiderToken)
+		_, err := client.GetBidPosition(ctx, req)
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	})
+}
@@ -0,0 +1,81 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	bidsv1 "github.com/floroz/gavel/pkg/proto/bids/v1"
+	"github.com/floroz/gavel/pkg/testhelpers"
+)
+
+func TestAPI_BidStep(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+
+	client, _, authConfig := setupBidApp(t, testDB.Pool)
+	ctx := context.Background()
+
+	sellerToken := authConfig.generateTestToken(t, uuid.New())
+	bidderToken := authConfig.generateTestToken(t, uuid.New())
+
+	createItem := func(step int64) (*bidsv1.Item, error) {
+		req := connect.NewRequest(&bidsv1.CreateItemRequest{
+			Title:      "Stepped Item",
+			StartPrice: 1000,
+			BidStep:    step,
+			EndAt:      time.Now().Add(48 * time.Hour).Format(time.RFC3339),
+		})
+		req.Header().Set("Authorization", "Bearer "+sellerToken)
+		res, err := client.CreateItem(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return res.Msg.Item, nil
+	}
+
+	placeBid := func(itemID string, amount int64) error {
+		req := connect.NewRequest(&bidsv1.PlaceBidRequest{ItemId: itemID, Amount: amount})
+		req.Header().Set("Authorization", "Bearer "+bidderToken)
+		_, err := client.PlaceBid(ctx, req)
+		return err
+	}
+
+	t.Run("on-step bids are accepted, off-step bids rejected", func(t *testing.T) {
+		item, err := createItem(250)
+		require.NoError(t, err)
+		assert.Equal(t, int64(250), item.BidStep)
+
+		err = placeBid(item.Id, 1100)
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+		require.NoError(t, placeBid(item.Id, 1250))
+		require.NoError(t, placeBid(item.Id, 2000))
+
+		err = placeBid(item.Id, 2001)
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+
+	t.Run("no step accepts any amount above the minimum", func(t *testing.T) {
+		item, err := createItem(0)
+		require.NoError(t, err)
+
+		require.NoError(t, placeBid(item.Id, 1001))
+		require.NoError(t, placeBid(item.Id, 1337))
+	})
+
+	t.Run("negative step is rejected", func(t *testing.T) {
+		_, err := createItem(-100)
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+}
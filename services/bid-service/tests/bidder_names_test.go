@@ -0,0 +1,146 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	authv1 "github.com/floroz/gavel/pkg/proto/auth/v1"
+	"github.com/floroz/gavel/pkg/proto/auth/v1/authv1connect"
+	bidsv1 "github.com/floroz/gavel/pkg/proto/bids/v1"
+	"github.com/floroz/gavel/pkg/testhelpers"
+	"github.com/floroz/gavel/services/bid-service/internal/adapters/authclient"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/items"
+)
+
+// stubAuthService answers GetDisplayNames from a fixed map, or fails with err when set
+type stubAuthService struct {
+	authv1connect.UnimplementedAuthServiceHandler
+	names map[string]string
+	err   error
+	calls int
+}
+
+func (s *stubAuthService) GetDisplayNames(
+	_ context.Context,
+	req *connect.Request[authv1.GetDisplayNamesRequest],
+) (*connect.Response[authv1.GetDisplayNamesResponse], error) {
+	s.calls++
+	if s.err != nil {
+		return nil, s.err
+	}
+	res := &authv1.GetDisplayNamesResponse{DisplayNames: map[string]string{}}
+	for _, id := range req.Msg.UserIds {
+		if name, ok := s.names[id]; ok {
+			res.DisplayNames[id] = name
+		}
+	}
+	return connect.NewResponse(res), nil
+}
+
+func startStubAuthService(t *testing.T, stub *stubAuthService) *authclient.BidderDirectory {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.Handle(authv1connect.NewAuthServiceHandler(stub))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return authclient.NewBidderDirectory(authv1connect.NewAuthServiceClient(server.Client(), server.URL))
+}
+
+func TestAPI_GetItemBids_BidderNames(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+
+	alice, bob := uuid.New(), uuid.New()
+	stub := &stubAuthService{names: map[string]string{
+		alice.String(): "Alice S.",
+		bob.String():   "Bob T.",
+	}}
+	client, pool, authConfig := setupBidAppWithBidders(t, testDB.Pool, startStubAuthService(t, stub))
+	ctx := context.Background()
+
+	item := &items.Item{
+		ID:         uuid.New(),
+		Title:      "Named Bids Item",
+		StartPrice: 1000,
+		EndAt:      time.Now().Add(24 * time.Hour),
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+		Images:     []string{},
+		Category:   "test",
+		SellerID:   uuid.New(),
+		Status:     items.ItemStatusActive,
+	}
+	seedTestItem(t, pool, item)
+
+	for i, bidder := range []uuid.UUID{alice, bob, alice} {
+		req := connect.NewRequest(&bidsv1.PlaceBidRequest{ItemId: item.ID.String(), Amount: int64(1500 + i*500)})
+		req.Header().Set("Authorization", "Bearer "+authConfig.generateTestToken(t, bidder))
+		_, err := client.PlaceBid(ctx, req)
+		require.NoError(t, err)
+	}
+
+	getBids := func(token string, includeNames bool) (*connect.Response[bidsv1.GetItemBidsResponse], error) {
+		req := connect.NewRequest(&bidsv1.GetItemBidsRequest{ItemId: item.ID.String(), IncludeBidderNames: includeNames})
+		if token != "" {
+			req.Header().Set("Authorization", "Bearer "+token)
+		}
+		return client.GetItemBids(ctx, req)
+	}
+	sellerToken := authConfig.generateTestToken(t, item.SellerID)
+
+	t.Run("names are populated in one lookup", func(t *testing.T) {
+		stub.calls = 0
+		resp, err := getBids(sellerToken, true)
+		require.NoError(t, err)
+		require.Len(t, resp.Msg.Bids, 3)
+		for _, bid := range resp.Msg.Bids {
+			assert.Equal(t, stub.names[bid.UserId], bid.BidderDisplayName)
+		}
+		assert.Equal(t, 1, stub.calls)
+	})
+
+	t.Run("names are omitted unless requested", func(t *testing.T) {
+		stub.calls = 0
+		resp, err := getBids("", false)
+		require.NoError(t, err)
+		for _, bid := range resp.Msg.Bids {
+			assert.Empty(t, bid.BidderDisplayName)
+		}
+		assert.Zero(t, stub.calls)
+	})
+
+	t.Run("anonymous callers cannot request names", func(t *testing.T) {
+		_, err := getBids("", true)
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	})
+
+	t.Run("other users cannot request names", func(t *testing.T) {
+		_, err := getBids(authConfig.generateTestToken(t, alice), true)
+		require.Error(t, err)
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	})
+
+	t.Run("auth service failure degrades to bids without names", func(t *testing.T) {
+		stub.err = connect.NewError(connect.CodeUnavailable, errors.New("auth service down"))
+		t.Cleanup(func() { stub.err = nil })
+
+		resp, err := getBids(sellerToken, true)
+		require.NoError(t, err)
+		require.Len(t, resp.Msg.Bids, 3)
+		for _, bid := range resp.Msg.Bids {
+			assert.Empty(t, bid.BidderDisplayName)
+		}
+	})
+}
@@ -0,0 +1,106 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	bidsv1 "github.com/floroz/gavel/pkg/proto/bids/v1"
+	"github.com/floroz/gavel/pkg/testhelpers"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/items"
+)
+
+func TestAPI_GetItemBids_BidderPseudonyms(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+
+	client, pool, authConfig := setupBidApp(t, testDB.Pool)
+	ctx := context.Background()
+
+	item := &items.Item{
+		ID:         uuid.New(),
+		Title:      "Pseudonym Item",
+		StartPrice: 1000,
+		EndAt:      time.Now().Add(24 * time.Hour),
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+		Images:     []string{},
+		Category:   "test",
+		SellerID:   uuid.New(),
+		Status:     items.ItemStatusActive,
+	}
+	seedTestItem(t, pool, item)
+
+	alice, bob := uuid.New(), uuid.New()
+	bidders := []uuid.UUID{alice, bob, alice, bob}
+	for i, bidder := range bidders {
+		req := connect.NewRequest(&bidsv1.PlaceBidRequest{ItemId: item.ID.String(), Amount: int64(1500 + i*500)})
+		req.Header().Set("Authorization", "Bearer "+authConfig.generateTestToken(t, bidder))
+		_, err := client.PlaceBid(ctx, req)
+		require.NoError(t, err)
+	}
+
+	// Each bid outbids the last, so amount order is the reverse of placement order
+	getBids := func(token string) []*bidsv1.Bid {
+		req := connect.NewRequest(&bidsv1.GetItemBidsRequest{
+			ItemId:  item.ID.String(),
+			OrderBy: bidsv1.BidOrderBy_BID_ORDER_BY_AMOUNT,
+		})
+		if token != "" {
+			req.Header().Set("Authorization", "Bearer "+token)
+		}
+		resp, err := client.GetItemBids(ctx, req)
+		require.NoError(t, err)
+		require.Len(t, resp.Msg.Bids, len(bidders))
+		return resp.Msg.Bids
+	}
+	// bidderAt maps a response position back to who placed that bid
+	bidderAt := func(i int) uuid.UUID { return bidders[len(bidders)-1-i] }
+
+	t.Run("public callers get consistent pseudonyms", func(t *testing.T) {
+		got := getBids("")
+
+		labels := map[uuid.UUID]string{}
+		for i, bid := range got {
+			assert.Empty(t, bid.UserId, "real bidder ids must not be exposed")
+			assert.Regexp(t, `^Bidder #[0-9a-f]{6}$`, bid.BidderLabel)
+
+			bidder := bidderAt(i)
+			if prev, ok := labels[bidder]; ok {
+				assert.Equal(t, prev, bid.BidderLabel, "a bidder keeps one label per item")
+			}
+			labels[bidder] = bid.BidderLabel
+		}
+		assert.NotEqual(t, labels[alice], labels[bob])
+	})
+
+	t.Run("seller sees real ids", func(t *testing.T) {
+		for i, bid := range getBids(authConfig.generateTestToken(t, item.SellerID)) {
+			assert.Equal(t, bidderAt(i).String(), bid.UserId)
+			assert.NotEmpty(t, bid.BidderLabel)
+		}
+	})
+
+	t.Run("admin sees real ids", func(t *testing.T) {
+		for i, bid := range getBids(authConfig.generateAdminToken(t, uuid.New())) {
+			assert.Equal(t, bidderAt(i).String(), bid.UserId)
+		}
+	})
+
+	t.Run("bidder sees only their own id", func(t *testing.T) {
+		for i, bid := range getBids(authConfig.generateTestToken(t, alice)) {
+			if bidderAt(i) == alice {
+				assert.Equal(t, alice.String(), bid.UserId)
+			} else {
+				assert.Empty(t, bid.UserId)
+			}
+		}
+	})
+}
@@ -0,0 +1,188 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	bidsv1 "github.com/floroz/gavel/pkg/proto/bids/v1"
+	"github.com/floroz/gavel/pkg/testhelpers"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/items"
+)
+
+func TestAPI_GetItemBidsSince(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+
+	client, pool, authConfig := setupBidApp(t, testDB.Pool)
+	ctx := context.Background()
+
+	item := &items.Item{
+		ID:         uuid.New(),
+		Title:      "Polled Item",
+		StartPrice: 100,
+		EndAt:      time.Now().Add(24 * time.Hour),
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+		Images:     []string{},
+		Category:   "test",
+		SellerID:   uuid.New(),
+		Status:     items.ItemStatusActive,
+	}
+	seedTestItem(t, pool, item)
+
+	// Seed history at known times so the boundary can be hit exactly
+	base := time.Now().Add(-time.Hour).UTC().Truncate(time.Microsecond)
+	seeded := make([]uuid.UUID, 3)
+	for i := range seeded {
+		seeded[i] = uuid.New()
+		_, err := pool.Exec(ctx,
+			`INSERT INTO bids (id, item_id, user_id, amount, created_at) VALUES ($1, $2, $3, $4, $5)`,
+			seeded[i], item.ID, uuid.New(), int64(200+i*100), base.Add(time.Duration(i)*time.Minute))
+		require.NoError(t, err)
+	}
+
+	poll := func(t *testing.T, since string, pageSize int32) *bidsv1.GetItemBidsResponse {
+		t.Helper()
+		res, err := client.GetItemBids(ctx, connect.NewRequest(&bidsv1.GetItemBidsRequest{
+			ItemId:   item.ID.String(),
+			Since:    since,
+			PageSize: pageSize,
+		}))
+		require.NoError(t, err)
+		return res.Msg
+	}
+	// next polls from where the previous response left off
+	next := func(t *testing.T, prev *bidsv1.GetItemBidsResponse, pageSize int32) *bidsv1.GetItemBidsResponse {
+		t.Helper()
+		res, err := client.GetItemBids(ctx, connect.NewRequest(&bidsv1.GetItemBidsRequest{
+			ItemId:     item.ID.String(),
+			Since:      prev.ServerTime,
+			SinceBidId: prev.SinceBidId,
+			PageSize:   pageSize,
+		}))
+		require.NoError(t, err)
+		return res.Msg
+	}
+	ids := func(res *bidsv1.GetItemBidsResponse) []string {
+		out := make([]string, len(res.Bids))
+		for i, bid := range res.Bids {
+			out[i] = bid.Id
+		}
+		return out
+	}
+
+	t.Run("returns only newer bids, oldest first", func(t *testing.T) {
+		res := poll(t, base.Add(-time.Second).Format(time.RFC3339Nano), 0)
+		assert.Equal(t, []string{seeded[0].String(), seeded[1].String(), seeded[2].String()}, ids(res))
+		assert.Empty(t, res.NextPageToken)
+	})
+
+	t.Run("the since boundary is exclusive", func(t *testing.T) {
+		res := poll(t, base.Add(time.Minute).Format(time.RFC3339Nano), 0)
+		assert.Equal(t, []string{seeded[2].String()}, ids(res))
+	})
+
+	t.Run("server_time picks up exactly the bids placed after it", func(t *testing.T) {
+		res := poll(t, base.Add(2*time.Minute).Format(time.RFC3339Nano), 0)
+		assert.Empty(t, res.Bids)
+		serverTime, err := time.Parse(time.RFC3339Nano, res.ServerTime)
+		require.NoError(t, err)
+		assert.WithinDuration(t, time.Now(), serverTime, time.Minute)
+
+		req := connect.NewRequest(&bidsv1.PlaceBidRequest{ItemId: item.ID.String(), Amount: 1000})
+		req.Header().Set("Authorization", "Bearer "+authConfig.generateTestToken(t, uuid.New()))
+		placed, err := client.PlaceBid(ctx, req)
+		require.NoError(t, err)
+
+		// server_time trails the clock, so the new bid shows up within a few polls
+		var got []string
+		require.Eventually(t, func() bool {
+			res = next(t, res, 0)
+			got = append(got, ids(res)...)
+			return len(got) > 0
+		}, 10*time.Second, 200*time.Millisecond)
+		assert.Equal(t, []string{placed.Msg.Bid.Id}, got)
+
+		res = next(t, res, 0)
+		assert.Empty(t, res.Bids, "a bid must not be returned twice")
+	})
+
+	t.Run("a full page resumes after its last bid", func(t *testing.T) {
+		res := poll(t, base.Add(-time.Second).Format(time.RFC3339Nano), 2)
+		assert.Equal(t, []string{seeded[0].String(), seeded[1].String()}, ids(res))
+		assert.Equal(t, seeded[1].String(), res.SinceBidId)
+
+		res = next(t, res, 2)
+		require.NotEmpty(t, res.Bids)
+		assert.Equal(t, seeded[2].String(), res.Bids[0].Id)
+	})
+
+	t.Run("bids sharing a timestamp are split across pages without loss", func(t *testing.T) {
+		other := &items.Item{
+			ID:         uuid.New(),
+			Title:      "Tied Item",
+			StartPrice: 100,
+			EndAt:      time.Now().Add(24 * time.Hour),
+			CreatedAt:  time.Now(),
+			UpdatedAt:  time.Now(),
+			Images:     []string{},
+			Category:   "test",
+			SellerID:   uuid.New(),
+			Status:     items.ItemStatusActive,
+		}
+		seedTestItem(t, pool, other)
+
+		tied := base.Add(10 * time.Minute)
+		first, second := uuid.New(), uuid.New()
+		if second.String() < first.String() {
+			first, second = second, first
+		}
+		for i, id := range []uuid.UUID{first, second} {
+			_, err := pool.Exec(ctx,
+				`INSERT INTO bids (id, item_id, user_id, amount, created_at) VALUES ($1, $2, $3, $4, $5)`,
+				id, other.ID, uuid.New(), int64(200+i*100), tied)
+			require.NoError(t, err)
+		}
+
+		res, err := client.GetItemBids(ctx, connect.NewRequest(&bidsv1.GetItemBidsRequest{
+			ItemId:   other.ID.String(),
+			Since:    base.Format(time.RFC3339Nano),
+			PageSize: 1,
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, []string{first.String()}, ids(res.Msg))
+		assert.Equal(t, first.String(), res.Msg.SinceBidId)
+
+		res, err = client.GetItemBids(ctx, connect.NewRequest(&bidsv1.GetItemBidsRequest{
+			ItemId:     other.ID.String(),
+			Since:      res.Msg.ServerTime,
+			SinceBidId: res.Msg.SinceBidId,
+			PageSize:   1,
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, []string{second.String()}, ids(res.Msg), "the second bid at the same time is not skipped")
+	})
+
+	t.Run("rejects invalid combinations", func(t *testing.T) {
+		for name, msg := range map[string]*bidsv1.GetItemBidsRequest{
+			"malformed since":  {ItemId: item.ID.String(), Since: "yesterday"},
+			"malformed bid id": {ItemId: item.ID.String(), Since: base.Format(time.RFC3339Nano), SinceBidId: "abc"},
+			"with page token":  {ItemId: item.ID.String(), Since: base.Format(time.RFC3339Nano), PageToken: "abc"},
+			"with amount sort": {ItemId: item.ID.String(), Since: base.Format(time.RFC3339Nano), OrderBy: bidsv1.BidOrderBy_BID_ORDER_BY_AMOUNT},
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, err := client.GetItemBids(ctx, connect.NewRequest(msg))
+				require.Error(t, err)
+				assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			})
+		}
+	})
+}
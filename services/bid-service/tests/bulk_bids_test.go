@@ -0,0 +1,102 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pkgdb "github.com/floroz/gavel/pkg/database"
+	"github.com/floroz/gavel/pkg/testhelpers"
+	"github.com/floroz/gavel/services/bid-service/internal/adapters/database"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/bids"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/items"
+)
+
+// copyRecordingTx counts CopyFrom and Exec calls so the test can tell COPY from row-by-row inserts
+type copyRecordingTx struct {
+	pgx.Tx
+	copyCalls int
+	execCalls int
+}
+
+func (tx *copyRecordingTx) CopyFrom(ctx context.Context, table pgx.Identifier, columns []string, src pgx.CopyFromSource) (int64, error) {
+	tx.copyCalls++
+	return tx.Tx.CopyFrom(ctx, table, columns, src)
+}
+
+func (tx *copyRecordingTx) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	tx.execCalls++
+	return tx.Tx.Exec(ctx, sql, args...)
+}
+
+func TestBidRepository_SaveBids(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+
+	pool := testDB.Pool
+	repo := database.NewPostgresBidRepository(pool, pkgdb.DefaultQueryTimeout)
+	ctx := context.Background()
+
+	item := &items.Item{
+		ID:         uuid.New(),
+		Title:      "Imported Auction",
+		StartPrice: 100,
+		EndAt:      time.Now().Add(-24 * time.Hour),
+		CreatedAt:  time.Now().Add(-48 * time.Hour),
+		UpdatedAt:  time.Now().Add(-48 * time.Hour),
+		Images:     []string{},
+		Category:   "import",
+		SellerID:   uuid.New(),
+		Status:     items.ItemStatusEnded,
+	}
+	seedTestItem(t, pool, item)
+
+	const n = 5000
+	start := time.Now().Add(-47 * time.Hour)
+	batch := make([]*bids.Bid, n)
+	for i := range batch {
+		batch[i] = &bids.Bid{
+			ID:        uuid.New(),
+			ItemID:    item.ID,
+			UserID:    uuid.New(),
+			Amount:    int64(200 + i),
+			CreatedAt: start.Add(time.Duration(i) * time.Second),
+		}
+	}
+
+	pgxTx, err := pool.Begin(ctx)
+	require.NoError(t, err)
+	tx := &copyRecordingTx{Tx: pgxTx}
+	require.NoError(t, repo.SaveBids(ctx, tx, batch))
+	require.NoError(t, pgxTx.Commit(ctx))
+
+	assert.Equal(t, 1, tx.copyCalls, "SaveBids should use a single COPY")
+	assert.Equal(t, 1, tx.execCalls, "SaveBids should not insert row by row, only bump bid counts")
+
+	var count int
+	require.NoError(t, pool.QueryRow(ctx, "SELECT COUNT(*) FROM bids WHERE item_id = $1", item.ID).Scan(&count))
+	assert.Equal(t, n, count)
+	assert.Equal(t, int64(n), getTestItem(t, pool, item.ID).BidCount)
+
+	// The bulk path leaves the item's highest bid for the caller to recompute
+	var highest int64
+	require.NoError(t, pool.QueryRow(ctx, "SELECT current_highest_bid FROM items WHERE id = $1", item.ID).Scan(&highest))
+	assert.Zero(t, highest)
+
+	t.Run("empty batch is a no-op", func(t *testing.T) {
+		pgxTx, err := pool.Begin(ctx)
+		require.NoError(t, err)
+		defer func() { _ = pgxTx.Rollback(ctx) }()
+		tx := &copyRecordingTx{Tx: pgxTx}
+		require.NoError(t, repo.SaveBids(ctx, tx, nil))
+		assert.Zero(t, tx.copyCalls)
+	})
+}
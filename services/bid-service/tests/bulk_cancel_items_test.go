@@ -0,0 +1,97 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	bidsv1 "github.com/floroz/gavel/pkg/proto/bids/v1"
+	"github.com/floroz/gavel/pkg/testhelpers"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/items"
+)
+
+func TestAPI_BulkCancelItems(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+
+	client, pool, authConfig := setupBidApp(t, testDB.Pool)
+	ctx := context.Background()
+
+	sellerID := uuid.New()
+	otherSellerID := uuid.New()
+
+	newItem := func(seller uuid.UUID, status items.ItemStatus) *items.Item {
+		item := &items.Item{
+			ID:         uuid.New(),
+			Title:      "Shop Item",
+			StartPrice: 1000,
+			EndAt:      time.Now().Add(24 * time.Hour),
+			CreatedAt:  time.Now(),
+			UpdatedAt:  time.Now(),
+			Images:     []string{},
+			SellerID:   seller,
+			Status:     status,
+		}
+		seedTestItem(t, pool, item)
+		return item
+	}
+	statusOf := func(id uuid.UUID) items.ItemStatus {
+		var status items.ItemStatus
+		require.NoError(t, pool.QueryRow(ctx, "SELECT status FROM items WHERE id = $1", id).Scan(&status))
+		return status
+	}
+	bulkCancel := func(seller uuid.UUID) (*bidsv1.BulkCancelItemsResponse, error) {
+		req := connect.NewRequest(&bidsv1.BulkCancelItemsRequest{})
+		req.Header().Set("Authorization", "Bearer "+authConfig.generateTestToken(t, seller))
+		res, err := client.BulkCancelItems(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return res.Msg, nil
+	}
+
+	active := newItem(sellerID, items.ItemStatusActive)
+	scheduled := newItem(sellerID, items.ItemStatusScheduled)
+	withBids := newItem(sellerID, items.ItemStatusActive)
+	seedTestBids(t, pool, withBids.ID, 1500)
+	paused := newItem(sellerID, items.ItemStatusPaused)
+	ended := newItem(sellerID, items.ItemStatusEnded)
+	otherSellers := newItem(otherSellerID, items.ItemStatusActive)
+	eventsBefore := countOutboxEvents(t, pool)
+
+	res, err := bulkCancel(sellerID)
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(2), res.CancelledCount)
+	assert.Equal(t, int32(1), res.SkippedCount)
+	assert.ElementsMatch(t, []string{active.ID.String(), scheduled.ID.String()}, res.CancelledItemIds)
+	assert.Equal(t, []string{withBids.ID.String()}, res.SkippedItemIds)
+
+	assert.Equal(t, items.ItemStatusCancelled, statusOf(active.ID))
+	assert.Equal(t, items.ItemStatusCancelled, statusOf(scheduled.ID))
+	assert.Equal(t, items.ItemStatusActive, statusOf(withBids.ID))
+	assert.Equal(t, items.ItemStatusPaused, statusOf(paused.ID))
+	assert.Equal(t, items.ItemStatusEnded, statusOf(ended.ID))
+	assert.Equal(t, items.ItemStatusActive, statusOf(otherSellers.ID), "only the caller's items are cancelled")
+	assert.Equal(t, eventsBefore+2, countOutboxEvents(t, pool), "one item.cancelled event per cancelled item")
+
+	t.Run("a second call only reports the items with bids", func(t *testing.T) {
+		res, err := bulkCancel(sellerID)
+		require.NoError(t, err)
+		assert.Zero(t, res.CancelledCount)
+		assert.Equal(t, []string{withBids.ID.String()}, res.SkippedItemIds)
+	})
+
+	t.Run("requires authentication", func(t *testing.T) {
+		_, err := client.BulkCancelItems(ctx, connect.NewRequest(&bidsv1.BulkCancelItemsRequest{}))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	})
+}
@@ -0,0 +1,191 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/floroz/gavel/pkg/flags"
+	pb "github.com/floroz/gavel/pkg/proto"
+	bidsv1 "github.com/floroz/gavel/pkg/proto/bids/v1"
+	"github.com/floroz/gavel/pkg/testhelpers"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/items"
+)
+
+func TestBuyNow(t *testing.T) {
+	t.Setenv(flags.EnvPrefix+"BUY_NOW", "true")
+
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+
+	client, pool, authConfig := setupBidApp(t, testDB.Pool)
+	ctx := context.Background()
+
+	sellerID := uuid.New()
+	sellerToken := authConfig.generateTestToken(t, sellerID)
+
+	createItem := func(t *testing.T, buyNowPrice int64) *bidsv1.Item {
+		t.Helper()
+		req := connect.NewRequest(&bidsv1.CreateItemRequest{
+			Title:       "Buy Now Item",
+			StartPrice:  1000,
+			BuyNowPrice: buyNowPrice,
+			EndAt:       time.Now().Add(48 * time.Hour).Format(time.RFC3339),
+		})
+		req.Header().Set("Authorization", "Bearer "+sellerToken)
+		res, err := client.CreateItem(ctx, req)
+		require.NoError(t, err)
+		return res.Msg.Item
+	}
+
+	buyNow := func(token, itemID string) (*bidsv1.BuyNowResponse, error) {
+		req := connect.NewRequest(&bidsv1.BuyNowRequest{ItemId: itemID})
+		req.Header().Set("Authorization", "Bearer "+token)
+		res, err := client.BuyNow(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return res.Msg, nil
+	}
+
+	tryBid := func(token, itemID string, amount int64) error {
+		req := connect.NewRequest(&bidsv1.PlaceBidRequest{ItemId: itemID, Amount: amount})
+		req.Header().Set("Authorization", "Bearer "+token)
+		_, err := client.PlaceBid(ctx, req)
+		return err
+	}
+	placeBid := func(token, itemID string, amount int64) {
+		require.NoError(t, tryBid(token, itemID, amount))
+	}
+
+	t.Run("buy-now price must exceed the start price", func(t *testing.T) {
+		req := connect.NewRequest(&bidsv1.CreateItemRequest{
+			Title:       "Cheap Buy Now",
+			StartPrice:  1000,
+			BuyNowPrice: 500,
+			EndAt:       time.Now().Add(48 * time.Hour).Format(time.RFC3339),
+		})
+		req.Header().Set("Authorization", "Bearer "+sellerToken)
+		_, err := client.CreateItem(ctx, req)
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+
+	t.Run("successful purchase ends the auction", func(t *testing.T) {
+		item := createItem(t, 5000)
+		assert.Equal(t, int64(5000), item.BuyNowPrice)
+
+		bidderID, buyerID := uuid.New(), uuid.New()
+		placeBid(authConfig.generateTestToken(t, bidderID), item.Id, 2000)
+
+		res, err := buyNow(authConfig.generateTestToken(t, buyerID), item.Id)
+		require.NoError(t, err)
+		assert.Equal(t, buyerID.String(), res.Bid.UserId)
+		assert.Equal(t, int64(5000), res.Bid.Amount)
+		assert.Equal(t, bidsv1.ItemStatus_ITEM_STATUS_ENDED, res.Item.Status)
+		assert.Equal(t, int64(5000), res.Item.CurrentHighestBid)
+
+		stored := getTestItem(t, pool, uuid.MustParse(item.Id))
+		assert.Equal(t, items.ItemStatusEnded, stored.Status)
+		assert.Equal(t, int64(5000), stored.CurrentHighestBid)
+
+		var payload []byte
+		err = pool.QueryRow(ctx, `SELECT payload FROM outbox_events WHERE event_type = 'auction.ended'`).Scan(&payload)
+		require.NoError(t, err)
+		var ended pb.AuctionEnded
+		require.NoError(t, proto.Unmarshal(payload, &ended))
+		assert.Equal(t, item.Id, ended.ItemId)
+		assert.Equal(t, sellerID.String(), ended.SellerId)
+		assert.Equal(t, buyerID.String(), ended.WinnerId)
+		assert.Equal(t, res.Bid.Id, ended.WinningBidId)
+		assert.Equal(t, int64(5000), ended.Amount)
+		assert.True(t, ended.BuyNow)
+
+		// The outbid bidder is notified like for any other bid
+		var placed pb.BidPlaced
+		err = pool.QueryRow(ctx, `SELECT payload FROM outbox_events WHERE event_type = 'bid.placed' ORDER BY created_at DESC LIMIT 1`).Scan(&payload)
+		require.NoError(t, err)
+		require.NoError(t, proto.Unmarshal(payload, &placed))
+		assert.Equal(t, bidderID.String(), placed.PreviousHighestBidderId)
+
+		// The auction is over for everyone else
+		_, err = buyNow(authConfig.generateTestToken(t, uuid.New()), item.Id)
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	})
+
+	t.Run("bids are rejected after a purchase", func(t *testing.T) {
+		item := createItem(t, 5000)
+		buyerID := uuid.New()
+
+		_, err := buyNow(authConfig.generateTestToken(t, buyerID), item.Id)
+		require.NoError(t, err)
+		eventsBefore := countOutboxEvents(t, pool)
+
+		err = tryBid(authConfig.generateTestToken(t, uuid.New()), item.Id, 9000)
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+		stored := getTestItem(t, pool, uuid.MustParse(item.Id))
+		assert.Equal(t, items.ItemStatusEnded, stored.Status)
+		assert.Equal(t, int64(5000), stored.CurrentHighestBid, "the buyer keeps the winning bid")
+		assert.Equal(t, eventsBefore, countOutboxEvents(t, pool))
+	})
+
+	t.Run("bids are rejected on a cancelled item", func(t *testing.T) {
+		item := createItem(t, 5000)
+
+		req := connect.NewRequest(&bidsv1.CancelItemRequest{Id: item.Id})
+		req.Header().Set("Authorization", "Bearer "+sellerToken)
+		_, err := client.CancelItem(ctx, req)
+		require.NoError(t, err)
+
+		err = tryBid(authConfig.generateTestToken(t, uuid.New()), item.Id, 2000)
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+		assert.Zero(t, getTestItem(t, pool, uuid.MustParse(item.Id)).CurrentHighestBid)
+	})
+
+	t.Run("rejected once bids reach the buy-now price", func(t *testing.T) {
+		item := createItem(t, 5000)
+		placeBid(authConfig.generateTestToken(t, uuid.New()), item.Id, 6000)
+
+		_, err := buyNow(authConfig.generateTestToken(t, uuid.New()), item.Id)
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+		stored := getTestItem(t, pool, uuid.MustParse(item.Id))
+		assert.Equal(t, items.ItemStatusActive, stored.Status)
+		assert.Equal(t, int64(6000), stored.CurrentHighestBid)
+	})
+
+	t.Run("items without a buy-now price", func(t *testing.T) {
+		item := createItem(t, 0)
+
+		_, err := buyNow(authConfig.generateTestToken(t, uuid.New()), item.Id)
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	})
+
+	t.Run("seller cannot buy their own item", func(t *testing.T) {
+		item := createItem(t, 5000)
+
+		_, err := buyNow(sellerToken, item.Id)
+		require.Error(t, err)
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	})
+
+	t.Run("unknown item", func(t *testing.T) {
+		_, err := buyNow(authConfig.generateTestToken(t, uuid.New()), uuid.NewString())
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	})
+}
@@ -0,0 +1,69 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	bidsv1 "github.com/floroz/gavel/pkg/proto/bids/v1"
+	"github.com/floroz/gavel/pkg/testhelpers"
+)
+
+func TestAPI_Categories(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+
+	client, _, authConfig := setupBidApp(t, testDB.Pool)
+	token := authConfig.generateTestToken(t, uuid.New())
+	ctx := context.Background()
+
+	createItem := func(category string) error {
+		r := connect.NewRequest(&bidsv1.CreateItemRequest{
+			Title:      "Categorized Item",
+			StartPrice: 1000,
+			EndAt:      time.Now().Add(48 * time.Hour).Format(time.RFC3339),
+			Category:   category,
+		})
+		r.Header().Set("Authorization", "Bearer "+token)
+		_, err := client.CreateItem(ctx, r)
+		return err
+	}
+
+	t.Run("ListCategories returns the seeded set without auth", func(t *testing.T) {
+		resp, err := client.ListCategories(ctx, connect.NewRequest(&bidsv1.ListCategoriesRequest{}))
+		require.NoError(t, err)
+
+		slugs := make([]string, len(resp.Msg.Categories))
+		for i, c := range resp.Msg.Categories {
+			slugs[i] = c.Slug
+			assert.NotEmpty(t, c.Name)
+		}
+		assert.Equal(t, []string{
+			"electronics", "collectibles", "art", "jewelry", "fashion", "home-garden",
+			"sports", "toys", "books", "music", "vehicles", "other",
+		}, slugs)
+	})
+
+	t.Run("accepts a managed category", func(t *testing.T) {
+		require.NoError(t, createItem("home-garden"))
+	})
+
+	t.Run("accepts no category", func(t *testing.T) {
+		require.NoError(t, createItem(""))
+	})
+
+	t.Run("rejects an unknown category", func(t *testing.T) {
+		for _, category := range []string{"electronic", "Electronics", "made-up"} {
+			err := createItem(category)
+			require.Error(t, err, category)
+			assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), category)
+		}
+	})
+}
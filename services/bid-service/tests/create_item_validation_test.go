@@ -0,0 +1,99 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	bidsv1 "github.com/floroz/gavel/pkg/proto/bids/v1"
+	"github.com/floroz/gavel/pkg/testhelpers"
+	"github.com/floroz/gavel/pkg/validation"
+)
+
+func TestAPI_CreateItem_FieldViolations(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+
+	client, _, authConfig := setupBidApp(t, testDB.Pool)
+	token := authConfig.generateTestToken(t, uuid.New())
+
+	valid := func() *bidsv1.CreateItemRequest {
+		return &bidsv1.CreateItemRequest{
+			Title:      "Validated Item",
+			StartPrice: 1000,
+			EndAt:      time.Now().Add(24 * time.Hour).Format(time.RFC3339),
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*bidsv1.CreateItemRequest)
+		field  string
+	}{
+		{"start price", func(r *bidsv1.CreateItemRequest) { r.StartPrice = 0 }, "start_price"},
+		{"buy-now price", func(r *bidsv1.CreateItemRequest) { r.BuyNowPrice = 500 }, "buy_now_price"},
+		{"end in the past", func(r *bidsv1.CreateItemRequest) {
+			r.EndAt = time.Now().Add(-time.Hour).Format(time.RFC3339)
+		}, "end_at"},
+		{"malformed end", func(r *bidsv1.CreateItemRequest) { r.EndAt = "tomorrow" }, "end_at"},
+		{"start after end", func(r *bidsv1.CreateItemRequest) {
+			r.StartAt = time.Now().Add(48 * time.Hour).Format(time.RFC3339)
+		}, "start_at"},
+		{"timezone", func(r *bidsv1.CreateItemRequest) { r.EndAtTimezone = "Not/A_Zone" }, "end_at_timezone"},
+	}
+
+	for _, tt := range tests {
+		for _, validateOnly := range []bool{false, true} {
+			name := tt.name
+			if validateOnly {
+				name += " (validate only)"
+			}
+			t.Run(name, func(t *testing.T) {
+				msg := valid()
+				tt.modify(msg)
+				msg.ValidateOnly = validateOnly
+
+				req := connect.NewRequest(msg)
+				req.Header().Set("Authorization", "Bearer "+token)
+				_, err := client.CreateItem(context.Background(), req)
+				require.Error(t, err)
+				assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+				violations := validation.FieldViolations(err)
+				require.Len(t, violations, 1, "got %v", violations)
+				assert.Contains(t, violations, tt.field)
+			})
+		}
+	}
+}
+
+func TestAPI_CreateItem_ValidateOnly(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+
+	client, pool, authConfig := setupBidApp(t, testDB.Pool)
+	ctx := context.Background()
+
+	req := connect.NewRequest(&bidsv1.CreateItemRequest{
+		Title:        "Dry Run Item",
+		StartPrice:   1000,
+		EndAt:        time.Now().Add(24 * time.Hour).Format(time.RFC3339),
+		ValidateOnly: true,
+	})
+	req.Header().Set("Authorization", "Bearer "+authConfig.generateTestToken(t, uuid.New()))
+	res, err := client.CreateItem(ctx, req)
+	require.NoError(t, err)
+	assert.Empty(t, res.Msg.Item.Id)
+	assert.Equal(t, "Dry Run Item", res.Msg.Item.Title)
+
+	var count int
+	require.NoError(t, pool.QueryRow(ctx, `SELECT COUNT(*) FROM items`).Scan(&count))
+	assert.Zero(t, count, "a dry run must not write the item")
+}
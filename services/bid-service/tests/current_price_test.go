@@ -0,0 +1,108 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/floroz/gavel/pkg/database"
+	"github.com/floroz/gavel/pkg/testhelpers"
+	"github.com/floroz/gavel/services/bid-service/internal/adapters/cache"
+	infradb "github.com/floroz/gavel/services/bid-service/internal/adapters/database"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/bids"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/items"
+)
+
+func TestCurrentPrice_RedisFastRead(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+	pool := testDB.Pool
+	rdb := testhelpers.NewTestRedis(t)
+	ctx := context.Background()
+
+	auctionService := bids.NewAuctionService(
+		database.NewPostgresTransactionManager(pool, 5*time.Second),
+		infradb.NewPostgresBidRepository(pool, database.DefaultQueryTimeout),
+		infradb.NewPostgresItemRepository(pool, database.DefaultQueryTimeout),
+		infradb.NewPostgresOutboxRepository(pool, database.DefaultQueryTimeout),
+		cache.NewRedisPriceCache(rdb, time.Minute),
+		nil,
+		bids.DefaultMaxBidAmount,
+		nil,
+		bids.AntiSniping{},
+		nil,
+	)
+
+	seed := func(t *testing.T, currentHighest int64) uuid.UUID {
+		t.Helper()
+		itemID := uuid.New()
+		seedTestItem(t, pool, &items.Item{
+			ID:                itemID,
+			Title:             "Priced Item",
+			StartPrice:        1000,
+			CurrentHighestBid: currentHighest,
+			EndAt:             time.Now().Add(1 * time.Hour),
+			CreatedAt:         time.Now(),
+			UpdatedAt:         time.Now(),
+			Images:            []string{},
+			Category:          "test",
+			SellerID:          uuid.New(),
+			Status:            items.ItemStatusActive,
+		})
+		return itemID
+	}
+
+	t.Run("PlaceBid populates the cache after commit", func(t *testing.T) {
+		itemID := seed(t, 0)
+
+		_, _, err := auctionService.PlaceBid(ctx, bids.PlaceBidCommand{
+			ItemID: itemID,
+			UserID: uuid.New(),
+			Amount: 1500,
+		})
+		require.NoError(t, err)
+
+		cached, err := rdb.Get(ctx, cache.PriceKey(itemID)).Int64()
+		require.NoError(t, err)
+		assert.Equal(t, int64(1500), cached)
+
+		price, err := auctionService.GetCurrentPrice(ctx, itemID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1500), price)
+	})
+
+	t.Run("Cache miss falls back to Postgres and repopulates", func(t *testing.T) {
+		itemID := seed(t, 4200)
+
+		exists, err := rdb.Exists(ctx, cache.PriceKey(itemID)).Result()
+		require.NoError(t, err)
+		require.Equal(t, int64(0), exists)
+
+		price, err := auctionService.GetCurrentPrice(ctx, itemID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(4200), price)
+
+		cached, err := rdb.Get(ctx, cache.PriceKey(itemID)).Int64()
+		require.NoError(t, err)
+		assert.Equal(t, int64(4200), cached)
+	})
+
+	t.Run("Stale writes never lower the cached price", func(t *testing.T) {
+		itemID := seed(t, 0)
+		priceCache := cache.NewRedisPriceCache(rdb, time.Minute)
+
+		require.NoError(t, priceCache.SetCurrentPrice(ctx, itemID, 3000))
+		require.NoError(t, priceCache.SetCurrentPrice(ctx, itemID, 2000))
+
+		price, found, err := priceCache.GetCurrentPrice(ctx, itemID)
+		require.NoError(t, err)
+		assert.True(t, found)
+		assert.Equal(t, int64(3000), price)
+	})
+}
@@ -0,0 +1,107 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pkgdb "github.com/floroz/gavel/pkg/database"
+	bidsv1 "github.com/floroz/gavel/pkg/proto/bids/v1"
+	"github.com/floroz/gavel/pkg/testhelpers"
+	"github.com/floroz/gavel/services/bid-service/internal/adapters/database"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/items"
+)
+
+func TestListItemsEndingSoon(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+
+	client, pool, _ := setupBidApp(t, testDB.Pool)
+	repo := database.NewPostgresItemRepository(pool, pkgdb.DefaultQueryTimeout)
+	ctx := context.Background()
+
+	seed := func(title string, endsIn time.Duration, status items.ItemStatus) *items.Item {
+		item := &items.Item{
+			ID:         uuid.New(),
+			Title:      title,
+			StartPrice: 1000,
+			EndAt:      time.Now().Add(endsIn),
+			CreatedAt:  time.Now(),
+			UpdatedAt:  time.Now(),
+			Images:     []string{},
+			Category:   "test",
+			SellerID:   uuid.New(),
+			Status:     status,
+		}
+		seedTestItem(t, pool, item)
+		return item
+	}
+
+	in45m := seed("45 minutes", 45*time.Minute, items.ItemStatusActive)
+	in10m := seed("10 minutes", 10*time.Minute, items.ItemStatusActive)
+	in30m := seed("30 minutes", 30*time.Minute, items.ItemStatusActive)
+	seed("Tomorrow", 24*time.Hour, items.ItemStatusActive)
+	seed("Already over", -5*time.Minute, items.ItemStatusActive)
+	seed("Cancelled", 20*time.Minute, items.ItemStatusCancelled)
+	seed("Ended", 25*time.Minute, items.ItemStatusEnded)
+
+	titles := func(list []*items.Item) []string {
+		out := make([]string, len(list))
+		for i, item := range list {
+			out[i] = item.Title
+		}
+		return out
+	}
+
+	t.Run("repository returns active items in the window, soonest first", func(t *testing.T) {
+		got, err := repo.ListItemsEndingSoon(ctx, time.Hour, 10, 0)
+		require.NoError(t, err)
+		assert.Equal(t, []string{in10m.Title, in30m.Title, in45m.Title}, titles(got))
+
+		got, err = repo.ListItemsEndingSoon(ctx, 15*time.Minute, 10, 0)
+		require.NoError(t, err)
+		assert.Equal(t, []string{in10m.Title}, titles(got))
+	})
+
+	t.Run("repository pages", func(t *testing.T) {
+		got, err := repo.ListItemsEndingSoon(ctx, time.Hour, 2, 1)
+		require.NoError(t, err)
+		assert.Equal(t, []string{in30m.Title, in45m.Title}, titles(got))
+	})
+
+	t.Run("rpc pages through the feed", func(t *testing.T) {
+		res, err := client.ListEndingSoon(ctx, connect.NewRequest(&bidsv1.ListEndingSoonRequest{PageSize: 2}))
+		require.NoError(t, err)
+		require.Len(t, res.Msg.Items, 2)
+		assert.Equal(t, in10m.ID.String(), res.Msg.Items[0].Id)
+		assert.Equal(t, in30m.ID.String(), res.Msg.Items[1].Id)
+		require.NotEmpty(t, res.Msg.NextPageToken)
+
+		res, err = client.ListEndingSoon(ctx, connect.NewRequest(&bidsv1.ListEndingSoonRequest{
+			PageSize:  2,
+			PageToken: res.Msg.NextPageToken,
+		}))
+		require.NoError(t, err)
+		require.Len(t, res.Msg.Items, 1)
+		assert.Equal(t, in45m.ID.String(), res.Msg.Items[0].Id)
+		assert.Empty(t, res.Msg.NextPageToken)
+	})
+
+	t.Run("rpc honours the requested window", func(t *testing.T) {
+		res, err := client.ListEndingSoon(ctx, connect.NewRequest(&bidsv1.ListEndingSoonRequest{WithinSeconds: 20 * 60}))
+		require.NoError(t, err)
+		require.Len(t, res.Msg.Items, 1)
+		assert.Equal(t, in10m.ID.String(), res.Msg.Items[0].Id)
+
+		_, err = client.ListEndingSoon(ctx, connect.NewRequest(&bidsv1.ListEndingSoonRequest{WithinSeconds: -1}))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+}
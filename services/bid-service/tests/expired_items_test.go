@@ -0,0 +1,101 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pkgdb "github.com/floroz/gavel/pkg/database"
+	"github.com/floroz/gavel/pkg/testhelpers"
+	"github.com/floroz/gavel/services/bid-service/internal/adapters/database"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/items"
+)
+
+func TestItemRepository_MarkExpiredItemsEnded(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+
+	pool := testDB.Pool
+	repo := database.NewPostgresItemRepository(pool, pkgdb.DefaultQueryTimeout)
+	ctx := context.Background()
+	now := time.Now()
+
+	seed := func(endAt time.Time, status items.ItemStatus) uuid.UUID {
+		item := &items.Item{
+			ID:         uuid.New(),
+			Title:      "Closing Item",
+			StartPrice: 100,
+			EndAt:      endAt,
+			CreatedAt:  now,
+			UpdatedAt:  now,
+			Images:     []string{},
+			Category:   "test",
+			SellerID:   uuid.New(),
+			Status:     status,
+		}
+		seedTestItem(t, pool, item)
+		return item.ID
+	}
+
+	// Five expired items, oldest first, plus items the batch must leave alone
+	expired := make([]uuid.UUID, 5)
+	for i := range expired {
+		expired[i] = seed(now.Add(-time.Duration(len(expired)-i)*time.Minute), items.ItemStatusActive)
+	}
+	open := seed(now.Add(time.Hour), items.ItemStatusActive)
+	cancelled := seed(now.Add(-time.Hour), items.ItemStatusCancelled)
+
+	statusOf := func(id uuid.UUID) items.ItemStatus {
+		var status items.ItemStatus
+		require.NoError(t, pool.QueryRow(ctx, `SELECT status FROM items WHERE id = $1`, id).Scan(&status))
+		return status
+	}
+
+	markBatch := func(limit int) []uuid.UUID {
+		tx, err := pool.Begin(ctx)
+		require.NoError(t, err)
+		defer func() { _ = tx.Rollback(ctx) }()
+
+		ended, err := repo.MarkExpiredItemsEnded(ctx, tx, now, limit)
+		require.NoError(t, err)
+		require.NoError(t, tx.Commit(ctx))
+
+		ids := make([]uuid.UUID, len(ended))
+		for i, item := range ended {
+			assert.Equal(t, items.ItemStatusEnded, item.Status)
+			ids[i] = item.ID
+		}
+		return ids
+	}
+
+	t.Run("ends a bounded batch, earliest first", func(t *testing.T) {
+		assert.Equal(t, expired[:2], markBatch(2))
+		assert.Equal(t, items.ItemStatusEnded, statusOf(expired[0]))
+		assert.Equal(t, items.ItemStatusActive, statusOf(expired[2]))
+	})
+
+	t.Run("skips items locked by another transaction", func(t *testing.T) {
+		lockTx, err := pool.Begin(ctx)
+		require.NoError(t, err)
+		defer func() { _ = lockTx.Rollback(ctx) }()
+		_, err = lockTx.Exec(ctx, `SELECT id FROM items WHERE id = $1 FOR UPDATE`, expired[2])
+		require.NoError(t, err)
+
+		assert.Equal(t, expired[3:4], markBatch(1))
+		require.NoError(t, lockTx.Rollback(ctx))
+	})
+
+	t.Run("drains the rest and leaves other items alone", func(t *testing.T) {
+		assert.ElementsMatch(t, []uuid.UUID{expired[2], expired[4]}, markBatch(10))
+		assert.Empty(t, markBatch(10))
+
+		assert.Equal(t, items.ItemStatusActive, statusOf(open))
+		assert.Equal(t, items.ItemStatusCancelled, statusOf(cancelled))
+	})
+}
@@ -0,0 +1,106 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/floroz/gavel/pkg/proto"
+	bidsv1 "github.com/floroz/gavel/pkg/proto/bids/v1"
+	"github.com/floroz/gavel/pkg/testhelpers"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/items"
+)
+
+func TestAPI_ExtendAuction(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+
+	client, pool, authConfig := setupBidApp(t, testDB.Pool)
+	ctx := context.Background()
+
+	sellerID := uuid.New()
+	sellerToken := authConfig.generateTestToken(t, sellerID)
+	endAt := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+
+	item := &items.Item{
+		ID:         uuid.New(),
+		Title:      "Extendable Item",
+		StartPrice: 1000,
+		EndAt:      endAt,
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+		Images:     []string{},
+		Category:   "test",
+		SellerID:   sellerID,
+		Status:     items.ItemStatusActive,
+	}
+	seedTestItem(t, pool, item)
+
+	extend := func(token string, newEndAt time.Time) (*bidsv1.ExtendAuctionResponse, error) {
+		req := connect.NewRequest(&bidsv1.ExtendAuctionRequest{
+			ItemId:   item.ID.String(),
+			NewEndAt: newEndAt.Format(time.RFC3339),
+		})
+		req.Header().Set("Authorization", "Bearer "+token)
+		res, err := client.ExtendAuction(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return res.Msg, nil
+	}
+	storedEndAt := func() time.Time {
+		var stored time.Time
+		require.NoError(t, pool.QueryRow(ctx, `SELECT end_at FROM items WHERE id = $1`, item.ID).Scan(&stored))
+		return stored.UTC()
+	}
+
+	t.Run("seller extends the auction", func(t *testing.T) {
+		newEndAt := endAt.Add(48 * time.Hour)
+		res, err := extend(sellerToken, newEndAt)
+		require.NoError(t, err)
+		assert.Equal(t, newEndAt.Format(time.RFC3339), res.Item.EndAt)
+		assert.True(t, newEndAt.Equal(storedEndAt()))
+
+		var payload []byte
+		require.NoError(t, pool.QueryRow(ctx,
+			`SELECT payload FROM outbox_events WHERE event_type = 'auction.extended'`).Scan(&payload))
+		var event pb.AuctionExtended
+		require.NoError(t, proto.Unmarshal(payload, &event))
+		assert.Equal(t, item.ID.String(), event.ItemId)
+		assert.True(t, endAt.Equal(event.PreviousEndAt.AsTime()))
+		assert.True(t, newEndAt.Equal(event.NewEndAt.AsTime()))
+	})
+
+	t.Run("shortening is rejected", func(t *testing.T) {
+		before := storedEndAt()
+		_, err := extend(sellerToken, before.Add(-time.Hour))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+		assert.True(t, before.Equal(storedEndAt()))
+	})
+
+	t.Run("non-owner is rejected", func(t *testing.T) {
+		before := storedEndAt()
+		_, err := extend(authConfig.generateTestToken(t, uuid.New()), before.Add(time.Hour))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+		assert.True(t, before.Equal(storedEndAt()))
+	})
+
+	t.Run("cancelled items cannot be extended", func(t *testing.T) {
+		_, err := pool.Exec(ctx, `UPDATE items SET status = 'cancelled' WHERE id = $1`, item.ID)
+		require.NoError(t, err)
+
+		_, err = extend(sellerToken, storedEndAt().Add(time.Hour))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	})
+}
@@ -0,0 +1,71 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/floroz/gavel/pkg/flags"
+	bidsv1 "github.com/floroz/gavel/pkg/proto/bids/v1"
+	"github.com/floroz/gavel/pkg/testhelpers"
+)
+
+func TestAPI_BuyNowFeatureFlag(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+
+	client, _, authConfig := setupBidApp(t, testDB.Pool)
+	ctx := context.Background()
+
+	sellerToken := authConfig.generateTestToken(t, uuid.New())
+	buyerToken := authConfig.generateTestToken(t, uuid.New())
+
+	createReq := connect.NewRequest(&bidsv1.CreateItemRequest{
+		Title:       "Flagged Item",
+		StartPrice:  1000,
+		BuyNowPrice: 5000,
+		EndAt:       time.Now().Add(48 * time.Hour).Format(time.RFC3339),
+	})
+	createReq.Header().Set("Authorization", "Bearer "+sellerToken)
+	created, err := client.CreateItem(ctx, createReq)
+	require.NoError(t, err)
+	itemID := created.Msg.Item.Id
+
+	buyNow := func() (*connect.Response[bidsv1.BuyNowResponse], error) {
+		req := connect.NewRequest(&bidsv1.BuyNowRequest{ItemId: itemID})
+		req.Header().Set("Authorization", "Bearer "+buyerToken)
+		return client.BuyNow(ctx, req)
+	}
+
+	t.Run("flagged off returns Unimplemented", func(t *testing.T) {
+		t.Setenv(flags.EnvPrefix+"BUY_NOW", "false")
+
+		_, err := buyNow()
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err))
+	})
+
+	t.Run("unset counts as off", func(t *testing.T) {
+		t.Setenv(flags.EnvPrefix+"BUY_NOW", "")
+
+		_, err := buyNow()
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err))
+	})
+
+	t.Run("flagged on buys the item", func(t *testing.T) {
+		t.Setenv(flags.EnvPrefix+"BUY_NOW", "true")
+
+		res, err := buyNow()
+		require.NoError(t, err)
+		assert.Equal(t, int64(5000), res.Msg.Bid.Amount)
+		assert.Equal(t, bidsv1.ItemStatus_ITEM_STATUS_ENDED, res.Msg.Item.Status)
+	})
+}
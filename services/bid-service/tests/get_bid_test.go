@@ -0,0 +1,98 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	bidsv1 "github.com/floroz/gavel/pkg/proto/bids/v1"
+	"github.com/floroz/gavel/pkg/testhelpers"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/items"
+)
+
+func TestAPI_GetBid(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+
+	client, pool, authConfig := setupBidApp(t, testDB.Pool)
+	ctx := context.Background()
+
+	sellerID := uuid.New()
+	bidderID := uuid.New()
+	item := &items.Item{
+		ID:                uuid.New(),
+		Title:             "Bid Lookup Item",
+		StartPrice:        1000,
+		CurrentHighestBid: 0,
+		EndAt:             time.Now().Add(24 * time.Hour),
+		CreatedAt:         time.Now(),
+		UpdatedAt:         time.Now(),
+		Images:            []string{},
+		Category:          "test",
+		SellerID:          sellerID,
+		Status:            items.ItemStatusActive,
+	}
+	seedTestItem(t, pool, item)
+
+	// Place a bid through the API so the stored row matches production writes
+	placeReq := connect.NewRequest(&bidsv1.PlaceBidRequest{ItemId: item.ID.String(), Amount: 1500})
+	placeReq.Header().Set("Authorization", "Bearer "+authConfig.generateTestToken(t, bidderID))
+	placed, err := client.PlaceBid(ctx, placeReq)
+	require.NoError(t, err)
+	bidID := placed.Msg.Bid.Id
+
+	getBid := func(bidID string, userID uuid.UUID) (*connect.Response[bidsv1.GetBidResponse], error) {
+		r := connect.NewRequest(&bidsv1.GetBidRequest{BidId: bidID})
+		r.Header().Set("Authorization", "Bearer "+authConfig.generateTestToken(t, userID))
+		return client.GetBid(ctx, r)
+	}
+
+	t.Run("bidder can view their bid", func(t *testing.T) {
+		resp, err := getBid(bidID, bidderID)
+		require.NoError(t, err)
+
+		bid := resp.Msg.Bid
+		assert.Equal(t, bidID, bid.Id)
+		assert.Equal(t, item.ID.String(), bid.ItemId)
+		assert.Equal(t, bidderID.String(), bid.UserId)
+		assert.Equal(t, int64(1500), bid.Amount)
+		assert.NotEmpty(t, bid.CreatedAt)
+	})
+
+	t.Run("seller can view bids on their item", func(t *testing.T) {
+		resp, err := getBid(bidID, sellerID)
+		require.NoError(t, err)
+		assert.Equal(t, bidID, resp.Msg.Bid.Id)
+	})
+
+	t.Run("other users are denied", func(t *testing.T) {
+		_, err := getBid(bidID, uuid.New())
+		require.Error(t, err)
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	})
+
+	t.Run("unknown bid returns not found", func(t *testing.T) {
+		_, err := getBid(uuid.New().String(), bidderID)
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	})
+
+	t.Run("malformed bid id returns invalid argument", func(t *testing.T) {
+		_, err := getBid("not-a-uuid", bidderID)
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+
+	t.Run("requires authentication", func(t *testing.T) {
+		_, err := client.GetBid(ctx, connect.NewRequest(&bidsv1.GetBidRequest{BidId: bidID}))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	})
+}
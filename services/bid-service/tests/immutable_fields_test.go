@@ -0,0 +1,87 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	bidsv1 "github.com/floroz/gavel/pkg/proto/bids/v1"
+	"github.com/floroz/gavel/pkg/testhelpers"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/items"
+)
+
+func TestAPI_UpdateItem_ImmutableFields(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+
+	client, pool, authConfig := setupBidApp(t, testDB.Pool)
+	ctx := context.Background()
+
+	sellerID := uuid.New()
+	item := &items.Item{
+		ID:         uuid.New(),
+		Title:      "Fixed Terms Item",
+		StartPrice: 1000,
+		EndAt:      time.Now().Add(24 * time.Hour),
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+		Images:     []string{},
+		Category:   "test",
+		SellerID:   sellerID,
+		Status:     items.ItemStatusActive,
+	}
+	seedTestItem(t, pool, item)
+	token := authConfig.generateTestToken(t, sellerID)
+
+	// A client built against a proto where UpdateItemRequest grew, say, start_price and
+	// status sends them as fields this server doesn't define
+	withExtraFields := func(msg *bidsv1.UpdateItemRequest) *bidsv1.UpdateItemRequest {
+		var raw []byte
+		raw = protowire.AppendTag(raw, 100, protowire.VarintType)
+		raw = protowire.AppendVarint(raw, 1)
+		raw = protowire.AppendTag(raw, 101, protowire.BytesType)
+		raw = protowire.AppendString(raw, "ended")
+		msg.ProtoReflect().SetUnknown(raw)
+		return msg
+	}
+
+	t.Run("rejects fields outside the editable set", func(t *testing.T) {
+		title := "Sneaky Edit"
+		req := connect.NewRequest(withExtraFields(&bidsv1.UpdateItemRequest{Id: item.ID.String(), Title: &title}))
+		req.Header().Set("Authorization", "Bearer "+token)
+
+		_, err := client.UpdateItem(ctx, req)
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+		// Nothing is applied, not even the editable part of the request
+		stored := getTestItem(t, pool, item.ID)
+		assert.Equal(t, "Fixed Terms Item", stored.Title)
+		assert.Equal(t, int64(1000), stored.StartPrice)
+		assert.Equal(t, items.ItemStatusActive, stored.Status)
+	})
+
+	t.Run("accepts every editable field", func(t *testing.T) {
+		title, description, category, tz := "New Title", "New Description", "art", "Europe/Paris"
+		req := connect.NewRequest(&bidsv1.UpdateItemRequest{
+			Id:            item.ID.String(),
+			Title:         &title,
+			Description:   &description,
+			Images:        []string{"a.jpg"},
+			Category:      &category,
+			EndAtTimezone: &tz,
+		})
+		req.Header().Set("Authorization", "Bearer "+token)
+
+		_, err := client.UpdateItem(ctx, req)
+		require.NoError(t, err)
+	})
+}
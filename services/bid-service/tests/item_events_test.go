@@ -0,0 +1,195 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/floroz/gavel/pkg/database"
+	"github.com/floroz/gavel/pkg/events"
+	pb "github.com/floroz/gavel/pkg/proto"
+	"github.com/floroz/gavel/pkg/testhelpers"
+	infradb "github.com/floroz/gavel/services/bid-service/internal/adapters/database"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/items"
+)
+
+// outboxPayloads returns the payloads of every outbox event of eventType, oldest first
+func outboxPayloads(t *testing.T, pool *pgxpool.Pool, eventType string) [][]byte {
+	t.Helper()
+	rows, err := pool.Query(context.Background(),
+		`SELECT payload FROM outbox_events WHERE event_type = $1 ORDER BY created_at`, eventType)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	var payloads [][]byte
+	for rows.Next() {
+		var payload []byte
+		require.NoError(t, rows.Scan(&payload))
+		payloads = append(payloads, payload)
+	}
+	require.NoError(t, rows.Err())
+	return payloads
+}
+
+func TestItemService_WritesItemEvents(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+
+	pool := testDB.Pool
+	ctx := context.Background()
+	itemService := newItemService(pool, 0)
+	sellerID := uuid.New()
+
+	item, err := itemService.CreateItem(ctx, items.CreateItemCommand{
+		Title:       "Evented Item",
+		Description: "Listed with an event",
+		StartPrice:  1000,
+		BuyNowPrice: 4000,
+		EndAt:       time.Now().Add(24 * time.Hour),
+		Images:      []string{"https://example.com/a.jpg"},
+		SellerID:    sellerID,
+	})
+	require.NoError(t, err)
+
+	t.Run("create writes item.created", func(t *testing.T) {
+		payloads := outboxPayloads(t, pool, "item.created")
+		require.Len(t, payloads, 1)
+
+		var created pb.ItemCreated
+		require.NoError(t, proto.Unmarshal(payloads[0], &created))
+		assert.Equal(t, sellerID.String(), created.SellerId)
+		assert.Equal(t, "Evented Item", created.Title)
+		assert.Equal(t, "Listed with an event", created.Description)
+		assert.Equal(t, int64(1000), created.StartPrice)
+		assert.Equal(t, int64(4000), created.BuyNowPrice)
+		assert.True(t, item.EndAt.Equal(created.EndAt.AsTime()))
+		assert.Equal(t, string(items.ItemStatusActive), created.Status)
+		assert.Equal(t, []string{"https://example.com/a.jpg"}, created.Images)
+	})
+
+	t.Run("update writes item.updated", func(t *testing.T) {
+		_, err := itemService.UpdateItem(ctx, items.UpdateItemCommand{
+			ItemID:      item.ID,
+			UserID:      sellerID,
+			Title:       "Renamed Item",
+			Description: "Edited",
+			Images:      item.Images,
+		})
+		require.NoError(t, err)
+
+		payloads := outboxPayloads(t, pool, "item.updated")
+		require.Len(t, payloads, 1)
+
+		var updated pb.ItemUpdated
+		require.NoError(t, proto.Unmarshal(payloads[0], &updated))
+		assert.Equal(t, sellerID.String(), updated.SellerId)
+		assert.Equal(t, "Renamed Item", updated.Title)
+		assert.Equal(t, "Edited", updated.Description)
+		assert.Equal(t, []string{"https://example.com/a.jpg"}, updated.Images)
+	})
+
+	t.Run("rejected update writes nothing", func(t *testing.T) {
+		_, err := itemService.UpdateItem(ctx, items.UpdateItemCommand{ItemID: item.ID, UserID: uuid.New(), Title: "Hijacked"})
+		require.ErrorIs(t, err, items.ErrUnauthorized)
+		assert.Len(t, outboxPayloads(t, pool, "item.updated"), 1)
+	})
+
+	t.Run("cancel writes item.cancelled", func(t *testing.T) {
+		_, err := itemService.CancelItem(ctx, items.CancelItemCommand{ItemID: item.ID, UserID: sellerID})
+		require.NoError(t, err)
+
+		payloads := outboxPayloads(t, pool, "item.cancelled")
+		require.Len(t, payloads, 1)
+
+		var cancelled pb.ItemCancelled
+		require.NoError(t, proto.Unmarshal(payloads[0], &cancelled))
+		assert.Equal(t, sellerID.String(), cancelled.SellerId)
+		assert.NotNil(t, cancelled.Timestamp)
+	})
+}
+
+// failAfterSaveOutbox writes each event and then fails, as if the transaction broke
+// between the event and the commit
+type failAfterSaveOutbox struct {
+	items.OutboxRepository
+}
+
+func (o failAfterSaveOutbox) SaveEvent(ctx context.Context, tx pgx.Tx, event *events.OutboxEvent) error {
+	if err := o.OutboxRepository.SaveEvent(ctx, tx, event); err != nil {
+		return err
+	}
+	return errors.New("outbox unavailable")
+}
+
+func TestItemService_ItemAndEventCommitTogether(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+
+	pool := testDB.Pool
+	ctx := context.Background()
+	txManager := database.NewPostgresTransactionManager(pool, 5*time.Second)
+	itemRepo := infradb.NewPostgresItemRepository(pool, database.DefaultQueryTimeout)
+	outboxRepo := infradb.NewPostgresOutboxRepository(pool, database.DefaultQueryTimeout)
+	itemService := items.NewService(txManager, itemRepo, outboxRepo, 0, 0, nil)
+	failingService := items.NewService(txManager, itemRepo, failAfterSaveOutbox{outboxRepo}, 0, 0, nil)
+	sellerID := uuid.New()
+
+	createCmd := items.CreateItemCommand{
+		Title:      "Atomic Item",
+		StartPrice: 1000,
+		EndAt:      time.Now().Add(24 * time.Hour),
+		SellerID:   sellerID,
+	}
+	countSellerItems := func(t *testing.T) int {
+		t.Helper()
+		var n int
+		require.NoError(t, pool.QueryRow(ctx, "SELECT COUNT(*) FROM items WHERE seller_id = $1", sellerID).Scan(&n))
+		return n
+	}
+
+	t.Run("failed create leaves neither item nor event", func(t *testing.T) {
+		_, err := failingService.CreateItem(ctx, createCmd)
+		require.Error(t, err)
+		assert.Equal(t, 0, countSellerItems(t))
+		assert.Equal(t, 0, countOutboxEvents(t, pool))
+	})
+
+	item, err := itemService.CreateItem(ctx, createCmd)
+	require.NoError(t, err)
+
+	t.Run("successful create commits both", func(t *testing.T) {
+		assert.Equal(t, 1, countSellerItems(t))
+		assert.Len(t, outboxPayloads(t, pool, "item.created"), 1)
+	})
+
+	t.Run("failed update leaves item and outbox unchanged", func(t *testing.T) {
+		_, err := failingService.UpdateItem(ctx, items.UpdateItemCommand{ItemID: item.ID, UserID: sellerID, Title: "Renamed"})
+		require.Error(t, err)
+
+		stored, err := itemRepo.GetItemByID(ctx, item.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Atomic Item", stored.Title)
+		assert.Empty(t, outboxPayloads(t, pool, "item.updated"))
+	})
+
+	t.Run("failed cancel leaves item active", func(t *testing.T) {
+		_, err := failingService.CancelItem(ctx, items.CancelItemCommand{ItemID: item.ID, UserID: sellerID})
+		require.Error(t, err)
+
+		stored, err := itemRepo.GetItemByID(ctx, item.ID)
+		require.NoError(t, err)
+		assert.Equal(t, items.ItemStatusActive, stored.Status)
+		assert.Empty(t, outboxPayloads(t, pool, "item.cancelled"))
+		assert.Equal(t, 1, countOutboxEvents(t, pool))
+	})
+}
@@ -0,0 +1,102 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	bidsv1 "github.com/floroz/gavel/pkg/proto/bids/v1"
+	"github.com/floroz/gavel/pkg/testhelpers"
+	"github.com/floroz/gavel/pkg/validation"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/items"
+)
+
+func TestAPI_ItemReadMask(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+
+	client, pool, _ := setupBidApp(t, testDB.Pool)
+	ctx := context.Background()
+
+	item := &items.Item{
+		ID:                uuid.New(),
+		Title:             "Masked Item",
+		Description:       "A long description mobile clients skip",
+		StartPrice:        1000,
+		CurrentHighestBid: 1500,
+		EndAt:             time.Now().Add(time.Hour),
+		CreatedAt:         time.Now(),
+		UpdatedAt:         time.Now(),
+		Images:            []string{"https://example.com/a.jpg"},
+		Category:          "test",
+		SellerID:          uuid.New(),
+		Status:            items.ItemStatusActive,
+	}
+	seedTestItem(t, pool, item)
+
+	t.Run("GetItem returns only the requested fields", func(t *testing.T) {
+		res, err := client.GetItem(ctx, connect.NewRequest(&bidsv1.GetItemRequest{
+			Id:       item.ID.String(),
+			ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"id", "title", "current_highest_bid"}},
+		}))
+		require.NoError(t, err)
+
+		got := res.Msg.Item
+		assert.Equal(t, item.ID.String(), got.Id)
+		assert.Equal(t, "Masked Item", got.Title)
+		assert.Equal(t, int64(1500), got.CurrentHighestBid)
+		assert.Empty(t, got.Description)
+		assert.Empty(t, got.Images)
+		assert.Empty(t, got.EndAt)
+		assert.Zero(t, got.StartPrice)
+		assert.Zero(t, got.SecondsRemaining)
+		assert.Equal(t, bidsv1.ItemStatus_ITEM_STATUS_UNSPECIFIED, got.Status)
+	})
+
+	t.Run("an empty mask returns every field", func(t *testing.T) {
+		res, err := client.GetItem(ctx, connect.NewRequest(&bidsv1.GetItemRequest{
+			Id:       item.ID.String(),
+			ReadMask: &fieldmaskpb.FieldMask{},
+		}))
+		require.NoError(t, err)
+
+		got := res.Msg.Item
+		assert.Equal(t, "A long description mobile clients skip", got.Description)
+		assert.Equal(t, int64(1000), got.StartPrice)
+		assert.NotEmpty(t, got.EndAt)
+	})
+
+	t.Run("ListItems masks every item", func(t *testing.T) {
+		res, err := client.ListItems(ctx, connect.NewRequest(&bidsv1.ListItemsRequest{
+			PageSize: 10,
+			ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"id", "title"}},
+		}))
+		require.NoError(t, err)
+		require.NotEmpty(t, res.Msg.Items)
+
+		for _, got := range res.Msg.Items {
+			assert.NotEmpty(t, got.Id)
+			assert.NotEmpty(t, got.Title)
+			assert.Empty(t, got.Description)
+			assert.Zero(t, got.StartPrice)
+		}
+	})
+
+	t.Run("unknown fields are rejected", func(t *testing.T) {
+		_, err := client.GetItem(ctx, connect.NewRequest(&bidsv1.GetItemRequest{
+			Id:       item.ID.String(),
+			ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"title", "reserve_price"}},
+		}))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+		assert.Contains(t, validation.FieldViolations(err), "read_mask")
+	})
+}
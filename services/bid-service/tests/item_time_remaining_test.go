@@ -0,0 +1,76 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/floroz/gavel/pkg/clock/clocktest"
+	bidsv1 "github.com/floroz/gavel/pkg/proto/bids/v1"
+	"github.com/floroz/gavel/pkg/testhelpers"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/items"
+)
+
+func TestAPI_GetItem_TimeRemainingAndAuctionState(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+
+	// A stopped clock a day ahead of the wall clock: remaining times are exact, and an
+	// auction can be over for the service while its end time is still in the future
+	clk := clocktest.NewFake(time.Now().Add(24 * time.Hour).Truncate(time.Second))
+	client, pool, _ := setupBidAppWithClock(t, testDB.Pool, clk)
+	ctx := context.Background()
+
+	seed := func(status items.ItemStatus, endAt time.Time) *items.Item {
+		item := &items.Item{
+			ID:         uuid.New(),
+			Title:      "Timed Item",
+			StartPrice: 1000,
+			EndAt:      endAt,
+			CreatedAt:  time.Now(),
+			UpdatedAt:  time.Now(),
+			Images:     []string{},
+			Category:   "test",
+			SellerID:   uuid.New(),
+			Status:     status,
+		}
+		seedTestItem(t, pool, item)
+		return item
+	}
+
+	getItem := func(item *items.Item) *bidsv1.Item {
+		res, err := client.GetItem(ctx, connect.NewRequest(&bidsv1.GetItemRequest{Id: item.ID.String()}))
+		require.NoError(t, err)
+		return res.Msg.Item
+	}
+
+	t.Run("active item counts down", func(t *testing.T) {
+		got := getItem(seed(items.ItemStatusActive, clk.Now().Add(time.Hour)))
+
+		assert.Equal(t, bidsv1.ItemStatus_ITEM_STATUS_ACTIVE, got.AuctionState)
+		assert.Equal(t, int64(3600), got.SecondsRemaining)
+	})
+
+	t.Run("expired item still marked active reads as ended", func(t *testing.T) {
+		// Still in the future by the wall clock, already over by the service's
+		got := getItem(seed(items.ItemStatusActive, clk.Now().Add(-time.Second)))
+
+		assert.Equal(t, bidsv1.ItemStatus_ITEM_STATUS_ACTIVE, got.Status, "the worker has not ended it yet")
+		assert.Equal(t, bidsv1.ItemStatus_ITEM_STATUS_ENDED, got.AuctionState)
+		assert.Zero(t, got.SecondsRemaining)
+	})
+
+	t.Run("cancelled item has no time left", func(t *testing.T) {
+		got := getItem(seed(items.ItemStatusCancelled, clk.Now().Add(time.Hour)))
+
+		assert.Equal(t, bidsv1.ItemStatus_ITEM_STATUS_CANCELLED, got.AuctionState)
+		assert.Zero(t, got.SecondsRemaining)
+	})
+}
@@ -1,7 +1,12 @@
+//go:build integration
+
 package tests
 
 import (
 	"context"
+	"encoding/base64"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -81,6 +86,51 @@ func TestAPI_CreateItem(t *testing.T) {
 		assert.Contains(t, err.Error(), "end time")
 	})
 
+	t.Run("resolves local end time in the seller timezone", func(t *testing.T) {
+		// 18:00 Rome wall-clock time, a year out so it is always in the future
+		localEnd := time.Now().AddDate(1, 0, 0).Format("2006-01-02") + "T18:00:00"
+		req := &bidsv1.CreateItemRequest{
+			Title:         "Zoned Item",
+			StartPrice:    1000,
+			EndAt:         localEnd,
+			EndAtTimezone: "Europe/Rome",
+		}
+
+		r := connect.NewRequest(req)
+		r.Header().Set("Authorization", "Bearer "+token)
+		resp, err := client.CreateItem(ctx, r)
+		require.NoError(t, err)
+
+		rome, err := time.LoadLocation("Europe/Rome")
+		require.NoError(t, err)
+		want, err := time.ParseInLocation("2006-01-02T15:04:05", localEnd, rome)
+		require.NoError(t, err)
+
+		assert.Equal(t, "Europe/Rome", resp.Msg.Item.EndAtTimezone)
+		assert.Equal(t, want.Format(time.RFC3339), resp.Msg.Item.EndAt)
+
+		// Reads echo the same zone
+		got, err := client.GetItem(ctx, connect.NewRequest(&bidsv1.GetItemRequest{Id: resp.Msg.Item.Id}))
+		require.NoError(t, err)
+		assert.Equal(t, want.Format(time.RFC3339), got.Msg.Item.EndAt)
+		assert.Equal(t, "Europe/Rome", got.Msg.Item.EndAtTimezone)
+	})
+
+	t.Run("fails with invalid timezone", func(t *testing.T) {
+		req := &bidsv1.CreateItemRequest{
+			Title:         "Zoned Item",
+			StartPrice:    1000,
+			EndAt:         time.Now().Add(24 * time.Hour).Format(time.RFC3339),
+			EndAtTimezone: "Mars/Olympus_Mons",
+		}
+
+		r := connect.NewRequest(req)
+		r.Header().Set("Authorization", "Bearer "+token)
+		_, err := client.CreateItem(ctx, r)
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+
 	t.Run("fails without authentication", func(t *testing.T) {
 		req := &bidsv1.CreateItemRequest{
 			Title:      "Test Item",
@@ -208,6 +258,71 @@ func TestAPI_ListItems(t *testing.T) {
 			assert.Equal(t, bidsv1.ItemStatus_ITEM_STATUS_ACTIVE, item.Status)
 		}
 	})
+
+	t.Run("pages items sharing a created_at without overlap", func(t *testing.T) {
+		// Seven more items with one timestamp, so only the id orders them
+		createdAt := time.Now().Add(-time.Hour).Truncate(time.Microsecond)
+		for i := 0; i < 7; i++ {
+			seedTestItem(t, pool, &items.Item{
+				ID:         uuid.New(),
+				Title:      "Same Second Item",
+				StartPrice: 1000,
+				EndAt:      time.Now().Add(24 * time.Hour),
+				CreatedAt:  createdAt,
+				UpdatedAt:  createdAt,
+				Images:     []string{},
+				SellerID:   sellerID,
+				Status:     items.ItemStatusActive,
+			})
+		}
+
+		listAll := func() []string {
+			var ids []string
+			pageToken := ""
+			for {
+				resp, err := client.ListItems(ctx, connect.NewRequest(&bidsv1.ListItemsRequest{PageSize: 3, PageToken: pageToken}))
+				require.NoError(t, err)
+				require.LessOrEqual(t, len(resp.Msg.Items), 3)
+				for _, item := range resp.Msg.Items {
+					ids = append(ids, item.Id)
+				}
+				if resp.Msg.NextPageToken == "" {
+					return ids
+				}
+				pageToken = resp.Msg.NextPageToken
+			}
+		}
+
+		first := listAll()
+		assert.Len(t, first, 10)
+		seen := make(map[string]bool, len(first))
+		for _, id := range first {
+			assert.False(t, seen[id], "item %s returned on more than one page", id)
+			seen[id] = true
+		}
+		assert.Equal(t, first, listAll(), "paging must be deterministic")
+	})
+
+	t.Run("rejects a malformed page token", func(t *testing.T) {
+		_, err := client.ListItems(ctx, connect.NewRequest(&bidsv1.ListItemsRequest{PageToken: "not-a-token"}))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+
+	t.Run("rejects an edited page token", func(t *testing.T) {
+		resp, err := client.ListItems(ctx, connect.NewRequest(&bidsv1.ListItemsRequest{PageSize: 3}))
+		require.NoError(t, err)
+		require.NotEmpty(t, resp.Msg.NextPageToken)
+
+		// Move the position but keep the signature issued for the original one
+		_, sig, ok := strings.Cut(resp.Msg.NextPageToken, ".")
+		require.True(t, ok)
+		payload := base64.RawURLEncoding.EncodeToString([]byte(`{"t":"2000-01-01T00:00:00Z","i":"` + uuid.NewString() + `"}`))
+
+		_, err = client.ListItems(ctx, connect.NewRequest(&bidsv1.ListItemsRequest{PageToken: payload + "." + sig}))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
 }
 
 func TestAPI_ListSellerItems(t *testing.T) {
@@ -309,7 +424,7 @@ func TestAPI_UpdateItem(t *testing.T) {
 		token := authConfig.generateTestToken(t, ownerID)
 		newTitle := "Updated Title"
 		newDescription := "Updated Description"
-		newCategory := "new_category"
+		newCategory := "collectibles"
 
 		req := &bidsv1.UpdateItemRequest{
 			Id:          item.ID.String(),
@@ -534,6 +649,115 @@ func TestAPI_GetItemBids(t *testing.T) {
 	})
 }
 
+func TestAPI_GetItemBids_Ordering(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+
+	client, pool, _ := setupBidApp(t, testDB.Pool)
+	ctx := context.Background()
+
+	item := &items.Item{
+		ID:         uuid.New(),
+		Title:      "Leaderboard Item",
+		StartPrice: 1000,
+		EndAt:      time.Now().Add(24 * time.Hour),
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+		Images:     []string{},
+		SellerID:   uuid.New(),
+		Status:     items.ItemStatusActive,
+	}
+	seedTestItem(t, pool, item)
+
+	// Bids placed one second apart; amounts deliberately out of order with a tie
+	amounts := []int64{1500, 1200, 1800, 1200, 1100}
+	ids := make([]string, len(amounts))
+	start := time.Now().Add(-time.Hour).Truncate(time.Second)
+	for i, amount := range amounts {
+		id := uuid.New()
+		ids[i] = id.String()
+		_, err := pool.Exec(ctx, `
+			INSERT INTO bids (id, item_id, user_id, amount, created_at)
+			VALUES ($1, $2, $3, $4, $5)
+		`, id, item.ID, uuid.New(), amount, start.Add(time.Duration(i)*time.Second))
+		require.NoError(t, err)
+	}
+
+	// fetchAll walks every page of pageSize and returns the bid IDs in order
+	fetchAll := func(t *testing.T, order bidsv1.BidOrderBy, pageSize int32) ([]string, int) {
+		t.Helper()
+		var got []string
+		pages := 0
+		token := ""
+		for {
+			resp, err := client.GetItemBids(ctx, connect.NewRequest(&bidsv1.GetItemBidsRequest{
+				ItemId:    item.ID.String(),
+				PageSize:  pageSize,
+				PageToken: token,
+				OrderBy:   order,
+			}))
+			require.NoError(t, err)
+			pages++
+			for _, bid := range resp.Msg.Bids {
+				got = append(got, bid.Id)
+			}
+			if resp.Msg.NextPageToken == "" {
+				return got, pages
+			}
+			token = resp.Msg.NextPageToken
+		}
+	}
+
+	timeOrder := []string{ids[4], ids[3], ids[2], ids[1], ids[0]}
+	amountOrder := []string{ids[2], ids[0], ids[1], ids[3], ids[4]}
+
+	t.Run("defaults to newest first", func(t *testing.T) {
+		got, pages := fetchAll(t, bidsv1.BidOrderBy_BID_ORDER_BY_UNSPECIFIED, 0)
+		assert.Equal(t, timeOrder, got)
+		assert.Equal(t, 1, pages)
+	})
+
+	t.Run("time order paginates", func(t *testing.T) {
+		got, pages := fetchAll(t, bidsv1.BidOrderBy_BID_ORDER_BY_TIME, 2)
+		assert.Equal(t, timeOrder, got)
+		assert.Equal(t, 3, pages)
+	})
+
+	t.Run("amount order paginates with ties broken by time", func(t *testing.T) {
+		got, pages := fetchAll(t, bidsv1.BidOrderBy_BID_ORDER_BY_AMOUNT, 2)
+		assert.Equal(t, amountOrder, got)
+		assert.Equal(t, 3, pages)
+	})
+
+	t.Run("rejects a token from another ordering", func(t *testing.T) {
+		resp, err := client.GetItemBids(ctx, connect.NewRequest(&bidsv1.GetItemBidsRequest{
+			ItemId:   item.ID.String(),
+			PageSize: 2,
+			OrderBy:  bidsv1.BidOrderBy_BID_ORDER_BY_TIME,
+		}))
+		require.NoError(t, err)
+		require.NotEmpty(t, resp.Msg.NextPageToken)
+
+		_, err = client.GetItemBids(ctx, connect.NewRequest(&bidsv1.GetItemBidsRequest{
+			ItemId:    item.ID.String(),
+			PageSize:  2,
+			PageToken: resp.Msg.NextPageToken,
+			OrderBy:   bidsv1.BidOrderBy_BID_ORDER_BY_AMOUNT,
+		}))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+
+	t.Run("rejects a malformed token", func(t *testing.T) {
+		_, err := client.GetItemBids(ctx, connect.NewRequest(&bidsv1.GetItemBidsRequest{
+			ItemId:    item.ID.String(),
+			PageToken: "not-a-token",
+		}))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+}
+
 func TestAPI_SellerCannotBidOnOwnItem(t *testing.T) {
 	testDB := testhelpers.NewTestDatabase(t, "../migrations")
 	defer testDB.Close()
@@ -573,3 +797,95 @@ func TestAPI_SellerCannotBidOnOwnItem(t *testing.T) {
 		assert.Contains(t, err.Error(), "seller cannot bid")
 	})
 }
+
+func TestAPI_RecordItemView(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+
+	client, pool, authConfig := setupBidApp(t, testDB.Pool)
+	ctx := context.Background()
+
+	sellerID := uuid.New()
+	item := &items.Item{
+		ID:                uuid.New(),
+		Title:             "Popular Item",
+		StartPrice:        1000,
+		CurrentHighestBid: 0,
+		EndAt:             time.Now().Add(24 * time.Hour),
+		CreatedAt:         time.Now(),
+		UpdatedAt:         time.Now(),
+		Images:            []string{},
+		SellerID:          sellerID,
+		Status:            items.ItemStatusActive,
+	}
+	seedTestItem(t, pool, item)
+
+	getViews := func(t *testing.T) int64 {
+		t.Helper()
+		resp, err := client.GetItem(ctx, connect.NewRequest(&bidsv1.GetItemRequest{Id: item.ID.String()}))
+		require.NoError(t, err)
+		return resp.Msg.Item.Views
+	}
+
+	t.Run("concurrent views are counted atomically", func(t *testing.T) {
+		const numViews = 50
+		var wg sync.WaitGroup
+		errs := make(chan error, numViews)
+		for i := 0; i < numViews; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := client.RecordItemView(ctx, connect.NewRequest(&bidsv1.RecordItemViewRequest{
+					ItemId: item.ID.String(),
+				}))
+				errs <- err
+			}()
+		}
+		wg.Wait()
+		close(errs)
+
+		for err := range errs {
+			require.NoError(t, err)
+		}
+		assert.Equal(t, int64(numViews), getViews(t))
+	})
+
+	t.Run("views do not wait on the bid lock", func(t *testing.T) {
+		before := getViews(t)
+
+		lockTx, err := pool.Begin(ctx)
+		require.NoError(t, err)
+		defer func() { _ = lockTx.Rollback(ctx) }()
+		_, err = lockTx.Exec(ctx, "SELECT id FROM items WHERE id = $1 FOR NO KEY UPDATE", item.ID)
+		require.NoError(t, err)
+
+		viewCtx, cancel := context.WithTimeout(ctx, 2*time.Second)
+		defer cancel()
+		_, err = client.RecordItemView(viewCtx, connect.NewRequest(&bidsv1.RecordItemViewRequest{
+			ItemId: item.ID.String(),
+		}))
+		require.NoError(t, err)
+		require.NoError(t, lockTx.Rollback(ctx))
+
+		assert.Equal(t, before+1, getViews(t))
+	})
+
+	t.Run("seller views are not counted", func(t *testing.T) {
+		before := getViews(t)
+
+		req := connect.NewRequest(&bidsv1.RecordItemViewRequest{ItemId: item.ID.String()})
+		req.Header().Set("Authorization", "Bearer "+authConfig.generateTestToken(t, sellerID))
+		_, err := client.RecordItemView(ctx, req)
+		require.NoError(t, err)
+
+		assert.Equal(t, before, getViews(t))
+	})
+
+	t.Run("fails with non-existent item", func(t *testing.T) {
+		_, err := client.RecordItemView(ctx, connect.NewRequest(&bidsv1.RecordItemViewRequest{
+			ItemId: uuid.New().String(),
+		}))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	})
+}
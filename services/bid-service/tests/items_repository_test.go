@@ -1,16 +1,20 @@
+//go:build integration
+
 package tests
 
 import (
 	"context"
-	"os"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	pkgdb "github.com/floroz/gavel/pkg/database"
+	"github.com/floroz/gavel/pkg/testhelpers"
 	"github.com/floroz/gavel/services/bid-service/internal/adapters/database"
 	"github.com/floroz/gavel/services/bid-service/internal/domain/items"
 )
@@ -18,29 +22,15 @@ import (
 func setupTestDB(t *testing.T) *pgxpool.Pool {
 	t.Helper()
 
-	dbURL := os.Getenv("BID_DB_URL")
-	if dbURL == "" {
-		t.Skip("BID_DB_URL not set, skipping integration tests")
-	}
-
-	ctx := context.Background()
-	pool, err := pgxpool.New(ctx, dbURL)
-	require.NoError(t, err, "Failed to connect to database")
-
-	// Clean up tables before each test
-	_, err = pool.Exec(ctx, "TRUNCATE items, bids, outbox_events CASCADE")
-	require.NoError(t, err, "Failed to truncate tables")
-
-	t.Cleanup(func() {
-		pool.Close()
-	})
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	t.Cleanup(testDB.Close)
 
-	return pool
+	return testDB.Pool
 }
 
 func TestItemRepository_CreateItem(t *testing.T) {
 	pool := setupTestDB(t)
-	repo := database.NewPostgresItemRepository(pool)
+	repo := database.NewPostgresItemRepository(pool, pkgdb.DefaultQueryTimeout)
 	ctx := context.Background()
 
 	item := &items.Item{
@@ -58,7 +48,7 @@ func TestItemRepository_CreateItem(t *testing.T) {
 		Status:            items.ItemStatusActive,
 	}
 
-	err := repo.CreateItem(ctx, item)
+	err := pgx.BeginFunc(ctx, pool, func(tx pgx.Tx) error { return repo.CreateItem(ctx, tx, item) })
 	require.NoError(t, err, "Failed to create item")
 
 	// Verify item was created
@@ -76,7 +66,7 @@ func TestItemRepository_CreateItem(t *testing.T) {
 
 func TestItemRepository_GetItemByID(t *testing.T) {
 	pool := setupTestDB(t)
-	repo := database.NewPostgresItemRepository(pool)
+	repo := database.NewPostgresItemRepository(pool, pkgdb.DefaultQueryTimeout)
 	ctx := context.Background()
 
 	t.Run("get existing item", func(t *testing.T) {
@@ -93,7 +83,7 @@ func TestItemRepository_GetItemByID(t *testing.T) {
 			Status:      items.ItemStatusActive,
 		}
 
-		err := repo.CreateItem(ctx, item)
+		err := pgx.BeginFunc(ctx, pool, func(tx pgx.Tx) error { return repo.CreateItem(ctx, tx, item) })
 		require.NoError(t, err)
 
 		retrieved, err := repo.GetItemByID(ctx, item.ID)
@@ -112,7 +102,7 @@ func TestItemRepository_GetItemByID(t *testing.T) {
 
 func TestItemRepository_UpdateItem(t *testing.T) {
 	pool := setupTestDB(t)
-	repo := database.NewPostgresItemRepository(pool)
+	repo := database.NewPostgresItemRepository(pool, pkgdb.DefaultQueryTimeout)
 	ctx := context.Background()
 
 	// Create initial item
@@ -130,7 +120,7 @@ func TestItemRepository_UpdateItem(t *testing.T) {
 		Status:      items.ItemStatusActive,
 	}
 
-	err := repo.CreateItem(ctx, item)
+	err := pgx.BeginFunc(ctx, pool, func(tx pgx.Tx) error { return repo.CreateItem(ctx, tx, item) })
 	require.NoError(t, err)
 
 	// Update item
@@ -140,7 +130,7 @@ func TestItemRepository_UpdateItem(t *testing.T) {
 	item.Category = "new_category"
 	item.UpdatedAt = time.Now()
 
-	err = repo.UpdateItem(ctx, item)
+	err = pgx.BeginFunc(ctx, pool, func(tx pgx.Tx) error { return repo.UpdateItem(ctx, tx, item) })
 	require.NoError(t, err)
 
 	// Verify updates
@@ -154,7 +144,7 @@ func TestItemRepository_UpdateItem(t *testing.T) {
 
 func TestItemRepository_UpdateStatus(t *testing.T) {
 	pool := setupTestDB(t)
-	repo := database.NewPostgresItemRepository(pool)
+	repo := database.NewPostgresItemRepository(pool, pkgdb.DefaultQueryTimeout)
 	ctx := context.Background()
 
 	item := &items.Item{
@@ -169,11 +159,11 @@ func TestItemRepository_UpdateStatus(t *testing.T) {
 		Status:    items.ItemStatusActive,
 	}
 
-	err := repo.CreateItem(ctx, item)
+	err := pgx.BeginFunc(ctx, pool, func(tx pgx.Tx) error { return repo.CreateItem(ctx, tx, item) })
 	require.NoError(t, err)
 
 	// Update status to cancelled
-	err = repo.UpdateStatus(ctx, item.ID, items.ItemStatusCancelled)
+	err = pgx.BeginFunc(ctx, pool, func(tx pgx.Tx) error { return repo.UpdateStatus(ctx, tx, item.ID, items.ItemStatusCancelled) })
 	require.NoError(t, err)
 
 	// Verify status was updated
@@ -184,7 +174,7 @@ func TestItemRepository_UpdateStatus(t *testing.T) {
 
 func TestItemRepository_ListActiveItems(t *testing.T) {
 	pool := setupTestDB(t)
-	repo := database.NewPostgresItemRepository(pool)
+	repo := database.NewPostgresItemRepository(pool, pkgdb.DefaultQueryTimeout)
 	ctx := context.Background()
 
 	sellerID := uuid.New()
@@ -202,7 +192,7 @@ func TestItemRepository_ListActiveItems(t *testing.T) {
 			SellerID:   sellerID,
 			Status:     items.ItemStatusActive,
 		}
-		err := repo.CreateItem(ctx, item)
+		err := pgx.BeginFunc(ctx, pool, func(tx pgx.Tx) error { return repo.CreateItem(ctx, tx, item) })
 		require.NoError(t, err)
 	}
 
@@ -218,7 +208,7 @@ func TestItemRepository_ListActiveItems(t *testing.T) {
 		SellerID:   sellerID,
 		Status:     items.ItemStatusActive,
 	}
-	err := repo.CreateItem(ctx, endedItem)
+	err := pgx.BeginFunc(ctx, pool, func(tx pgx.Tx) error { return repo.CreateItem(ctx, tx, endedItem) })
 	require.NoError(t, err)
 
 	// Create cancelled item
@@ -233,11 +223,11 @@ func TestItemRepository_ListActiveItems(t *testing.T) {
 		SellerID:   sellerID,
 		Status:     items.ItemStatusCancelled,
 	}
-	err = repo.CreateItem(ctx, cancelledItem)
+	err = pgx.BeginFunc(ctx, pool, func(tx pgx.Tx) error { return repo.CreateItem(ctx, tx, cancelledItem) })
 	require.NoError(t, err)
 
 	// List active items - should only return 3 active items with future end times
-	activeItems, err := repo.ListActiveItems(ctx, 10, 0)
+	activeItems, err := repo.ListActiveItems(ctx, 10, nil)
 	require.NoError(t, err)
 	assert.Equal(t, 3, len(activeItems))
 	for _, item := range activeItems {
@@ -248,7 +238,7 @@ func TestItemRepository_ListActiveItems(t *testing.T) {
 
 func TestItemRepository_ListItemsBySellerID(t *testing.T) {
 	pool := setupTestDB(t)
-	repo := database.NewPostgresItemRepository(pool)
+	repo := database.NewPostgresItemRepository(pool, pkgdb.DefaultQueryTimeout)
 	ctx := context.Background()
 
 	seller1ID := uuid.New()
@@ -267,7 +257,7 @@ func TestItemRepository_ListItemsBySellerID(t *testing.T) {
 			SellerID:   seller1ID,
 			Status:     items.ItemStatusActive,
 		}
-		err := repo.CreateItem(ctx, item)
+		err := pgx.BeginFunc(ctx, pool, func(tx pgx.Tx) error { return repo.CreateItem(ctx, tx, item) })
 		require.NoError(t, err)
 	}
 
@@ -284,7 +274,7 @@ func TestItemRepository_ListItemsBySellerID(t *testing.T) {
 			SellerID:   seller2ID,
 			Status:     items.ItemStatusActive,
 		}
-		err := repo.CreateItem(ctx, item)
+		err := pgx.BeginFunc(ctx, pool, func(tx pgx.Tx) error { return repo.CreateItem(ctx, tx, item) })
 		require.NoError(t, err)
 	}
 
@@ -307,7 +297,7 @@ func TestItemRepository_ListItemsBySellerID(t *testing.T) {
 
 func TestItemRepository_CountBidsByItemID(t *testing.T) {
 	pool := setupTestDB(t)
-	repo := database.NewPostgresItemRepository(pool)
+	repo := database.NewPostgresItemRepository(pool, pkgdb.DefaultQueryTimeout)
 	ctx := context.Background()
 
 	// Create an item
@@ -322,7 +312,7 @@ func TestItemRepository_CountBidsByItemID(t *testing.T) {
 		SellerID:   uuid.New(),
 		Status:     items.ItemStatusActive,
 	}
-	err := repo.CreateItem(ctx, item)
+	err := pgx.BeginFunc(ctx, pool, func(tx pgx.Tx) error { return repo.CreateItem(ctx, tx, item) })
 	require.NoError(t, err)
 
 	// Count bids (should be 0 initially)
@@ -344,3 +334,41 @@ func TestItemRepository_CountBidsByItemID(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, int64(3), count)
 }
+
+func TestItemRepository_QueryTimeout(t *testing.T) {
+	pool := setupTestDB(t)
+	ctx := context.Background()
+
+	item := &items.Item{
+		ID:         uuid.New(),
+		Title:      "Locked Item",
+		StartPrice: 1000,
+		EndAt:      time.Now().Add(24 * time.Hour),
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+		Images:     []string{},
+		SellerID:   uuid.New(),
+		Status:     items.ItemStatusActive,
+	}
+	seedRepo := database.NewPostgresItemRepository(pool, pkgdb.DefaultQueryTimeout)
+	require.NoError(t, pgx.BeginFunc(ctx, pool, func(tx pgx.Tx) error { return seedRepo.CreateItem(ctx, tx, item) }))
+
+	// Hold the row lock in a separate transaction so the next FOR UPDATE blocks
+	lockTx, err := pool.Begin(ctx)
+	require.NoError(t, err)
+	defer func() { _ = lockTx.Rollback(ctx) }()
+	_, err = lockTx.Exec(ctx, "SELECT id FROM items WHERE id = $1 FOR UPDATE", item.ID)
+	require.NoError(t, err)
+
+	repo := database.NewPostgresItemRepository(pool, 200*time.Millisecond)
+
+	tx, err := pool.Begin(ctx)
+	require.NoError(t, err)
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	start := time.Now()
+	_, err = repo.GetItemByIDForUpdate(ctx, tx, item.ID)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), 2*time.Second, "blocked query should fail fast")
+}
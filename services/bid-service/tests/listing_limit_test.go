@@ -0,0 +1,98 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/floroz/gavel/pkg/testhelpers"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/items"
+)
+
+func TestItemService_ListingLimit(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+
+	pool := testDB.Pool
+	ctx := context.Background()
+	const limit = 3
+	itemService := newItemService(pool, limit)
+
+	sellerID := uuid.New()
+	create := func(sellerID uuid.UUID) (*items.Item, error) {
+		return itemService.CreateItem(ctx, items.CreateItemCommand{
+			Title:      "Limited Listing",
+			StartPrice: 1000,
+			EndAt:      time.Now().Add(24 * time.Hour),
+			SellerID:   sellerID,
+		})
+	}
+
+	listed := make([]*items.Item, limit)
+	for i := range listed {
+		var err error
+		listed[i], err = create(sellerID)
+		require.NoError(t, err)
+	}
+
+	t.Run("blocks the listing past the cap", func(t *testing.T) {
+		_, err := create(sellerID)
+		assert.ErrorIs(t, err, items.ErrListingLimitReached)
+	})
+
+	t.Run("other sellers are unaffected", func(t *testing.T) {
+		_, err := create(uuid.New())
+		assert.NoError(t, err)
+	})
+
+	t.Run("a cancelled listing frees a slot", func(t *testing.T) {
+		_, err := itemService.CancelItem(ctx, items.CancelItemCommand{ItemID: listed[0].ID, UserID: sellerID})
+		require.NoError(t, err)
+
+		_, err = create(sellerID)
+		require.NoError(t, err)
+		_, err = create(sellerID)
+		assert.ErrorIs(t, err, items.ErrListingLimitReached)
+	})
+
+	t.Run("an ended listing frees a slot", func(t *testing.T) {
+		_, err := pool.Exec(ctx, `UPDATE items SET status = 'ended' WHERE id = $1`, listed[1].ID)
+		require.NoError(t, err)
+
+		_, err = create(sellerID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("concurrent creates cannot exceed the cap", func(t *testing.T) {
+		racer := uuid.New()
+		const attempts = 10
+
+		var wg sync.WaitGroup
+		errs := make([]error, attempts)
+		for i := range attempts {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, errs[i] = create(racer)
+			}()
+		}
+		wg.Wait()
+
+		created := 0
+		for _, err := range errs {
+			if err == nil {
+				created++
+			} else {
+				assert.ErrorIs(t, err, items.ErrListingLimitReached)
+			}
+		}
+		assert.Equal(t, limit, created)
+	})
+}
@@ -0,0 +1,174 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	bidsv1 "github.com/floroz/gavel/pkg/proto/bids/v1"
+	"github.com/floroz/gavel/pkg/testhelpers"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/items"
+)
+
+func TestAPI_PauseAndResumeItem(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+
+	client, pool, authConfig := setupBidApp(t, testDB.Pool)
+	ctx := context.Background()
+
+	sellerID := uuid.New()
+	sellerToken := authConfig.generateTestToken(t, sellerID)
+	bidderToken := authConfig.generateTestToken(t, uuid.New())
+
+	newItem := func(t *testing.T) *items.Item {
+		t.Helper()
+		item := &items.Item{
+			ID:         uuid.New(),
+			Title:      "Pausable Item",
+			StartPrice: 1000,
+			EndAt:      time.Now().Add(24 * time.Hour),
+			CreatedAt:  time.Now(),
+			UpdatedAt:  time.Now(),
+			Images:     []string{},
+			Category:   "test",
+			SellerID:   sellerID,
+			Status:     items.ItemStatusActive,
+		}
+		seedTestItem(t, pool, item)
+		return item
+	}
+
+	placeBid := func(itemID uuid.UUID, amount int64) error {
+		req := connect.NewRequest(&bidsv1.PlaceBidRequest{ItemId: itemID.String(), Amount: amount})
+		req.Header().Set("Authorization", "Bearer "+bidderToken)
+		_, err := client.PlaceBid(ctx, req)
+		return err
+	}
+
+	pause := func(itemID uuid.UUID, token string) (*bidsv1.PauseItemResponse, error) {
+		req := connect.NewRequest(&bidsv1.PauseItemRequest{ItemId: itemID.String()})
+		req.Header().Set("Authorization", "Bearer "+token)
+		res, err := client.PauseItem(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return res.Msg, nil
+	}
+
+	resume := func(itemID uuid.UUID, token string, extend bool) (*bidsv1.ResumeItemResponse, error) {
+		req := connect.NewRequest(&bidsv1.ResumeItemRequest{ItemId: itemID.String(), ExtendEndAt: extend})
+		req.Header().Set("Authorization", "Bearer "+token)
+		res, err := client.ResumeItem(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return res.Msg, nil
+	}
+
+	t.Run("paused items reject bids until resumed", func(t *testing.T) {
+		item := newItem(t)
+		require.NoError(t, placeBid(item.ID, 1500))
+
+		paused, err := pause(item.ID, sellerToken)
+		require.NoError(t, err)
+		assert.Equal(t, bidsv1.ItemStatus_ITEM_STATUS_PAUSED, paused.Item.Status)
+
+		err = placeBid(item.ID, 2000)
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+		// The highest bid survives the pause
+		stored := getTestItem(t, pool, item.ID)
+		assert.Equal(t, items.ItemStatusPaused, stored.Status)
+		assert.Equal(t, int64(1500), stored.CurrentHighestBid)
+
+		listed, err := client.ListItems(ctx, connect.NewRequest(&bidsv1.ListItemsRequest{PageSize: 100}))
+		require.NoError(t, err)
+		for _, listedItem := range listed.Msg.Items {
+			assert.NotEqual(t, item.ID.String(), listedItem.Id, "paused items must not be listed as active")
+		}
+
+		resumed, err := resume(item.ID, sellerToken, false)
+		require.NoError(t, err)
+		assert.Equal(t, bidsv1.ItemStatus_ITEM_STATUS_ACTIVE, resumed.Item.Status)
+
+		require.NoError(t, placeBid(item.ID, 2000))
+		assert.Equal(t, int64(2000), getTestItem(t, pool, item.ID).CurrentHighestBid)
+	})
+
+	t.Run("resume can extend the end time by the paused duration", func(t *testing.T) {
+		item := newItem(t)
+		original := getTestItem(t, pool, item.ID).EndAt
+
+		_, err := pause(item.ID, sellerToken)
+		require.NoError(t, err)
+
+		// Backdate the pause rather than sleeping through it
+		_, err = pool.Exec(ctx, `UPDATE items SET paused_at = NOW() - INTERVAL '1 hour' WHERE id = $1`, item.ID)
+		require.NoError(t, err)
+
+		resumed, err := resume(item.ID, sellerToken, true)
+		require.NoError(t, err)
+
+		extended := getTestItem(t, pool, item.ID).EndAt
+		assert.WithinDuration(t, original.Add(time.Hour), extended, 5*time.Second)
+		returned, err := time.Parse(time.RFC3339, resumed.Item.EndAt)
+		require.NoError(t, err)
+		assert.WithinDuration(t, extended, returned, time.Second)
+	})
+
+	t.Run("resume without extension keeps the end time", func(t *testing.T) {
+		item := newItem(t)
+		original := getTestItem(t, pool, item.ID).EndAt
+
+		_, err := pause(item.ID, sellerToken)
+		require.NoError(t, err)
+		_, err = pool.Exec(ctx, `UPDATE items SET paused_at = NOW() - INTERVAL '1 hour' WHERE id = $1`, item.ID)
+		require.NoError(t, err)
+
+		_, err = resume(item.ID, sellerToken, false)
+		require.NoError(t, err)
+		assert.WithinDuration(t, original, getTestItem(t, pool, item.ID).EndAt, time.Millisecond)
+	})
+
+	t.Run("only the seller or an admin can pause", func(t *testing.T) {
+		item := newItem(t)
+
+		_, err := pause(item.ID, bidderToken)
+		require.Error(t, err)
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+		adminToken := authConfig.generateAdminToken(t, uuid.New())
+		_, err = pause(item.ID, adminToken)
+		require.NoError(t, err)
+
+		_, err = resume(item.ID, bidderToken, false)
+		require.Error(t, err)
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+		_, err = resume(item.ID, adminToken, false)
+		require.NoError(t, err)
+	})
+
+	t.Run("state transitions are checked", func(t *testing.T) {
+		item := newItem(t)
+
+		_, err := resume(item.ID, sellerToken, false)
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+		_, err = pause(item.ID, sellerToken)
+		require.NoError(t, err)
+		_, err = pause(item.ID, sellerToken)
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	})
+}
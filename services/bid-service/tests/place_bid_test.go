@@ -1,7 +1,10 @@
+//go:build integration
+
 package tests
 
 import (
 	"context"
+	"math"
 	"sync"
 	"testing"
 	"time"
@@ -10,9 +13,14 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 
+	"github.com/floroz/gavel/pkg/database"
+	pb "github.com/floroz/gavel/pkg/proto"
 	bidsv1 "github.com/floroz/gavel/pkg/proto/bids/v1"
 	"github.com/floroz/gavel/pkg/testhelpers"
+	infradb "github.com/floroz/gavel/services/bid-service/internal/adapters/database"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/bids"
 	"github.com/floroz/gavel/services/bid-service/internal/domain/items"
 )
 
@@ -57,9 +65,17 @@ func TestPlaceBid_Scenarios(t *testing.T) {
 		assert.Equal(t, itemID.String(), res.Msg.Bid.ItemId)
 		assert.Equal(t, int64(1500), res.Msg.Bid.Amount)
 
+		// The response carries the item as the bid left it
+		require.NotNil(t, res.Msg.Item)
+		assert.Equal(t, int64(1500), res.Msg.Item.CurrentHighestBid)
+		assert.Equal(t, bidsv1.ItemStatus_ITEM_STATUS_ACTIVE, res.Msg.Item.AuctionState)
+
 		// Verify DB State
 		updatedItem := getTestItem(t, pool, itemID)
 		assert.Equal(t, int64(1500), updatedItem.CurrentHighestBid)
+		endAt, err := time.Parse(time.RFC3339, res.Msg.Item.EndAt)
+		require.NoError(t, err)
+		assert.True(t, updatedItem.EndAt.Truncate(time.Second).Equal(endAt), "returned end_at matches the stored one")
 	})
 
 	t.Run("Failure_ItemNotFound", func(t *testing.T) {
@@ -71,7 +87,7 @@ func TestPlaceBid_Scenarios(t *testing.T) {
 
 		_, err := client.PlaceBid(context.Background(), req)
 		require.Error(t, err)
-		assert.Equal(t, connect.CodeInternal, connect.CodeOf(err))
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
 	})
 
 	t.Run("Failure_BidTooLow", func(t *testing.T) {
@@ -102,6 +118,67 @@ func TestPlaceBid_Scenarios(t *testing.T) {
 		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
 	})
 
+	t.Run("Failure_FirstBidBelowStartPrice", func(t *testing.T) {
+		itemID := uuid.New()
+		testItem := &items.Item{
+			ID:                itemID,
+			Title:             "No Bids Yet",
+			StartPrice:        10000,
+			CurrentHighestBid: 0,
+			EndAt:             time.Now().Add(1 * time.Hour),
+			CreatedAt:         time.Now(),
+			UpdatedAt:         time.Now(),
+			Images:            []string{},
+			Category:          "test",
+			SellerID:          uuid.New(),
+			Status:            items.ItemStatusActive,
+		}
+		seedTestItem(t, pool, testItem)
+
+		req := connect.NewRequest(&bidsv1.PlaceBidRequest{
+			ItemId: itemID.String(),
+			Amount: 1,
+		})
+		req.Header().Set("Authorization", "Bearer "+authConfig.generateTestToken(t, uuid.New()))
+
+		_, err := client.PlaceBid(context.Background(), req)
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+		updatedItem := getTestItem(t, pool, itemID)
+		assert.Equal(t, int64(0), updatedItem.CurrentHighestBid)
+	})
+
+	t.Run("Success_FirstBidAtStartPrice", func(t *testing.T) {
+		itemID := uuid.New()
+		testItem := &items.Item{
+			ID:                itemID,
+			Title:             "No Bids Yet",
+			StartPrice:        10000,
+			CurrentHighestBid: 0,
+			EndAt:             time.Now().Add(1 * time.Hour),
+			CreatedAt:         time.Now(),
+			UpdatedAt:         time.Now(),
+			Images:            []string{},
+			Category:          "test",
+			SellerID:          uuid.New(),
+			Status:            items.ItemStatusActive,
+		}
+		seedTestItem(t, pool, testItem)
+
+		req := connect.NewRequest(&bidsv1.PlaceBidRequest{
+			ItemId: itemID.String(),
+			Amount: 10000,
+		})
+		req.Header().Set("Authorization", "Bearer "+authConfig.generateTestToken(t, uuid.New()))
+
+		_, err := client.PlaceBid(context.Background(), req)
+		require.NoError(t, err)
+
+		updatedItem := getTestItem(t, pool, itemID)
+		assert.Equal(t, int64(10000), updatedItem.CurrentHighestBid)
+	})
+
 	t.Run("Failure_AuctionEnded", func(t *testing.T) {
 		itemID := uuid.New()
 		testItem := &items.Item{
@@ -157,6 +234,36 @@ func TestPlaceBid_Scenarios(t *testing.T) {
 		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
 	})
 
+	t.Run("Failure_AmountAboveMax", func(t *testing.T) {
+		itemID := uuid.New()
+		testItem := &items.Item{
+			ID:                itemID,
+			Title:             "Item for Huge Bid",
+			StartPrice:        1000,
+			CurrentHighestBid: 1000,
+			EndAt:             time.Now().Add(1 * time.Hour),
+			CreatedAt:         time.Now(),
+			UpdatedAt:         time.Now(),
+			Images:            []string{},
+			Category:          "test",
+			SellerID:          uuid.New(),
+			Status:            items.ItemStatusActive,
+		}
+		seedTestItem(t, pool, testItem)
+
+		req := connect.NewRequest(&bidsv1.PlaceBidRequest{
+			ItemId: itemID.String(),
+			Amount: math.MaxInt64,
+		})
+		req.Header().Set("Authorization", "Bearer "+authConfig.generateTestToken(t, uuid.New()))
+		_, err := client.PlaceBid(context.Background(), req)
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+		// Price is unchanged
+		assert.Equal(t, int64(1000), getTestItem(t, pool, itemID).CurrentHighestBid)
+	})
+
 	t.Run("Failure_ZeroAmount", func(t *testing.T) {
 		itemID := uuid.New()
 		testItem := &items.Item{
@@ -280,4 +387,352 @@ func TestPlaceBid_Scenarios(t *testing.T) {
 
 		assert.Equal(t, 1, successCount, "Only one bid should succeed for the same amount")
 	})
+
+	t.Run("Concurrency_SameUserDoubleSubmit", func(t *testing.T) {
+		// One user submits the same bid twice at once (a double click).
+		// The first wins; the second finds its own bid standing and is a duplicate.
+		itemID := uuid.New()
+		testItem := &items.Item{
+			ID:                itemID,
+			Title:             "Double Click Item",
+			StartPrice:        50000,
+			CurrentHighestBid: 50000,
+			EndAt:             time.Now().Add(24 * time.Hour),
+			CreatedAt:         time.Now(),
+			UpdatedAt:         time.Now(),
+			Images:            []string{},
+			Category:          "test",
+			SellerID:          uuid.New(),
+			Status:            items.ItemStatusActive,
+		}
+		seedTestItem(t, pool, testItem)
+
+		token := authConfig.generateTestToken(t, uuid.New())
+		var wg sync.WaitGroup
+		results := make(chan error, 2)
+
+		for i := 0; i < 2; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				req := connect.NewRequest(&bidsv1.PlaceBidRequest{
+					ItemId: itemID.String(),
+					Amount: 60000,
+				})
+				req.Header().Set("Authorization", "Bearer "+token)
+				_, err := client.PlaceBid(context.Background(), req)
+				results <- err
+			}()
+		}
+
+		wg.Wait()
+		close(results)
+
+		var successCount int
+		for err := range results {
+			if err == nil {
+				successCount++
+				continue
+			}
+			assert.Equal(t, connect.CodeAlreadyExists, connect.CodeOf(err))
+		}
+
+		assert.Equal(t, 1, successCount, "Only one of the identical submissions should succeed")
+		assert.Equal(t, int64(1), getTestItem(t, pool, itemID).BidCount)
+	})
+}
+
+func TestPlaceBid_RetriesOnLockTimeout(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+	pool := testDB.Pool
+	ctx := context.Background()
+
+	// Short lock timeout so the first attempt fails while the row is held elsewhere
+	txManager := database.NewPostgresTransactionManager(pool, 100*time.Millisecond)
+	itemRepo := infradb.NewPostgresItemRepository(pool, database.DefaultQueryTimeout)
+	auctionService := bids.NewAuctionService(
+		txManager,
+		infradb.NewPostgresBidRepository(pool, database.DefaultQueryTimeout),
+		itemRepo,
+		infradb.NewPostgresOutboxRepository(pool, database.DefaultQueryTimeout),
+		nil,
+		nil,
+		bids.DefaultMaxBidAmount,
+		nil,
+		bids.AntiSniping{},
+		nil,
+	)
+
+	itemID := uuid.New()
+	seedTestItem(t, pool, &items.Item{
+		ID:         itemID,
+		Title:      "Contended Item",
+		StartPrice: 1000,
+		EndAt:      time.Now().Add(1 * time.Hour),
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+		Images:     []string{},
+		Category:   "test",
+		SellerID:   uuid.New(),
+		Status:     items.ItemStatusActive,
+	})
+
+	// Hold the item lock past the first attempt's lock timeout, then release it
+	lockTx, err := pool.Begin(ctx)
+	require.NoError(t, err)
+	_, err = lockTx.Exec(ctx, "SELECT id FROM items WHERE id = $1 FOR UPDATE", itemID)
+	require.NoError(t, err)
+	go func() {
+		time.Sleep(150 * time.Millisecond)
+		_ = lockTx.Rollback(ctx)
+	}()
+
+	bid, _, err := auctionService.PlaceBid(ctx, bids.PlaceBidCommand{
+		ItemID: itemID,
+		UserID: uuid.New(),
+		Amount: 1500,
+	})
+	require.NoError(t, err, "bid should succeed within the retry budget")
+	assert.Equal(t, int64(1500), bid.Amount)
+
+	updatedItem := getTestItem(t, pool, itemID)
+	assert.Equal(t, int64(1500), updatedItem.CurrentHighestBid)
+}
+
+func TestPlaceBid_HighestBidNeverDecreases(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+	pool := testDB.Pool
+	ctx := context.Background()
+
+	txManager := database.NewPostgresTransactionManager(pool, 5*time.Second)
+	itemRepo := infradb.NewPostgresItemRepository(pool, database.DefaultQueryTimeout)
+	auctionService := bids.NewAuctionService(
+		txManager,
+		infradb.NewPostgresBidRepository(pool, database.DefaultQueryTimeout),
+		itemRepo,
+		infradb.NewPostgresOutboxRepository(pool, database.DefaultQueryTimeout),
+		nil,
+		nil,
+		bids.DefaultMaxBidAmount,
+		nil,
+		bids.AntiSniping{},
+		nil,
+	)
+
+	itemID := uuid.New()
+	seedTestItem(t, pool, &items.Item{
+		ID:         itemID,
+		Title:      "Hot Item",
+		StartPrice: 1000,
+		EndAt:      time.Now().Add(1 * time.Hour),
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+		Images:     []string{},
+		Category:   "test",
+		SellerID:   uuid.New(),
+		Status:     items.ItemStatusActive,
+	})
+
+	t.Run("Concurrent bids keep the maximum", func(t *testing.T) {
+		// Interleave high and low amounts so later bids are often lower than the stored one
+		amounts := []int64{5000, 1500, 4000, 2000, 4500, 1200, 3000, 4800, 2500, 1800}
+
+		var wg sync.WaitGroup
+		var mu sync.Mutex
+		var maxAccepted int64
+		for _, amount := range amounts {
+			wg.Add(1)
+			go func(amount int64) {
+				defer wg.Done()
+				_, _, err := auctionService.PlaceBid(ctx, bids.PlaceBidCommand{
+					ItemID: itemID,
+					UserID: uuid.New(),
+					Amount: amount,
+				})
+				if err == nil {
+					mu.Lock()
+					if amount > maxAccepted {
+						maxAccepted = amount
+					}
+					mu.Unlock()
+				}
+			}(amount)
+		}
+		wg.Wait()
+
+		updatedItem := getTestItem(t, pool, itemID)
+		assert.Equal(t, int64(5000), updatedItem.CurrentHighestBid)
+		assert.Equal(t, maxAccepted, updatedItem.CurrentHighestBid)
+	})
+
+	t.Run("Unlocked lower update is rejected", func(t *testing.T) {
+		tx, err := pool.Begin(ctx)
+		require.NoError(t, err)
+		defer func() { _ = tx.Rollback(ctx) }()
+
+		// Bypass GetItemByIDForUpdate entirely: the SQL guard alone must hold the invariant
+		err = itemRepo.UpdateHighestBid(ctx, tx, itemID, 2000)
+		require.ErrorIs(t, err, items.ErrHighestBidChanged)
+		require.NoError(t, tx.Commit(ctx))
+
+		updatedItem := getTestItem(t, pool, itemID)
+		assert.Equal(t, int64(5000), updatedItem.CurrentHighestBid)
+	})
+}
+
+func TestPlaceBid_EventCarriesPreviousBidder(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+	pool := testDB.Pool
+	ctx := context.Background()
+
+	auctionService := bids.NewAuctionService(
+		database.NewPostgresTransactionManager(pool, 5*time.Second),
+		infradb.NewPostgresBidRepository(pool, database.DefaultQueryTimeout),
+		infradb.NewPostgresItemRepository(pool, database.DefaultQueryTimeout),
+		infradb.NewPostgresOutboxRepository(pool, database.DefaultQueryTimeout),
+		nil,
+		nil,
+		bids.DefaultMaxBidAmount,
+		nil,
+		bids.AntiSniping{},
+		nil,
+	)
+
+	itemID := uuid.New()
+	seedTestItem(t, pool, &items.Item{
+		ID:         itemID,
+		Title:      "Outbid Item",
+		StartPrice: 1000,
+		EndAt:      time.Now().Add(1 * time.Hour),
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+		Images:     []string{},
+		Category:   "test",
+		SellerID:   uuid.New(),
+		Status:     items.ItemStatusActive,
+	})
+
+	firstBidder, secondBidder := uuid.New(), uuid.New()
+	first, _, err := auctionService.PlaceBid(ctx, bids.PlaceBidCommand{ItemID: itemID, UserID: firstBidder, Amount: 1500})
+	require.NoError(t, err)
+	second, _, err := auctionService.PlaceBid(ctx, bids.PlaceBidCommand{ItemID: itemID, UserID: secondBidder, Amount: 2000})
+	require.NoError(t, err)
+
+	// Index the emitted BidPlaced events by bid ID
+	rows, err := pool.Query(ctx, `SELECT payload FROM outbox_events WHERE event_type = 'bid.placed'`)
+	require.NoError(t, err)
+	defer rows.Close()
+	emitted := make(map[string]*pb.BidPlaced)
+	for rows.Next() {
+		var payload []byte
+		require.NoError(t, rows.Scan(&payload))
+		var event pb.BidPlaced
+		require.NoError(t, proto.Unmarshal(payload, &event))
+		emitted[event.BidId] = &event
+	}
+	require.NoError(t, rows.Err())
+
+	firstEvent := emitted[first.ID.String()]
+	require.NotNil(t, firstEvent)
+	assert.Empty(t, firstEvent.PreviousHighestBidderId)
+	assert.Zero(t, firstEvent.PreviousAmount)
+
+	secondEvent := emitted[second.ID.String()]
+	require.NotNil(t, secondEvent)
+	assert.Equal(t, firstBidder.String(), secondEvent.PreviousHighestBidderId)
+	assert.Equal(t, int64(1500), secondEvent.PreviousAmount)
+}
+
+func TestPlaceBid_UsesDatabaseTimestamp(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+	pool := testDB.Pool
+	ctx := context.Background()
+
+	auctionService := bids.NewAuctionService(
+		database.NewPostgresTransactionManager(pool, 5*time.Second),
+		infradb.NewPostgresBidRepository(pool, database.DefaultQueryTimeout),
+		infradb.NewPostgresItemRepository(pool, database.DefaultQueryTimeout),
+		infradb.NewPostgresOutboxRepository(pool, database.DefaultQueryTimeout),
+		nil,
+		nil,
+		bids.DefaultMaxBidAmount,
+		nil,
+		bids.AntiSniping{},
+		nil,
+	)
+
+	itemID := uuid.New()
+	seedTestItem(t, pool, &items.Item{
+		ID:         itemID,
+		Title:      "Timestamp Item",
+		StartPrice: 1000,
+		EndAt:      time.Now().Add(1 * time.Hour),
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+		Images:     []string{},
+		Category:   "test",
+		SellerID:   uuid.New(),
+		Status:     items.ItemStatusActive,
+	})
+
+	bid, _, err := auctionService.PlaceBid(ctx, bids.PlaceBidCommand{ItemID: itemID, UserID: uuid.New(), Amount: 1500})
+	require.NoError(t, err)
+
+	var stored time.Time
+	err = pool.QueryRow(ctx, `SELECT created_at FROM bids WHERE id = $1`, bid.ID).Scan(&stored)
+	require.NoError(t, err)
+	assert.True(t, bid.CreatedAt.Equal(stored), "returned CreatedAt %v should equal stored %v", bid.CreatedAt, stored)
+
+	var payload []byte
+	err = pool.QueryRow(ctx, `SELECT payload FROM outbox_events WHERE event_type = 'bid.placed'`).Scan(&payload)
+	require.NoError(t, err)
+	var event pb.BidPlaced
+	require.NoError(t, proto.Unmarshal(payload, &event))
+	assert.True(t, event.Timestamp.AsTime().Equal(stored), "event timestamp %v should equal stored %v", event.Timestamp.AsTime(), stored)
+}
+
+func TestPlaceBid_StampsBidsInLockOrder(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+	pool := testDB.Pool
+	ctx := context.Background()
+
+	itemID := uuid.New()
+	seedTestItem(t, pool, &items.Item{
+		ID:         itemID,
+		Title:      "Lock Order Item",
+		StartPrice: 1000,
+		EndAt:      time.Now().Add(1 * time.Hour),
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+		Images:     []string{},
+		Category:   "test",
+		SellerID:   uuid.New(),
+		Status:     items.ItemStatusActive,
+	})
+	repo := infradb.NewPostgresBidRepository(pool, database.DefaultQueryTimeout)
+
+	// The later bid's transaction begins first but only saves once the earlier one committed
+	late, err := pool.Begin(ctx)
+	require.NoError(t, err)
+	defer func() { _ = late.Rollback(ctx) }()
+	var lateStart time.Time
+	require.NoError(t, late.QueryRow(ctx, `SELECT now()`).Scan(&lateStart))
+
+	early, err := pool.Begin(ctx)
+	require.NoError(t, err)
+	first := &bids.Bid{ID: uuid.New(), ItemID: itemID, UserID: uuid.New(), Amount: 1500}
+	require.NoError(t, repo.SaveBid(ctx, early, first))
+	require.NoError(t, early.Commit(ctx))
+
+	second := &bids.Bid{ID: uuid.New(), ItemID: itemID, UserID: uuid.New(), Amount: 2000}
+	require.NoError(t, repo.SaveBid(ctx, late, second))
+	require.NoError(t, late.Commit(ctx))
+
+	assert.True(t, second.CreatedAt.After(lateStart), "created_at is taken when the bid is saved, not when its transaction began")
+	assert.True(t, second.CreatedAt.After(first.CreatedAt), "the outbidding bid is stamped after the bid it outbid")
 }
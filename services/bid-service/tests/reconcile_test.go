@@ -0,0 +1,193 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	bidsv1 "github.com/floroz/gavel/pkg/proto/bids/v1"
+	"github.com/floroz/gavel/pkg/testhelpers"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/items"
+)
+
+func TestAPI_AdminReconcileItem(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+
+	client, pool, authConfig := setupBidApp(t, testDB.Pool)
+	ctx := context.Background()
+
+	item := &items.Item{
+		ID:         uuid.New(),
+		Title:      "Drifting Item",
+		StartPrice: 1000,
+		EndAt:      time.Now().Add(24 * time.Hour),
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+		Images:     []string{},
+		Category:   "test",
+		SellerID:   uuid.New(),
+		Status:     items.ItemStatusActive,
+	}
+	seedTestItem(t, pool, item)
+
+	bidderToken := authConfig.generateTestToken(t, uuid.New())
+	for _, amount := range []int64{1200, 1500} {
+		req := connect.NewRequest(&bidsv1.PlaceBidRequest{ItemId: item.ID.String(), Amount: amount})
+		req.Header().Set("Authorization", "Bearer "+bidderToken)
+		_, err := client.PlaceBid(ctx, req)
+		require.NoError(t, err)
+	}
+
+	// Simulate a bad manual edit
+	_, err := pool.Exec(ctx, "UPDATE items SET current_highest_bid = 99999, bid_count = 7 WHERE id = $1", item.ID)
+	require.NoError(t, err)
+
+	reconcile := func(token string, id string) (*connect.Response[bidsv1.AdminReconcileItemResponse], error) {
+		req := connect.NewRequest(&bidsv1.AdminReconcileItemRequest{ItemId: id})
+		req.Header().Set("Authorization", "Bearer "+token)
+		return client.AdminReconcileItem(ctx, req)
+	}
+	adminToken := authConfig.generateAdminToken(t, uuid.New())
+
+	t.Run("requires the admin permission", func(t *testing.T) {
+		_, err := reconcile(bidderToken, item.ID.String())
+		require.Error(t, err)
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	})
+
+	t.Run("corrects drifted totals", func(t *testing.T) {
+		res, err := reconcile(adminToken, item.ID.String())
+		require.NoError(t, err)
+		assert.True(t, res.Msg.Corrected)
+		assert.Equal(t, int64(99999), res.Msg.StoredHighestBid)
+		assert.Equal(t, int64(7), res.Msg.StoredBidCount)
+		assert.Equal(t, int64(1500), res.Msg.HighestBid)
+		assert.Equal(t, int64(2), res.Msg.BidCount)
+
+		stored := getTestItem(t, pool, item.ID)
+		assert.Equal(t, int64(1500), stored.CurrentHighestBid)
+		assert.Equal(t, int64(2), stored.BidCount)
+	})
+
+	t.Run("leaves consistent items alone", func(t *testing.T) {
+		res, err := reconcile(adminToken, item.ID.String())
+		require.NoError(t, err)
+		assert.False(t, res.Msg.Corrected)
+		assert.Equal(t, int64(1500), res.Msg.HighestBid)
+	})
+
+	t.Run("unknown item", func(t *testing.T) {
+		_, err := reconcile(adminToken, uuid.NewString())
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	})
+}
+
+func TestAPI_AdminGetUserBidTotals(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+
+	client, pool, authConfig := setupBidApp(t, testDB.Pool)
+	ctx := context.Background()
+
+	bidderID := uuid.New()
+	bidderToken := authConfig.generateTestToken(t, bidderID)
+	for _, amount := range []int64{1200, 1500} {
+		item := &items.Item{
+			ID:         uuid.New(),
+			Title:      "Totals Item",
+			StartPrice: 1000,
+			EndAt:      time.Now().Add(24 * time.Hour),
+			CreatedAt:  time.Now(),
+			UpdatedAt:  time.Now(),
+			Images:     []string{},
+			Category:   "test",
+			SellerID:   uuid.New(),
+			Status:     items.ItemStatusActive,
+		}
+		seedTestItem(t, pool, item)
+
+		req := connect.NewRequest(&bidsv1.PlaceBidRequest{ItemId: item.ID.String(), Amount: amount})
+		req.Header().Set("Authorization", "Bearer "+bidderToken)
+		_, err := client.PlaceBid(ctx, req)
+		require.NoError(t, err)
+	}
+
+	totals := func(token string, userID string) (*connect.Response[bidsv1.AdminGetUserBidTotalsResponse], error) {
+		req := connect.NewRequest(&bidsv1.AdminGetUserBidTotalsRequest{UserId: userID})
+		req.Header().Set("Authorization", "Bearer "+token)
+		return client.AdminGetUserBidTotals(ctx, req)
+	}
+	adminToken := authConfig.generateAdminToken(t, uuid.New())
+
+	t.Run("requires the admin permission", func(t *testing.T) {
+		_, err := totals(bidderToken, bidderID.String())
+		require.Error(t, err)
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	})
+
+	t.Run("sums the user's bids", func(t *testing.T) {
+		// as_of trails the clock, so the fresh bids are counted after a short wait
+		var res *connect.Response[bidsv1.AdminGetUserBidTotalsResponse]
+		require.Eventually(t, func() bool {
+			var err error
+			res, err = totals(adminToken, bidderID.String())
+			require.NoError(t, err)
+			return res.Msg.BidCount == 2
+		}, 10*time.Second, 200*time.Millisecond)
+		assert.Equal(t, int64(2), res.Msg.BidCount)
+		assert.Equal(t, int64(2700), res.Msg.TotalAmount)
+
+		lastBidAt, err := time.Parse(time.RFC3339Nano, res.Msg.LastBidAt)
+		require.NoError(t, err)
+		asOf, err := time.Parse(time.RFC3339Nano, res.Msg.AsOf)
+		require.NoError(t, err)
+		assert.False(t, lastBidAt.After(asOf))
+	})
+
+	t.Run("leaves bids newer than as_of to the event stream", func(t *testing.T) {
+		item := &items.Item{
+			ID:         uuid.New(),
+			Title:      "Late Item",
+			StartPrice: 1000,
+			EndAt:      time.Now().Add(24 * time.Hour),
+			CreatedAt:  time.Now(),
+			UpdatedAt:  time.Now(),
+			Images:     []string{},
+			Category:   "test",
+			SellerID:   uuid.New(),
+			Status:     items.ItemStatusActive,
+		}
+		seedTestItem(t, pool, item)
+		req := connect.NewRequest(&bidsv1.PlaceBidRequest{ItemId: item.ID.String(), Amount: 1100})
+		req.Header().Set("Authorization", "Bearer "+bidderToken)
+		placed, err := client.PlaceBid(ctx, req)
+		require.NoError(t, err)
+
+		res, err := totals(adminToken, bidderID.String())
+		require.NoError(t, err)
+		asOf, err := time.Parse(time.RFC3339Nano, res.Msg.AsOf)
+		require.NoError(t, err)
+		var placedAt time.Time
+		require.NoError(t, pool.QueryRow(ctx, "SELECT created_at FROM bids WHERE id = $1", placed.Msg.Bid.Id).Scan(&placedAt))
+		assert.True(t, placedAt.After(asOf), "a bid newer than as_of is not in the totals")
+		assert.Equal(t, int64(2), res.Msg.BidCount)
+	})
+
+	t.Run("user without bids", func(t *testing.T) {
+		res, err := totals(adminToken, uuid.NewString())
+		require.NoError(t, err)
+		assert.Zero(t, res.Msg.BidCount)
+		assert.Zero(t, res.Msg.TotalAmount)
+		assert.Empty(t, res.Msg.LastBidAt)
+		assert.NotEmpty(t, res.Msg.AsOf)
+	})
+}
@@ -0,0 +1,43 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/floroz/gavel/pkg/limits"
+	bidsv1 "github.com/floroz/gavel/pkg/proto/bids/v1"
+	"github.com/floroz/gavel/pkg/testhelpers"
+)
+
+func TestAPI_CreateItem_RequestSizeLimit(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+
+	client, pool, authConfig := setupBidApp(t, testDB.Pool)
+	token := authConfig.generateTestToken(t, uuid.New())
+
+	req := connect.NewRequest(&bidsv1.CreateItemRequest{
+		Title:       "Oversized Item",
+		Description: strings.Repeat("x", 2*limits.DefaultMaxRequestBytes),
+		StartPrice:  1000,
+		EndAt:       time.Now().Add(24 * time.Hour).Format(time.RFC3339),
+	})
+	req.Header().Set("Authorization", "Bearer "+token)
+
+	_, err := client.CreateItem(context.Background(), req)
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err))
+
+	var count int
+	require.NoError(t, pool.QueryRow(context.Background(), "SELECT COUNT(*) FROM items").Scan(&count))
+	assert.Zero(t, count, "an oversized request must not create an item")
+}
@@ -0,0 +1,123 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	bidsv1 "github.com/floroz/gavel/pkg/proto/bids/v1"
+	"github.com/floroz/gavel/pkg/testhelpers"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/bids"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/items"
+)
+
+func TestScheduledItems(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+
+	client, pool, authConfig := setupBidApp(t, testDB.Pool)
+	ctx := context.Background()
+
+	sellerToken := authConfig.generateTestToken(t, uuid.New())
+	bidderToken := authConfig.generateTestToken(t, uuid.New())
+
+	createScheduled := func(t *testing.T, title string) *bidsv1.Item {
+		t.Helper()
+		req := connect.NewRequest(&bidsv1.CreateItemRequest{
+			Title:      title,
+			StartPrice: 1000,
+			StartAt:    time.Now().Add(time.Hour).Format(time.RFC3339),
+			EndAt:      time.Now().Add(48 * time.Hour).Format(time.RFC3339),
+		})
+		req.Header().Set("Authorization", "Bearer "+sellerToken)
+		res, err := client.CreateItem(ctx, req)
+		require.NoError(t, err)
+		require.Equal(t, bidsv1.ItemStatus_ITEM_STATUS_SCHEDULED, res.Msg.Item.Status)
+		return res.Msg.Item
+	}
+
+	placeBid := func(itemID string, amount int64) error {
+		req := connect.NewRequest(&bidsv1.PlaceBidRequest{ItemId: itemID, Amount: amount})
+		req.Header().Set("Authorization", "Bearer "+bidderToken)
+		_, err := client.PlaceBid(ctx, req)
+		return err
+	}
+
+	// startNow moves the item's start time into the past, as if it had been reached
+	startNow := func(t *testing.T, itemID string) {
+		t.Helper()
+		_, err := pool.Exec(ctx, `UPDATE items SET start_at = NOW() - INTERVAL '1 minute' WHERE id = $1`, itemID)
+		require.NoError(t, err)
+	}
+
+	listedIDs := func(t *testing.T) []string {
+		t.Helper()
+		res, err := client.ListItems(ctx, connect.NewRequest(&bidsv1.ListItemsRequest{PageSize: 100}))
+		require.NoError(t, err)
+		ids := make([]string, len(res.Msg.Items))
+		for i, item := range res.Msg.Items {
+			ids[i] = item.Id
+		}
+		return ids
+	}
+
+	t.Run("start must be before end", func(t *testing.T) {
+		req := connect.NewRequest(&bidsv1.CreateItemRequest{
+			Title:      "Backwards",
+			StartPrice: 1000,
+			StartAt:    time.Now().Add(72 * time.Hour).Format(time.RFC3339),
+			EndAt:      time.Now().Add(48 * time.Hour).Format(time.RFC3339),
+		})
+		req.Header().Set("Authorization", "Bearer "+sellerToken)
+		_, err := client.CreateItem(ctx, req)
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+
+	t.Run("bids before start are rejected and the item is not listed", func(t *testing.T) {
+		item := createScheduled(t, "Not Yet")
+
+		err := placeBid(item.Id, 1500)
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+		assert.Contains(t, err.Error(), bids.ErrAuctionNotStarted.Error())
+
+		assert.NotContains(t, listedIDs(t), item.Id)
+		assert.Equal(t, items.ItemStatusScheduled, getTestItem(t, pool, uuid.MustParse(item.Id)).Status)
+	})
+
+	t.Run("first bid after start opens the auction", func(t *testing.T) {
+		item := createScheduled(t, "Lazy Start")
+		startNow(t, item.Id)
+
+		require.NoError(t, placeBid(item.Id, 1500))
+
+		stored := getTestItem(t, pool, uuid.MustParse(item.Id))
+		assert.Equal(t, items.ItemStatusActive, stored.Status)
+		assert.Equal(t, int64(1500), stored.CurrentHighestBid)
+		assert.Contains(t, listedIDs(t), item.Id)
+	})
+
+	t.Run("worker activates started auctions", func(t *testing.T) {
+		started := createScheduled(t, "Worker Start")
+		pending := createScheduled(t, "Still Waiting")
+		startNow(t, started.Id)
+
+		itemService := newItemService(pool, 0)
+		n, err := itemService.ActivateScheduledItems(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), n)
+
+		assert.Equal(t, items.ItemStatusActive, getTestItem(t, pool, uuid.MustParse(started.Id)).Status)
+		assert.Equal(t, items.ItemStatusScheduled, getTestItem(t, pool, uuid.MustParse(pending.Id)).Status)
+		assert.Contains(t, listedIDs(t), started.Id)
+		assert.NotContains(t, listedIDs(t), pending.Id)
+	})
+}
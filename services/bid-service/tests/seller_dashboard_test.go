@@ -0,0 +1,118 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	bidsv1 "github.com/floroz/gavel/pkg/proto/bids/v1"
+	"github.com/floroz/gavel/pkg/testhelpers"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/items"
+)
+
+// seedTestBids inserts bids for an item directly and sets its current highest bid and bid count
+// the way SaveBid would.
+func seedTestBids(t *testing.T, pool *pgxpool.Pool, itemID uuid.UUID, amounts ...int64) {
+	t.Helper()
+	ctx := context.Background()
+	var highest int64
+	for _, amount := range amounts {
+		_, err := pool.Exec(ctx,
+			"INSERT INTO bids (id, item_id, user_id, amount, created_at) VALUES ($1, $2, $3, $4, NOW())",
+			uuid.New(), itemID, uuid.New(), amount,
+		)
+		require.NoError(t, err, "Failed to seed test bid")
+		highest = max(highest, amount)
+	}
+	_, err := pool.Exec(ctx,
+		"UPDATE items SET current_highest_bid = $1, bid_count = bid_count + $2 WHERE id = $3",
+		highest, len(amounts), itemID,
+	)
+	require.NoError(t, err)
+}
+
+func TestAPI_GetSellerDashboard(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+
+	client, pool, authConfig := setupBidApp(t, testDB.Pool)
+	ctx := context.Background()
+
+	sellerID := uuid.New()
+	newItem := func(seller uuid.UUID, status items.ItemStatus, endAt time.Time) *items.Item {
+		item := &items.Item{
+			ID:         uuid.New(),
+			Title:      "Dashboard Item",
+			StartPrice: 1000,
+			EndAt:      endAt,
+			CreatedAt:  time.Now(),
+			UpdatedAt:  time.Now(),
+			Images:     []string{},
+			Category:   "test",
+			SellerID:   seller,
+			Status:     status,
+		}
+		seedTestItem(t, pool, item)
+		return item
+	}
+
+	getDashboard := func(userID uuid.UUID) *bidsv1.GetSellerDashboardResponse {
+		t.Helper()
+		req := connect.NewRequest(&bidsv1.GetSellerDashboardRequest{})
+		req.Header().Set("Authorization", "Bearer "+authConfig.generateTestToken(t, userID))
+		resp, err := client.GetSellerDashboard(ctx, req)
+		require.NoError(t, err)
+		return resp.Msg
+	}
+
+	t.Run("seller without items gets zeros", func(t *testing.T) {
+		dashboard := getDashboard(uuid.New())
+		assert.Equal(t, int64(0), dashboard.ActiveListings)
+		assert.Equal(t, int64(0), dashboard.TotalBidsReceived)
+		assert.Nil(t, dashboard.HighestValuedItem)
+	})
+
+	t.Run("aggregates across the seller's items", func(t *testing.T) {
+		future := time.Now().Add(24 * time.Hour)
+
+		activeWithBids := newItem(sellerID, items.ItemStatusActive, future)
+		seedTestBids(t, pool, activeWithBids.ID, 1000, 1500)
+
+		newItem(sellerID, items.ItemStatusActive, future) // active, no bids
+
+		ended := newItem(sellerID, items.ItemStatusEnded, time.Now().Add(-time.Hour))
+		seedTestBids(t, pool, ended.ID, 5000)
+
+		// Past its end time but not yet swept: not an active listing
+		newItem(sellerID, items.ItemStatusActive, time.Now().Add(-time.Minute))
+
+		cancelled := newItem(sellerID, items.ItemStatusCancelled, future)
+		seedTestBids(t, pool, cancelled.ID, 9000)
+
+		// Another seller's bids must not be counted
+		other := newItem(uuid.New(), items.ItemStatusActive, future)
+		seedTestBids(t, pool, other.ID, 20000)
+
+		dashboard := getDashboard(sellerID)
+		assert.Equal(t, sellerID.String(), dashboard.SellerId)
+		assert.Equal(t, int64(2), dashboard.ActiveListings)
+		assert.Equal(t, int64(4), dashboard.TotalBidsReceived)
+		require.NotNil(t, dashboard.HighestValuedItem)
+		assert.Equal(t, ended.ID.String(), dashboard.HighestValuedItem.Id)
+		assert.Equal(t, int64(5000), dashboard.HighestValuedItem.CurrentHighestBid)
+	})
+
+	t.Run("requires authentication", func(t *testing.T) {
+		_, err := client.GetSellerDashboard(ctx, connect.NewRequest(&bidsv1.GetSellerDashboardRequest{}))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	})
+}
@@ -1,3 +1,5 @@
+//go:build integration
+
 package tests
 
 import (
@@ -6,6 +8,7 @@ import (
 	"crypto/rsa"
 	"crypto/x509"
 	"encoding/pem"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -17,8 +20,13 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/floroz/gavel/pkg/auth"
+	"github.com/floroz/gavel/pkg/clock"
 	"github.com/floroz/gavel/pkg/database"
+	"github.com/floroz/gavel/pkg/limits"
+	"github.com/floroz/gavel/pkg/pagination"
 	"github.com/floroz/gavel/pkg/proto/bids/v1/bidsv1connect"
+	"github.com/floroz/gavel/pkg/recovery"
+	"github.com/floroz/gavel/pkg/tracing"
 	"github.com/floroz/gavel/services/bid-service/internal/adapters/api"
 	infradb "github.com/floroz/gavel/services/bid-service/internal/adapters/database"
 	"github.com/floroz/gavel/services/bid-service/internal/domain/bids"
@@ -52,43 +60,77 @@ func generateTestKeys(t *testing.T) ([]byte, []byte) {
 	return privPEM, pubPEM
 }
 
+// newItemService builds an items.Service on pool with the given listing cap, 0 for the default
+func newItemService(pool *pgxpool.Pool, maxListings int) *items.Service {
+	return items.NewService(
+		database.NewPostgresTransactionManager(pool, 5*time.Second),
+		infradb.NewPostgresItemRepository(pool, database.DefaultQueryTimeout),
+		infradb.NewPostgresOutboxRepository(pool, database.DefaultQueryTimeout),
+		0, maxListings, nil,
+	)
+}
+
 // setupBidApp wires up the application for testing using a real database connection.
 // It returns a ConnectRPC client, the database pool, and the auth config for generating tokens.
 func setupBidApp(t *testing.T, pool *pgxpool.Pool) (bidsv1connect.BidServiceClient, *pgxpool.Pool, *testAuthConfig) {
+	return setupBidAppWithBidders(t, pool, nil)
+}
+
+// setupBidAppWithBidders is setupBidApp with a BidderDirectory for GetItemBids bidder names.
+func setupBidAppWithBidders(t *testing.T, pool *pgxpool.Pool, bidders bids.BidderDirectory) (bidsv1connect.BidServiceClient, *pgxpool.Pool, *testAuthConfig) {
+	return newBidApp(t, pool, bidders, nil)
+}
+
+// setupBidAppWithClock is setupBidApp with the services and handler reading time from clk.
+func setupBidAppWithClock(t *testing.T, pool *pgxpool.Pool, clk clock.Clock) (bidsv1connect.BidServiceClient, *pgxpool.Pool, *testAuthConfig) {
+	return newBidApp(t, pool, nil, clk)
+}
+
+func newBidApp(t *testing.T, pool *pgxpool.Pool, bidders bids.BidderDirectory, clk clock.Clock) (bidsv1connect.BidServiceClient, *pgxpool.Pool, *testAuthConfig) {
+	t.Helper()
+
 	// 1. Generate test keys and create signer
 	privPEM, pubPEM := generateTestKeys(t)
-	signer, err := auth.NewSigner(privPEM, pubPEM, "test-issuer")
+	signer, err := auth.NewSigner(privPEM, pubPEM, "test-issuer", nil)
 	require.NoError(t, err, "Failed to create signer")
 
 	// 2. Initialize Repositories (Infrastructure Layer)
 	txManager := database.NewPostgresTransactionManager(pool, 5*time.Second)
-	bidRepo := infradb.NewPostgresBidRepository(pool)
-	itemRepo := infradb.NewPostgresItemRepository(pool)
-	outboxRepo := infradb.NewPostgresOutboxRepository(pool)
+	bidRepo := infradb.NewPostgresBidRepository(pool, database.DefaultQueryTimeout)
+	itemRepo := infradb.NewPostgresItemRepository(pool, database.DefaultQueryTimeout)
+	outboxRepo := infradb.NewPostgresOutboxRepository(pool, database.DefaultQueryTimeout)
 
 	// 3. Initialize Service (Domain Layer)
-	auctionService := bids.NewAuctionService(txManager, bidRepo, itemRepo, outboxRepo)
-	itemService := items.NewService(itemRepo)
+	auctionService := bids.NewAuctionService(txManager, bidRepo, itemRepo, outboxRepo, nil, nil, bids.DefaultMaxBidAmount, clk, bids.AntiSniping{}, nil)
+	itemService := items.NewService(txManager, itemRepo, outboxRepo, 0, 0, clk)
 
 	// 4. Initialize API Handler with auth interceptor (ConnectRPC)
-	bidHandler := api.NewBidServiceHandler(auctionService, itemService, bidRepo)
+	pageTokens, err := pagination.NewCodec([]byte("test-page-token-key-0123456789abcdef"))
+	require.NoError(t, err)
+	bidHandler := api.NewBidServiceHandler(auctionService, itemService, bidRepo, bidders, []byte("test-bidder-label-key-0123456789"), pageTokens, clk)
 
 	// Configure public routes (no auth required)
 	publicRoutes := map[string]bool{
-		"/bids.v1.BidService/GetItem":     true,
-		"/bids.v1.BidService/ListItems":   true,
-		"/bids.v1.BidService/GetItemBids": true,
+		"/bids.v1.BidService/GetItem":         true,
+		"/bids.v1.BidService/ListItems":       true,
+		"/bids.v1.BidService/ListEndingSoon":  true,
+		"/bids.v1.BidService/GetItemBids":     true,
+		"/bids.v1.BidService/RecordItemView":  true,
+		"/bids.v1.BidService/GetCurrentPrice": true,
+		"/bids.v1.BidService/GetWinningBid":   true,
+		"/bids.v1.BidService/ListCategories":  true,
 	}
 
 	authInterceptor := auth.NewAuthInterceptorWithPublicRoutes(signer, publicRoutes)
 	path, handler := bidsv1connect.NewBidServiceHandler(
 		bidHandler,
-		connect.WithInterceptors(authInterceptor),
+		connect.WithInterceptors(recovery.NewInterceptor(slog.Default()), tracing.NewInterceptor(), authInterceptor),
+		connect.WithReadMaxBytes(limits.DefaultMaxRequestBytes),
 	)
 
 	// 5. Create a test HTTP server
 	mux := http.NewServeMux()
-	mux.Handle(path, handler)
+	mux.Handle(path, limits.MaxBytesHandler(handler, limits.DefaultMaxRequestBytes))
 	server := httptest.NewServer(mux)
 	t.Cleanup(server.Close)
 
@@ -109,6 +151,14 @@ func (c *testAuthConfig) generateTestToken(t *testing.T, userID uuid.UUID) strin
 	return pair.AccessToken
 }
 
+// generateAdminToken creates a valid JWT token carrying the admin permission
+func (c *testAuthConfig) generateAdminToken(t *testing.T, userID uuid.UUID) string {
+	t.Helper()
+	pair, err := c.signer.GenerateTokens(userID, "admin@example.com", "Test Admin", []string{auth.PermissionAdmin})
+	require.NoError(t, err, "Failed to generate admin token")
+	return pair.AccessToken
+}
+
 // seedTestItem inserts a test item into the database directly.
 func seedTestItem(t *testing.T, pool *pgxpool.Pool, item *items.Item) {
 	t.Helper()
@@ -138,8 +188,8 @@ func seedTestItem(t *testing.T, pool *pgxpool.Pool, item *items.Item) {
 func getTestItem(t *testing.T, pool *pgxpool.Pool, id uuid.UUID) *items.Item {
 	t.Helper()
 	var item items.Item
-	row := pool.QueryRow(context.Background(), "SELECT current_highest_bid FROM items WHERE id = $1", id)
-	err := row.Scan(&item.CurrentHighestBid)
+	row := pool.QueryRow(context.Background(), "SELECT current_highest_bid, bid_count FROM items WHERE id = $1", id)
+	err := row.Scan(&item.CurrentHighestBid, &item.BidCount)
 	require.NoError(t, err)
 	return &item
 }
@@ -0,0 +1,93 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/floroz/gavel/pkg/database"
+	bidsv1 "github.com/floroz/gavel/pkg/proto/bids/v1"
+	"github.com/floroz/gavel/pkg/testhelpers"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/items"
+)
+
+func TestTracing_PlaceBidSpanTree(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	// A dedicated pool with the query tracer installed, as the mains configure it
+	dbConfig, err := pgxpool.ParseConfig(testDB.ConnStr)
+	require.NoError(t, err)
+	dbConfig.ConnConfig.Tracer = database.QueryTracer{}
+	pool, err := pgxpool.NewWithConfig(context.Background(), dbConfig)
+	require.NoError(t, err)
+	defer pool.Close()
+
+	client, _, authConfig := setupBidApp(t, pool)
+
+	itemID := uuid.New()
+	seedTestItem(t, pool, &items.Item{
+		ID:         itemID,
+		Title:      "Traced Item",
+		StartPrice: 1000,
+		EndAt:      time.Now().Add(1 * time.Hour),
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+		Images:     []string{},
+		Category:   "test",
+		SellerID:   uuid.New(),
+		Status:     items.ItemStatusActive,
+	})
+	exporter.Reset()
+
+	req := connect.NewRequest(&bidsv1.PlaceBidRequest{ItemId: itemID.String(), Amount: 1500})
+	req.Header().Set("Authorization", "Bearer "+authConfig.generateTestToken(t, uuid.New()))
+	_, err = client.PlaceBid(context.Background(), req)
+	require.NoError(t, err)
+
+	spans := exporter.GetSpans()
+	byName := map[string][]tracetest.SpanStub{}
+	for _, s := range spans {
+		byName[s.Name] = append(byName[s.Name], s)
+	}
+
+	require.Len(t, byName["/bids.v1.BidService/PlaceBid"], 1)
+	rpcSpan := byName["/bids.v1.BidService/PlaceBid"][0]
+
+	require.Len(t, byName["db.transaction"], 1)
+	txSpan := byName["db.transaction"][0]
+	assert.Equal(t, rpcSpan.SpanContext.SpanID(), txSpan.Parent.SpanID(), "transaction should be a child of the RPC span")
+
+	// Lock, bid insert, highest bid update and outbox insert all run inside the transaction
+	var txChildren []string
+	for _, s := range spans {
+		if s.Parent.SpanID() == txSpan.SpanContext.SpanID() {
+			txChildren = append(txChildren, s.Name)
+		}
+	}
+	assert.Contains(t, txChildren, "db.select")
+	assert.Contains(t, txChildren, "db.insert")
+	assert.Contains(t, txChildren, "db.update")
+	assert.Contains(t, txChildren, "db.commit")
+
+	for _, s := range spans {
+		assert.Equal(t, rpcSpan.SpanContext.TraceID(), s.SpanContext.TraceID(), "span %s is outside the RPC trace", s.Name)
+	}
+}
@@ -0,0 +1,92 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pkgdb "github.com/floroz/gavel/pkg/database"
+	"github.com/floroz/gavel/pkg/testhelpers"
+	"github.com/floroz/gavel/services/bid-service/internal/adapters/database"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/items"
+)
+
+func TestItems_UpdatedAtMaintainedByTrigger(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+
+	pool := testDB.Pool
+	repo := database.NewPostgresItemRepository(pool, pkgdb.DefaultQueryTimeout)
+	ctx := context.Background()
+
+	stale := time.Now().Add(-time.Hour).Truncate(time.Microsecond)
+	item := &items.Item{
+		ID:          uuid.New(),
+		Title:       "Trigger Item",
+		Description: "Trigger Description",
+		StartPrice:  1000,
+		EndAt:       time.Now().Add(24 * time.Hour),
+		CreatedAt:   stale,
+		UpdatedAt:   stale,
+		Category:    "test",
+		SellerID:    uuid.New(),
+		Status:      items.ItemStatusActive,
+	}
+	require.NoError(t, pgx.BeginFunc(ctx, pool, func(tx pgx.Tx) error { return repo.CreateItem(ctx, tx, item) }))
+
+	updatedAt := func(t *testing.T) time.Time {
+		t.Helper()
+		var ts time.Time
+		require.NoError(t, pool.QueryRow(ctx, "SELECT updated_at FROM items WHERE id = $1", item.ID).Scan(&ts))
+		return ts
+	}
+	// Pushes updated_at back so each step can observe the trigger moving it forward again
+	resetUpdatedAt := func(t *testing.T) {
+		t.Helper()
+		_, err := pool.Exec(ctx, "ALTER TABLE items DISABLE TRIGGER items_set_updated_at")
+		require.NoError(t, err)
+		_, err = pool.Exec(ctx, "UPDATE items SET updated_at = $1 WHERE id = $2", stale, item.ID)
+		require.NoError(t, err)
+		_, err = pool.Exec(ctx, "ALTER TABLE items ENABLE TRIGGER items_set_updated_at")
+		require.NoError(t, err)
+	}
+
+	t.Run("UpdateItem", func(t *testing.T) {
+		item.Title = "Renamed"
+		item.UpdatedAt = stale
+		require.NoError(t, pgx.BeginFunc(ctx, pool, func(tx pgx.Tx) error { return repo.UpdateItem(ctx, tx, item) }))
+
+		stored := updatedAt(t)
+		assert.True(t, stored.After(stale), "updated_at should advance, got %v", stored)
+		assert.True(t, item.UpdatedAt.Equal(stored), "UpdateItem should return the stored updated_at")
+	})
+
+	t.Run("UpdateStatus", func(t *testing.T) {
+		resetUpdatedAt(t)
+		require.NoError(t, pgx.BeginFunc(ctx, pool, func(tx pgx.Tx) error { return repo.UpdateStatus(ctx, tx, item.ID, items.ItemStatusActive) }))
+		assert.True(t, updatedAt(t).After(stale))
+	})
+
+	t.Run("UpdateHighestBid", func(t *testing.T) {
+		resetUpdatedAt(t)
+		tx, err := pool.Begin(ctx)
+		require.NoError(t, err)
+		require.NoError(t, repo.UpdateHighestBid(ctx, tx, item.ID, 1500))
+		require.NoError(t, tx.Commit(ctx))
+		assert.True(t, updatedAt(t).After(stale))
+	})
+
+	t.Run("Plain SQL update", func(t *testing.T) {
+		resetUpdatedAt(t)
+		_, err := pool.Exec(ctx, "UPDATE items SET description = 'changed' WHERE id = $1", item.ID)
+		require.NoError(t, err)
+		assert.True(t, updatedAt(t).After(stale))
+	})
+}
@@ -0,0 +1,89 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	bidsv1 "github.com/floroz/gavel/pkg/proto/bids/v1"
+	"github.com/floroz/gavel/pkg/testhelpers"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/items"
+)
+
+func TestAPI_ListUserBids(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+
+	client, pool, authConfig := setupBidApp(t, testDB.Pool)
+	ctx := context.Background()
+
+	item := &items.Item{
+		ID:         uuid.New(),
+		Title:      "Exported Item",
+		StartPrice: 1000,
+		EndAt:      time.Now().Add(24 * time.Hour),
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+		Images:     []string{},
+		Category:   "test",
+		SellerID:   uuid.New(),
+		Status:     items.ItemStatusActive,
+	}
+	seedTestItem(t, pool, item)
+
+	bidder, other := uuid.New(), uuid.New()
+	place := func(userID uuid.UUID, amount int64) {
+		req := connect.NewRequest(&bidsv1.PlaceBidRequest{ItemId: item.ID.String(), Amount: amount})
+		req.Header().Set("Authorization", "Bearer "+authConfig.generateTestToken(t, userID))
+		_, err := client.PlaceBid(ctx, req)
+		require.NoError(t, err)
+	}
+	place(bidder, 1100)
+	place(other, 1200)
+	place(bidder, 1300)
+
+	list := func(token string, msg *bidsv1.ListUserBidsRequest) (*connect.Response[bidsv1.ListUserBidsResponse], error) {
+		req := connect.NewRequest(msg)
+		req.Header().Set("Authorization", "Bearer "+token)
+		return client.ListUserBids(ctx, req)
+	}
+
+	t.Run("lists the caller's bids newest first", func(t *testing.T) {
+		res, err := list(authConfig.generateTestToken(t, bidder), &bidsv1.ListUserBidsRequest{})
+		require.NoError(t, err)
+		require.Len(t, res.Msg.Bids, 2)
+		assert.Equal(t, int64(1300), res.Msg.Bids[0].Amount)
+		assert.Equal(t, int64(1100), res.Msg.Bids[1].Amount)
+		assert.Empty(t, res.Msg.NextPageToken)
+	})
+
+	t.Run("pages", func(t *testing.T) {
+		token := authConfig.generateTestToken(t, bidder)
+		first, err := list(token, &bidsv1.ListUserBidsRequest{PageSize: 1})
+		require.NoError(t, err)
+		require.Len(t, first.Msg.Bids, 1)
+		require.NotEmpty(t, first.Msg.NextPageToken)
+
+		second, err := list(token, &bidsv1.ListUserBidsRequest{PageSize: 1, PageToken: first.Msg.NextPageToken})
+		require.NoError(t, err)
+		require.Len(t, second.Msg.Bids, 1)
+		assert.Equal(t, int64(1100), second.Msg.Bids[0].Amount)
+	})
+
+	t.Run("other users require admin", func(t *testing.T) {
+		_, err := list(authConfig.generateTestToken(t, other), &bidsv1.ListUserBidsRequest{UserId: bidder.String()})
+		require.Error(t, err)
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+		res, err := list(authConfig.generateAdminToken(t, uuid.New()), &bidsv1.ListUserBidsRequest{UserId: bidder.String()})
+		require.NoError(t, err)
+		assert.Len(t, res.Msg.Bids, 2)
+	})
+}
@@ -0,0 +1,101 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	bidsv1 "github.com/floroz/gavel/pkg/proto/bids/v1"
+	"github.com/floroz/gavel/pkg/testhelpers"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/items"
+)
+
+func TestAPI_GetWinningBid(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+
+	client, pool, authConfig := setupBidApp(t, testDB.Pool)
+	ctx := context.Background()
+
+	item := &items.Item{
+		ID:         uuid.New(),
+		Title:      "Winning Bid Item",
+		StartPrice: 1000,
+		EndAt:      time.Now().Add(24 * time.Hour),
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+		Images:     []string{},
+		Category:   "test",
+		SellerID:   uuid.New(),
+		Status:     items.ItemStatusActive,
+	}
+	seedTestItem(t, pool, item)
+
+	getWinningBid := func(token string) *bidsv1.GetWinningBidResponse {
+		t.Helper()
+		req := connect.NewRequest(&bidsv1.GetWinningBidRequest{ItemId: item.ID.String()})
+		if token != "" {
+			req.Header().Set("Authorization", "Bearer "+token)
+		}
+		resp, err := client.GetWinningBid(ctx, req)
+		require.NoError(t, err)
+		return resp.Msg
+	}
+
+	t.Run("no bids is an empty result", func(t *testing.T) {
+		res := getWinningBid("")
+		assert.Nil(t, res.Bid)
+		assert.False(t, res.IsCallerWinning)
+	})
+
+	bidder := uuid.New()
+	bidderToken := authConfig.generateTestToken(t, bidder)
+	req := connect.NewRequest(&bidsv1.PlaceBidRequest{ItemId: item.ID.String(), Amount: 1500})
+	req.Header().Set("Authorization", "Bearer "+bidderToken)
+	placed, err := client.PlaceBid(ctx, req)
+	require.NoError(t, err)
+
+	t.Run("one bid is public under a pseudonym", func(t *testing.T) {
+		res := getWinningBid("")
+		require.NotNil(t, res.Bid)
+		assert.Equal(t, placed.Msg.Bid.Id, res.Bid.Id)
+		assert.Equal(t, int64(1500), res.Bid.Amount)
+		assert.Empty(t, res.Bid.UserId, "real bidder ids must not be exposed")
+		assert.Regexp(t, `^Bidder #[0-9a-f]{6}$`, res.Bid.BidderLabel)
+		assert.False(t, res.IsCallerWinning)
+	})
+
+	t.Run("the winning bidder sees their own id", func(t *testing.T) {
+		res := getWinningBid(bidderToken)
+		require.NotNil(t, res.Bid)
+		assert.Equal(t, bidder.String(), res.Bid.UserId)
+		assert.True(t, res.IsCallerWinning)
+	})
+
+	t.Run("another bidder is not told who is winning", func(t *testing.T) {
+		res := getWinningBid(authConfig.generateTestToken(t, uuid.New()))
+		require.NotNil(t, res.Bid)
+		assert.Empty(t, res.Bid.UserId)
+		assert.False(t, res.IsCallerWinning)
+	})
+
+	t.Run("the seller sees the bidder", func(t *testing.T) {
+		res := getWinningBid(authConfig.generateTestToken(t, item.SellerID))
+		require.NotNil(t, res.Bid)
+		assert.Equal(t, bidder.String(), res.Bid.UserId)
+		assert.False(t, res.IsCallerWinning)
+	})
+
+	t.Run("unknown item", func(t *testing.T) {
+		_, err := client.GetWinningBid(ctx, connect.NewRequest(&bidsv1.GetWinningBidRequest{ItemId: uuid.NewString()}))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	})
+}
@@ -0,0 +1,125 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	bidsv1 "github.com/floroz/gavel/pkg/proto/bids/v1"
+	"github.com/floroz/gavel/pkg/testhelpers"
+	"github.com/floroz/gavel/services/bid-service/internal/domain/items"
+)
+
+func TestListWonAuctions(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../migrations")
+	defer testDB.Close()
+
+	client, pool, authConfig := setupBidApp(t, testDB.Pool)
+	ctx := context.Background()
+
+	alice, bob := uuid.New(), uuid.New()
+
+	seed := func(title string) *items.Item {
+		item := &items.Item{
+			ID:         uuid.New(),
+			Title:      title,
+			StartPrice: 1000,
+			EndAt:      time.Now().Add(time.Hour),
+			CreatedAt:  time.Now(),
+			UpdatedAt:  time.Now(),
+			Images:     []string{},
+			Category:   "test",
+			SellerID:   uuid.New(),
+			Status:     items.ItemStatusActive,
+		}
+		seedTestItem(t, pool, item)
+		return item
+	}
+
+	bid := func(userID uuid.UUID, item *items.Item, amount int64) {
+		req := connect.NewRequest(&bidsv1.PlaceBidRequest{ItemId: item.ID.String(), Amount: amount})
+		req.Header().Set("Authorization", "Bearer "+authConfig.generateTestToken(t, userID))
+		_, err := client.PlaceBid(ctx, req)
+		require.NoError(t, err)
+	}
+
+	// finish closes the auction, endedAgo in the past, optionally leaving the status behind
+	finish := func(item *items.Item, status items.ItemStatus, endedAgo time.Duration) {
+		_, err := pool.Exec(ctx, `UPDATE items SET status = $1, end_at = NOW() - make_interval(secs => $2) WHERE id = $3`,
+			status, endedAgo.Seconds(), item.ID)
+		require.NoError(t, err)
+	}
+
+	wonEarlier := seed("Alice won earlier")
+	bid(bob, wonEarlier, 1500)
+	bid(alice, wonEarlier, 2000)
+	finish(wonEarlier, items.ItemStatusEnded, 2*time.Hour)
+
+	wonLater := seed("Alice won later")
+	bid(alice, wonLater, 1200)
+	finish(wonLater, items.ItemStatusEnded, time.Hour)
+
+	pastEnd := seed("Alice won, status not yet updated")
+	bid(alice, pastEnd, 1100)
+	finish(pastEnd, items.ItemStatusActive, time.Minute)
+
+	outbid := seed("Bob outbid Alice")
+	bid(alice, outbid, 1500)
+	bid(bob, outbid, 3000)
+	finish(outbid, items.ItemStatusEnded, 30*time.Minute)
+
+	stillOpen := seed("Alice leading, still open")
+	bid(alice, stillOpen, 1500)
+
+	listWon := func(userID uuid.UUID, msg *bidsv1.ListWonAuctionsRequest) *bidsv1.ListWonAuctionsResponse {
+		req := connect.NewRequest(msg)
+		req.Header().Set("Authorization", "Bearer "+authConfig.generateTestToken(t, userID))
+		res, err := client.ListWonAuctions(ctx, req)
+		require.NoError(t, err)
+		return res.Msg
+	}
+
+	t.Run("user sees only their own wins, most recent first", func(t *testing.T) {
+		res := listWon(alice, &bidsv1.ListWonAuctionsRequest{})
+		require.Len(t, res.Auctions, 3)
+		assert.Equal(t, pastEnd.ID.String(), res.Auctions[0].Item.Id)
+		assert.Equal(t, wonLater.ID.String(), res.Auctions[1].Item.Id)
+		assert.Equal(t, wonEarlier.ID.String(), res.Auctions[2].Item.Id)
+
+		winning := res.Auctions[2].WinningBid
+		assert.Equal(t, alice.String(), winning.UserId)
+		assert.Equal(t, int64(2000), winning.Amount)
+		assert.Equal(t, wonEarlier.ID.String(), winning.ItemId)
+	})
+
+	t.Run("other bidders see their wins", func(t *testing.T) {
+		res := listWon(bob, &bidsv1.ListWonAuctionsRequest{})
+		require.Len(t, res.Auctions, 1)
+		assert.Equal(t, outbid.ID.String(), res.Auctions[0].Item.Id)
+		assert.Equal(t, int64(3000), res.Auctions[0].WinningBid.Amount)
+	})
+
+	t.Run("pages", func(t *testing.T) {
+		first := listWon(alice, &bidsv1.ListWonAuctionsRequest{PageSize: 2})
+		require.Len(t, first.Auctions, 2)
+		require.NotEmpty(t, first.NextPageToken)
+
+		second := listWon(alice, &bidsv1.ListWonAuctionsRequest{PageSize: 2, PageToken: first.NextPageToken})
+		require.Len(t, second.Auctions, 1)
+		assert.Equal(t, wonEarlier.ID.String(), second.Auctions[0].Item.Id)
+		assert.Empty(t, second.NextPageToken)
+	})
+
+	t.Run("requires authentication", func(t *testing.T) {
+		_, err := client.ListWonAuctions(ctx, connect.NewRequest(&bidsv1.ListWonAuctionsRequest{}))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	})
+}
@@ -8,30 +8,48 @@ import (
 	"time"
 
 	"connectrpc.com/connect"
-	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/joho/godotenv"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 
 	"github.com/floroz/gavel/pkg/auth"
 	pkgdb "github.com/floroz/gavel/pkg/database"
+	"github.com/floroz/gavel/pkg/limits"
+	"github.com/floroz/gavel/pkg/logging"
 	"github.com/floroz/gavel/pkg/proto/userstats/v1/userstatsv1connect"
+	"github.com/floroz/gavel/pkg/recovery"
+	"github.com/floroz/gavel/pkg/tracing"
 	"github.com/floroz/gavel/services/user-stats-service/internal/adapters/api"
 	"github.com/floroz/gavel/services/user-stats-service/internal/adapters/database"
 	"github.com/floroz/gavel/services/user-stats-service/internal/domain/userstats"
 )
 
 func main() {
-	// Initialize structured logger
-	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
-	slog.SetDefault(logger)
-
 	// Load environment variables (local overrides .env)
 	_ = godotenv.Load(".env.local")
 	_ = godotenv.Load()
 
+	// Initialize structured logger (LOG_LEVEL, LOG_FORMAT)
+	logger := logging.NewFromEnv()
+	slog.SetDefault(logger)
+
 	ctx := context.Background()
 
+	// Tracing is a no-op unless an OTLP endpoint is configured
+	shutdownTracing, err := tracing.Setup(ctx, "user-stats-service")
+	if err != nil {
+		logger.Error("Failed to set up tracing", "error", err)
+		os.Exit(1)
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			logger.Error("Failed to flush traces", "error", err)
+		}
+	}()
+
 	// 1. Load JWT Public Key for token validation
 	publicKeyPath := os.Getenv("JWT_PUBLIC_KEY_PATH")
 	if publicKeyPath == "" {
@@ -52,11 +70,15 @@ func main() {
 	}
 
 	// Create signer with only public key (for validation only)
-	signer, err := auth.NewSignerFromPublicKey(publicKeyPEM, issuer)
+	signer, err := auth.NewSignerFromPublicKey(publicKeyPEM, issuer, nil)
 	if err != nil {
 		logger.Error("Failed to create signer", "error", err)
 		os.Exit(1)
 	}
+	if err := signer.SelfTest(); err != nil {
+		logger.Error("JWT key check failed", "error", err)
+		os.Exit(1)
+	}
 	logger.Info("JWT public key loaded", "path", publicKeyPath)
 
 	// 2. Initialize Postgres Connection Pool
@@ -65,12 +87,8 @@ func main() {
 		logger.Error("USER_STATS_DB_URL is not set")
 		os.Exit(1)
 	}
-	dbConfig, err := pgxpool.ParseConfig(dbURL)
-	if err != nil {
-		logger.Error("Unable to parse database config", "error", err)
-		os.Exit(1)
-	}
-	pool, err := pgxpool.NewWithConfig(ctx, dbConfig)
+
+	pool, err := pkgdb.NewPool(ctx, dbURL)
 	if err != nil {
 		logger.Error("Unable to create connection pool", "error", err)
 		os.Exit(1)
@@ -83,25 +101,46 @@ func main() {
 	}
 	logger.Info("Postgres Connected")
 
+	if migrateErr := pkgdb.MigrateFromEnv(ctx, dbURL, logger); migrateErr != nil {
+		logger.Error("Unable to run database migrations", "error", migrateErr)
+		os.Exit(1)
+	}
+
 	// 2. Initialize Dependencies
 	txManager := pkgdb.NewPostgresTransactionManager(pool, 5*time.Second)
-	statsRepo := database.NewUserStatsRepository(pool)
+	statsRepo := database.NewUserStatsRepository(pool, pkgdb.DefaultQueryTimeout)
 	statsService := userstats.NewService(statsRepo, txManager)
 
 	// 4. Initialize API Handler with auth interceptor
 	statsHandler := api.NewUserStatsServiceHandler(statsService)
+	// MAX_REQUEST_BYTES caps request messages; oversized ones get CodeResourceExhausted
+	maxRequestBytes, err := limits.MaxRequestBytesFromEnv()
+	if err != nil {
+		logger.Error("Invalid request size limit", "error", err)
+		os.Exit(1)
+	}
 	authInterceptor := auth.NewAuthInterceptor(signer)
 	path, handler := userstatsv1connect.NewUserStatsServiceHandler(
 		statsHandler,
-		connect.WithInterceptors(authInterceptor),
+		connect.WithInterceptors(recovery.NewInterceptor(logger), tracing.NewInterceptor(), authInterceptor),
+		connect.WithReadMaxBytes(maxRequestBytes),
+	)
+
+	// Metrics are served on /metrics
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		pkgdb.NewPoolCollector(pool),
 	)
 
 	mux := http.NewServeMux()
-	mux.Handle(path, handler)
+	mux.Handle(path, limits.MaxBytesHandler(handler, maxRequestBytes))
 	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 		_, _ = w.Write([]byte("OK"))
 	})
+	mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
 
 	// 4. Start Server
 	addr := ":8081" // Use 8081 for Stats Service API to avoid conflict with Bid API (8080)
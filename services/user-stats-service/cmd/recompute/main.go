@@ -0,0 +1,108 @@
+// Command recompute rebuilds user stats from the bid service's bids, correcting totals that
+// drifted through lost or double-applied events.
+//
+// Usage:
+//
+//	go run ./services/user-stats-service/cmd/recompute -user 2b1c...   # one user
+//	go run ./services/user-stats-service/cmd/recompute -batch 200      # every user with stats
+//
+// It reads USER_STATS_DB_URL, BID_SERVICE_URL and SERVICE_TOKEN_PATH. The token must carry
+// the admin permission (servicetoken -permissions admin), since the totals come from the bid
+// service's AdminGetUserBidTotals RPC. Corrected users are printed; it is safe to run while
+// the worker is consuming events.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/floroz/gavel/pkg/auth"
+	"github.com/floroz/gavel/pkg/clients/bid"
+	pkgdb "github.com/floroz/gavel/pkg/database"
+	"github.com/floroz/gavel/services/user-stats-service/internal/adapters/bidclient"
+	"github.com/floroz/gavel/services/user-stats-service/internal/adapters/database"
+	"github.com/floroz/gavel/services/user-stats-service/internal/domain/userstats"
+)
+
+func main() {
+	user := flag.String("user", "", "recompute only this user ID (default: every user with stats)")
+	batch := flag.Int("batch", 100, "users per batch in a full sweep")
+	flag.Parse()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := run(ctx, *user, *batch); err != nil {
+		fmt.Fprintf(os.Stderr, "recompute: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, user string, batch int) error {
+	dbURL := os.Getenv("USER_STATS_DB_URL")
+	if dbURL == "" {
+		return errors.New("USER_STATS_DB_URL is not set")
+	}
+	bidURL := os.Getenv("BID_SERVICE_URL")
+	if bidURL == "" {
+		return errors.New("BID_SERVICE_URL is not set")
+	}
+	tokenPath := os.Getenv(auth.EnvServiceTokenPath)
+	if tokenPath == "" {
+		return fmt.Errorf("%s is not set", auth.EnvServiceTokenPath)
+	}
+
+	pool, err := pkgdb.NewPool(ctx, dbURL)
+	if err != nil {
+		return fmt.Errorf("failed to create connection pool: %w", err)
+	}
+	defer pool.Close()
+
+	bidClient := bid.NewClient(&http.Client{Timeout: 10 * time.Second}, bidURL, auth.NewFileTokenSource(tokenPath))
+	recomputer := userstats.NewRecomputer(
+		database.NewUserStatsRepository(pool, pkgdb.DefaultQueryTimeout),
+		pkgdb.NewPostgresTransactionManager(pool, 5*time.Second),
+		bidclient.NewBidTotalsSource(bidClient),
+	)
+
+	if user != "" {
+		userID, err := uuid.Parse(user)
+		if err != nil {
+			return fmt.Errorf("invalid -user: %w", err)
+		}
+		rec, err := recomputer.RecomputeUserStats(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if rec.Drifted() {
+			printCorrection(rec)
+		} else {
+			fmt.Fprintf(os.Stderr, "stats for %s were already correct\n", userID)
+		}
+		return nil
+	}
+
+	drifted, err := recomputer.RecomputeAllUserStats(ctx, batch)
+	for _, rec := range drifted {
+		printCorrection(rec)
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "corrected %d users\n", len(drifted))
+	return nil
+}
+
+func printCorrection(rec *userstats.Recomputation) {
+	fmt.Printf("%s bids %d -> %d, amount %d -> %d\n",
+		rec.UserID, rec.Stored.Count, rec.Actual.Count, rec.Stored.TotalAmount, rec.Actual.TotalAmount)
+}
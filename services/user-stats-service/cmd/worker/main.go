@@ -2,32 +2,40 @@ package main
 
 import (
 	"context"
+	"errors"
 	"log/slog"
+	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 	"time"
 
-	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/joho/godotenv"
-	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"golang.org/x/sync/errgroup"
 
 	pkgdb "github.com/floroz/gavel/pkg/database"
+	"github.com/floroz/gavel/pkg/dial"
+	"github.com/floroz/gavel/pkg/logging"
+	"github.com/floroz/gavel/pkg/shutdown"
 	"github.com/floroz/gavel/services/user-stats-service/internal/adapters/database"
 	"github.com/floroz/gavel/services/user-stats-service/internal/adapters/events"
+	"github.com/floroz/gavel/services/user-stats-service/internal/domain/readmodel"
 	"github.com/floroz/gavel/services/user-stats-service/internal/domain/userstats"
 )
 
 func main() {
-	// Initialize structured logger
-	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
-	slog.SetDefault(logger)
-
 	// Load environment variables (local overrides .env)
 	_ = godotenv.Load(".env.local")
 	_ = godotenv.Load()
 
+	// Initialize structured logger (LOG_LEVEL, LOG_FORMAT)
+	logger := logging.NewFromEnv()
+	slog.SetDefault(logger)
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -40,18 +48,20 @@ func main() {
 		cancel()
 	}()
 
+	shutdownTimeout, err := shutdown.TimeoutFromEnv()
+	if err != nil {
+		logger.Error("Invalid shutdown timeout", "error", err)
+		os.Exit(1)
+	}
+
 	// 1. Initialize Postgres Connection Pool
 	dbURL := os.Getenv("USER_STATS_DB_URL")
 	if dbURL == "" {
 		logger.Error("USER_STATS_DB_URL is not set")
 		os.Exit(1)
 	}
-	dbConfig, err := pgxpool.ParseConfig(dbURL)
-	if err != nil {
-		logger.Error("Unable to parse database config", "error", err)
-		os.Exit(1)
-	}
-	pool, err := pgxpool.NewWithConfig(ctx, dbConfig)
+
+	pool, err := pkgdb.NewPool(ctx, dbURL)
 	if err != nil {
 		logger.Error("Unable to create connection pool", "error", err)
 		os.Exit(1)
@@ -64,30 +74,92 @@ func main() {
 	}
 	logger.Info("Postgres Connected")
 
+	if migrateErr := pkgdb.MigrateFromEnv(ctx, dbURL, logger); migrateErr != nil {
+		logger.Error("Unable to run database migrations", "error", migrateErr)
+		os.Exit(1)
+	}
+
 	// 2. Initialize Dependencies
 	txManager := pkgdb.NewPostgresTransactionManager(pool, 5*time.Second)
-	statsRepo := database.NewUserStatsRepository(pool)
+	statsRepo := database.NewUserStatsRepository(pool, pkgdb.DefaultQueryTimeout)
 	statsService := userstats.NewService(statsRepo, txManager)
+	outbidNotifier := userstats.NewOutbidNotifier(statsRepo)
+	projector := readmodel.NewProjector(database.NewItemReadModelRepository(pool, pkgdb.DefaultQueryTimeout), txManager)
 
 	// 3. Connect to RabbitMQ
-	rabbitURL := os.Getenv("RABBITMQ_URL")
-	if rabbitURL == "" {
-		logger.Error("RABBITMQ_URL is not set")
+	// RABBITMQ_URL, optionally RABBITMQ_USERNAME/RABBITMQ_PASSWORD and RABBITMQ_TLS*
+	rabbitCfg, err := dial.RabbitMQFromEnv()
+	if err != nil {
+		logger.Error("Invalid RabbitMQ configuration", "error", err)
 		os.Exit(1)
 	}
-	amqpConn, err := amqp.Dial(rabbitURL)
+	amqpConn, err := rabbitCfg.Dial()
 	if err != nil {
 		logger.Error("Failed to connect to RabbitMQ", "error", err)
 		os.Exit(1)
 	}
 	defer amqpConn.Close()
 
-	// 4. Start Consumers
-	bidConsumer := events.NewBidConsumer(amqpConn, statsService, logger)
-	userConsumer := events.NewUserConsumer(amqpConn, statsService, logger)
+	// 4. Initialize Metrics
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		pkgdb.NewPoolCollector(pool),
+	)
+	metrics := events.NewMetrics(registry)
+
+	metricsAddr := os.Getenv("METRICS_ADDR")
+	if metricsAddr == "" {
+		metricsAddr = ":9091"
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+	metricsServer := &http.Server{
+		Addr:              metricsAddr,
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	// 5. Start Consumers
+	// BID_ACK_BATCH_SIZE acks bid events that many at a time; unset acks each one
+	bidAckBatchSize := 1
+	if v := os.Getenv("BID_ACK_BATCH_SIZE"); v != "" {
+		bidAckBatchSize, err = strconv.Atoi(v)
+		if err != nil || bidAckBatchSize <= 0 {
+			logger.Error("Invalid BID_ACK_BATCH_SIZE", "value", v)
+			os.Exit(1)
+		}
+	}
+	bidConsumer := events.NewBidConsumer(amqpConn, statsService, metrics, logger, bidAckBatchSize)
+	userConsumer := events.NewUserConsumer(amqpConn, statsService, metrics, logger)
+	outbidConsumer := events.NewOutbidConsumer(amqpConn, outbidNotifier, metrics, logger)
+	readModelConsumer := events.NewReadModelConsumer(amqpConn, projector, metrics, logger)
+	depthMonitor := events.NewQueueDepthMonitor(amqpConn, metrics, []string{
+		events.BidQueue, events.BidDeadLetterQueue, events.UserQueue, events.OutbidQueue, events.ReadModelQueue,
+	}, 15*time.Second, logger)
 
 	g, gCtx := errgroup.WithContext(ctx)
 
+	g.Go(func() error {
+		logger.Info("Metrics server starting", "addr", metricsAddr)
+		if err := metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+		return nil
+	})
+
+	g.Go(func() error {
+		<-gCtx.Done()
+		shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer shutdownCancel()
+		return metricsServer.Shutdown(shutdownCtx)
+	})
+
+	g.Go(func() error {
+		return depthMonitor.Run(gCtx)
+	})
+
 	g.Go(func() error {
 		logger.Info("Starting bid consumer...")
 		return bidConsumer.Run(gCtx)
@@ -98,9 +170,24 @@ func main() {
 		return userConsumer.Run(gCtx)
 	})
 
-	if err := g.Wait(); err != nil {
+	g.Go(func() error {
+		logger.Info("Starting outbid consumer...")
+		return outbidConsumer.Run(gCtx)
+	})
+
+	g.Go(func() error {
+		logger.Info("Starting item read model consumer...")
+		return readModelConsumer.Run(gCtx)
+	})
+
+	// On shutdown each consumer finishes the message it is handling; give them
+	// shutdownTimeout to do so
+	if err := shutdown.Wait(ctx, shutdownTimeout, g.Wait); err != nil {
+		if errors.Is(err, shutdown.ErrTimeout) {
+			logger.Error("Consumers did not stop in time", "timeout", shutdownTimeout)
+			os.Exit(1)
+		}
 		logger.Error("Consumers failed", "error", err)
-		// Don't exit here immediately if context was canceled?
 		// Run returns nil on context cancel.
 		if ctx.Err() == nil {
 			os.Exit(1)
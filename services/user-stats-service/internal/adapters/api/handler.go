@@ -35,6 +35,20 @@ func (h *UserStatsServiceHandler) GetUserStats(
 		return nil, connect.NewError(connect.CodeInternal, errors.New("invalid user_id in token"))
 	}
 
+	// Admins may read anyone's stats, e.g. for a data export
+	if req.Msg.UserId != "" {
+		requested, err := uuid.Parse(req.Msg.UserId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid user_id"))
+		}
+		if requested != userID {
+			if err := auth.RequirePermission(ctx, auth.PermissionAdmin); err != nil {
+				return nil, err
+			}
+			userID = requested
+		}
+	}
+
 	stats, err := h.service.GetUserStats(ctx, userID)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
@@ -1,3 +1,5 @@
+//go:build integration
+
 package api_test
 
 import (
@@ -66,11 +68,11 @@ func (c *testAuthConfig) generateTestToken(t *testing.T, userID uuid.UUID) strin
 func setupUserStatsService(t *testing.T, pool *pgxpool.Pool) (userstatsv1connect.UserStatsServiceClient, http.Handler, *testAuthConfig) {
 	// Generate test keys and create signer
 	privPEM, pubPEM := generateTestKeys(t)
-	signer, err := auth.NewSigner(privPEM, pubPEM, "test-issuer")
+	signer, err := auth.NewSigner(privPEM, pubPEM, "test-issuer", nil)
 	require.NoError(t, err, "Failed to create signer")
 
 	txManager := database.NewPostgresTransactionManager(pool, 5*time.Second)
-	repo := infradb.NewUserStatsRepository(pool)
+	repo := infradb.NewUserStatsRepository(pool, database.DefaultQueryTimeout)
 	service := userstats.NewService(repo, txManager)
 	handler := api.NewUserStatsServiceHandler(service)
 
@@ -160,6 +162,33 @@ func TestUserStatsServiceHandler_GetUserStats_Integration(t *testing.T) {
 		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
 	})
 
+	t.Run("Other users require admin", func(t *testing.T) {
+		target := uuid.New()
+		seedUserStats(t, testDB.Pool, &userstats.UserStats{
+			UserID:          target,
+			TotalBidsPlaced: 2,
+			TotalAmountBid:  3000,
+			LastBidAt:       time.Now(),
+			CreatedAt:       time.Now(),
+			UpdatedAt:       time.Now(),
+		})
+
+		req := connect.NewRequest(&userstatsv1.GetUserStatsRequest{UserId: target.String()})
+		req.Header().Set("Authorization", "Bearer "+authConfig.generateTestToken(t, uuid.New()))
+		_, err := client.GetUserStats(context.Background(), req)
+		require.Error(t, err)
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+		admin, err := authConfig.signer.GenerateTokens(uuid.New(), "admin@example.com", "Admin", []string{auth.PermissionAdmin})
+		require.NoError(t, err)
+		req = connect.NewRequest(&userstatsv1.GetUserStatsRequest{UserId: target.String()})
+		req.Header().Set("Authorization", "Bearer "+admin.AccessToken)
+		res, err := client.GetUserStats(context.Background(), req)
+		require.NoError(t, err)
+		assert.Equal(t, target.String(), res.Msg.Stats.UserId)
+		assert.Equal(t, int64(2), res.Msg.Stats.TotalBids)
+	})
+
 	t.Run("Unauthenticated", func(t *testing.T) {
 		// Request without auth header
 		req := connect.NewRequest(&userstatsv1.GetUserStatsRequest{})
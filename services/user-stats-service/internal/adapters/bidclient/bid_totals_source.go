@@ -0,0 +1,53 @@
+package bidclient
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	bidsv1 "github.com/floroz/gavel/pkg/proto/bids/v1"
+	"github.com/floroz/gavel/pkg/proto/bids/v1/bidsv1connect"
+	"github.com/floroz/gavel/services/user-stats-service/internal/domain/userstats"
+)
+
+// BidTotalsSource implements userstats.BidTotalsSource with the bid service's
+// AdminGetUserBidTotals RPC; the client's token must carry the admin permission
+type BidTotalsSource struct {
+	client bidsv1connect.BidServiceClient
+}
+
+var _ userstats.BidTotalsSource = (*BidTotalsSource)(nil)
+
+// NewBidTotalsSource creates a source backed by client
+func NewBidTotalsSource(client bidsv1connect.BidServiceClient) *BidTotalsSource {
+	return &BidTotalsSource{client: client}
+}
+
+// UserBidTotals fetches the user's totals as the bid service computes them from its bids
+func (s *BidTotalsSource) UserBidTotals(ctx context.Context, userID uuid.UUID) (userstats.BidTotals, error) {
+	res, err := s.client.AdminGetUserBidTotals(ctx, connect.NewRequest(&bidsv1.AdminGetUserBidTotalsRequest{
+		UserId: userID.String(),
+	}))
+	if err != nil {
+		return userstats.BidTotals{}, fmt.Errorf("failed to get user bid totals: %w", err)
+	}
+
+	totals := userstats.BidTotals{
+		Count:       res.Msg.BidCount,
+		TotalAmount: res.Msg.TotalAmount,
+	}
+	totals.AsOf, err = time.Parse(time.RFC3339Nano, res.Msg.AsOf)
+	if err != nil {
+		return userstats.BidTotals{}, fmt.Errorf("bid service returned invalid as_of %q", res.Msg.AsOf)
+	}
+	if res.Msg.LastBidAt != "" {
+		totals.LastBidAt, err = time.Parse(time.RFC3339Nano, res.Msg.LastBidAt)
+		if err != nil {
+			return userstats.BidTotals{}, fmt.Errorf("bid service returned invalid last_bid_at %q", res.Msg.LastBidAt)
+		}
+	}
+	return totals, nil
+}
@@ -0,0 +1,120 @@
+//go:build integration
+
+package database_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pkgdb "github.com/floroz/gavel/pkg/database"
+	"github.com/floroz/gavel/pkg/testhelpers"
+	"github.com/floroz/gavel/services/user-stats-service/internal/adapters/database"
+	"github.com/floroz/gavel/services/user-stats-service/internal/domain/readmodel"
+)
+
+func TestItemBidAggregates_Converge(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../../../migrations")
+	defer testDB.Close()
+
+	ctx := context.Background()
+	txManager := pkgdb.NewPostgresTransactionManager(testDB.Pool, 5*time.Second)
+	projector := readmodel.NewProjector(
+		database.NewItemReadModelRepository(testDB.Pool, pkgdb.DefaultQueryTimeout),
+		txManager,
+	)
+
+	bid := func(itemID, bidderID uuid.UUID, amount int64) readmodel.BidPlacedEvent {
+		return readmodel.BidPlacedEvent{EventID: uuid.New(), ItemID: itemID, BidderID: bidderID, Amount: amount}
+	}
+
+	t.Run("no bids yet", func(t *testing.T) {
+		agg, err := projector.GetBidAggregates(ctx, uuid.New())
+		require.NoError(t, err)
+		assert.Nil(t, agg)
+	})
+
+	t.Run("counts bids and distinct bidders", func(t *testing.T) {
+		itemID := uuid.New()
+		alice, bob := uuid.New(), uuid.New()
+
+		first := bid(itemID, alice, 1000)
+		events := []readmodel.BidPlacedEvent{
+			first,
+			bid(itemID, bob, 1500),
+			bid(itemID, alice, 2500),
+			first,                  // redelivered
+			bid(itemID, bob, 2000), // arrives after a higher bid
+		}
+		for _, event := range events {
+			require.NoError(t, projector.ProcessBidPlaced(ctx, event))
+		}
+
+		agg, err := projector.GetBidAggregates(ctx, itemID)
+		require.NoError(t, err)
+		require.NotNil(t, agg)
+		assert.Equal(t, int64(4), agg.BidCount, "the redelivered bid is counted once")
+		assert.Equal(t, int64(2), agg.DistinctBidders)
+		assert.Equal(t, int64(2500), agg.HighestBid, "a late lower bid does not lower the highest")
+	})
+
+	t.Run("concurrent bids converge", func(t *testing.T) {
+		itemID := uuid.New()
+		bidders := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+
+		// Every bidder bids several times, with first bids from the same user racing each other
+		const bidsPerBidder = 5
+		var wg sync.WaitGroup
+		for _, bidderID := range bidders {
+			for i := range bidsPerBidder {
+				wg.Add(1)
+				go func(bidderID uuid.UUID, amount int64) {
+					defer wg.Done()
+					assert.NoError(t, projector.ProcessBidPlaced(ctx, bid(itemID, bidderID, amount)))
+				}(bidderID, int64(1000+i*100))
+			}
+		}
+		wg.Wait()
+
+		agg, err := projector.GetBidAggregates(ctx, itemID)
+		require.NoError(t, err)
+		require.NotNil(t, agg)
+		assert.Equal(t, int64(len(bidders)*bidsPerBidder), agg.BidCount)
+		assert.Equal(t, int64(len(bidders)), agg.DistinctBidders)
+		assert.Equal(t, int64(1400), agg.HighestBid)
+	})
+	t.Run("deleted bidders are erased and not counted again", func(t *testing.T) {
+		itemID := uuid.New()
+		alice, bob := uuid.New(), uuid.New()
+		require.NoError(t, projector.ProcessBidPlaced(ctx, bid(itemID, alice, 1000)))
+
+		tx, err := txManager.BeginTx(ctx)
+		require.NoError(t, err)
+		statsRepo := database.NewUserStatsRepository(testDB.Pool, pkgdb.DefaultQueryTimeout)
+		require.NoError(t, statsRepo.DeleteUserData(ctx, tx, alice, time.Now()))
+		require.NoError(t, tx.Commit(ctx))
+
+		var memberships int
+		require.NoError(t, testDB.Pool.QueryRow(ctx,
+			"SELECT count(*) FROM item_bidders WHERE user_id = $1", alice).Scan(&memberships))
+		assert.Zero(t, memberships)
+
+		// A late event for the deleted user and a new bidder
+		require.NoError(t, projector.ProcessBidPlaced(ctx, bid(itemID, alice, 1200)))
+		require.NoError(t, projector.ProcessBidPlaced(ctx, bid(itemID, bob, 1500)))
+
+		agg, err := projector.GetBidAggregates(ctx, itemID)
+		require.NoError(t, err)
+		require.NotNil(t, agg)
+		assert.Equal(t, int64(3), agg.BidCount)
+		assert.Equal(t, int64(2), agg.DistinctBidders, "the deleted bidder is not counted twice")
+		require.NoError(t, testDB.Pool.QueryRow(ctx,
+			"SELECT count(*) FROM item_bidders WHERE user_id = $1", alice).Scan(&memberships))
+		assert.Zero(t, memberships)
+	})
+}
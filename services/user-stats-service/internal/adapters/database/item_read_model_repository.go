@@ -0,0 +1,249 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	pkgdb "github.com/floroz/gavel/pkg/database"
+	"github.com/floroz/gavel/services/user-stats-service/internal/domain/readmodel"
+)
+
+// ItemReadModelRepository implements readmodel.Repository on the item_read_model table.
+// Every write is an upsert whose update only moves columns forward (higher price, later
+// end time, newer details, final status), so events commute.
+type ItemReadModelRepository struct {
+	pool         *pgxpool.Pool
+	queryTimeout time.Duration
+}
+
+func NewItemReadModelRepository(pool *pgxpool.Pool, queryTimeout time.Duration) *ItemReadModelRepository {
+	return &ItemReadModelRepository{pool: pool, queryTimeout: queryTimeout}
+}
+
+func (r *ItemReadModelRepository) MarkEventProcessed(ctx context.Context, tx pgx.Tx, eventID uuid.UUID) (bool, error) {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	return markEventProcessed(ctx, tx, eventID)
+}
+
+// ApplyItemCreated fills in the listing. Bids or a final status applied before it are kept.
+func (r *ItemReadModelRepository) ApplyItemCreated(ctx context.Context, tx pgx.Tx, event readmodel.ItemCreatedEvent) error {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	query := `
+		INSERT INTO item_read_model (item_id, seller_id, title, category, details_at, status, start_price, current_price, start_at, end_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $7, $8, $9)
+		ON CONFLICT (item_id) DO UPDATE SET
+			seller_id = EXCLUDED.seller_id,
+			title = CASE WHEN item_read_model.details_at >= EXCLUDED.details_at THEN item_read_model.title ELSE EXCLUDED.title END,
+			category = CASE WHEN item_read_model.details_at >= EXCLUDED.details_at THEN item_read_model.category ELSE EXCLUDED.category END,
+			details_at = GREATEST(item_read_model.details_at, EXCLUDED.details_at),
+			status = CASE WHEN item_read_model.status IN ($10, $11) THEN item_read_model.status ELSE EXCLUDED.status END,
+			start_price = EXCLUDED.start_price,
+			current_price = GREATEST(item_read_model.current_price, EXCLUDED.current_price),
+			start_at = EXCLUDED.start_at,
+			end_at = GREATEST(item_read_model.end_at, EXCLUDED.end_at)
+	`
+	_, err := tx.Exec(ctx, query,
+		event.ItemID,              // $1
+		event.SellerID,            // $2
+		event.Title,               // $3
+		event.Category,            // $4
+		event.Timestamp,           // $5
+		event.Status,              // $6
+		event.StartPrice,          // $7
+		event.StartAt,             // $8
+		event.EndAt,               // $9
+		readmodel.StatusEnded,     // $10
+		readmodel.StatusCancelled, // $11
+	)
+	if err != nil {
+		return fmt.Errorf("failed to apply item created: %w", err)
+	}
+	return nil
+}
+
+// ApplyItemUpdated replaces the details unless the row already holds a newer version
+func (r *ItemReadModelRepository) ApplyItemUpdated(ctx context.Context, tx pgx.Tx, event readmodel.ItemUpdatedEvent) error {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	query := `
+		INSERT INTO item_read_model (item_id, title, category, details_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (item_id) DO UPDATE SET
+			title = EXCLUDED.title,
+			category = EXCLUDED.category,
+			details_at = EXCLUDED.details_at
+		WHERE item_read_model.details_at IS NULL OR item_read_model.details_at < EXCLUDED.details_at
+	`
+	_, err := tx.Exec(ctx, query, event.ItemID, event.Title, event.Category, event.Timestamp)
+	if err != nil {
+		return fmt.Errorf("failed to apply item updated: %w", err)
+	}
+	return nil
+}
+
+// ApplyBid counts the bid and raises the price. A bid also shows a scheduled item has opened.
+func (r *ItemReadModelRepository) ApplyBid(ctx context.Context, tx pgx.Tx, itemID uuid.UUID, amount int64) error {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	query := `
+		INSERT INTO item_read_model (item_id, current_price, bid_count)
+		VALUES ($1, $2, 1)
+		ON CONFLICT (item_id) DO UPDATE SET
+			current_price = GREATEST(item_read_model.current_price, EXCLUDED.current_price),
+			bid_count = item_read_model.bid_count + 1,
+			status = CASE WHEN item_read_model.status = $3 THEN $4 ELSE item_read_model.status END
+	`
+	_, err := tx.Exec(ctx, query, itemID, amount, readmodel.StatusScheduled, readmodel.StatusActive)
+	if err != nil {
+		return fmt.Errorf("failed to apply bid: %w", err)
+	}
+	return nil
+}
+
+// ApplyBidAggregate counts the bid and raises the highest bid in one statement. The bidder
+// is counted only when this bid adds them to item_bidders: a concurrent first bid from the
+// same user waits on the membership key and then finds it taken. Deleted users are never
+// added back, so a late event cannot count them a second time.
+func (r *ItemReadModelRepository) ApplyBidAggregate(ctx context.Context, tx pgx.Tx, itemID, bidderID uuid.UUID, amount int64) error {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	query := `
+		WITH new_bidder AS (
+			INSERT INTO item_bidders (item_id, user_id)
+			SELECT $1, $2
+			WHERE NOT EXISTS (SELECT 1 FROM deleted_users WHERE user_id = $2)
+			ON CONFLICT DO NOTHING
+			RETURNING user_id
+		)
+		INSERT INTO item_bid_aggregates (item_id, bid_count, distinct_bidders, highest_bid)
+		VALUES ($1, 1, (SELECT COUNT(*) FROM new_bidder), $3)
+		ON CONFLICT (item_id) DO UPDATE SET
+			bid_count = item_bid_aggregates.bid_count + 1,
+			distinct_bidders = item_bid_aggregates.distinct_bidders + EXCLUDED.distinct_bidders,
+			highest_bid = GREATEST(item_bid_aggregates.highest_bid, EXCLUDED.highest_bid)
+	`
+	_, err := tx.Exec(ctx, query, itemID, bidderID, amount)
+	if err != nil {
+		return fmt.Errorf("failed to apply bid aggregate: %w", err)
+	}
+	return nil
+}
+
+// ApplyFinalStatus ends or cancels the item; whichever final status is applied first stays
+func (r *ItemReadModelRepository) ApplyFinalStatus(ctx context.Context, tx pgx.Tx, itemID uuid.UUID, status string, amount int64) error {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	query := `
+		INSERT INTO item_read_model (item_id, status, current_price)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (item_id) DO UPDATE SET
+			status = CASE WHEN item_read_model.status IN ($4, $5) THEN item_read_model.status ELSE EXCLUDED.status END,
+			current_price = GREATEST(item_read_model.current_price, EXCLUDED.current_price)
+	`
+	_, err := tx.Exec(ctx, query, itemID, status, amount, readmodel.StatusEnded, readmodel.StatusCancelled)
+	if err != nil {
+		return fmt.Errorf("failed to apply status: %w", err)
+	}
+	return nil
+}
+
+// ApplyEndAt pushes the end time out to endAt; an earlier endAt is ignored
+func (r *ItemReadModelRepository) ApplyEndAt(ctx context.Context, tx pgx.Tx, itemID uuid.UUID, endAt time.Time) error {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	query := `
+		INSERT INTO item_read_model (item_id, end_at)
+		VALUES ($1, $2)
+		ON CONFLICT (item_id) DO UPDATE SET
+			end_at = GREATEST(item_read_model.end_at, EXCLUDED.end_at)
+	`
+	_, err := tx.Exec(ctx, query, itemID, endAt)
+	if err != nil {
+		return fmt.Errorf("failed to apply end time: %w", err)
+	}
+	return nil
+}
+
+func (r *ItemReadModelRepository) GetItem(ctx context.Context, itemID uuid.UUID) (*readmodel.ItemView, error) {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	query := `
+		SELECT item_id, seller_id, title, category, status, start_price, current_price, bid_count, start_at, end_at, updated_at
+		FROM item_read_model
+		WHERE item_id = $1
+	`
+	var item readmodel.ItemView
+	var sellerID *uuid.UUID
+	var startAt, endAt *time.Time
+	err := r.pool.QueryRow(ctx, query, itemID).Scan(
+		&item.ItemID,
+		&sellerID,
+		&item.Title,
+		&item.Category,
+		&item.Status,
+		&item.StartPrice,
+		&item.CurrentPrice,
+		&item.BidCount,
+		&startAt,
+		&endAt,
+		&item.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get item read model: %w", err)
+	}
+	if sellerID != nil {
+		item.SellerID = *sellerID
+	}
+	if startAt != nil {
+		item.StartAt = *startAt
+	}
+	if endAt != nil {
+		item.EndAt = *endAt
+	}
+	return &item, nil
+}
+
+func (r *ItemReadModelRepository) GetBidAggregates(ctx context.Context, itemID uuid.UUID) (*readmodel.BidAggregates, error) {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	query := `
+		SELECT item_id, bid_count, distinct_bidders, highest_bid, updated_at
+		FROM item_bid_aggregates
+		WHERE item_id = $1
+	`
+	var agg readmodel.BidAggregates
+	err := r.pool.QueryRow(ctx, query, itemID).Scan(
+		&agg.ItemID,
+		&agg.BidCount,
+		&agg.DistinctBidders,
+		&agg.HighestBid,
+		&agg.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get bid aggregates: %w", err)
+	}
+	return &agg, nil
+}
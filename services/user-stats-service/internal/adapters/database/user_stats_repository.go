@@ -2,6 +2,7 @@ package database
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -9,43 +10,78 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 
+	pkgdb "github.com/floroz/gavel/pkg/database"
 	"github.com/floroz/gavel/services/user-stats-service/internal/domain/userstats"
 )
 
 type UserStatsRepository struct {
-	pool *pgxpool.Pool
+	pool         *pgxpool.Pool
+	queryTimeout time.Duration
 }
 
-func NewUserStatsRepository(pool *pgxpool.Pool) *UserStatsRepository {
-	return &UserStatsRepository{pool: pool}
+func NewUserStatsRepository(pool *pgxpool.Pool, queryTimeout time.Duration) *UserStatsRepository {
+	return &UserStatsRepository{pool: pool, queryTimeout: queryTimeout}
 }
 
-// IncrementUserStats increments the user's bid stats atomically
-func (r *UserStatsRepository) IncrementUserStats(ctx context.Context, tx pgx.Tx, userID uuid.UUID, amount int64, lastBidAt time.Time) error {
+// recentBidRetention is how long a counted bid stays in user_stats_recent_bids. A recompute
+// only looks back to its totals' AsOf, which is seconds old, so an hour leaves ample room.
+const recentBidRetention = time.Hour
+
+// IncrementUserStats increments the user's bid stats atomically. Deleted users are skipped,
+// and so are bids the last recompute already counted (placed at or before recomputed_through).
+// A counted bid is also recorded in user_stats_recent_bids for ReplaceUserStats.
+func (r *UserStatsRepository) IncrementUserStats(ctx context.Context, tx pgx.Tx, userID, bidID uuid.UUID, amount int64, lastBidAt time.Time) error {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
 	query := `
 		INSERT INTO user_stats (user_id, total_bids_placed, total_amount_bid, last_bid_at, created_at, updated_at)
-		VALUES ($1, 1, $2, $3, NOW(), NOW())
+		SELECT $1, 1, $2, $3, NOW(), NOW()
+		WHERE NOT EXISTS (SELECT 1 FROM deleted_users WHERE user_id = $1)
 		ON CONFLICT (user_id) DO UPDATE SET
 			total_bids_placed = user_stats.total_bids_placed + 1,
-			total_amount_bid = user_stats.total_amount_bid + EXCLUDED.total_amount_bid,
-			last_bid_at = EXCLUDED.last_bid_at,
-			updated_at = NOW()
+			-- Saturate instead of failing with "bigint out of range": the sum is computed
+			-- as numeric and capped, so a poisoned total can never block the consumer
+			total_amount_bid = LEAST(user_stats.total_amount_bid::numeric + EXCLUDED.total_amount_bid, $4)::bigint,
+			last_bid_at = EXCLUDED.last_bid_at
+		WHERE user_stats.recomputed_through IS NULL OR $3 > user_stats.recomputed_through
 	`
-	_, err := tx.Exec(ctx, query,
-		userID,    // $1
-		amount,    // $2
-		lastBidAt, // $3
+	tag, err := tx.Exec(ctx, query,
+		userID,                             // $1
+		amount,                             // $2
+		lastBidAt,                          // $3
+		int64(userstats.MaxTotalAmountBid), // $4
 	)
 	if err != nil {
 		return fmt.Errorf("failed to increment user stats: %w", err)
 	}
+	if tag.RowsAffected() == 0 {
+		return nil
+	}
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO user_stats_recent_bids (bid_id, user_id, amount, placed_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (bid_id) DO NOTHING
+	`, bidID, userID, amount, lastBidAt); err != nil {
+		return fmt.Errorf("failed to record counted bid: %w", err)
+	}
+	if _, err := tx.Exec(ctx, `
+		DELETE FROM user_stats_recent_bids WHERE user_id = $1 AND placed_at < $2
+	`, userID, lastBidAt.Add(-recentBidRetention)); err != nil {
+		return fmt.Errorf("failed to prune counted bids: %w", err)
+	}
 	return nil
 }
 
 func (r *UserStatsRepository) CreateUserStats(ctx context.Context, tx pgx.Tx, userID uuid.UUID, createdAt time.Time) error {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
 	query := `
 		INSERT INTO user_stats (user_id, total_bids_placed, total_amount_bid, last_bid_at, created_at, updated_at)
-		VALUES ($1, 0, 0, NULL, $2, $2)
+		SELECT $1, 0, 0, NULL, $2, $2
+		WHERE NOT EXISTS (SELECT 1 FROM deleted_users WHERE user_id = $1)
 		ON CONFLICT (user_id) DO NOTHING
 	`
 	_, err := tx.Exec(ctx, query, userID, createdAt)
@@ -56,47 +92,217 @@ func (r *UserStatsRepository) CreateUserStats(ctx context.Context, tx pgx.Tx, us
 }
 
 func (r *UserStatsRepository) GetUserStats(ctx context.Context, userID uuid.UUID) (*userstats.UserStats, error) {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	query := `
+		SELECT user_id, total_bids_placed, total_amount_bid, last_bid_at, created_at, updated_at
+		FROM user_stats
+		WHERE user_id = $1
+	`
+	userStats, err := scanUserStats(r.pool.QueryRow(ctx, query, userID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user stats: %w", err)
+	}
+	return userStats, nil
+}
+
+// LockUserStats reads the user's stats within tx and locks the row until tx ends
+func (r *UserStatsRepository) LockUserStats(ctx context.Context, tx pgx.Tx, userID uuid.UUID) (*userstats.UserStats, error) {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
 	query := `
 		SELECT user_id, total_bids_placed, total_amount_bid, last_bid_at, created_at, updated_at
 		FROM user_stats
 		WHERE user_id = $1
+		FOR UPDATE
 	`
+	userStats, err := scanUserStats(tx.QueryRow(ctx, query, userID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock user stats: %w", err)
+	}
+	return userStats, nil
+}
+
+// scanUserStats scans a user_stats row, returning nil if there is none. last_bid_at is
+// NULL until the user's first bid and scans as the zero time.
+func scanUserStats(row pgx.Row) (*userstats.UserStats, error) {
 	var userStats userstats.UserStats
-	err := r.pool.QueryRow(ctx, query, userID).Scan(
+	var lastBidAt *time.Time
+	err := row.Scan(
 		&userStats.UserID,
 		&userStats.TotalBidsPlaced,
 		&userStats.TotalAmountBid,
-		&userStats.LastBidAt,
+		&lastBidAt,
 		&userStats.CreatedAt,
 		&userStats.UpdatedAt,
 	)
 	if err != nil {
-		if err == pgx.ErrNoRows {
+		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, nil
 		}
-		return nil, fmt.Errorf("failed to get user stats: %w", err)
+		return nil, err
+	}
+	if lastBidAt != nil {
+		userStats.LastBidAt = *lastBidAt
 	}
 	return &userStats, nil
 }
 
-func (r *UserStatsRepository) MarkEventProcessed(ctx context.Context, tx pgx.Tx, eventID uuid.UUID) error {
-	query := `INSERT INTO processed_events (event_id) VALUES ($1)`
-	_, err := tx.Exec(ctx, query, eventID)
+// ReplaceUserStats overwrites the user's bid stats with totals plus the bids already counted
+// from events placed after totals.AsOf, records totals.AsOf as recomputed_through and returns
+// the figures written, creating the row if needed. Deleted users are skipped.
+func (r *UserStatsRepository) ReplaceUserStats(ctx context.Context, tx pgx.Tx, userID uuid.UUID, totals userstats.BidTotals) (userstats.BidTotals, error) {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	var lastBidAt *time.Time
+	if !totals.LastBidAt.IsZero() {
+		lastBidAt = &totals.LastBidAt
+	}
+
+	query := `
+		WITH recent AS (
+			SELECT COUNT(*) AS n, COALESCE(SUM(amount), 0) AS amount, MAX(placed_at) AS last_at
+			FROM user_stats_recent_bids
+			WHERE user_id = $1 AND placed_at > $5
+		)
+		INSERT INTO user_stats (user_id, total_bids_placed, total_amount_bid, last_bid_at, recomputed_through, created_at, updated_at)
+		SELECT $1, $2 + recent.n, LEAST($3::numeric + recent.amount, $6)::bigint,
+			GREATEST($4::timestamptz, recent.last_at), $5, NOW(), NOW()
+		FROM recent
+		WHERE NOT EXISTS (SELECT 1 FROM deleted_users WHERE user_id = $1)
+		ON CONFLICT (user_id) DO UPDATE SET
+			total_bids_placed = EXCLUDED.total_bids_placed,
+			total_amount_bid = EXCLUDED.total_amount_bid,
+			last_bid_at = EXCLUDED.last_bid_at,
+			recomputed_through = EXCLUDED.recomputed_through
+		RETURNING total_bids_placed, total_amount_bid, last_bid_at
+	`
+	written := userstats.BidTotals{AsOf: totals.AsOf}
+	var writtenLastBidAt *time.Time
+	err := tx.QueryRow(ctx, query,
+		userID,                             // $1
+		totals.Count,                       // $2
+		totals.TotalAmount,                 // $3
+		lastBidAt,                          // $4
+		totals.AsOf,                        // $5
+		int64(userstats.MaxTotalAmountBid), // $6
+	).Scan(&written.Count, &written.TotalAmount, &writtenLastBidAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		// deleted user
+		return totals, nil
+	}
 	if err != nil {
-		return fmt.Errorf("failed to mark event processed: %w", err)
+		return userstats.BidTotals{}, fmt.Errorf("failed to replace user stats: %w", err)
 	}
-	return nil
+	if writtenLastBidAt != nil {
+		written.LastBidAt = *writtenLastBidAt
+	}
+
+	// The totals now cover these
+	if _, err := tx.Exec(ctx, `
+		DELETE FROM user_stats_recent_bids WHERE user_id = $1 AND placed_at <= $2
+	`, userID, totals.AsOf); err != nil {
+		return userstats.BidTotals{}, fmt.Errorf("failed to prune counted bids: %w", err)
+	}
+	return written, nil
+}
+
+// ListUserIDs returns the IDs of users with stats after the given one in ascending order
+func (r *UserStatsRepository) ListUserIDs(ctx context.Context, after uuid.UUID, limit int) ([]uuid.UUID, error) {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT user_id FROM user_stats WHERE user_id > $1 ORDER BY user_id LIMIT $2
+	`, after, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list user ids: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan user id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return ids, nil
+}
+
+// MarkEventProcessed inserts the event id, relying on the primary key rather than a prior
+// read: a concurrent delivery of the same event blocks on the insert until the first
+// transaction finishes, then fails with a unique violation, reported here as false
+func (r *UserStatsRepository) MarkEventProcessed(ctx context.Context, tx pgx.Tx, eventID uuid.UUID) (bool, error) {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	return markEventProcessed(ctx, tx, eventID)
 }
 
-func (r *UserStatsRepository) IsEventProcessed(ctx context.Context, tx pgx.Tx, eventID uuid.UUID) (bool, error) {
-	query := `SELECT 1 FROM processed_events WHERE event_id = $1`
-	var exists int
-	err := tx.QueryRow(ctx, query, eventID).Scan(&exists)
+// markEventProcessed claims eventID in processed_events, which every consumer in this
+// service shares
+func markEventProcessed(ctx context.Context, tx pgx.Tx, eventID uuid.UUID) (bool, error) {
+	query := `INSERT INTO processed_events (event_id) VALUES ($1)`
+	_, err := tx.Exec(ctx, query, eventID)
 	if err != nil {
-		if err == pgx.ErrNoRows {
+		if pkgdb.IsUniqueViolation(err) {
 			return false, nil
 		}
-		return false, fmt.Errorf("failed to check processed event: %w", err)
+		return false, fmt.Errorf("failed to mark event processed: %w", err)
 	}
 	return true, nil
 }
+
+// CreateOutbidNotification inserts the notification unless one exists for the same bid
+// or the user has been deleted, and reports whether it inserted one
+func (r *UserStatsRepository) CreateOutbidNotification(ctx context.Context, n *userstats.OutbidNotification) (bool, error) {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	query := `
+		INSERT INTO outbid_notifications (bid_id, user_id, item_id, previous_amount, new_amount, created_at)
+		SELECT $1, $2, $3, $4, $5, $6
+		WHERE NOT EXISTS (SELECT 1 FROM deleted_users WHERE user_id = $2)
+		ON CONFLICT (bid_id) DO NOTHING
+	`
+	tag, err := r.pool.Exec(ctx, query, n.BidID, n.UserID, n.ItemID, n.PreviousAmount, n.NewAmount, n.CreatedAt)
+	if err != nil {
+		return false, fmt.Errorf("failed to create outbid notification: %w", err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// DeleteUserData removes the user's stats, notifications and item memberships and records
+// the deletion so later events for the user are ignored. The items' distinct bidder counts
+// are left as they are. Repeating it is a no-op.
+func (r *UserStatsRepository) DeleteUserData(ctx context.Context, tx pgx.Tx, userID uuid.UUID, deletedAt time.Time) error {
+	ctx, cancel := pkgdb.WithQueryTimeout(ctx, r.queryTimeout)
+	defer cancel()
+
+	if _, err := tx.Exec(ctx, `INSERT INTO deleted_users (user_id, deleted_at) VALUES ($1, $2) ON CONFLICT (user_id) DO NOTHING`, userID, deletedAt); err != nil {
+		return fmt.Errorf("failed to record deleted user: %w", err)
+	}
+	if _, err := tx.Exec(ctx, `DELETE FROM user_stats WHERE user_id = $1`, userID); err != nil {
+		return fmt.Errorf("failed to delete user stats: %w", err)
+	}
+	if _, err := tx.Exec(ctx, `DELETE FROM outbid_notifications WHERE user_id = $1`, userID); err != nil {
+		return fmt.Errorf("failed to delete outbid notifications: %w", err)
+	}
+	if _, err := tx.Exec(ctx, `DELETE FROM user_stats_recent_bids WHERE user_id = $1`, userID); err != nil {
+		return fmt.Errorf("failed to delete counted bids: %w", err)
+	}
+	if _, err := tx.Exec(ctx, `DELETE FROM item_bidders WHERE user_id = $1`, userID); err != nil {
+		return fmt.Errorf("failed to delete item bidders: %w", err)
+	}
+	return nil
+}
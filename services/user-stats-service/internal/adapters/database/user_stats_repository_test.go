@@ -0,0 +1,244 @@
+//go:build integration
+
+package database_test
+
+import (
+	"context"
+	"math"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pkgdb "github.com/floroz/gavel/pkg/database"
+	"github.com/floroz/gavel/pkg/testhelpers"
+	"github.com/floroz/gavel/services/user-stats-service/internal/adapters/database"
+	"github.com/floroz/gavel/services/user-stats-service/internal/domain/userstats"
+)
+
+func TestUserStatsRepository_IncrementUserStats_Saturates(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../../../migrations")
+	defer testDB.Close()
+
+	ctx := context.Background()
+	repo := database.NewUserStatsRepository(testDB.Pool, pkgdb.DefaultQueryTimeout)
+	txManager := pkgdb.NewPostgresTransactionManager(testDB.Pool, time.Second)
+	userID := uuid.New()
+
+	increment := func(amount int64) {
+		t.Helper()
+		tx, err := txManager.BeginTx(ctx)
+		require.NoError(t, err)
+		require.NoError(t, repo.IncrementUserStats(ctx, tx, userID, uuid.New(), amount, time.Now()))
+		require.NoError(t, tx.Commit(ctx))
+	}
+
+	// Large sums accumulate exactly while they fit
+	increment(math.MaxInt64 / 4)
+	increment(math.MaxInt64 / 4)
+
+	stats, err := repo.GetUserStats(ctx, userID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(math.MaxInt64/4)*2, stats.TotalAmountBid)
+
+	// Past the cap the total saturates instead of failing the event
+	increment(math.MaxInt64 / 2)
+	increment(math.MaxInt64 / 2)
+
+	stats, err = repo.GetUserStats(ctx, userID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(userstats.MaxTotalAmountBid), stats.TotalAmountBid)
+	assert.Equal(t, int64(4), stats.TotalBidsPlaced)
+}
+
+func TestUserStatsService_ProcessBidPlaced_ConcurrentRedelivery(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../../../migrations")
+	defer testDB.Close()
+
+	ctx := context.Background()
+	repo := database.NewUserStatsRepository(testDB.Pool, pkgdb.DefaultQueryTimeout)
+	txManager := pkgdb.NewPostgresTransactionManager(testDB.Pool, 5*time.Second)
+	service := userstats.NewService(repo, txManager)
+
+	for round := 0; round < 20; round++ {
+		event := userstats.BidPlacedEvent{
+			EventID:   uuid.New(),
+			UserID:    uuid.New(),
+			ItemID:    uuid.New(),
+			Amount:    1500,
+			Timestamp: time.Now(),
+		}
+
+		// Both deliveries start together so their transactions overlap
+		start := make(chan struct{})
+		errs := make(chan error, 2)
+		var wg sync.WaitGroup
+		for range 2 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				<-start
+				errs <- service.ProcessBidPlaced(ctx, event)
+			}()
+		}
+		close(start)
+		wg.Wait()
+		close(errs)
+
+		for err := range errs {
+			require.NoError(t, err, "a duplicate delivery is acknowledged, not failed")
+		}
+
+		stats, err := repo.GetUserStats(ctx, event.UserID)
+		require.NoError(t, err)
+		require.NotNil(t, stats)
+		assert.Equal(t, int64(1), stats.TotalBidsPlaced, "round %d", round)
+		assert.Equal(t, int64(1500), stats.TotalAmountBid, "round %d", round)
+	}
+}
+
+// fixedTotalsSource returns the same totals for every user
+type fixedTotalsSource struct {
+	totals userstats.BidTotals
+}
+
+func (s fixedTotalsSource) UserBidTotals(context.Context, uuid.UUID) (userstats.BidTotals, error) {
+	return s.totals, nil
+}
+
+func TestRecomputer_RecomputeUserStats_CorrectsDrift(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../../../migrations")
+	defer testDB.Close()
+
+	ctx := context.Background()
+	repo := database.NewUserStatsRepository(testDB.Pool, pkgdb.DefaultQueryTimeout)
+	txManager := pkgdb.NewPostgresTransactionManager(testDB.Pool, time.Second)
+	service := userstats.NewService(repo, txManager)
+	userID := uuid.New()
+
+	lastBidAt := time.Now().Add(-time.Minute).UTC().Truncate(time.Microsecond)
+	asOf := lastBidAt.Add(30 * time.Second)
+	source := fixedTotalsSource{totals: userstats.BidTotals{
+		Count:       3,
+		TotalAmount: 4500,
+		LastBidAt:   lastBidAt,
+		AsOf:        asOf,
+	}}
+
+	// A redelivered event counted twice and a lost one leave the row wrong
+	_, err := testDB.Pool.Exec(ctx, `
+		INSERT INTO user_stats (user_id, total_bids_placed, total_amount_bid, last_bid_at)
+		VALUES ($1, 7, 99999, NOW() - INTERVAL '1 day')
+	`, userID)
+	require.NoError(t, err)
+
+	recomputer := userstats.NewRecomputer(repo, txManager, source)
+	rec, err := recomputer.RecomputeUserStats(ctx, userID)
+	require.NoError(t, err)
+	assert.True(t, rec.Drifted())
+	assert.Equal(t, int64(7), rec.Stored.Count)
+	assert.Equal(t, int64(99999), rec.Stored.TotalAmount)
+
+	stats, err := repo.GetUserStats(ctx, userID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), stats.TotalBidsPlaced)
+	assert.Equal(t, int64(4500), stats.TotalAmountBid)
+	assert.True(t, lastBidAt.Equal(stats.LastBidAt))
+
+	// A late event for a bid the totals already include is not counted again
+	require.NoError(t, service.ProcessBidPlaced(ctx, userstats.BidPlacedEvent{
+		EventID: uuid.New(), UserID: userID, ItemID: uuid.New(), Amount: 1500, Timestamp: lastBidAt,
+	}))
+	stats, err = repo.GetUserStats(ctx, userID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), stats.TotalBidsPlaced)
+
+	// A bid placed after the totals were taken still counts
+	newBidAt := asOf.Add(time.Second)
+	require.NoError(t, service.ProcessBidPlaced(ctx, userstats.BidPlacedEvent{
+		EventID: uuid.New(), UserID: userID, ItemID: uuid.New(), Amount: 500, Timestamp: newBidAt,
+	}))
+	stats, err = repo.GetUserStats(ctx, userID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), stats.TotalBidsPlaced)
+	assert.Equal(t, int64(5000), stats.TotalAmountBid)
+
+	// A second recompute finds nothing to correct once the source catches up
+	source.totals = userstats.BidTotals{Count: 4, TotalAmount: 5000, LastBidAt: newBidAt, AsOf: newBidAt.Add(time.Second)}
+	rec, err = userstats.NewRecomputer(repo, txManager, source).RecomputeUserStats(ctx, userID)
+	require.NoError(t, err)
+	assert.False(t, rec.Drifted())
+}
+
+func TestRecomputer_RecomputeUserStats_KeepsBidsCountedAfterAsOf(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../../../migrations")
+	defer testDB.Close()
+
+	ctx := context.Background()
+	repo := database.NewUserStatsRepository(testDB.Pool, pkgdb.DefaultQueryTimeout)
+	txManager := pkgdb.NewPostgresTransactionManager(testDB.Pool, time.Second)
+	service := userstats.NewService(repo, txManager)
+	userID := uuid.New()
+
+	asOf := time.Now().Add(-time.Minute).UTC().Truncate(time.Microsecond)
+	covered := asOf.Add(-time.Second)
+	inFlight := asOf.Add(time.Second)
+
+	// Both events arrive before the recompute; only the first bid is in the totals
+	for _, ev := range []userstats.BidPlacedEvent{
+		{EventID: uuid.New(), UserID: userID, ItemID: uuid.New(), Amount: 1000, Timestamp: covered},
+		{EventID: uuid.New(), UserID: userID, ItemID: uuid.New(), Amount: 700, Timestamp: inFlight},
+	} {
+		require.NoError(t, service.ProcessBidPlaced(ctx, ev))
+	}
+
+	source := fixedTotalsSource{totals: userstats.BidTotals{Count: 1, TotalAmount: 1000, LastBidAt: covered, AsOf: asOf}}
+	rec, err := userstats.NewRecomputer(repo, txManager, source).RecomputeUserStats(ctx, userID)
+	require.NoError(t, err)
+	assert.False(t, rec.Drifted())
+	assert.Equal(t, int64(2), rec.Actual.Count)
+
+	stats, err := repo.GetUserStats(ctx, userID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), stats.TotalBidsPlaced, "the in-flight bid is not wiped by the recompute")
+	assert.Equal(t, int64(1700), stats.TotalAmountBid)
+	assert.True(t, inFlight.Equal(stats.LastBidAt))
+
+	var remaining int
+	require.NoError(t, testDB.Pool.QueryRow(ctx,
+		"SELECT count(*) FROM user_stats_recent_bids WHERE user_id = $1", userID).Scan(&remaining))
+	assert.Equal(t, 1, remaining, "bids the totals cover are pruned")
+}
+
+func TestRecomputer_RecomputeAllUserStats(t *testing.T) {
+	testDB := testhelpers.NewTestDatabase(t, "../../../migrations")
+	defer testDB.Close()
+
+	ctx := context.Background()
+	repo := database.NewUserStatsRepository(testDB.Pool, pkgdb.DefaultQueryTimeout)
+	txManager := pkgdb.NewPostgresTransactionManager(testDB.Pool, time.Second)
+
+	// Five users who never bid; two of them carry phantom bids
+	for i := 0; i < 5; i++ {
+		tx, err := txManager.BeginTx(ctx)
+		require.NoError(t, err)
+		userID := uuid.New()
+		require.NoError(t, repo.CreateUserStats(ctx, tx, userID, time.Now()))
+		if i < 2 {
+			require.NoError(t, repo.IncrementUserStats(ctx, tx, userID, uuid.New(), 100, time.Now()))
+		}
+		require.NoError(t, tx.Commit(ctx))
+	}
+
+	recomputer := userstats.NewRecomputer(repo, txManager, fixedTotalsSource{totals: userstats.BidTotals{AsOf: time.Now()}})
+	drifted, err := recomputer.RecomputeAllUserStats(ctx, 2)
+	require.NoError(t, err)
+	assert.Len(t, drifted, 2)
+
+	drifted, err = recomputer.RecomputeAllUserStats(ctx, 2)
+	require.NoError(t, err)
+	assert.Empty(t, drifted)
+}
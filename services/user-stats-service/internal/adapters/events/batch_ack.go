@@ -0,0 +1,52 @@
+package events
+
+import (
+	"log/slog"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// ackFlushInterval bounds how long a successful delivery waits for its batch to fill
+// before it is acked anyway
+const ackFlushInterval = 250 * time.Millisecond
+
+// batchAcker acks successful deliveries size at a time with one multiple-ack on the last
+// of them, saving a round trip per message. Deliveries are handled one by one, so every
+// tag below the pending one is either a buffered success or a failure already settled on
+// its own; failures must flush first, so a multiple-ack never covers a delivery that has
+// not been processed yet. A size of one or less acks each delivery as it succeeds.
+type batchAcker struct {
+	size    int
+	pending amqp.Delivery // last successful delivery not yet acked
+	count   int           // successes the pending ack covers
+	logger  *slog.Logger
+}
+
+// succeeded records d as processed, acking the batch once it is full
+func (a *batchAcker) succeeded(d amqp.Delivery) {
+	if a.size <= 1 {
+		if err := d.Ack(false); err != nil {
+			a.logger.Error("Failed to Ack message", "error", err)
+		}
+		return
+	}
+	a.pending = d
+	a.count++
+	if a.count >= a.size {
+		a.flush()
+	}
+}
+
+// flush acks every buffered success. Call it before settling a failed delivery and
+// before the consumer stops.
+func (a *batchAcker) flush() {
+	if a.count == 0 {
+		return
+	}
+	if err := a.pending.Ack(true); err != nil {
+		a.logger.Error("Failed to Ack messages", "error", err, "count", a.count)
+	}
+	a.pending = amqp.Delivery{}
+	a.count = 0
+}
@@ -0,0 +1,165 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/floroz/gavel/services/user-stats-service/internal/domain/userstats"
+)
+
+// settlement is one Ack or Nack the consumer sent to the broker
+type settlement struct {
+	tag      uint64
+	ack      bool
+	multiple bool
+	requeue  bool
+}
+
+// recordingAcknowledger keeps every settlement in order and, like the broker, applies a
+// multiple-ack to every unsettled tag up to the given one
+type recordingAcknowledger struct {
+	calls   []settlement
+	settled map[uint64]bool
+}
+
+func newRecordingAcknowledger() *recordingAcknowledger {
+	return &recordingAcknowledger{settled: map[uint64]bool{}}
+}
+
+func (r *recordingAcknowledger) Ack(tag uint64, multiple bool) error {
+	r.calls = append(r.calls, settlement{tag: tag, ack: true, multiple: multiple})
+	r.settle(tag, multiple)
+	return nil
+}
+
+func (r *recordingAcknowledger) Nack(tag uint64, multiple bool, requeue bool) error {
+	r.calls = append(r.calls, settlement{tag: tag, multiple: multiple, requeue: requeue})
+	r.settle(tag, multiple)
+	return nil
+}
+
+func (r *recordingAcknowledger) Reject(tag uint64, requeue bool) error {
+	return r.Nack(tag, false, requeue)
+}
+
+func (r *recordingAcknowledger) settle(tag uint64, multiple bool) {
+	if !multiple {
+		r.settled[tag] = true
+		return
+	}
+	for t := uint64(1); t <= tag; t++ {
+		r.settled[t] = true
+	}
+}
+
+// failingBidService fails the calls whose 1-based position is in failOn
+type failingBidService struct {
+	failOn map[int]bool
+	calls  int
+}
+
+func (f *failingBidService) ProcessBidPlaced(_ context.Context, _ userstats.BidPlacedEvent) error {
+	f.calls++
+	if f.failOn[f.calls] {
+		return errors.New("db down")
+	}
+	return nil
+}
+
+func TestBidConsumer_BatchAck(t *testing.T) {
+	t.Run("acks full batches with one multiple-ack", func(t *testing.T) {
+		consumer, _, _ := newTestBidConsumer(&fakeBidService{})
+		consumer.acks.size = 3
+		ack := newRecordingAcknowledger()
+
+		for tag := uint64(1); tag <= 7; tag++ {
+			d := bidDelivery(t, ack)
+			d.DeliveryTag = tag
+			consumer.handleDelivery(context.Background(), d)
+		}
+
+		assert.Equal(t, []settlement{
+			{tag: 3, ack: true, multiple: true},
+			{tag: 6, ack: true, multiple: true},
+		}, ack.calls)
+		assert.False(t, ack.settled[7], "a partial batch waits for a flush")
+
+		consumer.acks.flush()
+		assert.Equal(t, settlement{tag: 7, ack: true, multiple: true}, ack.calls[2])
+		consumer.acks.flush()
+		assert.Len(t, ack.calls, 3, "an empty flush sends nothing")
+	})
+
+	t.Run("a mid-batch failure flushes earlier successes and settles on its own", func(t *testing.T) {
+		service := &failingBidService{failOn: map[int]bool{3: true}}
+		consumer, _, _ := newTestBidConsumer(service)
+		consumer.acks.size = 10
+		publisher := consumer.publisher.(*fakePublisher)
+		ack := newRecordingAcknowledger()
+
+		for tag := uint64(1); tag <= 5; tag++ {
+			d := bidDelivery(t, ack)
+			d.DeliveryTag = tag
+			consumer.handleDelivery(context.Background(), d)
+
+			if tag == 3 {
+				// Only what was processed before the failure is acked
+				assert.True(t, ack.settled[1])
+				assert.True(t, ack.settled[2])
+				assert.False(t, ack.settled[4])
+			}
+		}
+		consumer.acks.flush()
+
+		assert.Equal(t, []settlement{
+			{tag: 2, ack: true, multiple: true},
+			{tag: 3, ack: true},
+			{tag: 5, ack: true, multiple: true},
+		}, ack.calls)
+		require.Len(t, publisher.published, 1, "the failed event is retried, not lost")
+		assert.Equal(t, BidQueue, publisher.published[0].queue)
+		for tag := uint64(1); tag <= 5; tag++ {
+			assert.True(t, ack.settled[tag], "delivery %d left unsettled", tag)
+		}
+	})
+
+	t.Run("a requeued failure is not covered by a later multiple-ack", func(t *testing.T) {
+		service := &failingBidService{failOn: map[int]bool{2: true}}
+		consumer, _, _ := newTestBidConsumer(service)
+		consumer.acks.size = 10
+		consumer.publisher = &fakePublisher{err: errors.New("channel closed")}
+		ack := newRecordingAcknowledger()
+
+		for tag := uint64(1); tag <= 4; tag++ {
+			d := bidDelivery(t, ack)
+			d.DeliveryTag = tag
+			consumer.handleDelivery(context.Background(), d)
+		}
+		consumer.acks.flush()
+
+		// The nack for 2 is sent before the multiple-ack for 4, which the broker then
+		// applies only to the still unsettled 3 and 4
+		assert.Equal(t, []settlement{
+			{tag: 1, ack: true, multiple: true},
+			{tag: 2, requeue: true},
+			{tag: 4, ack: true, multiple: true},
+		}, ack.calls)
+	})
+
+	t.Run("a batch size of one acks each delivery", func(t *testing.T) {
+		consumer, _, _ := newTestBidConsumer(&fakeBidService{})
+		ack := newRecordingAcknowledger()
+
+		for tag := uint64(1); tag <= 2; tag++ {
+			d := bidDelivery(t, ack)
+			d.DeliveryTag = tag
+			consumer.handleDelivery(context.Background(), d)
+		}
+
+		assert.Equal(t, []settlement{{tag: 1, ack: true}, {tag: 2, ack: true}}, ack.calls)
+	})
+}
@@ -2,30 +2,52 @@ package events
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/google/uuid"
 	amqp "github.com/rabbitmq/amqp091-go"
 	"google.golang.org/protobuf/proto"
 
+	pkgevents "github.com/floroz/gavel/pkg/events"
 	pb "github.com/floroz/gavel/pkg/proto"
 	"github.com/floroz/gavel/services/user-stats-service/internal/domain/userstats"
 )
 
+// BidQueue is the queue the bid consumer reads from
+const BidQueue = "user_stats_bids"
+
+// BidDeadLetterQueue holds bid events that still failed after MaxBidRetries retries, and
+// poison messages that cannot be parsed at all
+const BidDeadLetterQueue = "user_stats_bids.dlq"
+
+// BidEventProcessor applies bid events to user statistics
+type BidEventProcessor interface {
+	ProcessBidPlaced(ctx context.Context, event userstats.BidPlacedEvent) error
+}
+
 // BidConsumer consumes bid events and updates user statistics
 type BidConsumer struct {
-	conn    *amqp.Connection
-	service *userstats.Service
-	logger  *slog.Logger
+	conn      *amqp.Connection
+	service   BidEventProcessor
+	metrics   *Metrics
+	logger    *slog.Logger
+	publisher amqpPublisher // the consuming channel, set by Run
+	acks      *batchAcker
 }
 
-// NewBidConsumer creates a new bid consumer
-func NewBidConsumer(conn *amqp.Connection, service *userstats.Service, logger *slog.Logger) *BidConsumer {
+// NewBidConsumer creates a new bid consumer. With ackBatchSize above one, successful
+// deliveries are acked that many at a time (or after ackFlushInterval); a crash before
+// the ack redelivers the whole unacked window, which processing tolerates as it is idempotent.
+func NewBidConsumer(conn *amqp.Connection, service BidEventProcessor, metrics *Metrics, logger *slog.Logger, ackBatchSize int) *BidConsumer {
 	return &BidConsumer{
 		conn:    conn,
 		service: service,
+		metrics: metrics,
 		logger:  logger,
+		acks:    &batchAcker{size: ackBatchSize, logger: logger},
 	}
 }
 
@@ -41,15 +63,16 @@ func (c *BidConsumer) Run(ctx context.Context) error {
 	if setupErr := c.setupRabbitMQ(ch); setupErr != nil {
 		return fmt.Errorf("failed to setup rabbitmq: %w", setupErr)
 	}
+	c.publisher = ch
 
 	msgs, err := ch.Consume(
-		"user_stats_bids", // queue
-		"",                // consumer tag
-		false,             // auto-ack
-		false,             // exclusive
-		false,             // no-local
-		false,             // no-wait
-		nil,               // args
+		BidQueue, // queue
+		"",       // consumer tag
+		false,    // auto-ack
+		false,    // exclusive
+		false,    // no-local
+		false,    // no-wait
+		nil,      // args
 	)
 	if err != nil {
 		return fmt.Errorf("failed to start consuming: %w", err)
@@ -57,83 +80,193 @@ func (c *BidConsumer) Run(ctx context.Context) error {
 
 	c.logger.Info("Waiting for messages...")
 
+	// A message taken off the queue is seen through to its Ack or Nack even if ctx is
+	// cancelled meanwhile, so a shutdown never leaves a half-applied event; the worker's
+	// SHUTDOWN_TIMEOUT bounds how long that can take
+	inFlight := context.WithoutCancel(ctx)
+
+	// Batched acks are flushed on a timer too, so a quiet queue doesn't hold them back
+	var flushTick <-chan time.Time
+	if c.acks.size > 1 {
+		ticker := time.NewTicker(ackFlushInterval)
+		defer ticker.Stop()
+		flushTick = ticker.C
+	}
+
 	for {
 		select {
 		case <-ctx.Done():
+			c.acks.flush()
 			return nil
+		case <-flushTick:
+			c.acks.flush()
 		case d, ok := <-msgs:
 			if !ok {
 				return fmt.Errorf("channel closed")
 			}
-			c.logger.Info("Received message", "routing_key", d.RoutingKey)
-
-			// Unmarshal Protobuf
-			var event pb.BidPlaced
-			if err := proto.Unmarshal(d.Body, &event); err != nil {
-				c.logger.Error("Failed to unmarshal event", "error", err)
-				// If we can't parse it, we probably can't process it ever.
-				if nackErr := d.Nack(false, false); nackErr != nil {
-					c.logger.Error("Failed to Nack message", "error", nackErr)
-				}
-				continue
-			}
+			c.handleDelivery(inFlight, d)
+		}
+	}
+}
 
-			// Map to Domain DTO
-			bidEvent := userstats.BidPlacedEvent{
-				EventID:   uuid.MustParse(event.BidId), // Using BidID as EventID as per main.go logic
-				UserID:    uuid.MustParse(event.UserId),
-				Amount:    event.Amount,
-				Timestamp: event.Timestamp.AsTime(),
-			}
+// handleDelivery processes a single delivery and acks or nacks it
+func (c *BidConsumer) handleDelivery(ctx context.Context, d amqp.Delivery) {
+	start := time.Now()
+	c.logger.Info("Received message", "routing_key", d.RoutingKey)
 
-			// Call Service (Idempotent)
-			if err := c.service.ProcessBidPlaced(ctx, bidEvent); err != nil {
-				c.logger.Error("Failed to process event", "error", err)
-				// Nack(true) to requeue and retry
-				if nackErr := d.Nack(false, true); nackErr != nil {
-					c.logger.Error("Failed to Nack message (requeue)", "error", nackErr)
-				}
-			} else {
-				// Ack on success
-				if ackErr := d.Ack(false); ackErr != nil {
-					c.logger.Error("Failed to Ack message", "error", ackErr)
-				}
-				c.logger.Info("Successfully processed event", "bid_id", event.BidId)
-			}
+	// Unmarshal Protobuf
+	var event pb.BidPlaced
+	if err := proto.Unmarshal(d.Body, &event); err != nil {
+		c.acks.flush()
+		c.deadLetterPoison(ctx, d, fmt.Errorf("failed to unmarshal event: %w", err), start)
+		return
+	}
+	eventID, err := uuid.Parse(event.BidId)
+	if err != nil {
+		c.acks.flush()
+		c.deadLetterPoison(ctx, d, fmt.Errorf("invalid bid_id: %w", err), start)
+		return
+	}
+	userID, err := uuid.Parse(event.UserId)
+	if err != nil {
+		c.acks.flush()
+		c.deadLetterPoison(ctx, d, fmt.Errorf("invalid user_id: %w", err), start)
+		return
+	}
+
+	// Fields added by a newer producer are ignored; count them so rollouts can be coordinated
+	if unknown := unknownFieldNumbers(&event); len(unknown) > 0 {
+		c.metrics.unknownFields.WithLabelValues(BidQueue).Inc()
+		c.logger.Info("Event has fields unknown to this consumer", "bid_id", event.BidId, "fields", unknown)
+	}
+
+	// Map to Domain DTO
+	bidEvent := userstats.BidPlacedEvent{
+		EventID:   eventID, // Using BidID as EventID as per main.go logic
+		UserID:    userID,
+		Amount:    event.Amount,
+		Timestamp: event.Timestamp.AsTime(),
+	}
+
+	// Call Service (Idempotent)
+	if err := c.service.ProcessBidPlaced(ctx, bidEvent); err != nil {
+		c.logger.Error("Failed to process event", "error", err, "bid_id", event.BidId)
+		c.acks.flush()
+		c.retryOrDeadLetter(ctx, d, start)
+		return
+	}
+
+	// Ack on success (possibly as part of a batch)
+	c.acks.succeeded(d)
+	c.metrics.observeProcessed(BidQueue, start)
+	c.logger.Info("Successfully processed event", "bid_id", event.BidId)
+}
+
+// retryOrDeadLetter puts a failed delivery back at the tail of the queue with its retry
+// count bumped, or parks it on the dead letter queue once the retries are used up. The
+// original is acked only after the copy is published; if publishing fails it is
+// requeued as before, so nothing is lost.
+func (c *BidConsumer) retryOrDeadLetter(ctx context.Context, d amqp.Delivery, start time.Time) {
+	retries := retryCount(d)
+	queue, outcome := BidQueue, outcomeRequeued
+	if retries >= MaxBidRetries {
+		queue, outcome = BidDeadLetterQueue, outcomeDeadLettered
+	}
+
+	if err := republish(ctx, c.publisher, queue, d, retries+1); err != nil {
+		c.logger.Error("Failed to republish message, requeueing", "queue", queue, "error", err)
+		if nackErr := d.Nack(false, true); nackErr != nil {
+			c.logger.Error("Failed to Nack message (requeue)", "error", nackErr)
 		}
+		c.metrics.observeFailed(BidQueue, outcomeRequeued, start)
+		return
 	}
+
+	if ackErr := d.Ack(false); ackErr != nil {
+		c.logger.Error("Failed to Ack message", "error", ackErr)
+	}
+	if outcome == outcomeDeadLettered {
+		c.logger.Warn("Giving up on message after retries", "retries", retries, "queue", queue)
+	}
+	c.metrics.observeFailed(BidQueue, outcome, start)
+}
+
+// maxLoggedPoisonBody caps how much of a poison message's body is logged
+const maxLoggedPoisonBody = 4096
+
+// poisonPublishTimeout bounds the dead-letter publish of a poison message, so a stalled
+// broker cannot hold up the consumer loop
+const poisonPublishTimeout = 5 * time.Second
+
+// deadLetterPoison handles a delivery that can never be processed: its raw body is logged
+// for investigation and it is parked on the dead letter queue without retries. If that
+// publish fails the message is dropped; requeueing it would redeliver it forever.
+func (c *BidConsumer) deadLetterPoison(ctx context.Context, d amqp.Delivery, cause error, start time.Time) {
+	c.metrics.poisonMessages.WithLabelValues(BidQueue).Inc()
+	body := d.Body
+	if len(body) > maxLoggedPoisonBody {
+		body = body[:maxLoggedPoisonBody]
+	}
+	c.logger.Error("Poison message",
+		"error", cause,
+		"routing_key", d.RoutingKey,
+		"retries", retryCount(d),
+		"body_size", len(d.Body),
+		"body_base64", base64.StdEncoding.EncodeToString(body),
+	)
+
+	publishCtx, cancel := context.WithTimeout(ctx, poisonPublishTimeout)
+	defer cancel()
+	if err := republish(publishCtx, c.publisher, BidDeadLetterQueue, d, retryCount(d)); err != nil {
+		c.logger.Error("Failed to dead-letter poison message, dropping it", "error", err)
+		if nackErr := d.Nack(false, false); nackErr != nil {
+			c.logger.Error("Failed to Nack message", "error", nackErr)
+		}
+		c.metrics.observeFailed(BidQueue, outcomeDropped, start)
+		return
+	}
+
+	if ackErr := d.Ack(false); ackErr != nil {
+		c.logger.Error("Failed to Ack message", "error", ackErr)
+	}
+	c.metrics.observeFailed(BidQueue, outcomeDeadLettered, start)
 }
 
 func (c *BidConsumer) setupRabbitMQ(ch *amqp.Channel) error {
 	err := ch.ExchangeDeclare(
-		"auction.events", // name
-		"topic",          // type
-		true,             // durable
-		false,            // auto-deleted
-		false,            // internal
-		false,            // no-wait
-		nil,              // args
+		pkgevents.AuctionEventsExchange, // name
+		"topic",                         // type
+		true,                            // durable
+		false,                           // auto-deleted
+		false,                           // internal
+		false,                           // no-wait
+		nil,                             // args
 	)
 	if err != nil {
 		return err
 	}
 
 	q, err := ch.QueueDeclare(
-		"user_stats_bids", // name
-		true,              // durable
-		false,             // delete when unused
-		false,             // exclusive
-		false,             // no-wait
-		nil,               // args
+		BidQueue, // name
+		true,     // durable
+		false,    // delete when unused
+		false,    // exclusive
+		false,    // no-wait
+		nil,      // args
 	)
 	if err != nil {
 		return err
 	}
 
+	// Messages reach the dead letter queue through the default exchange, so it needs no binding
+	if _, err := ch.QueueDeclare(BidDeadLetterQueue, true, false, false, false, nil); err != nil {
+		return err
+	}
+
 	return ch.QueueBind(
-		q.Name,           // queue name
-		"bid.placed",     // routing key
-		"auction.events", // exchange
+		q.Name,                          // queue name
+		"bid.placed",                    // routing key
+		pkgevents.AuctionEventsExchange, // exchange
 		false,
 		nil,
 	)
@@ -1,13 +1,17 @@
+//go:build integration
+
 package events_test
 
 import (
 	"context"
+	"io"
 	"log/slog"
 	"os"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
 	amqp "github.com/rabbitmq/amqp091-go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -16,7 +20,9 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/floroz/gavel/pkg/database"
+	pkgevents "github.com/floroz/gavel/pkg/events"
 	pb "github.com/floroz/gavel/pkg/proto"
+	"github.com/floroz/gavel/pkg/shutdown"
 	"github.com/floroz/gavel/pkg/testhelpers"
 	infradb "github.com/floroz/gavel/services/user-stats-service/internal/adapters/database"
 	"github.com/floroz/gavel/services/user-stats-service/internal/adapters/events"
@@ -24,10 +30,6 @@ import (
 )
 
 func TestBidConsumerIntegration(t *testing.T) {
-	if testing.Short() {
-		t.Skip("Skipping integration test in short mode")
-	}
-
 	ctx := context.Background()
 	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
 
@@ -53,7 +55,7 @@ func TestBidConsumerIntegration(t *testing.T) {
 
 	// 3. Setup Dependencies
 	txManager := database.NewPostgresTransactionManager(dbPool, time.Second)
-	statsRepo := infradb.NewUserStatsRepository(dbPool)
+	statsRepo := infradb.NewUserStatsRepository(dbPool, database.DefaultQueryTimeout)
 	statsService := userstats.NewService(statsRepo, txManager)
 
 	// 4. Setup Consumer
@@ -61,7 +63,7 @@ func TestBidConsumerIntegration(t *testing.T) {
 	require.NoError(t, err)
 	defer conn.Close()
 
-	consumer := events.NewBidConsumer(conn, statsService, logger)
+	consumer := events.NewBidConsumer(conn, statsService, events.NewMetrics(prometheus.NewRegistry()), logger, 1)
 
 	// 5. Run Consumer in Background
 	ctxConsumer, cancelConsumer := context.WithCancel(ctx)
@@ -101,10 +103,10 @@ func TestBidConsumerIntegration(t *testing.T) {
 	require.NoError(t, err)
 
 	err = ch.PublishWithContext(ctx,
-		"auction.events", // exchange
-		"bid.placed",     // routing key
-		false,            // mandatory
-		false,            // immediate
+		pkgevents.AuctionEventsExchange, // exchange
+		"bid.placed",                    // routing key
+		false,                           // mandatory
+		false,                           // immediate
 		amqp.Publishing{
 			ContentType: "application/x-protobuf",
 			Body:        body,
@@ -125,7 +127,7 @@ func TestBidConsumerIntegration(t *testing.T) {
 
 	// 8. Verify Idempotency (Publish same event again)
 	err = ch.PublishWithContext(ctx,
-		"auction.events",
+		pkgevents.AuctionEventsExchange,
 		"bid.placed",
 		false,
 		false,
@@ -146,3 +148,105 @@ func TestBidConsumerIntegration(t *testing.T) {
 	assert.Equal(t, amount, totalAmount)
 	assert.Equal(t, 1, totalBids)
 }
+
+// blockingBidProcessor holds each event until released, recording the context it was given
+type blockingBidProcessor struct {
+	started  chan struct{}
+	release  chan struct{}
+	ctxErr   chan error
+	finished chan struct{}
+}
+
+func (p *blockingBidProcessor) ProcessBidPlaced(ctx context.Context, _ userstats.BidPlacedEvent) error {
+	close(p.started)
+	<-p.release
+	p.ctxErr <- ctx.Err()
+	close(p.finished)
+	return nil
+}
+
+func TestBidConsumer_FinishesInFlightMessageOnShutdown(t *testing.T) {
+	ctx := context.Background()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	rabbitmqContainer, err := rabbitmq.Run(ctx,
+		"rabbitmq:3.12-management-alpine",
+		rabbitmq.WithAdminPassword("password"),
+	)
+	require.NoError(t, err)
+	defer func() { _ = rabbitmqContainer.Terminate(ctx) }()
+
+	amqpURL, err := rabbitmqContainer.AmqpURL(ctx)
+	require.NoError(t, err)
+
+	conn, err := amqp.Dial(amqpURL)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	processor := &blockingBidProcessor{
+		started:  make(chan struct{}),
+		release:  make(chan struct{}),
+		ctxErr:   make(chan error, 1),
+		finished: make(chan struct{}),
+	}
+	consumer := events.NewBidConsumer(conn, processor, events.NewMetrics(prometheus.NewRegistry()), logger, 1)
+
+	// Run the consumer the way the worker does: behind shutdown.Wait
+	ctxConsumer, cancelConsumer := context.WithCancel(ctx)
+	defer cancelConsumer()
+	stopped := make(chan error, 1)
+	go func() {
+		stopped <- shutdown.Wait(ctxConsumer, 10*time.Second, func() error { return consumer.Run(ctxConsumer) })
+	}()
+
+	// Wait for the consumer to declare and bind its queue
+	time.Sleep(1 * time.Second)
+
+	ch, err := conn.Channel()
+	require.NoError(t, err)
+	defer ch.Close()
+
+	body, err := proto.Marshal(&pb.BidPlaced{
+		BidId:     uuid.New().String(),
+		ItemId:    uuid.New().String(),
+		UserId:    uuid.New().String(),
+		Amount:    1000,
+		Timestamp: timestamppb.Now(),
+	})
+	require.NoError(t, err)
+	require.NoError(t, ch.PublishWithContext(ctx, pkgevents.AuctionEventsExchange, "bid.placed", false, false, amqp.Publishing{
+		ContentType: "application/x-protobuf",
+		Body:        body,
+	}))
+
+	select {
+	case <-processor.started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("consumer never picked up the message")
+	}
+
+	// Signal shutdown while the message is still being processed
+	cancelConsumer()
+
+	select {
+	case <-stopped:
+		t.Fatal("worker stopped before its in-flight message finished")
+	case <-time.After(300 * time.Millisecond):
+	}
+
+	close(processor.release)
+
+	select {
+	case err := <-stopped:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("worker did not stop after its in-flight message finished")
+	}
+	<-processor.finished
+	assert.NoError(t, <-processor.ctxErr, "in-flight message should not see the shutdown cancellation")
+
+	// The message was acked rather than left for redelivery
+	q, err := ch.QueueDeclarePassive(events.BidQueue, true, false, false, false, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 0, q.Messages)
+}
@@ -0,0 +1,148 @@
+package events
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// Outcome labels for failed events
+const (
+	outcomeRequeued     = "requeued"
+	outcomeDropped      = "dropped"
+	outcomeDeadLettered = "dead_lettered"
+)
+
+// Metrics holds the Prometheus collectors for the user stats consumers
+type Metrics struct {
+	eventsProcessed    *prometheus.CounterVec
+	eventsFailed       *prometheus.CounterVec
+	processingDuration *prometheus.HistogramVec
+	queueDepth         *prometheus.GaugeVec
+	unknownFields      *prometheus.CounterVec
+	poisonMessages     *prometheus.CounterVec
+	duplicateEvents    *prometheus.CounterVec
+}
+
+// NewMetrics creates the consumer metrics and registers them with the given registerer
+func NewMetrics(reg prometheus.Registerer) *Metrics {
+	m := &Metrics{
+		eventsProcessed: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "user_stats",
+			Name:      "events_processed_total",
+			Help:      "Number of events successfully processed, by queue.",
+		}, []string{"queue"}),
+		eventsFailed: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "user_stats",
+			Name:      "events_failed_total",
+			Help:      "Number of events that failed processing, by queue and outcome (requeued, dropped or dead_lettered).",
+		}, []string{"queue", "outcome"}),
+		processingDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "user_stats",
+			Name:      "event_processing_duration_seconds",
+			Help:      "Time spent handling a single event, by queue.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"queue"}),
+		queueDepth: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "user_stats",
+			Name:      "queue_depth",
+			Help:      "Approximate number of messages ready for delivery, by queue.",
+		}, []string{"queue"}),
+		unknownFields: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "user_stats",
+			Name:      "events_unknown_fields_total",
+			Help:      "Number of events carrying fields the consumer's schema does not know, by queue. Nonzero means a producer is ahead of this consumer.",
+		}, []string{"queue"}),
+		poisonMessages: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "user_stats",
+			Name:      "poison_messages_total",
+			Help:      "Number of messages that could not be parsed and were never processed, by queue.",
+		}, []string{"queue"}),
+		duplicateEvents: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "user_stats",
+			Name:      "duplicate_events_total",
+			Help:      "Number of processed events that had already been applied and changed nothing, by queue.",
+		}, []string{"queue"}),
+	}
+
+	reg.MustRegister(m.eventsProcessed, m.eventsFailed, m.processingDuration, m.queueDepth, m.unknownFields, m.poisonMessages, m.duplicateEvents)
+	return m
+}
+
+func (m *Metrics) observeProcessed(queue string, start time.Time) {
+	m.eventsProcessed.WithLabelValues(queue).Inc()
+	m.processingDuration.WithLabelValues(queue).Observe(time.Since(start).Seconds())
+}
+
+func (m *Metrics) observeDuplicate(queue string) {
+	m.duplicateEvents.WithLabelValues(queue).Inc()
+}
+
+func (m *Metrics) observeFailed(queue, outcome string, start time.Time) {
+	m.eventsFailed.WithLabelValues(queue, outcome).Inc()
+	m.processingDuration.WithLabelValues(queue).Observe(time.Since(start).Seconds())
+}
+
+// QueueDepthMonitor periodically samples the message count of the consumer queues
+type QueueDepthMonitor struct {
+	conn     *amqp.Connection
+	metrics  *Metrics
+	queues   []string
+	interval time.Duration
+	logger   *slog.Logger
+}
+
+// NewQueueDepthMonitor creates a new queue depth monitor
+func NewQueueDepthMonitor(conn *amqp.Connection, metrics *Metrics, queues []string, interval time.Duration, logger *slog.Logger) *QueueDepthMonitor {
+	return &QueueDepthMonitor{
+		conn:     conn,
+		metrics:  metrics,
+		queues:   queues,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+// Run samples queue depths until the context is canceled
+func (m *QueueDepthMonitor) Run(ctx context.Context) error {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			m.sample()
+		}
+	}
+}
+
+func (m *QueueDepthMonitor) sample() {
+	// A failed passive declare closes the channel, so use a fresh one per sample
+	ch, err := m.conn.Channel()
+	if err != nil {
+		m.logger.Error("Failed to open channel for queue inspection", "error", err)
+		return
+	}
+	defer ch.Close()
+
+	for _, queue := range m.queues {
+		q, err := ch.QueueDeclarePassive(
+			queue, // name
+			true,  // durable
+			false, // delete when unused
+			false, // exclusive
+			false, // no-wait
+			nil,   // args
+		)
+		if err != nil {
+			m.logger.Warn("Failed to inspect queue", "queue", queue, "error", err)
+			return
+		}
+		m.metrics.queueDepth.WithLabelValues(queue).Set(float64(q.Messages))
+	}
+}
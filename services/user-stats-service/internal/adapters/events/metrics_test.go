@@ -0,0 +1,161 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/floroz/gavel/pkg/proto"
+	"github.com/floroz/gavel/services/user-stats-service/internal/domain/userstats"
+)
+
+type fakeBidService struct {
+	err   error
+	calls int
+}
+
+func (f *fakeBidService) ProcessBidPlaced(_ context.Context, _ userstats.BidPlacedEvent) error {
+	f.calls++
+	return f.err
+}
+
+type fakeOutbidNotifier struct {
+	outcome userstats.OutbidOutcome
+	err     error
+}
+
+func (f *fakeOutbidNotifier) ProcessOutbid(_ context.Context, _ userstats.BidPlacedEvent) (userstats.OutbidOutcome, error) {
+	return f.outcome, f.err
+}
+
+type fakeAcknowledger struct {
+	acks    int
+	nacks   int
+	requeue bool
+}
+
+func (f *fakeAcknowledger) Ack(_ uint64, _ bool) error {
+	f.acks++
+	return nil
+}
+
+func (f *fakeAcknowledger) Nack(_ uint64, _ bool, requeue bool) error {
+	f.nacks++
+	f.requeue = requeue
+	return nil
+}
+
+func (f *fakeAcknowledger) Reject(_ uint64, requeue bool) error {
+	f.nacks++
+	f.requeue = requeue
+	return nil
+}
+
+func bidDelivery(t *testing.T, ack amqp.Acknowledger) amqp.Delivery {
+	t.Helper()
+	body, err := proto.Marshal(&pb.BidPlaced{
+		BidId:     uuid.New().String(),
+		ItemId:    uuid.New().String(),
+		UserId:    uuid.New().String(),
+		Amount:    100,
+		Timestamp: timestamppb.Now(),
+	})
+	require.NoError(t, err)
+	return amqp.Delivery{Acknowledger: ack, RoutingKey: "bid.placed", Body: body}
+}
+
+func newTestBidConsumer(service BidEventProcessor) (*BidConsumer, *Metrics, *prometheus.Registry) {
+	reg := prometheus.NewRegistry()
+	metrics := NewMetrics(reg)
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	consumer := NewBidConsumer(nil, service, metrics, logger, 1)
+	consumer.publisher = &fakePublisher{}
+	return consumer, metrics, reg
+}
+
+func TestBidConsumerMetrics(t *testing.T) {
+	t.Run("success increments processed", func(t *testing.T) {
+		service := &fakeBidService{}
+		consumer, metrics, reg := newTestBidConsumer(service)
+		ack := &fakeAcknowledger{}
+
+		consumer.handleDelivery(context.Background(), bidDelivery(t, ack))
+
+		assert.Equal(t, 1, service.calls)
+		assert.Equal(t, 1, ack.acks)
+		assert.Equal(t, float64(1), testutil.ToFloat64(metrics.eventsProcessed.WithLabelValues(BidQueue)))
+		assert.Equal(t, 0, testutil.CollectAndCount(metrics.eventsFailed))
+		assert.Equal(t, 1, testutil.CollectAndCount(metrics.processingDuration))
+
+		count, err := testutil.GatherAndCount(reg, "user_stats_event_processing_duration_seconds")
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("service error increments requeued", func(t *testing.T) {
+		service := &fakeBidService{err: errors.New("db down")}
+		consumer, metrics, _ := newTestBidConsumer(service)
+		ack := &fakeAcknowledger{}
+
+		consumer.handleDelivery(context.Background(), bidDelivery(t, ack))
+
+		// The retry is a republished copy, so the original is acked
+		assert.Equal(t, 1, ack.acks)
+		assert.Len(t, consumer.publisher.(*fakePublisher).published, 1)
+		assert.Equal(t, float64(1), testutil.ToFloat64(metrics.eventsFailed.WithLabelValues(BidQueue, outcomeRequeued)))
+		assert.Equal(t, 0, testutil.CollectAndCount(metrics.eventsProcessed))
+	})
+
+	t.Run("malformed payload increments dead_lettered", func(t *testing.T) {
+		service := &fakeBidService{}
+		consumer, metrics, _ := newTestBidConsumer(service)
+		ack := &fakeAcknowledger{}
+
+		consumer.handleDelivery(context.Background(), amqp.Delivery{Acknowledger: ack, Body: []byte{0xff, 0xff}})
+
+		assert.Equal(t, 0, service.calls)
+		assert.Equal(t, 1, ack.acks)
+		assert.Equal(t, float64(1), testutil.ToFloat64(metrics.eventsFailed.WithLabelValues(BidQueue, outcomeDeadLettered)))
+	})
+}
+
+func TestOutbidConsumerMetrics(t *testing.T) {
+	newConsumer := func(notifier OutbidEventProcessor) (*OutbidConsumer, *Metrics) {
+		metrics := NewMetrics(prometheus.NewRegistry())
+		logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+		return NewOutbidConsumer(nil, notifier, metrics, logger), metrics
+	}
+
+	t.Run("recorded notification is not a duplicate", func(t *testing.T) {
+		consumer, metrics := newConsumer(&fakeOutbidNotifier{outcome: userstats.OutbidRecorded})
+		ack := &fakeAcknowledger{}
+
+		consumer.handleDelivery(context.Background(), bidDelivery(t, ack))
+
+		assert.Equal(t, 1, ack.acks)
+		assert.Equal(t, float64(1), testutil.ToFloat64(metrics.eventsProcessed.WithLabelValues(OutbidQueue)))
+		assert.Equal(t, 0, testutil.CollectAndCount(metrics.duplicateEvents))
+	})
+
+	t.Run("duplicate notification increments duplicates", func(t *testing.T) {
+		consumer, metrics := newConsumer(&fakeOutbidNotifier{outcome: userstats.OutbidDuplicate})
+		ack := &fakeAcknowledger{}
+
+		consumer.handleDelivery(context.Background(), bidDelivery(t, ack))
+
+		assert.Equal(t, 1, ack.acks)
+		assert.Equal(t, float64(1), testutil.ToFloat64(metrics.eventsProcessed.WithLabelValues(OutbidQueue)))
+		assert.Equal(t, float64(1), testutil.ToFloat64(metrics.duplicateEvents.WithLabelValues(OutbidQueue)))
+	})
+}
@@ -0,0 +1,196 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"google.golang.org/protobuf/proto"
+
+	pkgevents "github.com/floroz/gavel/pkg/events"
+	pb "github.com/floroz/gavel/pkg/proto"
+	"github.com/floroz/gavel/services/user-stats-service/internal/domain/userstats"
+)
+
+// OutbidQueue is the queue the outbid consumer reads from.
+// It gets its own copy of every bid.placed event, independent of BidQueue.
+const OutbidQueue = "user_stats_outbid"
+
+// OutbidEventProcessor turns bid events into outbid notifications
+type OutbidEventProcessor interface {
+	ProcessOutbid(ctx context.Context, event userstats.BidPlacedEvent) (userstats.OutbidOutcome, error)
+}
+
+// OutbidConsumer consumes bid events and records notifications for outbid users
+type OutbidConsumer struct {
+	conn     *amqp.Connection
+	notifier OutbidEventProcessor
+	metrics  *Metrics
+	logger   *slog.Logger
+}
+
+// NewOutbidConsumer creates a new outbid consumer
+func NewOutbidConsumer(conn *amqp.Connection, notifier OutbidEventProcessor, metrics *Metrics, logger *slog.Logger) *OutbidConsumer {
+	return &OutbidConsumer{
+		conn:     conn,
+		notifier: notifier,
+		metrics:  metrics,
+		logger:   logger,
+	}
+}
+
+// Run starts the consumer loop
+func (c *OutbidConsumer) Run(ctx context.Context) error {
+	ch, err := c.conn.Channel()
+	if err != nil {
+		return fmt.Errorf("failed to open channel: %w", err)
+	}
+	defer ch.Close()
+
+	if setupErr := c.setupRabbitMQ(ch); setupErr != nil {
+		return fmt.Errorf("failed to setup rabbitmq: %w", setupErr)
+	}
+
+	msgs, err := ch.Consume(
+		OutbidQueue, // queue
+		"",          // consumer tag
+		false,       // auto-ack
+		false,       // exclusive
+		false,       // no-local
+		false,       // no-wait
+		nil,         // args
+	)
+	if err != nil {
+		return fmt.Errorf("failed to start consuming: %w", err)
+	}
+
+	// Finish the current message on shutdown, as BidConsumer does
+	inFlight := context.WithoutCancel(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case d, ok := <-msgs:
+			if !ok {
+				return fmt.Errorf("channel closed")
+			}
+			c.handleDelivery(inFlight, d)
+		}
+	}
+}
+
+// handleDelivery processes a single delivery and acks or nacks it
+func (c *OutbidConsumer) handleDelivery(ctx context.Context, d amqp.Delivery) {
+	start := time.Now()
+
+	event, err := parseOutbidEvent(d.Body)
+	if err != nil {
+		c.logger.Error("Dropping malformed bid event", "queue", OutbidQueue, "error", err)
+		if nackErr := d.Nack(false, false); nackErr != nil {
+			c.logger.Error("Failed to Nack message", "error", nackErr)
+		}
+		c.metrics.observeFailed(OutbidQueue, outcomeDropped, start)
+		return
+	}
+
+	outcome, err := c.notifier.ProcessOutbid(ctx, event)
+	if err != nil {
+		c.logger.Error("Failed to process outbid event", "bid_id", event.EventID, "error", err)
+		if nackErr := d.Nack(false, true); nackErr != nil {
+			c.logger.Error("Failed to Nack message (requeue)", "error", nackErr)
+		}
+		c.metrics.observeFailed(OutbidQueue, outcomeRequeued, start)
+		return
+	}
+
+	if ackErr := d.Ack(false); ackErr != nil {
+		c.logger.Error("Failed to Ack message", "error", ackErr)
+	}
+	c.metrics.observeProcessed(OutbidQueue, start)
+	switch outcome {
+	case userstats.OutbidRecorded:
+		c.logger.Info("Outbid notification recorded",
+			"user_id", event.PreviousBidderID,
+			"item_id", event.ItemID,
+			"bid_id", event.EventID,
+			"previous_amount", event.PreviousAmount,
+			"new_amount", event.Amount,
+		)
+	case userstats.OutbidDuplicate:
+		c.metrics.observeDuplicate(OutbidQueue)
+		c.logger.Info("Outbid notification already recorded, skipping duplicate",
+			"user_id", event.PreviousBidderID,
+			"item_id", event.ItemID,
+			"bid_id", event.EventID,
+		)
+	}
+}
+
+// parseOutbidEvent maps a BidPlaced payload to the domain event, rejecting bad IDs
+func parseOutbidEvent(body []byte) (userstats.BidPlacedEvent, error) {
+	var msg pb.BidPlaced
+	if err := proto.Unmarshal(body, &msg); err != nil {
+		return userstats.BidPlacedEvent{}, fmt.Errorf("failed to unmarshal event: %w", err)
+	}
+
+	event := userstats.BidPlacedEvent{
+		Amount:         msg.Amount,
+		Timestamp:      msg.Timestamp.AsTime(),
+		PreviousAmount: msg.PreviousAmount,
+	}
+	var err error
+	if event.EventID, err = uuid.Parse(msg.BidId); err != nil {
+		return event, fmt.Errorf("invalid bid_id: %w", err)
+	}
+	if event.UserID, err = uuid.Parse(msg.UserId); err != nil {
+		return event, fmt.Errorf("invalid user_id: %w", err)
+	}
+	if event.ItemID, err = uuid.Parse(msg.ItemId); err != nil {
+		return event, fmt.Errorf("invalid item_id: %w", err)
+	}
+	if msg.PreviousHighestBidderId != "" {
+		if event.PreviousBidderID, err = uuid.Parse(msg.PreviousHighestBidderId); err != nil {
+			return event, fmt.Errorf("invalid previous_highest_bidder_id: %w", err)
+		}
+	}
+	return event, nil
+}
+
+func (c *OutbidConsumer) setupRabbitMQ(ch *amqp.Channel) error {
+	err := ch.ExchangeDeclare(
+		pkgevents.AuctionEventsExchange, // name
+		"topic",                         // type
+		true,                            // durable
+		false,                           // auto-deleted
+		false,                           // internal
+		false,                           // no-wait
+		nil,                             // args
+	)
+	if err != nil {
+		return err
+	}
+
+	q, err := ch.QueueDeclare(
+		OutbidQueue, // name
+		true,        // durable
+		false,       // delete when unused
+		false,       // exclusive
+		false,       // no-wait
+		nil,         // args
+	)
+	if err != nil {
+		return err
+	}
+
+	return ch.QueueBind(
+		q.Name,                          // queue name
+		"bid.placed",                    // routing key
+		pkgevents.AuctionEventsExchange, // exchange
+		false,
+		nil,
+	)
+}
@@ -0,0 +1,123 @@
+//go:build integration
+
+package events_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go/modules/rabbitmq"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pkgevents "github.com/floroz/gavel/pkg/events"
+	pb "github.com/floroz/gavel/pkg/proto"
+	"github.com/floroz/gavel/pkg/testhelpers"
+	infradb "github.com/floroz/gavel/services/user-stats-service/internal/adapters/database"
+	"github.com/floroz/gavel/services/user-stats-service/internal/adapters/events"
+	"github.com/floroz/gavel/services/user-stats-service/internal/domain/userstats"
+)
+
+func TestOutbidConsumerIntegration(t *testing.T) {
+	ctx := context.Background()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	rabbitmqContainer, err := rabbitmq.Run(ctx,
+		"rabbitmq:3.12-management-alpine",
+		rabbitmq.WithAdminPassword("password"),
+	)
+	require.NoError(t, err)
+	defer func() { _ = rabbitmqContainer.Terminate(ctx) }()
+
+	amqpURL, err := rabbitmqContainer.AmqpURL(ctx)
+	require.NoError(t, err)
+
+	testDB := testhelpers.NewTestDatabase(t, "../../../migrations")
+	defer testDB.Close()
+	dbPool := testDB.Pool
+
+	statsRepo := infradb.NewUserStatsRepository(dbPool, time.Second)
+
+	conn, err := amqp.Dial(amqpURL)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	consumer := events.NewOutbidConsumer(conn, userstats.NewOutbidNotifier(statsRepo), events.NewMetrics(prometheus.NewRegistry()), logger)
+
+	ctxConsumer, cancelConsumer := context.WithCancel(ctx)
+	defer cancelConsumer()
+	go func() { _ = consumer.Run(ctxConsumer) }()
+
+	// Wait for the consumer to declare and bind its queue
+	time.Sleep(1 * time.Second)
+
+	ch, err := conn.Channel()
+	require.NoError(t, err)
+	defer ch.Close()
+
+	publish := func(event *pb.BidPlaced) {
+		body, marshalErr := proto.Marshal(event)
+		require.NoError(t, marshalErr)
+		require.NoError(t, ch.PublishWithContext(ctx, pkgevents.AuctionEventsExchange, "bid.placed", false, false, amqp.Publishing{
+			ContentType: "application/x-protobuf",
+			Body:        body,
+		}))
+	}
+
+	countFor := func(userID uuid.UUID) int {
+		var count int
+		_ = dbPool.QueryRow(ctx, "SELECT COUNT(*) FROM outbid_notifications WHERE user_id = $1", userID).Scan(&count)
+		return count
+	}
+
+	itemID := uuid.New()
+	firstBidder, secondBidder := uuid.New(), uuid.New()
+
+	// First bid on the item: nobody is outbid
+	publish(&pb.BidPlaced{
+		BidId:     uuid.New().String(),
+		ItemId:    itemID.String(),
+		UserId:    firstBidder.String(),
+		Amount:    1000,
+		Timestamp: timestamppb.Now(),
+	})
+
+	outbid := &pb.BidPlaced{
+		BidId:                   uuid.New().String(),
+		ItemId:                  itemID.String(),
+		UserId:                  secondBidder.String(),
+		Amount:                  1500,
+		Timestamp:               timestamppb.Now(),
+		PreviousHighestBidderId: firstBidder.String(),
+		PreviousAmount:          1000,
+	}
+	publish(outbid)
+
+	require.Eventually(t, func() bool {
+		return countFor(firstBidder) == 1
+	}, 5*time.Second, 100*time.Millisecond, "Prior bidder should get an outbid notification")
+
+	var previousAmount, newAmount int64
+	var notifiedItem uuid.UUID
+	err = dbPool.QueryRow(ctx,
+		"SELECT item_id, previous_amount, new_amount FROM outbid_notifications WHERE user_id = $1", firstBidder,
+	).Scan(&notifiedItem, &previousAmount, &newAmount)
+	require.NoError(t, err)
+	assert.Equal(t, itemID, notifiedItem)
+	assert.Equal(t, int64(1000), previousAmount)
+	assert.Equal(t, int64(1500), newAmount)
+
+	// Redelivery of the same event must not notify twice
+	publish(outbid)
+	time.Sleep(1 * time.Second)
+	assert.Equal(t, 1, countFor(firstBidder))
+	assert.Equal(t, 0, countFor(secondBidder))
+}
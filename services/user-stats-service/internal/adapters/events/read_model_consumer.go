@@ -0,0 +1,318 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"google.golang.org/protobuf/proto"
+
+	pkgevents "github.com/floroz/gavel/pkg/events"
+	pb "github.com/floroz/gavel/pkg/proto"
+	"github.com/floroz/gavel/services/user-stats-service/internal/domain/readmodel"
+)
+
+// ReadModelQueue is the queue the item read model consumer reads from.
+// It gets its own copy of every event it is bound to, independent of the stats queues.
+const ReadModelQueue = "user_stats_item_read_model"
+
+// Routing keys bound to ReadModelQueue
+const (
+	itemCreatedRoutingKey     = "item.created"
+	itemUpdatedRoutingKey     = "item.updated"
+	itemCancelledRoutingKey   = "item.cancelled"
+	bidPlacedRoutingKey       = "bid.placed"
+	auctionEndedRoutingKey    = "auction.ended"
+	auctionExtendedRoutingKey = "auction.extended"
+)
+
+// readModelEventNamespace scopes the event ids derived in readModelEventID, so they never
+// collide with the raw bid and user ids the stats consumers record in processed_events
+var readModelEventNamespace = uuid.MustParse("6f1d5c52-9a43-4c8e-8f0e-2b7d3a61c9e4")
+
+// readModelEventID derives a stable id for an event from its routing key and the payload
+// fields that identify it, since the relay does not publish outbox event ids
+func readModelEventID(routingKey string, key ...string) uuid.UUID {
+	name := routingKey
+	for _, k := range key {
+		name += "/" + k
+	}
+	return uuid.NewSHA1(readModelEventNamespace, []byte(name))
+}
+
+// ReadModelEventProcessor applies bid-service events to the item read model
+type ReadModelEventProcessor interface {
+	ProcessItemCreated(ctx context.Context, event readmodel.ItemCreatedEvent) error
+	ProcessItemUpdated(ctx context.Context, event readmodel.ItemUpdatedEvent) error
+	ProcessItemCancelled(ctx context.Context, event readmodel.ItemCancelledEvent) error
+	ProcessBidPlaced(ctx context.Context, event readmodel.BidPlacedEvent) error
+	ProcessAuctionEnded(ctx context.Context, event readmodel.AuctionEndedEvent) error
+	ProcessAuctionExtended(ctx context.Context, event readmodel.AuctionExtendedEvent) error
+}
+
+// ReadModelConsumer consumes item, bid and auction events and maintains the item read model
+type ReadModelConsumer struct {
+	conn      *amqp.Connection
+	projector ReadModelEventProcessor
+	metrics   *Metrics
+	logger    *slog.Logger
+}
+
+// NewReadModelConsumer creates a new read model consumer
+func NewReadModelConsumer(conn *amqp.Connection, projector ReadModelEventProcessor, metrics *Metrics, logger *slog.Logger) *ReadModelConsumer {
+	return &ReadModelConsumer{
+		conn:      conn,
+		projector: projector,
+		metrics:   metrics,
+		logger:    logger,
+	}
+}
+
+// Run starts the consumer loop
+func (c *ReadModelConsumer) Run(ctx context.Context) error {
+	ch, err := c.conn.Channel()
+	if err != nil {
+		return fmt.Errorf("failed to open channel: %w", err)
+	}
+	defer ch.Close()
+
+	if setupErr := c.setupRabbitMQ(ch); setupErr != nil {
+		return fmt.Errorf("failed to setup rabbitmq: %w", setupErr)
+	}
+
+	msgs, err := ch.Consume(
+		ReadModelQueue, // queue
+		"",             // consumer tag
+		false,          // auto-ack
+		false,          // exclusive
+		false,          // no-local
+		false,          // no-wait
+		nil,            // args
+	)
+	if err != nil {
+		return fmt.Errorf("failed to start consuming: %w", err)
+	}
+
+	// Finish the current message on shutdown, as BidConsumer does
+	inFlight := context.WithoutCancel(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case d, ok := <-msgs:
+			if !ok {
+				return fmt.Errorf("channel closed")
+			}
+			c.handleDelivery(inFlight, d)
+		}
+	}
+}
+
+// handleDelivery processes a single delivery and acks or nacks it
+func (c *ReadModelConsumer) handleDelivery(ctx context.Context, d amqp.Delivery) {
+	start := time.Now()
+
+	process, err := c.decode(d.RoutingKey, d.Body)
+	if err != nil {
+		c.logger.Error("Dropping malformed event", "queue", ReadModelQueue, "routing_key", d.RoutingKey, "error", err)
+		if nackErr := d.Nack(false, false); nackErr != nil {
+			c.logger.Error("Failed to Nack message", "error", nackErr)
+		}
+		c.metrics.observeFailed(ReadModelQueue, outcomeDropped, start)
+		return
+	}
+
+	if err := process(ctx); err != nil {
+		c.logger.Error("Failed to apply event to read model", "routing_key", d.RoutingKey, "error", err)
+		if nackErr := d.Nack(false, true); nackErr != nil {
+			c.logger.Error("Failed to Nack message (requeue)", "error", nackErr)
+		}
+		c.metrics.observeFailed(ReadModelQueue, outcomeRequeued, start)
+		return
+	}
+
+	if ackErr := d.Ack(false); ackErr != nil {
+		c.logger.Error("Failed to Ack message", "error", ackErr)
+	}
+	c.metrics.observeProcessed(ReadModelQueue, start)
+}
+
+// decode maps a message to the call that applies it to the read model
+func (c *ReadModelConsumer) decode(routingKey string, body []byte) (func(context.Context) error, error) {
+	switch routingKey {
+	case itemCreatedRoutingKey:
+		var msg pb.ItemCreated
+		if err := proto.Unmarshal(body, &msg); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal event: %w", err)
+		}
+		itemID, err := uuid.Parse(msg.ItemId)
+		if err != nil {
+			return nil, fmt.Errorf("invalid item_id: %w", err)
+		}
+		sellerID, err := uuid.Parse(msg.SellerId)
+		if err != nil {
+			return nil, fmt.Errorf("invalid seller_id: %w", err)
+		}
+		// An item is created once, so its id identifies the event
+		event := readmodel.ItemCreatedEvent{
+			EventID:    readModelEventID(routingKey, itemID.String()),
+			ItemID:     itemID,
+			SellerID:   sellerID,
+			Title:      msg.Title,
+			Category:   msg.Category,
+			Status:     msg.Status,
+			StartPrice: msg.StartPrice,
+			StartAt:    msg.StartAt.AsTime(),
+			EndAt:      msg.EndAt.AsTime(),
+			Timestamp:  msg.Timestamp.AsTime(),
+		}
+		return func(ctx context.Context) error { return c.projector.ProcessItemCreated(ctx, event) }, nil
+
+	case itemUpdatedRoutingKey:
+		var msg pb.ItemUpdated
+		if err := proto.Unmarshal(body, &msg); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal event: %w", err)
+		}
+		itemID, err := uuid.Parse(msg.ItemId)
+		if err != nil {
+			return nil, fmt.Errorf("invalid item_id: %w", err)
+		}
+		timestamp := msg.Timestamp.AsTime()
+		event := readmodel.ItemUpdatedEvent{
+			EventID:   readModelEventID(routingKey, itemID.String(), timestamp.Format(time.RFC3339Nano)),
+			ItemID:    itemID,
+			Title:     msg.Title,
+			Category:  msg.Category,
+			Timestamp: timestamp,
+		}
+		return func(ctx context.Context) error { return c.projector.ProcessItemUpdated(ctx, event) }, nil
+
+	case itemCancelledRoutingKey:
+		var msg pb.ItemCancelled
+		if err := proto.Unmarshal(body, &msg); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal event: %w", err)
+		}
+		itemID, err := uuid.Parse(msg.ItemId)
+		if err != nil {
+			return nil, fmt.Errorf("invalid item_id: %w", err)
+		}
+		event := readmodel.ItemCancelledEvent{
+			EventID: readModelEventID(routingKey, itemID.String()),
+			ItemID:  itemID,
+		}
+		return func(ctx context.Context) error { return c.projector.ProcessItemCancelled(ctx, event) }, nil
+
+	case bidPlacedRoutingKey:
+		var msg pb.BidPlaced
+		if err := proto.Unmarshal(body, &msg); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal event: %w", err)
+		}
+		bidID, err := uuid.Parse(msg.BidId)
+		if err != nil {
+			return nil, fmt.Errorf("invalid bid_id: %w", err)
+		}
+		itemID, err := uuid.Parse(msg.ItemId)
+		if err != nil {
+			return nil, fmt.Errorf("invalid item_id: %w", err)
+		}
+		bidderID, err := uuid.Parse(msg.UserId)
+		if err != nil {
+			return nil, fmt.Errorf("invalid user_id: %w", err)
+		}
+		event := readmodel.BidPlacedEvent{
+			EventID:  readModelEventID(routingKey, bidID.String()),
+			ItemID:   itemID,
+			BidderID: bidderID,
+			Amount:   msg.Amount,
+		}
+		return func(ctx context.Context) error { return c.projector.ProcessBidPlaced(ctx, event) }, nil
+
+	case auctionEndedRoutingKey:
+		var msg pb.AuctionEnded
+		if err := proto.Unmarshal(body, &msg); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal event: %w", err)
+		}
+		itemID, err := uuid.Parse(msg.ItemId)
+		if err != nil {
+			return nil, fmt.Errorf("invalid item_id: %w", err)
+		}
+		// An auction ends once
+		event := readmodel.AuctionEndedEvent{
+			EventID: readModelEventID(routingKey, itemID.String()),
+			ItemID:  itemID,
+			Amount:  msg.Amount,
+		}
+		return func(ctx context.Context) error { return c.projector.ProcessAuctionEnded(ctx, event) }, nil
+
+	case auctionExtendedRoutingKey:
+		var msg pb.AuctionExtended
+		if err := proto.Unmarshal(body, &msg); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal event: %w", err)
+		}
+		itemID, err := uuid.Parse(msg.ItemId)
+		if err != nil {
+			return nil, fmt.Errorf("invalid item_id: %w", err)
+		}
+		endAt := msg.NewEndAt.AsTime()
+		event := readmodel.AuctionExtendedEvent{
+			EventID: readModelEventID(routingKey, itemID.String(), endAt.Format(time.RFC3339Nano)),
+			ItemID:  itemID,
+			EndAt:   endAt,
+		}
+		return func(ctx context.Context) error { return c.projector.ProcessAuctionExtended(ctx, event) }, nil
+
+	default:
+		return nil, fmt.Errorf("unexpected routing key %q", routingKey)
+	}
+}
+
+func (c *ReadModelConsumer) setupRabbitMQ(ch *amqp.Channel) error {
+	err := ch.ExchangeDeclare(
+		pkgevents.AuctionEventsExchange, // name
+		"topic",                         // type
+		true,                            // durable
+		false,                           // auto-deleted
+		false,                           // internal
+		false,                           // no-wait
+		nil,                             // args
+	)
+	if err != nil {
+		return err
+	}
+
+	q, err := ch.QueueDeclare(
+		ReadModelQueue, // name
+		true,           // durable
+		false,          // delete when unused
+		false,          // exclusive
+		false,          // no-wait
+		nil,            // args
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range []string{
+		itemCreatedRoutingKey,
+		itemUpdatedRoutingKey,
+		itemCancelledRoutingKey,
+		bidPlacedRoutingKey,
+		auctionEndedRoutingKey,
+		auctionExtendedRoutingKey,
+	} {
+		if err := ch.QueueBind(
+			q.Name,                          // queue name
+			key,                             // routing key
+			pkgevents.AuctionEventsExchange, // exchange
+			false,
+			nil,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+//go:build integration
+
+package events_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go/modules/rabbitmq"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pkgdb "github.com/floroz/gavel/pkg/database"
+	pkgevents "github.com/floroz/gavel/pkg/events"
+	pb "github.com/floroz/gavel/pkg/proto"
+	"github.com/floroz/gavel/pkg/testhelpers"
+	infradb "github.com/floroz/gavel/services/user-stats-service/internal/adapters/database"
+	"github.com/floroz/gavel/services/user-stats-service/internal/adapters/events"
+	"github.com/floroz/gavel/services/user-stats-service/internal/domain/readmodel"
+)
+
+func TestReadModelConsumerIntegration(t *testing.T) {
+	ctx := context.Background()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	rabbitmqContainer, err := rabbitmq.Run(ctx,
+		"rabbitmq:3.12-management-alpine",
+		rabbitmq.WithAdminPassword("password"),
+	)
+	require.NoError(t, err)
+	defer func() { _ = rabbitmqContainer.Terminate(ctx) }()
+
+	amqpURL, err := rabbitmqContainer.AmqpURL(ctx)
+	require.NoError(t, err)
+
+	testDB := testhelpers.NewTestDatabase(t, "../../../migrations")
+	defer testDB.Close()
+	dbPool := testDB.Pool
+
+	projector := readmodel.NewProjector(
+		infradb.NewItemReadModelRepository(dbPool, time.Second),
+		pkgdb.NewPostgresTransactionManager(dbPool, 5*time.Second),
+	)
+
+	conn, err := amqp.Dial(amqpURL)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	consumer := events.NewReadModelConsumer(conn, projector, events.NewMetrics(prometheus.NewRegistry()), logger)
+
+	ctxConsumer, cancelConsumer := context.WithCancel(ctx)
+	defer cancelConsumer()
+	go func() { _ = consumer.Run(ctxConsumer) }()
+
+	// Wait for the consumer to declare and bind its queue
+	time.Sleep(1 * time.Second)
+
+	ch, err := conn.Channel()
+	require.NoError(t, err)
+	defer ch.Close()
+
+	publish := func(routingKey string, event proto.Message) {
+		body, marshalErr := proto.Marshal(event)
+		require.NoError(t, marshalErr)
+		require.NoError(t, ch.PublishWithContext(ctx, pkgevents.AuctionEventsExchange, routingKey, false, false, amqp.Publishing{
+			ContentType: "application/x-protobuf",
+			Body:        body,
+		}))
+	}
+
+	itemID, sellerID := uuid.New(), uuid.New()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	endAt := now.Add(24 * time.Hour)
+
+	publish("item.created", &pb.ItemCreated{
+		ItemId:     itemID.String(),
+		SellerId:   sellerID.String(),
+		Title:      "Vintage Camera",
+		Category:   "cameras",
+		StartPrice: 1000,
+		StartAt:    timestamppb.New(now),
+		EndAt:      timestamppb.New(endAt),
+		Status:     readmodel.StatusActive,
+		Timestamp:  timestamppb.New(now),
+	})
+
+	firstBid := &pb.BidPlaced{
+		BidId:     uuid.New().String(),
+		ItemId:    itemID.String(),
+		UserId:    uuid.New().String(),
+		Amount:    1500,
+		Timestamp: timestamppb.New(now.Add(time.Minute)),
+	}
+	publish("bid.placed", firstBid)
+	// Redelivery must not count the bid twice
+	publish("bid.placed", firstBid)
+	publish("bid.placed", &pb.BidPlaced{
+		BidId:     uuid.New().String(),
+		ItemId:    itemID.String(),
+		UserId:    uuid.New().String(),
+		Amount:    2000,
+		Timestamp: timestamppb.New(now.Add(2 * time.Minute)),
+	})
+
+	publish("item.updated", &pb.ItemUpdated{
+		ItemId:    itemID.String(),
+		SellerId:  sellerID.String(),
+		Title:     "Vintage Camera (boxed)",
+		Category:  "cameras",
+		Timestamp: timestamppb.New(now.Add(3 * time.Minute)),
+	})
+	// An older edit arriving late must not overwrite the newer one
+	publish("item.updated", &pb.ItemUpdated{
+		ItemId:    itemID.String(),
+		SellerId:  sellerID.String(),
+		Title:     "Stale Title",
+		Category:  "misc",
+		Timestamp: timestamppb.New(now.Add(30 * time.Second)),
+	})
+
+	extendedEndAt := endAt.Add(time.Hour)
+	publish("auction.extended", &pb.AuctionExtended{
+		ItemId:   itemID.String(),
+		SellerId: sellerID.String(),
+		NewEndAt: timestamppb.New(extendedEndAt),
+	})
+
+	publish("auction.ended", &pb.AuctionEnded{
+		ItemId:    itemID.String(),
+		SellerId:  sellerID.String(),
+		Amount:    2000,
+		Timestamp: timestamppb.New(extendedEndAt),
+	})
+
+	require.Eventually(t, func() bool {
+		view, getErr := projector.GetItem(ctx, itemID)
+		return getErr == nil && view != nil && view.Status == readmodel.StatusEnded
+	}, 10*time.Second, 100*time.Millisecond, "Read model should reach the ended state")
+
+	// Let anything still queued drain before reading the final state
+	time.Sleep(1 * time.Second)
+
+	view, err := projector.GetItem(ctx, itemID)
+	require.NoError(t, err)
+	require.NotNil(t, view)
+	assert.Equal(t, "Vintage Camera (boxed)", view.Title)
+	assert.Equal(t, "cameras", view.Category)
+	assert.Equal(t, readmodel.StatusEnded, view.Status)
+	assert.Equal(t, int64(1000), view.StartPrice)
+	assert.Equal(t, int64(2000), view.CurrentPrice)
+	assert.Equal(t, int64(2), view.BidCount)
+	assert.True(t, extendedEndAt.Equal(view.EndAt), "end time should reflect the extension")
+	assert.Equal(t, sellerID, view.SellerID)
+
+	agg, err := projector.GetBidAggregates(ctx, itemID)
+	require.NoError(t, err)
+	require.NotNil(t, agg)
+	assert.Equal(t, int64(2), agg.BidCount)
+	assert.Equal(t, int64(2), agg.DistinctBidders)
+	assert.Equal(t, int64(2000), agg.HighestBid)
+}
@@ -0,0 +1,54 @@
+package events
+
+import (
+	"context"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// retryCountHeader counts how many times a message has been put back on its queue.
+// Nack with requeue leaves headers untouched, so retries republish a copy instead.
+const retryCountHeader = "x-retry-count"
+
+// MaxBidRetries is how often a failing bid event is retried before it is parked on
+// BidDeadLetterQueue for inspection
+const MaxBidRetries = 5
+
+// amqpPublisher is the part of *amqp.Channel used to republish failed deliveries
+type amqpPublisher interface {
+	PublishWithContext(ctx context.Context, exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error
+}
+
+// retryCount reads retryCountHeader, treating a missing or malformed value as zero
+func retryCount(d amqp.Delivery) int {
+	switch n := d.Headers[retryCountHeader].(type) {
+	case int32:
+		return int(n)
+	case int64:
+		return int(n)
+	case int:
+		return n
+	}
+	return 0
+}
+
+// republish publishes a copy of d, with the retry count set to retries, straight to
+// queue through the default exchange
+func republish(ctx context.Context, publisher amqpPublisher, queue string, d amqp.Delivery, retries int) error {
+	headers := make(amqp.Table, len(d.Headers)+1)
+	for k, v := range d.Headers {
+		headers[k] = v
+	}
+	headers[retryCountHeader] = int32(retries)
+
+	return publisher.PublishWithContext(ctx, "", queue, false, false, amqp.Publishing{
+		Headers:       headers,
+		ContentType:   d.ContentType,
+		DeliveryMode:  amqp.Persistent,
+		CorrelationId: d.CorrelationId,
+		MessageId:     d.MessageId,
+		Timestamp:     d.Timestamp,
+		Type:          d.Type,
+		Body:          d.Body,
+	})
+}
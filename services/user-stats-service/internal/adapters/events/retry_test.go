@@ -0,0 +1,160 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/floroz/gavel/pkg/proto"
+)
+
+type publishedMessage struct {
+	queue string
+	msg   amqp.Publishing
+}
+
+type fakePublisher struct {
+	published []publishedMessage
+	err       error
+}
+
+func (f *fakePublisher) PublishWithContext(_ context.Context, exchange, key string, _, _ bool, msg amqp.Publishing) error {
+	if f.err != nil {
+		return f.err
+	}
+	if exchange != "" {
+		return errors.New("expected the default exchange")
+	}
+	f.published = append(f.published, publishedMessage{queue: key, msg: msg})
+	return nil
+}
+
+// redeliver turns a republished message back into the delivery the broker would hand out
+func redeliver(p publishedMessage, ack amqp.Acknowledger) amqp.Delivery {
+	return amqp.Delivery{
+		Acknowledger: ack,
+		RoutingKey:   p.queue,
+		Headers:      p.msg.Headers,
+		Body:         p.msg.Body,
+	}
+}
+
+func TestBidConsumer_Retries(t *testing.T) {
+	t.Run("an event that always fails ends up on the dead letter queue", func(t *testing.T) {
+		service := &fakeBidService{err: errors.New("check constraint violated")}
+		consumer, metrics, _ := newTestBidConsumer(service)
+		publisher := consumer.publisher.(*fakePublisher)
+		ack := &fakeAcknowledger{}
+
+		d := bidDelivery(t, ack)
+		for deliveries := 1; ; deliveries++ {
+			require.LessOrEqual(t, deliveries, MaxBidRetries+1, "the event must stop being retried")
+
+			consumer.handleDelivery(context.Background(), d)
+			last := publisher.published[len(publisher.published)-1]
+			if last.queue == BidDeadLetterQueue {
+				break
+			}
+			assert.Equal(t, BidQueue, last.queue)
+			d = redeliver(last, ack)
+		}
+
+		assert.Equal(t, MaxBidRetries+1, service.calls, "the first delivery plus every retry")
+		assert.Equal(t, MaxBidRetries+1, ack.acks)
+		assert.Zero(t, ack.nacks)
+		assert.Equal(t, float64(MaxBidRetries), testutil.ToFloat64(metrics.eventsFailed.WithLabelValues(BidQueue, outcomeRequeued)))
+		assert.Equal(t, float64(1), testutil.ToFloat64(metrics.eventsFailed.WithLabelValues(BidQueue, outcomeDeadLettered)))
+
+		parked := publisher.published[len(publisher.published)-1].msg
+		assert.Equal(t, d.Body, parked.Body)
+		assert.Equal(t, int32(MaxBidRetries+1), parked.Headers[retryCountHeader])
+		assert.Equal(t, amqp.Persistent, parked.DeliveryMode)
+	})
+
+	t.Run("a retried event that succeeds is acked", func(t *testing.T) {
+		service := &fakeBidService{}
+		consumer, _, _ := newTestBidConsumer(service)
+		ack := &fakeAcknowledger{}
+
+		d := bidDelivery(t, ack)
+		d.Headers = amqp.Table{retryCountHeader: int32(3)}
+		consumer.handleDelivery(context.Background(), d)
+
+		assert.Equal(t, 1, ack.acks)
+		assert.Empty(t, consumer.publisher.(*fakePublisher).published)
+	})
+
+	t.Run("falls back to requeueing when the copy can't be published", func(t *testing.T) {
+		service := &fakeBidService{err: errors.New("db down")}
+		consumer, _, _ := newTestBidConsumer(service)
+		consumer.publisher = &fakePublisher{err: errors.New("channel closed")}
+		ack := &fakeAcknowledger{}
+
+		consumer.handleDelivery(context.Background(), bidDelivery(t, ack))
+
+		assert.Zero(t, ack.acks)
+		assert.Equal(t, 1, ack.nacks)
+		assert.True(t, ack.requeue)
+	})
+}
+
+func TestRetryCount(t *testing.T) {
+	assert.Equal(t, 0, retryCount(amqp.Delivery{}))
+	assert.Equal(t, 2, retryCount(amqp.Delivery{Headers: amqp.Table{retryCountHeader: int32(2)}}))
+	assert.Equal(t, 3, retryCount(amqp.Delivery{Headers: amqp.Table{retryCountHeader: int64(3)}}))
+	assert.Equal(t, 0, retryCount(amqp.Delivery{Headers: amqp.Table{retryCountHeader: "7"}}))
+}
+
+func TestBidConsumer_PoisonMessages(t *testing.T) {
+	garbage := []byte{0xff, 0xff, 0x00, 0x13, 0x37}
+
+	t.Run("garbage bytes are parked on the dead letter queue", func(t *testing.T) {
+		service := &fakeBidService{}
+		consumer, metrics, _ := newTestBidConsumer(service)
+		publisher := consumer.publisher.(*fakePublisher)
+		ack := &fakeAcknowledger{}
+
+		consumer.handleDelivery(context.Background(), amqp.Delivery{Acknowledger: ack, RoutingKey: "bid.placed", Body: garbage})
+
+		assert.Zero(t, service.calls)
+		assert.Equal(t, float64(1), testutil.ToFloat64(metrics.poisonMessages.WithLabelValues(BidQueue)))
+		require.Len(t, publisher.published, 1, "the message is kept, not dropped")
+		assert.Equal(t, BidDeadLetterQueue, publisher.published[0].queue)
+		assert.Equal(t, garbage, publisher.published[0].msg.Body)
+		assert.Equal(t, 1, ack.acks)
+		assert.Zero(t, ack.nacks)
+	})
+
+	t.Run("a parsable event with invalid ids is poison too", func(t *testing.T) {
+		consumer, metrics, _ := newTestBidConsumer(&fakeBidService{})
+		publisher := consumer.publisher.(*fakePublisher)
+		body, err := proto.Marshal(&pb.BidPlaced{BidId: "not-a-uuid", UserId: uuid.New().String()})
+		require.NoError(t, err)
+
+		consumer.handleDelivery(context.Background(), amqp.Delivery{Acknowledger: &fakeAcknowledger{}, Body: body})
+
+		assert.Equal(t, float64(1), testutil.ToFloat64(metrics.poisonMessages.WithLabelValues(BidQueue)))
+		require.Len(t, publisher.published, 1)
+		assert.Equal(t, BidDeadLetterQueue, publisher.published[0].queue)
+	})
+
+	t.Run("dropped without requeue when the dead letter queue is unreachable", func(t *testing.T) {
+		consumer, metrics, _ := newTestBidConsumer(&fakeBidService{})
+		consumer.publisher = &fakePublisher{err: errors.New("channel closed")}
+		ack := &fakeAcknowledger{}
+
+		consumer.handleDelivery(context.Background(), amqp.Delivery{Acknowledger: ack, Body: garbage})
+
+		assert.Equal(t, 1, ack.nacks)
+		assert.False(t, ack.requeue, "requeueing a poison message would loop forever")
+		assert.Equal(t, float64(1), testutil.ToFloat64(metrics.poisonMessages.WithLabelValues(BidQueue)))
+		assert.Equal(t, float64(1), testutil.ToFloat64(metrics.eventsFailed.WithLabelValues(BidQueue, outcomeDropped)))
+	})
+}
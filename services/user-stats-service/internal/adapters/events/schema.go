@@ -0,0 +1,49 @@
+package events
+
+import (
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// unknownFieldNumbers returns the numbers of the fields in msg, or in any message nested
+// in it, that this build's schema does not know. Protobuf parsing keeps such fields
+// instead of failing, so a producer running a newer schema goes unnoticed without this.
+func unknownFieldNumbers(msg proto.Message) []protowire.Number {
+	var numbers []protowire.Number
+	collectUnknownFields(msg.ProtoReflect(), &numbers)
+	return numbers
+}
+
+func collectUnknownFields(m protoreflect.Message, numbers *[]protowire.Number) {
+	for raw := m.GetUnknown(); len(raw) > 0; {
+		num, typ, n := protowire.ConsumeTag(raw)
+		if n < 0 {
+			return
+		}
+		raw = raw[n:]
+		if n = protowire.ConsumeFieldValue(num, typ, raw); n < 0 {
+			return
+		}
+		raw = raw[n:]
+		*numbers = append(*numbers, num)
+	}
+
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsList() && fd.Message() != nil:
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				collectUnknownFields(list.Get(i).Message(), numbers)
+			}
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+				collectUnknownFields(mv.Message(), numbers)
+				return true
+			})
+		case !fd.IsList() && !fd.IsMap() && fd.Message() != nil:
+			collectUnknownFields(v.Message(), numbers)
+		}
+		return true
+	})
+}
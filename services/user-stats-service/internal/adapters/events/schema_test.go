@@ -0,0 +1,76 @@
+package events
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/floroz/gavel/pkg/proto"
+)
+
+// newerBidPlaced returns a BidPlaced as a producer with two extra fields would encode it:
+// one at the top level and one inside the timestamp
+func newerBidPlaced(t *testing.T) []byte {
+	t.Helper()
+	ts, err := proto.Marshal(timestamppb.Now())
+	require.NoError(t, err)
+	ts = protowire.AppendTag(ts, 7, protowire.VarintType)
+	ts = protowire.AppendVarint(ts, 1)
+
+	body, err := proto.Marshal(&pb.BidPlaced{
+		BidId:  uuid.New().String(),
+		ItemId: uuid.New().String(),
+		UserId: uuid.New().String(),
+		Amount: 100,
+	})
+	require.NoError(t, err)
+	body = protowire.AppendTag(body, 99, protowire.BytesType)
+	body = protowire.AppendString(body, "new field")
+
+	// Timestamp is field 5 of BidPlaced
+	body = protowire.AppendTag(body, 5, protowire.BytesType)
+	return protowire.AppendBytes(body, ts)
+}
+
+func TestUnknownFieldNumbers(t *testing.T) {
+	var event pb.BidPlaced
+	require.NoError(t, proto.Unmarshal(newerBidPlaced(t), &event))
+	assert.ElementsMatch(t, []protowire.Number{99, 7}, unknownFieldNumbers(&event))
+
+	current, err := proto.Marshal(&pb.BidPlaced{BidId: uuid.New().String(), Timestamp: timestamppb.Now()})
+	require.NoError(t, err)
+	event.Reset()
+	require.NoError(t, proto.Unmarshal(current, &event))
+	assert.Empty(t, unknownFieldNumbers(&event))
+}
+
+func TestBidConsumer_UnknownFields(t *testing.T) {
+	t.Run("an event from a newer producer is counted and still processed", func(t *testing.T) {
+		service := &fakeBidService{}
+		consumer, metrics, _ := newTestBidConsumer(service)
+		ack := &fakeAcknowledger{}
+
+		consumer.handleDelivery(context.Background(), amqp.Delivery{Acknowledger: ack, RoutingKey: "bid.placed", Body: newerBidPlaced(t)})
+
+		assert.Equal(t, float64(1), testutil.ToFloat64(metrics.unknownFields.WithLabelValues(BidQueue)))
+		assert.Equal(t, 1, service.calls)
+		assert.Equal(t, 1, ack.acks)
+		assert.Equal(t, float64(1), testutil.ToFloat64(metrics.eventsProcessed.WithLabelValues(BidQueue)))
+	})
+
+	t.Run("an event matching the schema is not counted", func(t *testing.T) {
+		consumer, metrics, _ := newTestBidConsumer(&fakeBidService{})
+
+		consumer.handleDelivery(context.Background(), bidDelivery(t, &fakeAcknowledger{}))
+
+		assert.Equal(t, 0, testutil.CollectAndCount(metrics.unknownFields))
+	})
+}
@@ -4,27 +4,46 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/google/uuid"
 	amqp "github.com/rabbitmq/amqp091-go"
 	"google.golang.org/protobuf/proto"
 
+	pkgevents "github.com/floroz/gavel/pkg/events"
 	pb "github.com/floroz/gavel/pkg/proto"
 	"github.com/floroz/gavel/services/user-stats-service/internal/domain/userstats"
 )
 
+// UserQueue is the queue the user consumer reads from
+const UserQueue = "user_stats_users"
+
+// Routing keys bound to UserQueue
+const (
+	userCreatedRoutingKey = "user.created"
+	userDeletedRoutingKey = "user.deleted"
+)
+
+// UserEventProcessor applies user events to user statistics
+type UserEventProcessor interface {
+	ProcessUserCreated(ctx context.Context, event userstats.UserCreatedEvent) error
+	ProcessUserDeleted(ctx context.Context, event userstats.UserDeletedEvent) error
+}
+
 // UserConsumer consumes user events and updates user statistics
 type UserConsumer struct {
 	conn    *amqp.Connection
-	service *userstats.Service
+	service UserEventProcessor
+	metrics *Metrics
 	logger  *slog.Logger
 }
 
 // NewUserConsumer creates a new user consumer
-func NewUserConsumer(conn *amqp.Connection, service *userstats.Service, logger *slog.Logger) *UserConsumer {
+func NewUserConsumer(conn *amqp.Connection, service UserEventProcessor, metrics *Metrics, logger *slog.Logger) *UserConsumer {
 	return &UserConsumer{
 		conn:    conn,
 		service: service,
+		metrics: metrics,
 		logger:  logger,
 	}
 }
@@ -43,13 +62,13 @@ func (c *UserConsumer) Run(ctx context.Context) error {
 	}
 
 	msgs, err := ch.Consume(
-		"user_stats_users", // queue
-		"",                 // consumer tag
-		false,              // auto-ack
-		false,              // exclusive
-		false,              // no-local
-		false,              // no-wait
-		nil,                // args
+		UserQueue, // queue
+		"",        // consumer tag
+		false,     // auto-ack
+		false,     // exclusive
+		false,     // no-local
+		false,     // no-wait
+		nil,       // args
 	)
 	if err != nil {
 		return fmt.Errorf("failed to start consuming: %w", err)
@@ -57,6 +76,9 @@ func (c *UserConsumer) Run(ctx context.Context) error {
 
 	c.logger.Info("UserConsumer waiting for messages...")
 
+	// Finish the current message on shutdown, as BidConsumer does
+	inFlight := context.WithoutCancel(ctx)
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -65,85 +87,135 @@ func (c *UserConsumer) Run(ctx context.Context) error {
 			if !ok {
 				return fmt.Errorf("channel closed")
 			}
-			c.logger.Info("Received message", "routing_key", d.RoutingKey)
-
-			// Unmarshal Protobuf
-			var event pb.UserCreated
-			if err := proto.Unmarshal(d.Body, &event); err != nil {
-				c.logger.Error("Failed to unmarshal event", "error", err)
-				if nackErr := d.Nack(false, false); nackErr != nil {
-					c.logger.Error("Failed to Nack message", "error", nackErr)
-				}
-				continue
-			}
-
-			// Map to Domain DTO
-			// We use UserId as EventID for idempotency because a user is created only once.
-			userID, err := uuid.Parse(event.UserId)
-			if err != nil {
-				c.logger.Error("Invalid UserID UUID", "error", err)
-				d.Nack(false, false)
-				continue
-			}
+			c.handleDelivery(inFlight, d)
+		}
+	}
+}
 
-			userEvent := userstats.UserCreatedEvent{
-				EventID:     userID, // Using UserID as EventID
-				UserID:      userID,
-				Email:       event.Email,
-				FullName:    event.FullName,
-				CountryCode: event.CountryCode,
-				CreatedAt:   event.CreatedAt.AsTime(),
-			}
+// handleDelivery processes a single delivery and acks or nacks it
+func (c *UserConsumer) handleDelivery(ctx context.Context, d amqp.Delivery) {
+	start := time.Now()
+	c.logger.Info("Received message", "routing_key", d.RoutingKey)
+
+	var process func(context.Context) error
+	var err error
+	switch d.RoutingKey {
+	case userDeletedRoutingKey:
+		process, err = c.decodeUserDeleted(d.Body)
+	default:
+		process, err = c.decodeUserCreated(d.Body)
+	}
+	if err != nil {
+		c.logger.Error("Failed to decode event", "routing_key", d.RoutingKey, "error", err)
+		if nackErr := d.Nack(false, false); nackErr != nil {
+			c.logger.Error("Failed to Nack message", "error", nackErr)
+		}
+		c.metrics.observeFailed(UserQueue, outcomeDropped, start)
+		return
+	}
 
-			// Call Service (Idempotent)
-			if err := c.service.ProcessUserCreated(ctx, userEvent); err != nil {
-				c.logger.Error("Failed to process event", "error", err)
-				// Nack(true) to requeue and retry
-				if nackErr := d.Nack(false, true); nackErr != nil {
-					c.logger.Error("Failed to Nack message (requeue)", "error", nackErr)
-				}
-			} else {
-				// Ack on success
-				if ackErr := d.Ack(false); ackErr != nil {
-					c.logger.Error("Failed to Ack message", "error", ackErr)
-				}
-				c.logger.Info("Successfully processed user created event", "user_id", event.UserId)
-			}
+	// Call Service (Idempotent)
+	if err := process(ctx); err != nil {
+		c.logger.Error("Failed to process event", "routing_key", d.RoutingKey, "error", err)
+		// Nack(true) to requeue and retry
+		if nackErr := d.Nack(false, true); nackErr != nil {
+			c.logger.Error("Failed to Nack message (requeue)", "error", nackErr)
 		}
+		c.metrics.observeFailed(UserQueue, outcomeRequeued, start)
+		return
+	}
+
+	// Ack on success
+	if ackErr := d.Ack(false); ackErr != nil {
+		c.logger.Error("Failed to Ack message", "error", ackErr)
 	}
+	c.metrics.observeProcessed(UserQueue, start)
+	c.logger.Info("Successfully processed user event", "routing_key", d.RoutingKey)
+}
+
+// decodeUserCreated maps a UserCreated message to the call that applies it
+func (c *UserConsumer) decodeUserCreated(body []byte) (func(context.Context) error, error) {
+	var event pb.UserCreated
+	if err := proto.Unmarshal(body, &event); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal event: %w", err)
+	}
+
+	// We use UserId as EventID for idempotency because a user is created only once.
+	userID, err := uuid.Parse(event.UserId)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user_id: %w", err)
+	}
+
+	userEvent := userstats.UserCreatedEvent{
+		EventID:     userID, // Using UserID as EventID
+		UserID:      userID,
+		Email:       event.Email,
+		FullName:    event.FullName,
+		CountryCode: event.CountryCode,
+		CreatedAt:   event.CreatedAt.AsTime(),
+	}
+	return func(ctx context.Context) error {
+		return c.service.ProcessUserCreated(ctx, userEvent)
+	}, nil
+}
+
+// decodeUserDeleted maps a UserDeleted message to the call that applies it
+func (c *UserConsumer) decodeUserDeleted(body []byte) (func(context.Context) error, error) {
+	var event pb.UserDeleted
+	if err := proto.Unmarshal(body, &event); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal event: %w", err)
+	}
+
+	userID, err := uuid.Parse(event.UserId)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user_id: %w", err)
+	}
+
+	userEvent := userstats.UserDeletedEvent{
+		UserID:    userID,
+		DeletedAt: event.DeletedAt.AsTime(),
+	}
+	return func(ctx context.Context) error {
+		return c.service.ProcessUserDeleted(ctx, userEvent)
+	}, nil
 }
 
 func (c *UserConsumer) setupRabbitMQ(ch *amqp.Channel) error {
 	err := ch.ExchangeDeclare(
-		"auction.events", // name
-		"topic",          // type
-		true,             // durable
-		false,            // auto-deleted
-		false,            // internal
-		false,            // no-wait
-		nil,              // args
+		pkgevents.AuctionEventsExchange, // name
+		"topic",                         // type
+		true,                            // durable
+		false,                           // auto-deleted
+		false,                           // internal
+		false,                           // no-wait
+		nil,                             // args
 	)
 	if err != nil {
 		return err
 	}
 
 	q, err := ch.QueueDeclare(
-		"user_stats_users", // name
-		true,               // durable
-		false,              // delete when unused
-		false,              // exclusive
-		false,              // no-wait
-		nil,                // args
+		UserQueue, // name
+		true,      // durable
+		false,     // delete when unused
+		false,     // exclusive
+		false,     // no-wait
+		nil,       // args
 	)
 	if err != nil {
 		return err
 	}
 
-	return ch.QueueBind(
-		q.Name,           // queue name
-		"user.created",   // routing key
-		"auction.events", // exchange
-		false,
-		nil,
-	)
+	for _, key := range []string{userCreatedRoutingKey, userDeletedRoutingKey} {
+		if err := ch.QueueBind(
+			q.Name,                          // queue name
+			key,                             // routing key
+			pkgevents.AuctionEventsExchange, // exchange
+			false,
+			nil,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
 }
@@ -0,0 +1,131 @@
+//go:build integration
+
+package events_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go/modules/rabbitmq"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/floroz/gavel/pkg/database"
+	pkgevents "github.com/floroz/gavel/pkg/events"
+	pb "github.com/floroz/gavel/pkg/proto"
+	"github.com/floroz/gavel/pkg/testhelpers"
+	infradb "github.com/floroz/gavel/services/user-stats-service/internal/adapters/database"
+	"github.com/floroz/gavel/services/user-stats-service/internal/adapters/events"
+	"github.com/floroz/gavel/services/user-stats-service/internal/domain/userstats"
+)
+
+func TestUserConsumer_UserDeleted(t *testing.T) {
+	ctx := context.Background()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	rabbitmqContainer, err := rabbitmq.Run(ctx,
+		"rabbitmq:3.12-management-alpine",
+		rabbitmq.WithAdminPassword("password"),
+	)
+	require.NoError(t, err)
+	defer func() { _ = rabbitmqContainer.Terminate(ctx) }()
+
+	amqpURL, err := rabbitmqContainer.AmqpURL(ctx)
+	require.NoError(t, err)
+
+	testDB := testhelpers.NewTestDatabase(t, "../../../migrations")
+	defer testDB.Close()
+	dbPool := testDB.Pool
+
+	txManager := database.NewPostgresTransactionManager(dbPool, time.Second)
+	statsRepo := infradb.NewUserStatsRepository(dbPool, database.DefaultQueryTimeout)
+	statsService := userstats.NewService(statsRepo, txManager)
+
+	conn, err := amqp.Dial(amqpURL)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	consumer := events.NewUserConsumer(conn, statsService, events.NewMetrics(prometheus.NewRegistry()), logger)
+
+	ctxConsumer, cancelConsumer := context.WithCancel(ctx)
+	defer cancelConsumer()
+	go func() { _ = consumer.Run(ctxConsumer) }()
+
+	// Wait for the consumer to declare and bind its queue
+	time.Sleep(1 * time.Second)
+
+	ch, err := conn.Channel()
+	require.NoError(t, err)
+	defer ch.Close()
+
+	publish := func(routingKey string, event proto.Message) {
+		body, marshalErr := proto.Marshal(event)
+		require.NoError(t, marshalErr)
+		require.NoError(t, ch.PublishWithContext(ctx, pkgevents.AuctionEventsExchange, routingKey, false, false, amqp.Publishing{
+			ContentType: "application/x-protobuf",
+			Body:        body,
+		}))
+	}
+
+	count := func(query string, userID uuid.UUID) int {
+		var n int
+		_ = dbPool.QueryRow(ctx, query, userID).Scan(&n)
+		return n
+	}
+	statsRows := func(userID uuid.UUID) int {
+		return count("SELECT COUNT(*) FROM user_stats WHERE user_id = $1", userID)
+	}
+
+	userID := uuid.New()
+	created := &pb.UserCreated{
+		UserId:      userID.String(),
+		Email:       "gone@example.com",
+		FullName:    "Gone User",
+		CountryCode: "DE",
+		CreatedAt:   timestamppb.Now(),
+	}
+	publish("user.created", created)
+	require.Eventually(t, func() bool {
+		return statsRows(userID) == 1
+	}, 5*time.Second, 100*time.Millisecond, "user.created should initialize stats")
+
+	stored, err := statsRepo.CreateOutbidNotification(ctx, &userstats.OutbidNotification{
+		BidID: uuid.New(), UserID: userID, ItemID: uuid.New(), PreviousAmount: 100, NewAmount: 200, CreatedAt: time.Now(),
+	})
+	require.NoError(t, err)
+	assert.True(t, stored)
+
+	publish("user.deleted", &pb.UserDeleted{UserId: userID.String(), DeletedAt: timestamppb.Now()})
+	require.Eventually(t, func() bool {
+		return statsRows(userID) == 0
+	}, 5*time.Second, 100*time.Millisecond, "user.deleted should remove stats")
+
+	assert.Zero(t, count("SELECT COUNT(*) FROM outbid_notifications WHERE user_id = $1", userID))
+	assert.Equal(t, 1, count("SELECT COUNT(*) FROM deleted_users WHERE user_id = $1", userID))
+
+	// Events still queued for the user must not bring the data back
+	tx, err := txManager.BeginTx(ctx)
+	require.NoError(t, err)
+	require.NoError(t, statsRepo.IncrementUserStats(ctx, tx, userID, uuid.New(), 500, time.Now()))
+	require.NoError(t, tx.Commit(ctx))
+	stored, err = statsRepo.CreateOutbidNotification(ctx, &userstats.OutbidNotification{
+		BidID: uuid.New(), UserID: userID, ItemID: uuid.New(), PreviousAmount: 100, NewAmount: 200, CreatedAt: time.Now(),
+	})
+	require.NoError(t, err)
+	assert.False(t, stored, "no notification is stored for a deleted user")
+	assert.Zero(t, statsRows(userID))
+	assert.Zero(t, count("SELECT COUNT(*) FROM outbid_notifications WHERE user_id = $1", userID))
+
+	// Redelivered deletions are acknowledged without error
+	publish("user.deleted", &pb.UserDeleted{UserId: userID.String(), DeletedAt: timestamppb.Now()})
+	time.Sleep(1 * time.Second)
+	assert.Equal(t, 1, count("SELECT COUNT(*) FROM deleted_users WHERE user_id = $1", userID))
+}
@@ -0,0 +1,94 @@
+package readmodel
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Item statuses as carried by bid-service events. Ended and cancelled are final: once
+// applied, no later or redelivered event moves an item out of them. Scheduled items open
+// at StartAt without an event of their own, so readers compare StartAt to the time.
+const (
+	StatusScheduled = "scheduled"
+	StatusActive    = "active"
+	StatusEnded     = "ended"
+	StatusCancelled = "cancelled"
+)
+
+// ItemView is the denormalized listing row for one item, as of the events applied so far.
+// Events can arrive in any order, so a row may exist before its item.created has been
+// applied; until then SellerID is uuid.Nil and the listing fields are empty.
+type ItemView struct {
+	ItemID       uuid.UUID
+	SellerID     uuid.UUID
+	Title        string
+	Category     string
+	Status       string
+	StartPrice   int64
+	CurrentPrice int64 // the highest bid, or the start price before any bid
+	BidCount     int64
+	StartAt      time.Time // zero until item.created is applied
+	EndAt        time.Time // zero until item.created is applied
+	UpdatedAt    time.Time
+}
+
+// BidAggregates are an item's bid totals, kept up to date as bids are applied
+type BidAggregates struct {
+	ItemID          uuid.UUID
+	BidCount        int64
+	DistinctBidders int64
+	HighestBid      int64
+	UpdatedAt       time.Time
+}
+
+// ItemCreatedEvent represents the domain event for a newly listed item
+type ItemCreatedEvent struct {
+	EventID    uuid.UUID
+	ItemID     uuid.UUID
+	SellerID   uuid.UUID
+	Title      string
+	Category   string
+	Status     string
+	StartPrice int64
+	StartAt    time.Time
+	EndAt      time.Time
+	Timestamp  time.Time
+}
+
+// ItemUpdatedEvent represents the domain event for edited listing details
+type ItemUpdatedEvent struct {
+	EventID   uuid.UUID
+	ItemID    uuid.UUID
+	Title     string
+	Category  string
+	Timestamp time.Time // orders edits, so an older edit never overwrites a newer one
+}
+
+// BidPlacedEvent represents the domain event for a placed bid
+type BidPlacedEvent struct {
+	EventID  uuid.UUID
+	ItemID   uuid.UUID
+	BidderID uuid.UUID
+	Amount   int64
+}
+
+// AuctionEndedEvent represents the domain event for a closed auction
+type AuctionEndedEvent struct {
+	EventID uuid.UUID
+	ItemID  uuid.UUID
+	Amount  int64 // winning amount, 0 if the auction ended without bids
+}
+
+// AuctionExtendedEvent represents the domain event for a seller moving an auction's end time
+type AuctionExtendedEvent struct {
+	EventID uuid.UUID
+	ItemID  uuid.UUID
+	EndAt   time.Time
+}
+
+// ItemCancelledEvent represents the domain event for a cancelled item
+type ItemCancelledEvent struct {
+	EventID uuid.UUID
+	ItemID  uuid.UUID
+}
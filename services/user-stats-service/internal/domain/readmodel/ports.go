@@ -0,0 +1,43 @@
+package readmodel
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// Repository persists the item read model. Every Apply method creates the item's row if
+// it does not exist yet and is safe to run in any order relative to the others.
+type Repository interface {
+	// MarkEventProcessed records an event as processed within tx and reports whether
+	// this call recorded it; false means the event was already processed (Race-safe)
+	MarkEventProcessed(ctx context.Context, tx pgx.Tx, eventID uuid.UUID) (bool, error)
+
+	// ApplyItemCreated fills in the item's listing fields, start price and end time
+	ApplyItemCreated(ctx context.Context, tx pgx.Tx, event ItemCreatedEvent) error
+
+	// ApplyItemUpdated replaces the title and category unless a later edit was applied
+	ApplyItemUpdated(ctx context.Context, tx pgx.Tx, event ItemUpdatedEvent) error
+
+	// ApplyBid counts a bid and raises the current price to amount if it is higher
+	ApplyBid(ctx context.Context, tx pgx.Tx, itemID uuid.UUID, amount int64) error
+
+	// ApplyBidAggregate counts a bid in the item's aggregates, adding bidderID to its
+	// distinct bidders the first time they bid on it
+	ApplyBidAggregate(ctx context.Context, tx pgx.Tx, itemID, bidderID uuid.UUID, amount int64) error
+
+	// ApplyFinalStatus moves the item to ended or cancelled, raising the current price to
+	// amount if it is higher
+	ApplyFinalStatus(ctx context.Context, tx pgx.Tx, itemID uuid.UUID, status string, amount int64) error
+
+	// ApplyEndAt moves the item's end time to endAt if that is later
+	ApplyEndAt(ctx context.Context, tx pgx.Tx, itemID uuid.UUID, endAt time.Time) error
+
+	// GetItem retrieves an item's row, or nil if no event for it has been applied
+	GetItem(ctx context.Context, itemID uuid.UUID) (*ItemView, error)
+
+	// GetBidAggregates retrieves an item's bid totals, or nil if no bid has been applied
+	GetBidAggregates(ctx context.Context, itemID uuid.UUID) (*BidAggregates, error)
+}
@@ -0,0 +1,103 @@
+package readmodel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/floroz/gavel/pkg/database"
+)
+
+// Projector applies bid-service events to the item read model. Each event is applied
+// at most once, keyed by its event id.
+type Projector struct {
+	repo      Repository
+	txManager database.TransactionManager
+}
+
+func NewProjector(repo Repository, txManager database.TransactionManager) *Projector {
+	return &Projector{
+		repo:      repo,
+		txManager: txManager,
+	}
+}
+
+func (p *Projector) ProcessItemCreated(ctx context.Context, event ItemCreatedEvent) error {
+	return p.apply(ctx, event.EventID, func(tx pgx.Tx) error {
+		return p.repo.ApplyItemCreated(ctx, tx, event)
+	})
+}
+
+func (p *Projector) ProcessItemUpdated(ctx context.Context, event ItemUpdatedEvent) error {
+	return p.apply(ctx, event.EventID, func(tx pgx.Tx) error {
+		return p.repo.ApplyItemUpdated(ctx, tx, event)
+	})
+}
+
+func (p *Projector) ProcessBidPlaced(ctx context.Context, event BidPlacedEvent) error {
+	return p.apply(ctx, event.EventID, func(tx pgx.Tx) error {
+		if err := p.repo.ApplyBid(ctx, tx, event.ItemID, event.Amount); err != nil {
+			return err
+		}
+		return p.repo.ApplyBidAggregate(ctx, tx, event.ItemID, event.BidderID, event.Amount)
+	})
+}
+
+func (p *Projector) ProcessAuctionEnded(ctx context.Context, event AuctionEndedEvent) error {
+	return p.apply(ctx, event.EventID, func(tx pgx.Tx) error {
+		return p.repo.ApplyFinalStatus(ctx, tx, event.ItemID, StatusEnded, event.Amount)
+	})
+}
+
+func (p *Projector) ProcessAuctionExtended(ctx context.Context, event AuctionExtendedEvent) error {
+	return p.apply(ctx, event.EventID, func(tx pgx.Tx) error {
+		return p.repo.ApplyEndAt(ctx, tx, event.ItemID, event.EndAt)
+	})
+}
+
+func (p *Projector) ProcessItemCancelled(ctx context.Context, event ItemCancelledEvent) error {
+	return p.apply(ctx, event.EventID, func(tx pgx.Tx) error {
+		return p.repo.ApplyFinalStatus(ctx, tx, event.ItemID, StatusCancelled, 0)
+	})
+}
+
+// GetItem returns the item's read model row, or nil if none of its events have been applied
+func (p *Projector) GetItem(ctx context.Context, itemID uuid.UUID) (*ItemView, error) {
+	return p.repo.GetItem(ctx, itemID)
+}
+
+// GetBidAggregates returns the item's bid totals, or nil if none of its bids have been applied
+func (p *Projector) GetBidAggregates(ctx context.Context, itemID uuid.UUID) (*BidAggregates, error) {
+	return p.repo.GetBidAggregates(ctx, itemID)
+}
+
+// apply claims eventID and runs fn in the same transaction; an event claimed before is
+// acknowledged without running fn again
+func (p *Projector) apply(ctx context.Context, eventID uuid.UUID, fn func(tx pgx.Tx) error) error {
+	tx, err := p.txManager.BeginTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	claimed, err := p.repo.MarkEventProcessed(ctx, tx, eventID)
+	if err != nil {
+		return fmt.Errorf("failed to mark event as processed: %w", err)
+	}
+	if !claimed {
+		return nil
+	}
+
+	if err := fn(tx); err != nil {
+		return fmt.Errorf("failed to apply event: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
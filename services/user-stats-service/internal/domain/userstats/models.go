@@ -1,11 +1,15 @@
 package userstats
 
 import (
+	"math"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+// MaxTotalAmountBid caps TotalAmountBid; accumulation saturates here instead of overflowing
+const MaxTotalAmountBid = math.MaxInt64
+
 type UserStats struct {
 	UserID          uuid.UUID
 	TotalBidsPlaced int64
@@ -15,6 +19,28 @@ type UserStats struct {
 	UpdatedAt       time.Time
 }
 
+// BidTotals are a user's bid figures as computed from the bids themselves
+type BidTotals struct {
+	Count       int64
+	TotalAmount int64
+	LastBidAt   time.Time // zero if the user has no bids
+	AsOf        time.Time // the totals cover every bid placed up to this time
+}
+
+// Recomputation is the outcome of rebuilding one user's stats from their bids
+type Recomputation struct {
+	UserID uuid.UUID
+	Stored BidTotals // what user_stats held before (AsOf unset); zero if there was no row
+	Actual BidTotals // what it holds now: the bid service's totals plus bids counted after AsOf
+}
+
+// Drifted reports whether the stored stats were wrong and had to be corrected
+func (r *Recomputation) Drifted() bool {
+	return r.Stored.Count != r.Actual.Count ||
+		r.Stored.TotalAmount != r.Actual.TotalAmount ||
+		!r.Stored.LastBidAt.Equal(r.Actual.LastBidAt)
+}
+
 type ProcessedEvent struct {
 	EventID     uuid.UUID
 	ProcessedAt time.Time
@@ -25,8 +51,23 @@ type ProcessedEvent struct {
 type BidPlacedEvent struct {
 	EventID   uuid.UUID
 	UserID    uuid.UUID
+	ItemID    uuid.UUID
 	Amount    int64
 	Timestamp time.Time
+
+	// PreviousBidderID is uuid.Nil for the first bid on an item
+	PreviousBidderID uuid.UUID
+	PreviousAmount   int64
+}
+
+// OutbidNotification tells a bidder that someone placed a higher bid on their item
+type OutbidNotification struct {
+	BidID          uuid.UUID // the bid that outbid the user; unique per notification
+	UserID         uuid.UUID
+	ItemID         uuid.UUID
+	PreviousAmount int64
+	NewAmount      int64
+	CreatedAt      time.Time
 }
 
 // UserCreatedEvent represents the domain event for a new user
@@ -38,3 +79,9 @@ type UserCreatedEvent struct {
 	CountryCode string
 	CreatedAt   time.Time
 }
+
+// UserDeletedEvent represents the domain event for a deleted account
+type UserDeletedEvent struct {
+	UserID    uuid.UUID
+	DeletedAt time.Time
+}
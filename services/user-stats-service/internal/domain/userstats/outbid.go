@@ -0,0 +1,57 @@
+package userstats
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// OutbidOutcome says what ProcessOutbid did with an event
+type OutbidOutcome int
+
+const (
+	// OutbidNotApplicable means the event displaced nobody
+	OutbidNotApplicable OutbidOutcome = iota
+	// OutbidRecorded means a notification was stored for the displaced bidder
+	OutbidRecorded
+	// OutbidDuplicate means nothing was stored: the bid already has a notification
+	// (a redelivered event) or the displaced bidder has been deleted
+	OutbidDuplicate
+)
+
+// OutbidNotifier turns bid events into "you were outbid" notifications.
+// Delivery is out of scope: notifications are stored for a sender to pick up.
+type OutbidNotifier struct {
+	repo NotificationRepository
+}
+
+func NewOutbidNotifier(repo NotificationRepository) *OutbidNotifier {
+	return &OutbidNotifier{repo: repo}
+}
+
+// ProcessOutbid records a notification for the bidder the event displaced and reports
+// the outcome. Nobody is outbid by the first bid on an item or by a bidder raising their
+// own winning bid. Redelivered events do not create duplicates.
+func (n *OutbidNotifier) ProcessOutbid(ctx context.Context, event BidPlacedEvent) (OutbidOutcome, error) {
+	if event.PreviousBidderID == uuid.Nil || event.PreviousBidderID == event.UserID {
+		return OutbidNotApplicable, nil
+	}
+
+	notification := &OutbidNotification{
+		BidID:          event.EventID,
+		UserID:         event.PreviousBidderID,
+		ItemID:         event.ItemID,
+		PreviousAmount: event.PreviousAmount,
+		NewAmount:      event.Amount,
+		CreatedAt:      event.Timestamp,
+	}
+	created, err := n.repo.CreateOutbidNotification(ctx, notification)
+	if err != nil {
+		return OutbidNotApplicable, fmt.Errorf("failed to create outbid notification: %w", err)
+	}
+	if !created {
+		return OutbidDuplicate, nil
+	}
+	return OutbidRecorded, nil
+}
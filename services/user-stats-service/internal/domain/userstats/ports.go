@@ -9,8 +9,8 @@ import (
 )
 
 type Repository interface {
-	// IncrementUserStats increments the bid count and total amount for a user (Upsert)
-	IncrementUserStats(ctx context.Context, tx pgx.Tx, userID uuid.UUID, amount int64, lastBidAt time.Time) error
+	// IncrementUserStats increments the bid count and total amount for a user by bidID (Upsert)
+	IncrementUserStats(ctx context.Context, tx pgx.Tx, userID, bidID uuid.UUID, amount int64, lastBidAt time.Time) error
 
 	// CreateUserStats initializes stats for a new user (Idempotent)
 	CreateUserStats(ctx context.Context, tx pgx.Tx, userID uuid.UUID, createdAt time.Time) error
@@ -18,9 +18,33 @@ type Repository interface {
 	// GetUserStats retrieves stats for a user
 	GetUserStats(ctx context.Context, userID uuid.UUID) (*UserStats, error)
 
-	// MarkEventProcessed marks an event as processed to prevent duplicates
-	MarkEventProcessed(ctx context.Context, tx pgx.Tx, eventID uuid.UUID) error
+	// LockUserStats retrieves stats for a user and locks the row until tx ends; nil if none
+	LockUserStats(ctx context.Context, tx pgx.Tx, userID uuid.UUID) (*UserStats, error)
 
-	// IsEventProcessed checks if an event has already been processed
-	IsEventProcessed(ctx context.Context, tx pgx.Tx, eventID uuid.UUID) (bool, error)
+	// ReplaceUserStats overwrites a user's bid stats with recomputed totals, keeping the
+	// bids counted from events placed after totals.AsOf, and returns what it wrote (Upsert)
+	ReplaceUserStats(ctx context.Context, tx pgx.Tx, userID uuid.UUID, totals BidTotals) (BidTotals, error)
+
+	// ListUserIDs returns up to limit user IDs with stats, in ascending order after the given one
+	ListUserIDs(ctx context.Context, after uuid.UUID, limit int) ([]uuid.UUID, error)
+
+	// MarkEventProcessed records an event as processed within tx and reports whether
+	// this call recorded it; false means the event was already processed (Race-safe)
+	MarkEventProcessed(ctx context.Context, tx pgx.Tx, eventID uuid.UUID) (bool, error)
+
+	// DeleteUserData erases the user's stats and notifications and stops later events
+	// from recreating them (Idempotent)
+	DeleteUserData(ctx context.Context, tx pgx.Tx, userID uuid.UUID, deletedAt time.Time) error
+}
+
+type NotificationRepository interface {
+	// CreateOutbidNotification stores a notification and reports whether this call stored it;
+	// a second call for the same BidID is a no-op that returns false
+	CreateOutbidNotification(ctx context.Context, n *OutbidNotification) (bool, error)
+}
+
+// BidTotalsSource provides a user's bid totals from the authoritative bids data. Stats live
+// in this service's own database, so the bid service is the only place they can come from.
+type BidTotalsSource interface {
+	UserBidTotals(ctx context.Context, userID uuid.UUID) (BidTotals, error)
 }
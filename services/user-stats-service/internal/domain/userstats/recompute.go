@@ -0,0 +1,99 @@
+package userstats
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/floroz/gavel/pkg/database"
+)
+
+// Recomputer rebuilds user stats from the bid service's totals, to repair drift left by
+// lost or double-applied events
+type Recomputer struct {
+	repo      Repository
+	txManager database.TransactionManager
+	source    BidTotalsSource
+}
+
+func NewRecomputer(repo Repository, txManager database.TransactionManager, source BidTotalsSource) *Recomputer {
+	return &Recomputer{
+		repo:      repo,
+		txManager: txManager,
+		source:    source,
+	}
+}
+
+// RecomputeUserStats replaces the user's total_bids_placed, total_amount_bid and last_bid_at
+// with the bid service's figures. Those trail the clock, so bids in flight while they are
+// read come after AsOf: their events are counted whether they arrived before the replace
+// (see ReplaceUserStats) or after it (see IncrementUserStats). Deleted users are left alone.
+func (r *Recomputer) RecomputeUserStats(ctx context.Context, userID uuid.UUID) (*Recomputation, error) {
+	actual, err := r.source.UserBidTotals(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bid totals: %w", err)
+	}
+
+	tx, err := r.txManager.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	stored, err := r.repo.LockUserStats(ctx, tx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock user stats: %w", err)
+	}
+
+	rec := &Recomputation{UserID: userID}
+	if stored != nil {
+		rec.Stored = BidTotals{
+			Count:       stored.TotalBidsPlaced,
+			TotalAmount: stored.TotalAmountBid,
+			LastBidAt:   stored.LastBidAt,
+		}
+	}
+
+	rec.Actual, err = r.repo.ReplaceUserStats(ctx, tx, userID, actual)
+	if err != nil {
+		return nil, fmt.Errorf("failed to replace user stats: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return rec, nil
+}
+
+// RecomputeAllUserStats recomputes every user with stats, batchSize users at a time, and
+// returns the ones whose stats had drifted
+func (r *Recomputer) RecomputeAllUserStats(ctx context.Context, batchSize int) ([]*Recomputation, error) {
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+
+	var drifted []*Recomputation
+	after := uuid.Nil
+	for {
+		ids, err := r.repo.ListUserIDs(ctx, after, batchSize)
+		if err != nil {
+			return drifted, fmt.Errorf("failed to list users: %w", err)
+		}
+		for _, id := range ids {
+			rec, err := r.RecomputeUserStats(ctx, id)
+			if err != nil {
+				return drifted, err
+			}
+			if rec.Drifted() {
+				drifted = append(drifted, rec)
+			}
+		}
+		if len(ids) < batchSize {
+			return drifted, nil
+		}
+		after = ids[len(ids)-1]
+	}
+}
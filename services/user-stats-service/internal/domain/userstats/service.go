@@ -31,28 +31,24 @@ func (s *Service) ProcessBidPlaced(ctx context.Context, event BidPlacedEvent) er
 		_ = tx.Rollback(ctx)
 	}()
 
-	// 2. Check Idempotency (Has this event been processed?)
-	isProcessed, err := s.repo.IsEventProcessed(ctx, tx, event.EventID)
+	// 2. Claim the event first, so two deliveries can't both pass a check before either marks it
+	claimed, err := s.repo.MarkEventProcessed(ctx, tx, event.EventID)
 	if err != nil {
-		return fmt.Errorf("failed to check idempotency: %w", err)
+		return fmt.Errorf("failed to mark event as processed: %w", err)
 	}
-	if isProcessed {
+	if !claimed {
 		// Already processed, acknowledge and return (Idempotent Success)
 		return nil
 	}
 
 	// 3. Update User Stats (Increment/Upsert)
 	// We no longer construct a struct with "1". We explicitly call Increment.
-	if err := s.repo.IncrementUserStats(ctx, tx, event.UserID, event.Amount, event.Timestamp); err != nil {
+	// The event id is the bid id
+	if err := s.repo.IncrementUserStats(ctx, tx, event.UserID, event.EventID, event.Amount, event.Timestamp); err != nil {
 		return fmt.Errorf("failed to increment user stats: %w", err)
 	}
 
-	// 4. Mark Event as Processed
-	if err := s.repo.MarkEventProcessed(ctx, tx, event.EventID); err != nil {
-		return fmt.Errorf("failed to mark event as processed: %w", err)
-	}
-
-	// 5. Commit
+	// 4. Commit
 	if err := tx.Commit(ctx); err != nil {
 		return fmt.Errorf("failed to commit transaction: %w", err)
 	}
@@ -70,12 +66,12 @@ func (s *Service) ProcessUserCreated(ctx context.Context, event UserCreatedEvent
 		_ = tx.Rollback(ctx)
 	}()
 
-	// 2. Check Idempotency
-	isProcessed, err := s.repo.IsEventProcessed(ctx, tx, event.EventID)
+	// 2. Claim the event (Idempotency)
+	claimed, err := s.repo.MarkEventProcessed(ctx, tx, event.EventID)
 	if err != nil {
-		return fmt.Errorf("failed to check idempotency: %w", err)
+		return fmt.Errorf("failed to mark event as processed: %w", err)
 	}
-	if isProcessed {
+	if !claimed {
 		return nil
 	}
 
@@ -84,16 +80,32 @@ func (s *Service) ProcessUserCreated(ctx context.Context, event UserCreatedEvent
 		return fmt.Errorf("failed to create user stats: %w", err)
 	}
 
-	// 4. Mark Event as Processed
-	if err := s.repo.MarkEventProcessed(ctx, tx, event.EventID); err != nil {
-		return fmt.Errorf("failed to mark event as processed: %w", err)
+	// 4. Commit
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// ProcessUserDeleted erases everything held about the user. Deletion is idempotent by
+// itself, so unlike the other events it needs no processed_events entry.
+func (s *Service) ProcessUserDeleted(ctx context.Context, event UserDeletedEvent) error {
+	tx, err := s.txManager.BeginTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	if err := s.repo.DeleteUserData(ctx, tx, event.UserID, event.DeletedAt); err != nil {
+		return fmt.Errorf("failed to delete user data: %w", err)
 	}
 
-	// 5. Commit
 	if err := tx.Commit(ctx); err != nil {
 		return fmt.Errorf("failed to commit transaction: %w", err)
 	}
-
 	return nil
 }
 
@@ -0,0 +1,16 @@
+-- +goose Up
+-- Pending "you were outbid" notifications, one per outbidding bid.
+-- bid_id is the BidPlaced event that caused it, which makes inserts idempotent.
+CREATE TABLE outbid_notifications (
+    bid_id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,          -- the bidder who was outbid
+    item_id UUID NOT NULL,
+    previous_amount BIGINT NOT NULL,
+    new_amount BIGINT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX idx_outbid_notifications_user_id ON outbid_notifications(user_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS outbid_notifications;
@@ -0,0 +1,18 @@
+-- +goose Up
+-- Also fires on the DO UPDATE branch of the stats upsert, so the worker no longer sets updated_at
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION set_updated_at() RETURNS trigger AS $$
+BEGIN
+    NEW.updated_at = NOW();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER user_stats_set_updated_at
+    BEFORE UPDATE ON user_stats
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+-- +goose Down
+DROP TRIGGER IF EXISTS user_stats_set_updated_at ON user_stats;
+DROP FUNCTION IF EXISTS set_updated_at();
@@ -0,0 +1,10 @@
+-- +goose Up
+-- Users erased after a user.deleted event. Only the id is kept, so that bid events
+-- still queued for the user cannot recreate the stats that were just deleted.
+CREATE TABLE deleted_users (
+    user_id UUID PRIMARY KEY,
+    deleted_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS deleted_users;
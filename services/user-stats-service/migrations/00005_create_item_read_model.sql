@@ -0,0 +1,28 @@
+-- +goose Up
+-- Denormalized listing view of every item, built from bid-service events so listing
+-- queries never touch the bid database. Events may arrive in any order: each one creates
+-- the row if needed and only moves its columns forward, so the final state does not
+-- depend on delivery order.
+CREATE TABLE item_read_model (
+    item_id UUID PRIMARY KEY,
+    seller_id UUID,                            -- NULL until item.created is applied
+    title TEXT NOT NULL DEFAULT '',
+    category TEXT NOT NULL DEFAULT '',
+    details_at TIMESTAMP WITH TIME ZONE,       -- when title and category were last set
+    status TEXT NOT NULL DEFAULT 'active',
+    start_price BIGINT NOT NULL DEFAULT 0,
+    current_price BIGINT NOT NULL DEFAULT 0,
+    bid_count BIGINT NOT NULL DEFAULT 0,
+    start_at TIMESTAMP WITH TIME ZONE,         -- scheduled items open here without an event
+    end_at TIMESTAMP WITH TIME ZONE,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_item_read_model_status_end_at ON item_read_model(status, end_at);
+
+CREATE TRIGGER item_read_model_set_updated_at
+    BEFORE UPDATE ON item_read_model
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+-- +goose Down
+DROP TABLE IF EXISTS item_read_model;
@@ -0,0 +1,8 @@
+-- +goose Up
+-- Set when a row is rebuilt from the bid service's totals: the totals covered every bid
+-- placed up to this time, so bid.placed events at or before it are already counted and
+-- must not be applied again. NULL for rows only ever built from events.
+ALTER TABLE user_stats ADD COLUMN recomputed_through TIMESTAMP WITH TIME ZONE;
+
+-- +goose Down
+ALTER TABLE user_stats DROP COLUMN IF EXISTS recomputed_through;
@@ -0,0 +1,26 @@
+-- +goose Up
+-- Per-item bid totals maintained by the read model consumer as bid.placed events arrive,
+-- so summaries are a primary-key lookup instead of COUNT/MAX over the bids.
+CREATE TABLE item_bid_aggregates (
+    item_id UUID PRIMARY KEY,
+    bid_count BIGINT NOT NULL DEFAULT 0,
+    distinct_bidders BIGINT NOT NULL DEFAULT 0,
+    highest_bid BIGINT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TRIGGER item_bid_aggregates_set_updated_at
+    BEFORE UPDATE ON item_bid_aggregates
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+-- Who has bid on each item. distinct_bidders only grows when a bid adds a row here, which
+-- keeps the count exact under concurrent and repeated bids from the same user.
+CREATE TABLE item_bidders (
+    item_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    PRIMARY KEY (item_id, user_id)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS item_bidders;
+DROP TABLE IF EXISTS item_bid_aggregates;
@@ -0,0 +1,15 @@
+-- +goose Up
+-- The bids counted into user_stats from events, so a recompute can add back the ones newer
+-- than the bid service's totals instead of overwriting them. Rows the totals cover are
+-- removed by the recompute, and older ones are pruned as events arrive.
+CREATE TABLE user_stats_recent_bids (
+    bid_id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    amount BIGINT NOT NULL,
+    placed_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX idx_user_stats_recent_bids_user_placed ON user_stats_recent_bids(user_id, placed_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS user_stats_recent_bids;